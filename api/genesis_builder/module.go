@@ -83,6 +83,7 @@ func (m Module) CreateDefaultConfig() int64 {
 // - dataLen: Length of the data.
 // which represent the SCALE-encoded serialised JSON genesis configuration.
 // The serialised bytes must contain the genesis configuration for each runtime module.
+// The in-code storage version of each module is stored as well.
 func (m Module) BuildConfig(dataPtr int32, dataLen int32) int64 {
 	gcJsonBytes := m.memUtils.GetWasmMemorySlice(dataPtr, dataLen)
 	gcDecoded, err := sc.DecodeSequence[sc.U8](bytes.NewBuffer(gcJsonBytes))
@@ -93,13 +94,15 @@ func (m Module) BuildConfig(dataPtr int32, dataLen int32) int64 {
 	gcDecodedBytes := sc.SequenceU8ToBytes(gcDecoded)
 
 	for _, module := range m.modules {
-		genesisBuilder, ok := module.(GenesisBuilder)
-		if !ok {
-			continue
+		if genesisBuilder, ok := module.(GenesisBuilder); ok {
+			if err := genesisBuilder.BuildConfig(gcDecodedBytes); err != nil {
+				m.logger.Critical(err.Error())
+			}
 		}
 
-		if err := genesisBuilder.BuildConfig(gcDecodedBytes); err != nil {
-			m.logger.Critical(err.Error())
+		// The genesis storage is in the layout expected by the code of the module.
+		if storageVersioned, ok := module.(primitives.StorageVersioned); ok {
+			storageVersioned.PutInCodeStorageVersion()
 		}
 	}
 
@@ -70,6 +70,7 @@ func Test_CreateDefaultConfig_Error(t *testing.T) {
 func Test_BuildConfig(t *testing.T) {
 	setup()
 	mockModule.On("BuildConfig", genesis).Return(nil)
+	mockModule.On("PutInCodeStorageVersion").Return()
 	mockMemoryUtils.On("GetWasmMemorySlice", int32(0), int32(0)).Return(genesisSequence)
 	mockMemoryUtils.On("BytesToOffsetAndSize", []byte{0}).Return(int64(0))
 
@@ -77,6 +78,7 @@ func Test_BuildConfig(t *testing.T) {
 
 	mockMemoryUtils.AssertCalled(t, "GetWasmMemorySlice", int32(0), int32(0))
 	mockModule.AssertCalled(t, "BuildConfig", genesis)
+	mockModule.AssertCalled(t, "PutInCodeStorageVersion")
 	mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", []byte{0})
 }
 
@@ -57,6 +57,16 @@ func (m Module) Authorities() int64 {
 	return m.memUtils.BytesToOffsetAndSize(authorities.Bytes())
 }
 
+// CurrentSetId returns the id of the current set of Grandpa authorities.
+// Returns a pointer-size of the SCALE-encoded set id.
+func (m Module) CurrentSetId() int64 {
+	setId, err := m.grandpa.CurrentSetId()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+	return m.memUtils.BytesToOffsetAndSize(setId.Bytes())
+}
+
 // Metadata returns the runtime api metadata of the module.
 func (m Module) Metadata() primitives.RuntimeApiMetadata {
 	methods := sc.Sequence[primitives.RuntimeApiMethodMetadata]{
@@ -73,6 +83,14 @@ func (m Module) Metadata() primitives.RuntimeApiMetadata {
 				" is finalized by the authorities from block B-1.",
 			},
 		},
+		primitives.RuntimeApiMethodMetadata{
+			Name:   "current_set_id",
+			Inputs: sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+			Output: sc.ToCompact(metadata.PrimitiveTypesU64),
+			Docs: sc.Sequence[sc.Str]{
+				" Get current GRANDPA authority set id.",
+			},
+		},
 	}
 
 	return primitives.RuntimeApiMetadata{
@@ -80,6 +80,34 @@ func Test_Authorities_Panics(t *testing.T) {
 	mockGrandpa.AssertCalled(t, "Authorities")
 }
 
+func Test_CurrentSetId(t *testing.T) {
+	setup()
+
+	setId := sc.U64(2)
+
+	mockGrandpa.On("CurrentSetId").Return(setId, nil)
+	mockMemoryUtils.On("BytesToOffsetAndSize", setId.Bytes()).Return(int64(13))
+
+	target.CurrentSetId()
+
+	mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", setId.Bytes())
+	mockMemoryUtils.AssertNumberOfCalls(t, "BytesToOffsetAndSize", 1)
+}
+
+func Test_CurrentSetId_Panics(t *testing.T) {
+	setup()
+
+	expectedErr := errors.New("panic")
+
+	mockGrandpa.On("CurrentSetId").Return(sc.U64(0), expectedErr)
+	assert.PanicsWithValue(t,
+		expectedErr.Error(),
+		func() { target.CurrentSetId() },
+	)
+
+	mockGrandpa.AssertCalled(t, "CurrentSetId")
+}
+
 func Test_Module_Metadata(t *testing.T) {
 	setup()
 
@@ -99,6 +127,14 @@ func Test_Module_Metadata(t *testing.T) {
 					" is finalized by the authorities from block B-1.",
 				},
 			},
+			types.RuntimeApiMethodMetadata{
+				Name:   "current_set_id",
+				Inputs: sc.Sequence[types.RuntimeApiMethodParamMetadata]{},
+				Output: sc.ToCompact(metadata.PrimitiveTypesU64),
+				Docs: sc.Sequence[sc.Str]{
+					" Get current GRANDPA authority set id.",
+				},
+			},
 		},
 		Docs: sc.Sequence[sc.Str]{
 			" APIs for integrating the GRANDPA finality gadget into runtimes.",
@@ -0,0 +1,124 @@
+package try_runtime
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/executive"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+const (
+	ApiModuleName = "TryRuntime"
+	apiVersion    = 1
+)
+
+// Variants of UpgradeCheckSelect, which select the checks executed on runtime upgrade.
+const (
+	UpgradeCheckSelectNone sc.U8 = iota
+	UpgradeCheckSelectAll
+	UpgradeCheckSelectPreAndPost
+	UpgradeCheckSelectTryState
+)
+
+// Module implements the TryRuntime API, used to dry-run runtime upgrades and blocks against
+// a snapshot of live state before enacting them. It is exported only in runtimes built with the
+// `tryruntime` build tag.
+type Module struct {
+	executive    executive.Module
+	blockWeights primitives.BlockWeights
+	decoder      types.RuntimeDecoder
+	memUtils     utils.WasmMemoryTranslator
+	logger       log.Logger
+}
+
+func New(executive executive.Module, blockWeights primitives.BlockWeights, decoder types.RuntimeDecoder, logger log.Logger) Module {
+	return Module{
+		executive:    executive,
+		blockWeights: blockWeights,
+		decoder:      decoder,
+		memUtils:     utils.NewMemoryTranslator(),
+		logger:       logger,
+	}
+}
+
+// Name returns the name of the api module.
+func (m Module) Name() string {
+	return ApiModuleName
+}
+
+// Item returns the first 8 bytes of the Blake2b hash of the name and version of the api module.
+func (m Module) Item() primitives.ApiItem {
+	hash := hashing.MustBlake2b8([]byte(ApiModuleName))
+	return primitives.NewApiItem(hash, apiVersion)
+}
+
+// OnRuntimeUpgrade executes the runtime upgrade hooks of the runtime migrations and all modules.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded UpgradeCheckSelect. PreUpgrade and PostUpgrade checks are
+// executed for UpgradeCheckSelectAll and UpgradeCheckSelectPreAndPost.
+//
+// Returns a pointer-size of the SCALE-encoded tuple of the consumed weight and the maximum block weight.
+func (m Module) OnRuntimeUpgrade(dataPtr int32, dataLen int32) int64 {
+	data := m.memUtils.GetWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(data)
+	checks, err := sc.DecodeU8(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	if checks == UpgradeCheckSelectAll || checks == UpgradeCheckSelectTryState {
+		m.logger.Warn("try-state checks are not supported, skipping")
+	}
+
+	weight, err := m.executive.TryRuntimeUpgrade(checks == UpgradeCheckSelectAll || checks == UpgradeCheckSelectPreAndPost)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	maxBlock := m.blockWeights.MaxBlock
+	if weight.AnyGt(maxBlock) {
+		m.logger.Warnf("the consumed weight of the runtime upgrade [%v] exceeds the maximum block weight [%v]", weight, maxBlock)
+	}
+
+	encoded := append(weight.Bytes(), maxBlock.Bytes()...)
+	return m.memUtils.BytesToOffsetAndSize(encoded)
+}
+
+// ExecuteBlock executes the provided block, optionally skipping the state root and signature checks.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded block, state root check flag, signature check flag and TryStateSelect.
+// Try-state checks are not supported and TryStateSelect is ignored.
+//
+// Returns a pointer-size of the SCALE-encoded consumed weight of the block.
+func (m Module) ExecuteBlock(dataPtr int32, dataLen int32) int64 {
+	data := m.memUtils.GetWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(data)
+	block, err := m.decoder.DecodeBlock(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+	stateRootCheck, err := sc.DecodeBool(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+	signatureCheck, err := sc.DecodeBool(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	weight, err := m.executive.TryExecuteBlock(block, bool(stateRootCheck), bool(signatureCheck))
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	return m.memUtils.BytesToOffsetAndSize(weight.Bytes())
+}
@@ -0,0 +1,137 @@
+package try_runtime
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	dataPtr    = int32(0)
+	dataLen    = int32(1)
+	ptrAndSize = int64(2)
+
+	blockWeights = primitives.BlockWeights{
+		MaxBlock: primitives.WeightFromParts(10, 10),
+	}
+	weight = primitives.WeightFromParts(3, 4)
+	block  = types.NewBlock(primitives.Header{Number: 2}, sc.Sequence[primitives.UncheckedExtrinsic]{})
+
+	errPanic = errors.New("panic")
+)
+
+var (
+	mockExecutive      *mocks.Executive
+	mockRuntimeDecoder *mocks.RuntimeDecoder
+	mockMemoryUtils    *mocks.MemoryTranslator
+)
+
+func Test_Module_Name(t *testing.T) {
+	target := setup()
+
+	result := target.Name()
+
+	assert.Equal(t, ApiModuleName, result)
+}
+
+func Test_Module_Item(t *testing.T) {
+	target := setup()
+
+	hexName := common.MustBlake2b8([]byte(ApiModuleName))
+	expect := primitives.NewApiItem(hexName, apiVersion)
+
+	result := target.Item()
+
+	assert.Equal(t, expect, result)
+}
+
+func Test_Module_OnRuntimeUpgrade(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		checkSelect sc.U8
+		checks      bool
+	}{
+		{name: "None", checkSelect: UpgradeCheckSelectNone, checks: false},
+		{name: "All", checkSelect: UpgradeCheckSelectAll, checks: true},
+		{name: "PreAndPost", checkSelect: UpgradeCheckSelectPreAndPost, checks: true},
+		{name: "TryState", checkSelect: UpgradeCheckSelectTryState, checks: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setup()
+			expect := append(weight.Bytes(), blockWeights.MaxBlock.Bytes()...)
+
+			mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(tt.checkSelect.Bytes())
+			mockExecutive.On("TryRuntimeUpgrade", tt.checks).Return(weight, nil)
+			mockMemoryUtils.On("BytesToOffsetAndSize", expect).Return(ptrAndSize)
+
+			result := target.OnRuntimeUpgrade(dataPtr, dataLen)
+
+			assert.Equal(t, ptrAndSize, result)
+			mockExecutive.AssertCalled(t, "TryRuntimeUpgrade", tt.checks)
+			mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", expect)
+		})
+	}
+}
+
+func Test_Module_OnRuntimeUpgrade_Panics(t *testing.T) {
+	target := setup()
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(UpgradeCheckSelectPreAndPost.Bytes())
+	mockExecutive.On("TryRuntimeUpgrade", true).Return(primitives.WeightZero(), errPanic)
+
+	assert.PanicsWithValue(t,
+		errPanic.Error(),
+		func() { target.OnRuntimeUpgrade(dataPtr, dataLen) },
+	)
+}
+
+func Test_Module_ExecuteBlock(t *testing.T) {
+	target := setup()
+	data := []byte{0, 1, 0}
+	buffer := bytes.NewBuffer(data)
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(data)
+	mockRuntimeDecoder.On("DecodeBlock", buffer).Return(block, nil)
+	mockExecutive.On("TryExecuteBlock", block, false, true).Return(weight, nil)
+	mockMemoryUtils.On("BytesToOffsetAndSize", weight.Bytes()).Return(ptrAndSize)
+
+	result := target.ExecuteBlock(dataPtr, dataLen)
+
+	assert.Equal(t, ptrAndSize, result)
+	mockRuntimeDecoder.AssertExpectations(t)
+	mockExecutive.AssertCalled(t, "TryExecuteBlock", block, false, true)
+}
+
+func Test_Module_ExecuteBlock_Panics(t *testing.T) {
+	target := setup()
+	data := []byte{1, 1, 0}
+	buffer := bytes.NewBuffer(data)
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(data)
+	mockRuntimeDecoder.On("DecodeBlock", buffer).Return(block, nil)
+	mockExecutive.On("TryExecuteBlock", block, true, true).Return(primitives.WeightZero(), errPanic)
+
+	assert.PanicsWithValue(t,
+		errPanic.Error(),
+		func() { target.ExecuteBlock(dataPtr, dataLen) },
+	)
+}
+
+func setup() Module {
+	mockExecutive = new(mocks.Executive)
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockMemoryUtils = new(mocks.MemoryTranslator)
+
+	target := New(mockExecutive, blockWeights, mockRuntimeDecoder, log.NewLogger())
+	target.memUtils = mockMemoryUtils
+
+	return target
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ChainSafe/gossamer/pkg/scale"
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/primitives/benchmarking"
 	benchmarkingtypes "github.com/LimeChain/gosemble/primitives/benchmarking"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -91,6 +92,48 @@ func (i *Instance) Storage() *runtime.Storage {
 	return i.storage
 }
 
+// Returns the storage keys which start with the specified prefix, in lexicographic order
+func (i *Instance) IterKeys(prefix []byte) [][]byte {
+	keys := [][]byte{}
+
+	key := (*i.storage).NextKey(prefix)
+	for len(key) > 0 && bytes.HasPrefix(key, prefix) {
+		keys = append(keys, key)
+		key = (*i.storage).NextKey(key)
+	}
+
+	return keys
+}
+
+// Returns the encoded keys of all entries in the specified storage map.
+// The hasher of the map must keep the keys recoverable, as the concat hashers do.
+func (i *Instance) IterMapKeys(module string, name string, hasher support.StorageHasher) [][]byte {
+	return i.IterMapPrefix(module, name, hasher, []byte{})
+}
+
+// Returns the encoded keys of the entries in the specified storage map, which start with keyPrefix.
+// The hasher of the map must keep the keys recoverable, as the concat hashers do.
+func (i *Instance) IterMapPrefix(module string, name string, hasher support.StorageHasher, keyPrefix []byte) [][]byte {
+	moduleHash, _ := common.Twox128Hash([]byte(module))
+	nameHash, _ := common.Twox128Hash([]byte(name))
+	prefix := append(moduleHash, nameHash...)
+
+	keys := [][]byte{}
+	for _, storageKey := range i.IterKeys(prefix) {
+		key := hasher.Reverse(storageKey[len(prefix):])
+		if bytes.HasPrefix(key, keyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// Returns the public keys of all accounts in the System.Account storage map
+func (i *Instance) Accounts() [][]byte {
+	return i.IterMapKeys("System", "Account", support.NewHasherBlake128Concat())
+}
+
 // Sets the specified account info for the specified public key
 func (i *Instance) SetAccountInfo(publicKey []byte, accountInfo gossamertypes.AccountInfo) error {
 	bAccountInfo, err := scale.Marshal(accountInfo)
@@ -121,6 +164,11 @@ func (i *Instance) GetAccountInfo(publicKey []byte) (gossamertypes.AccountInfo,
 		},
 	}
 
+	// Reaped accounts have no entry in storage.
+	if len(bytesStorage) == 0 {
+		return accountInfo, nil
+	}
+
 	err := scale.Unmarshal(bytesStorage, &accountInfo)
 
 	return accountInfo, err
@@ -10,6 +10,7 @@ import (
 	wazero_runtime "github.com/ChainSafe/gossamer/lib/runtime/wazero"
 	"github.com/ChainSafe/gossamer/pkg/trie"
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/stretchr/testify/assert"
@@ -75,5 +76,11 @@ func TestInstance(t *testing.T) {
 		assert.Equal(t, errors.New("failed to create new call: module Invalid not found in metadata for call Invalid.invalid"), err)
 
 		assert.Equal(t, testAccStorageKey, accountStorageKey([]byte("test")))
+
+		err = (*instance.Storage()).Put(testAccStorageKey, []byte{0})
+		assert.NoError(t, err)
+		assert.Contains(t, instance.Accounts(), []byte("test"))
+		assert.Equal(t, [][]byte{[]byte("test")}, instance.IterMapPrefix("System", "Account", support.NewHasherBlake128Concat(), []byte("te")))
+		assert.Equal(t, [][]byte{}, instance.IterMapPrefix("System", "Account", support.NewHasherBlake128Concat(), []byte("x")))
 	})
 }
@@ -175,4 +175,96 @@ const (
 	TypesSequenceKeyValue
 
 	TypesCodeUpgradeAuthorization
+
+	TypesOptionAddress32
+
+	TypesSudoEvent
+	TypesSudoErrors
+
+	TypesUtilityEvent
+	TypesUtilityErrors
+
+	TypesBalancesReasons
+	TypesBalanceLock
+	TypesSequenceBalanceLock
+	TypesWeakBoundedVecBalanceLock
+
+	TypesReserveData
+	TypesSequenceReserveData
+	TypesBoundedVecReserveData
+
+	TypesIdAmount
+	TypesSequenceIdAmount
+	TypesBoundedVecIdAmount
+
+	TypesFixedI64
+	TypesPermill
+	TypesPercent
+	TypesPerquintill
+
+	TypesWeightToFeeCoefficient
+	TypesSequenceWeightToFeeCoefficient
+
+	TypesMultiBlockMigrationsEvent
+	TypesMultiBlockMigrationsCursor
+	TypesMultiBlockMigrationsActiveCursor
+
+	TypesSequenceAddress32
+
+	TypesMultisigEvent
+	TypesMultisigErrors
+	TypesMultisigTimepoint
+	TypesMultisig
+	TypesTupleAddress32H256
+
+	TypesProxyType
+	TypesProxyEvent
+	TypesProxyErrors
+	TypesProxyDefinition
+	TypesSequenceProxyDefinition
+	TypesTupleSequenceProxyDefinitionU128
+	TypesProxyAnnouncement
+	TypesSequenceProxyAnnouncement
+	TypesTupleSequenceProxyAnnouncementU128
+
+	TypesVestingInfo
+	TypesSequenceVestingInfo
+	TypesVestingEvent
+	TypesVestingErrors
+
+	TypesTupleAddress32U128Bool
+	TypesIndicesEvent
+	TypesIndicesErrors
+
+	TypesTupleU64U32
+	TypesOptionTupleU64U32
+	TypesOptionFixedSequence32U8
+	TypesRawOrigin
+	TypesPreimageBounded
+	TypesSchedulerScheduled
+	TypesOptionSchedulerScheduled
+	TypesSequenceOptionSchedulerScheduled
+	TypesSchedulerEvent
+	TypesSchedulerErrors
+
+	TypesTupleAddress32U128
+	TypesOptionTupleAddress32U128
+	TypesOptionU32
+	TypesPreimageRequestStatus
+	TypesTupleH256U32
+	TypesPreimageEvent
+	TypesPreimageErrors
+
+	TypesSessionKeys
+	TypesTupleAddress32SessionKeys
+	TypesSequenceTupleAddress32SessionKeys
+	TypesTupleFixedSequence4U8SequenceU8
+	TypesSessionEvent
+	TypesSessionErrors
+
+	TypesOptionU64
+	TypesTupleU64U64
+	TypesGrandpaStoredState
+	TypesGrandpaStoredPendingChange
+	TypesGrandpaEvent
 )
@@ -2,14 +2,16 @@
 
 package env
 
+import "github.com/LimeChain/gosemble/env/emulator"
+
 /*
 	Allocator: Provides functionality for calling into the memory allocator.
 */
 
 func ExtAllocatorFreeVersion1(ptr int32) {
-	panic("not implemented")
+	emulator.Current().Memory.Free(ptr)
 }
 
 func ExtAllocatorMallocVersion1(size int32) int32 {
-	panic("not implemented")
+	return emulator.Current().Memory.Malloc(size)
 }
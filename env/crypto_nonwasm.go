@@ -2,46 +2,63 @@
 
 package env
 
+import "github.com/LimeChain/gosemble/env/emulator"
+
 /*
 	Crypto: Interfaces for working with crypto related types from within the runtime.
 */
 
+const (
+	keyTypeIdLength        = 4
+	publicKeyLength        = 32
+	signatureLength        = 64
+	ecdsaSignatureLength   = 65
+	ecdsaMessageHashLength = 32
+)
+
 func ExtCryptoEd25519GenerateVersion1(key_type_id int32, seed int64) int32 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return storeFixed(ext.CryptoEd25519Generate(loadFixed(key_type_id, keyTypeIdLength), load(seed)))
 }
 
 func ExtCryptoEd25519VerifyVersion1(sig int32, msg int64, key int32) int32 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return boolToInt32(ext.CryptoEd25519Verify(loadFixed(sig, signatureLength), load(msg), loadFixed(key, publicKeyLength)))
 }
 
+// Signatures are verified immediately, so a batch verification always succeeds.
 func ExtCryptoFinishBatchVerifyVersion1() int32 {
-	panic("not implemented")
+	return 1
 }
 
 func ExtCryptoSecp256k1EcdsaRecoverVersion2(sig int32, msg int32) int64 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return store(ext.CryptoSecp256k1EcdsaRecover(loadFixed(sig, ecdsaSignatureLength), loadFixed(msg, ecdsaMessageHashLength)))
 }
 
 func ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(sig int32, msg int32) int64 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return store(ext.CryptoSecp256k1EcdsaRecoverCompressed(loadFixed(sig, ecdsaSignatureLength), loadFixed(msg, ecdsaMessageHashLength)))
 }
 
 func ExtCryptoSr25519GenerateVersion1(key_type_id int32, seed int64) int32 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return storeFixed(ext.CryptoSr25519Generate(loadFixed(key_type_id, keyTypeIdLength), load(seed)))
 }
 
 func ExtCryptoSr25519PublicKeysVersion1(key_type_id int32) int64 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return store(ext.CryptoSr25519PublicKeys(loadFixed(key_type_id, keyTypeIdLength)))
 }
 
 func ExtCryptoSr25519SignVersion1(key_type_id int32, key int32, msg int64) int64 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return store(ext.CryptoSr25519Sign(loadFixed(key_type_id, keyTypeIdLength), loadFixed(key, publicKeyLength), load(msg)))
 }
 
 func ExtCryptoSr25519VerifyVersion2(sig int32, msg int64, key int32) int32 {
-	panic("not implemented")
+	ext := emulator.Current()
+	return boolToInt32(ext.CryptoSr25519Verify(loadFixed(sig, signatureLength), load(msg), loadFixed(key, publicKeyLength)))
 }
 
-func ExtCryptoStartBatchVerifyVersion1() {
-	panic("not implemented")
-}
+func ExtCryptoStartBatchVerifyVersion1() {}
@@ -0,0 +1,185 @@
+package emulator
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/vedhavyas/go-subkey"
+	subkeyEd25519 "github.com/vedhavyas/go-subkey/ed25519"
+	subkeySr25519 "github.com/vedhavyas/go-subkey/sr25519"
+)
+
+// KeyTypeId identifies the purpose of the keys in a Keystore, e.g. "aura" or "gran".
+type KeyTypeId [4]byte
+
+// Keystore keeps the key pairs generated by the runtime.
+type Keystore struct {
+	mu      sync.Mutex
+	ed25519 map[KeyTypeId][]subkey.KeyPair
+	sr25519 map[KeyTypeId][]subkey.KeyPair
+}
+
+func NewKeystore() *Keystore {
+	return &Keystore{
+		ed25519: map[KeyTypeId][]subkey.KeyPair{},
+		sr25519: map[KeyTypeId][]subkey.KeyPair{},
+	}
+}
+
+// Ed25519Generate generates an ed25519 key pair and stores it under keyType.
+// The key pair is derived from suri (e.g. "//Alice") if provided, otherwise it is random.
+func (k *Keystore) Ed25519Generate(keyType KeyTypeId, suri []byte) ([32]byte, error) {
+	return k.generate(k.ed25519, subkeyEd25519.Scheme{}, keyType, suri)
+}
+
+// Sr25519Generate generates an sr25519 key pair and stores it under keyType.
+// The key pair is derived from suri (e.g. "//Alice") if provided, otherwise it is random.
+func (k *Keystore) Sr25519Generate(keyType KeyTypeId, suri []byte) ([32]byte, error) {
+	return k.generate(k.sr25519, subkeySr25519.Scheme{}, keyType, suri)
+}
+
+// Sr25519PublicKeys returns the public keys of all sr25519 key pairs stored under keyType.
+func (k *Keystore) Sr25519PublicKeys(keyType KeyTypeId) [][32]byte {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	keys := make([][32]byte, 0, len(k.sr25519[keyType]))
+	for _, pair := range k.sr25519[keyType] {
+		keys = append(keys, toPublicKey(pair))
+	}
+	return keys
+}
+
+// Sr25519Sign signs msg with the sr25519 key pair of publicKey stored under keyType.
+// Returns false if there is no such key pair.
+func (k *Keystore) Sr25519Sign(keyType KeyTypeId, publicKey [32]byte, msg []byte) ([64]byte, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, pair := range k.sr25519[keyType] {
+		if toPublicKey(pair) != publicKey {
+			continue
+		}
+
+		signature, err := pair.Sign(msg)
+		if err != nil {
+			return [64]byte{}, false
+		}
+
+		var result [64]byte
+		copy(result[:], signature)
+		return result, true
+	}
+
+	return [64]byte{}, false
+}
+
+func (k *Keystore) generate(pairs map[KeyTypeId][]subkey.KeyPair, scheme subkey.Scheme, keyType KeyTypeId, suri []byte) ([32]byte, error) {
+	var pair subkey.KeyPair
+	var err error
+	if suri == nil {
+		pair, err = scheme.Generate()
+	} else {
+		pair, err = subkey.DeriveKeyPair(scheme, string(suri))
+	}
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	pairs[keyType] = append(pairs[keyType], pair)
+	return toPublicKey(pair), nil
+}
+
+func Ed25519Verify(signature [64]byte, msg []byte, publicKey [32]byte) bool {
+	return ed25519.Verify(publicKey[:], msg, signature[:])
+}
+
+func Sr25519Verify(signature [64]byte, msg []byte, publicKey [32]byte) bool {
+	key, err := subkeySr25519.Scheme{}.FromPublicKey(publicKey[:])
+	if err != nil {
+		return false
+	}
+	return key.Verify(msg, signature[:])
+}
+
+// EcdsaVerifyError is the reason for which an ECDSA public key could not be recovered.
+type EcdsaVerifyError byte
+
+const (
+	EcdsaVerifyErrorBadRS EcdsaVerifyError = iota
+	EcdsaVerifyErrorBadV
+	EcdsaVerifyErrorBadSignature
+)
+
+func (e EcdsaVerifyError) Error() string {
+	switch e {
+	case EcdsaVerifyErrorBadRS:
+		return "Incorrect value of R or S"
+	case EcdsaVerifyErrorBadV:
+		return "Incorrect value of V"
+	case EcdsaVerifyErrorBadSignature:
+		return "Invalid signature"
+	default:
+		return fmt.Sprintf("unknown ecdsa verify error [%d]", byte(e))
+	}
+}
+
+// Secp256k1EcdsaRecover recovers the 64-byte public key, without the type prefix, from a
+// 65-byte `r || s || v` signature of the 32-byte msg hash.
+func Secp256k1EcdsaRecover(signature [65]byte, msg [32]byte) ([64]byte, error) {
+	publicKey, err := recoverEcdsa(signature, msg)
+	if err != nil {
+		return [64]byte{}, err
+	}
+
+	var result [64]byte
+	copy(result[:], publicKey.SerializeUncompressed()[1:])
+	return result, nil
+}
+
+// Secp256k1EcdsaRecoverCompressed recovers the 33-byte compressed public key from a
+// 65-byte `r || s || v` signature of the 32-byte msg hash.
+func Secp256k1EcdsaRecoverCompressed(signature [65]byte, msg [32]byte) ([33]byte, error) {
+	publicKey, err := recoverEcdsa(signature, msg)
+	if err != nil {
+		return [33]byte{}, err
+	}
+
+	var result [33]byte
+	copy(result[:], publicKey.SerializeCompressed())
+	return result, nil
+}
+
+func recoverEcdsa(signature [65]byte, msg [32]byte) (*secp256k1.PublicKey, error) {
+	v := signature[64]
+	if v > 26 {
+		v -= 27
+	}
+	if v > 3 {
+		return nil, EcdsaVerifyErrorBadV
+	}
+
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:64]) || r.IsZero() || s.IsZero() {
+		return nil, EcdsaVerifyErrorBadRS
+	}
+
+	compact := append([]byte{27 + v}, signature[:64]...)
+	publicKey, _, err := ecdsa.RecoverCompact(compact, msg[:])
+	if err != nil {
+		return nil, EcdsaVerifyErrorBadSignature
+	}
+	return publicKey, nil
+}
+
+func toPublicKey(pair subkey.KeyPair) [32]byte {
+	var result [32]byte
+	copy(result[:], pair.Public())
+	return result
+}
@@ -0,0 +1,111 @@
+package emulator
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyTypeAura = KeyTypeId{'a', 'u', 'r', 'a'}
+	message     = []byte("message")
+)
+
+func Test_Keystore_Sr25519Generate_FromSuri(t *testing.T) {
+	target := NewKeystore()
+
+	publicKey, err := target.Sr25519Generate(keyTypeAura, []byte("//Alice"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d", hex.EncodeToString(publicKey[:]))
+	assert.Equal(t, [][32]byte{publicKey}, target.Sr25519PublicKeys(keyTypeAura))
+	assert.Empty(t, target.Sr25519PublicKeys(KeyTypeId{'g', 'r', 'a', 'n'}))
+}
+
+func Test_Keystore_Sr25519Sign_Sr25519Verify(t *testing.T) {
+	target := NewKeystore()
+	publicKey, err := target.Sr25519Generate(keyTypeAura, nil)
+	assert.NoError(t, err)
+
+	signature, ok := target.Sr25519Sign(keyTypeAura, publicKey, message)
+
+	assert.True(t, ok)
+	assert.True(t, Sr25519Verify(signature, message, publicKey))
+	assert.False(t, Sr25519Verify(signature, []byte("other"), publicKey))
+}
+
+func Test_Keystore_Sr25519Sign_UnknownKey(t *testing.T) {
+	target := NewKeystore()
+
+	_, ok := target.Sr25519Sign(keyTypeAura, [32]byte{1}, message)
+
+	assert.False(t, ok)
+}
+
+func Test_Keystore_Ed25519Generate_FromSuri(t *testing.T) {
+	target := NewKeystore()
+
+	publicKey, err := target.Ed25519Generate(keyTypeAura, []byte("//Alice"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee", hex.EncodeToString(publicKey[:]))
+}
+
+func Test_Keystore_Generate_InvalidSuri(t *testing.T) {
+	target := NewKeystore()
+
+	_, err := target.Ed25519Generate(keyTypeAura, []byte("//Alice//"))
+
+	assert.Error(t, err)
+}
+
+func Test_Ed25519Verify(t *testing.T) {
+	target := NewKeystore()
+	publicKey, err := target.Ed25519Generate(keyTypeAura, []byte("//Alice"))
+	assert.NoError(t, err)
+	pair := target.ed25519[keyTypeAura][0]
+
+	signature, err := pair.Sign(message)
+	assert.NoError(t, err)
+
+	var sig [64]byte
+	copy(sig[:], signature)
+	assert.True(t, Ed25519Verify(sig, message, publicKey))
+	assert.False(t, Ed25519Verify(sig, []byte("other"), publicKey))
+}
+
+func Test_Secp256k1EcdsaRecover(t *testing.T) {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	assert.NoError(t, err)
+	msg := Blake2256(message)
+
+	compact := ecdsa.SignCompact(privateKey, msg[:], false)
+	var signature [65]byte
+	copy(signature[:64], compact[1:])
+	signature[64] = compact[0] - 27
+
+	publicKey, err := Secp256k1EcdsaRecover(signature, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey.PubKey().SerializeUncompressed()[1:], publicKey[:])
+
+	compressed, err := Secp256k1EcdsaRecoverCompressed(signature, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey.PubKey().SerializeCompressed(), compressed[:])
+}
+
+func Test_Secp256k1EcdsaRecover_Errors(t *testing.T) {
+	msg := Blake2256(message)
+
+	var badV [65]byte
+	badV[64] = 4
+	_, err := Secp256k1EcdsaRecover(badV, msg)
+	assert.Equal(t, EcdsaVerifyErrorBadV, err)
+
+	var badRS [65]byte
+	_, err = Secp256k1EcdsaRecover(badRS, msg)
+	assert.Equal(t, EcdsaVerifyErrorBadRS, err)
+	assert.Equal(t, "Incorrect value of R or S", err.Error())
+}
@@ -0,0 +1,54 @@
+// Package emulator provides an in-memory implementation of the host functions, which the runtime
+// imports from the environment. It backs the `env` package when built with the `nonwasmenv` tag,
+// so runtime modules can be executed natively, e.g. with `go test -tags nonwasmenv -race`.
+package emulator
+
+import (
+	"os"
+	"sync"
+)
+
+// Externalities is the state of the host, which is accessible to the runtime.
+type Externalities struct {
+	Storage  *Storage
+	Memory   *Memory
+	Keystore *Keystore
+	Logger   *Logger
+}
+
+func New() *Externalities {
+	return &Externalities{
+		Storage:  NewStorage(),
+		Memory:   NewMemory(),
+		Keystore: NewKeystore(),
+		Logger:   NewLogger(os.Stdout, LogLevelInfo),
+	}
+}
+
+var (
+	mu      sync.RWMutex
+	current = New()
+)
+
+// Current returns the externalities used by the host functions.
+func Current() *Externalities {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return current
+}
+
+// Set replaces the externalities used by the host functions.
+func Set(ext *Externalities) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	current = ext
+}
+
+// Reset replaces the externalities used by the host functions with empty ones.
+func Reset() *Externalities {
+	ext := New()
+	Set(ext)
+	return ext
+}
@@ -0,0 +1,24 @@
+package emulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Reset(t *testing.T) {
+	Current().Storage.Set(keyA, []byte{1})
+
+	ext := Reset()
+
+	assert.Equal(t, ext, Current())
+	assert.False(t, Current().Storage.Exists(keyA))
+}
+
+func Test_Set(t *testing.T) {
+	ext := New()
+
+	Set(ext)
+
+	assert.Same(t, ext, Current())
+}
@@ -0,0 +1,47 @@
+package emulator
+
+import (
+	"encoding/binary"
+
+	"github.com/pierrec/xxHash/xxHash64"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+func Blake2128(data []byte) [16]byte {
+	hasher, err := blake2b.New(16, nil)
+	if err != nil {
+		panic(err)
+	}
+	hasher.Write(data)
+
+	var result [16]byte
+	copy(result[:], hasher.Sum(nil))
+	return result
+}
+
+func Blake2256(data []byte) [32]byte {
+	return blake2b.Sum256(data)
+}
+
+func Keccak256(data []byte) [32]byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+
+	var result [32]byte
+	copy(result[:], hasher.Sum(nil))
+	return result
+}
+
+func Twox64(data []byte) [8]byte {
+	var result [8]byte
+	binary.LittleEndian.PutUint64(result[:], xxHash64.Checksum(data, 0))
+	return result
+}
+
+func Twox128(data []byte) [16]byte {
+	var result [16]byte
+	binary.LittleEndian.PutUint64(result[:8], xxHash64.Checksum(data, 0))
+	binary.LittleEndian.PutUint64(result[8:], xxHash64.Checksum(data, 1))
+	return result
+}
@@ -0,0 +1,40 @@
+package emulator
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Blake2128(t *testing.T) {
+	result := Blake2128([]byte("abc"))
+
+	assert.Equal(t, "cf4ab791c62b8d2b2109c90275287816", hex.EncodeToString(result[:]))
+}
+
+func Test_Blake2256(t *testing.T) {
+	result := Blake2256([]byte("abc"))
+
+	assert.Equal(t, "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319", hex.EncodeToString(result[:]))
+}
+
+func Test_Keccak256(t *testing.T) {
+	result := Keccak256([]byte{})
+
+	assert.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(result[:]))
+}
+
+func Test_Twox64(t *testing.T) {
+	result := Twox64([]byte("System"))
+
+	assert.Equal(t, "26aa394eea5630e0", hex.EncodeToString(result[:]))
+}
+
+func Test_Twox128(t *testing.T) {
+	system := Twox128([]byte("System"))
+	balances := Twox128([]byte("Balances"))
+
+	assert.Equal(t, "26aa394eea5630e07c48ae0c9558cef7", hex.EncodeToString(system[:]))
+	assert.Equal(t, "c2261276cc9d1f8598ea4b6a74b15c2f", hex.EncodeToString(balances[:]))
+}
@@ -0,0 +1,168 @@
+package emulator
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// The methods below implement the host functions at the level of their SCALE encoded arguments
+// and results, as they are exchanged through the memory shared with the runtime.
+
+// StorageGet returns the encoded Option<Vec<u8>> of the value stored under key.
+func (e *Externalities) StorageGet(key []byte) []byte {
+	value, ok := e.Storage.Get(key)
+	return encodeOptionBytes(value, ok)
+}
+
+// StorageRead copies the value stored under key, starting at offset, into valueOut.
+// Returns the encoded Option<u32> of the number of bytes left in the value from offset.
+func (e *Externalities) StorageRead(key []byte, valueOut []byte, offset uint32) []byte {
+	value, ok := e.Storage.Get(key)
+	if !ok {
+		return []byte{0}
+	}
+
+	data := value[min(int(offset), len(value)):]
+	copy(valueOut, data)
+
+	return binary.LittleEndian.AppendUint32([]byte{1}, uint32(len(data)))
+}
+
+// StorageClearPrefix removes the values whose key starts with prefix, up to the encoded Option<u32> limit.
+// Returns the encoded MultiRemovalResults.
+func (e *Externalities) StorageClearPrefix(prefix []byte, limit []byte) []byte {
+	var maxRemovals *uint32
+	if len(limit) == 5 && limit[0] == 1 {
+		value := binary.LittleEndian.Uint32(limit[1:])
+		maxRemovals = &value
+	}
+
+	removed, cursor := e.Storage.ClearPrefix(prefix, maxRemovals)
+
+	result := encodeOptionBytes(cursor, cursor != nil)
+	for i := 0; i < 3; i++ {
+		result = binary.LittleEndian.AppendUint32(result, removed)
+	}
+	return result
+}
+
+// StorageNextKey returns the encoded Option<Vec<u8>> of the key which follows key.
+func (e *Externalities) StorageNextKey(key []byte) []byte {
+	next, ok := e.Storage.NextKey(key)
+	return encodeOptionBytes(next, ok)
+}
+
+// StorageRoot returns the storage root for the given state version.
+func (e *Externalities) StorageRoot(version int32) []byte {
+	root := e.Storage.Root(StateVersion(version))
+	return root[:]
+}
+
+// CryptoEd25519Generate generates an ed25519 key pair from the encoded Option<Vec<u8>> seed
+// and returns its public key.
+func (e *Externalities) CryptoEd25519Generate(keyTypeId []byte, seed []byte) []byte {
+	publicKey, err := e.Keystore.Ed25519Generate(toKeyTypeId(keyTypeId), decodeSeed(seed))
+	if err != nil {
+		panic(fmt.Sprintf("emulator: ed25519 generate: %v", err))
+	}
+	return publicKey[:]
+}
+
+// CryptoSr25519Generate generates an sr25519 key pair from the encoded Option<Vec<u8>> seed
+// and returns its public key.
+func (e *Externalities) CryptoSr25519Generate(keyTypeId []byte, seed []byte) []byte {
+	publicKey, err := e.Keystore.Sr25519Generate(toKeyTypeId(keyTypeId), decodeSeed(seed))
+	if err != nil {
+		panic(fmt.Sprintf("emulator: sr25519 generate: %v", err))
+	}
+	return publicKey[:]
+}
+
+// CryptoSr25519PublicKeys returns the encoded Vec<[u8; 32]> of the sr25519 public keys of keyTypeId.
+func (e *Externalities) CryptoSr25519PublicKeys(keyTypeId []byte) []byte {
+	keys := e.Keystore.Sr25519PublicKeys(toKeyTypeId(keyTypeId))
+
+	result := encodeCompact(uint64(len(keys)))
+	for _, key := range keys {
+		result = append(result, key[:]...)
+	}
+	return result
+}
+
+// CryptoSr25519Sign returns the encoded Option<[u8; 64]> signature of msg.
+func (e *Externalities) CryptoSr25519Sign(keyTypeId []byte, publicKey []byte, msg []byte) []byte {
+	signature, ok := e.Keystore.Sr25519Sign(toKeyTypeId(keyTypeId), [32]byte(publicKey), msg)
+	if !ok {
+		return []byte{0}
+	}
+	return append([]byte{1}, signature[:]...)
+}
+
+func (e *Externalities) CryptoEd25519Verify(signature []byte, msg []byte, publicKey []byte) bool {
+	return Ed25519Verify([64]byte(signature), msg, [32]byte(publicKey))
+}
+
+func (e *Externalities) CryptoSr25519Verify(signature []byte, msg []byte, publicKey []byte) bool {
+	return Sr25519Verify([64]byte(signature), msg, [32]byte(publicKey))
+}
+
+// CryptoSecp256k1EcdsaRecover returns the encoded Result<[u8; 64], EcdsaVerifyError> of the recovered public key.
+func (e *Externalities) CryptoSecp256k1EcdsaRecover(signature []byte, msg []byte) []byte {
+	publicKey, err := Secp256k1EcdsaRecover([65]byte(signature), [32]byte(msg))
+	if err != nil {
+		return []byte{1, byte(err.(EcdsaVerifyError))}
+	}
+	return append([]byte{0}, publicKey[:]...)
+}
+
+// CryptoSecp256k1EcdsaRecoverCompressed returns the encoded Result<[u8; 33], EcdsaVerifyError> of the
+// recovered compressed public key.
+func (e *Externalities) CryptoSecp256k1EcdsaRecoverCompressed(signature []byte, msg []byte) []byte {
+	publicKey, err := Secp256k1EcdsaRecoverCompressed([65]byte(signature), [32]byte(msg))
+	if err != nil {
+		return []byte{1, byte(err.(EcdsaVerifyError))}
+	}
+	return append([]byte{0}, publicKey[:]...)
+}
+
+// TrieBlake2256OrderedRoot returns the ordered trie root of the encoded Vec<Vec<u8>> input.
+func (e *Externalities) TrieBlake2256OrderedRoot(input []byte, version int32) []byte {
+	values, err := DecodeBytesSequence(input)
+	if err != nil {
+		panic(fmt.Sprintf("emulator: ordered trie root: %v", err))
+	}
+
+	root := OrderedTrieRoot(values, StateVersion(version))
+	return root[:]
+}
+
+// MiscRuntimeVersion returns the encoded Option<Vec<u8>> of the version of a runtime code blob.
+// Executing wasm is not supported, so the version is always unknown.
+func (e *Externalities) MiscRuntimeVersion(_ []byte) []byte {
+	return []byte{0}
+}
+
+func encodeOptionBytes(value []byte, ok bool) []byte {
+	if !ok {
+		return []byte{0}
+	}
+	return append([]byte{1}, encodeBytes(value)...)
+}
+
+func decodeSeed(seed []byte) []byte {
+	if len(seed) == 0 || seed[0] == 0 {
+		return nil
+	}
+
+	value, _, err := decodeBytes(seed[1:])
+	if err != nil {
+		panic(fmt.Sprintf("emulator: invalid seed: %v", err))
+	}
+	return value
+}
+
+func toKeyTypeId(keyTypeId []byte) KeyTypeId {
+	var result KeyTypeId
+	copy(result[:], keyTypeId)
+	return result
+}
@@ -0,0 +1,111 @@
+package emulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Externalities_StorageGet(t *testing.T) {
+	target := New()
+	target.Storage.Set(keyA, []byte{1, 2})
+
+	assert.Equal(t, []byte{1, 0x08, 1, 2}, target.StorageGet(keyA))
+	assert.Equal(t, []byte{0}, target.StorageGet(keyB))
+}
+
+func Test_Externalities_StorageRead(t *testing.T) {
+	target := New()
+	target.Storage.Set(keyA, []byte{1, 2, 3})
+	valueOut := make([]byte, 1)
+
+	result := target.StorageRead(keyA, valueOut, 1)
+
+	assert.Equal(t, []byte{1, 2, 0, 0, 0}, result)
+	assert.Equal(t, []byte{2}, valueOut)
+}
+
+func Test_Externalities_StorageRead_OffsetOutOfBounds(t *testing.T) {
+	target := New()
+	target.Storage.Set(keyA, []byte{1})
+
+	result := target.StorageRead(keyA, make([]byte, 1), 5)
+
+	assert.Equal(t, []byte{1, 0, 0, 0, 0}, result)
+}
+
+func Test_Externalities_StorageRead_Missing(t *testing.T) {
+	assert.Equal(t, []byte{0}, New().StorageRead(keyA, nil, 0))
+}
+
+func Test_Externalities_StorageClearPrefix(t *testing.T) {
+	target := New()
+	target.Storage.Set(keyA, []byte{1})
+	target.Storage.Set(keyAB, []byte{2})
+
+	result := target.StorageClearPrefix(keyA, []byte{1, 1, 0, 0, 0})
+
+	assert.Equal(t, []byte{1, 0x08, 'a', 'b', 1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0}, result)
+
+	result = target.StorageClearPrefix(keyA, []byte{0})
+
+	assert.Equal(t, []byte{0, 1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0}, result)
+	assert.Empty(t, target.Storage.Entries())
+}
+
+func Test_Externalities_StorageNextKey(t *testing.T) {
+	target := New()
+	target.Storage.Set(keyB, []byte{1})
+
+	assert.Equal(t, []byte{1, 0x04, 'b'}, target.StorageNextKey(keyA))
+	assert.Equal(t, []byte{0}, target.StorageNextKey(keyB))
+}
+
+func Test_Externalities_StorageRoot(t *testing.T) {
+	assert.Equal(t, emptyTrieRoot[:], New().StorageRoot(int32(StateVersionV1)))
+}
+
+func Test_Externalities_CryptoSr25519(t *testing.T) {
+	target := New()
+	keyTypeId := []byte("aura")
+
+	publicKey := target.CryptoSr25519Generate(keyTypeId, append([]byte{1, 0x1c}, "//Alice"...))
+
+	assert.Equal(t, append([]byte{0x04}, publicKey...), target.CryptoSr25519PublicKeys(keyTypeId))
+
+	signature := target.CryptoSr25519Sign(keyTypeId, publicKey, message)
+	assert.Equal(t, byte(1), signature[0])
+	assert.True(t, target.CryptoSr25519Verify(signature[1:], message, publicKey))
+	assert.Equal(t, []byte{0}, target.CryptoSr25519Sign([]byte("gran"), publicKey, message))
+}
+
+func Test_Externalities_CryptoEd25519(t *testing.T) {
+	target := New()
+
+	publicKey := target.CryptoEd25519Generate([]byte("gran"), []byte{0})
+
+	assert.Len(t, publicKey, 32)
+	assert.False(t, target.CryptoEd25519Verify(make([]byte, 64), message, publicKey))
+}
+
+func Test_Externalities_CryptoSecp256k1EcdsaRecover_Error(t *testing.T) {
+	target := New()
+	signature := make([]byte, 65)
+	signature[64] = 5
+
+	assert.Equal(t, []byte{1, byte(EcdsaVerifyErrorBadV)}, target.CryptoSecp256k1EcdsaRecover(signature, make([]byte, 32)))
+	assert.Equal(t, []byte{1, byte(EcdsaVerifyErrorBadV)}, target.CryptoSecp256k1EcdsaRecoverCompressed(signature, make([]byte, 32)))
+}
+
+func Test_Externalities_TrieBlake2256OrderedRoot(t *testing.T) {
+	target := New()
+	expect := OrderedTrieRoot([][]byte{{1}, {2, 3}}, StateVersionV0)
+
+	result := target.TrieBlake2256OrderedRoot([]byte{0x08, 0x04, 1, 0x08, 2, 3}, int32(StateVersionV0))
+
+	assert.Equal(t, expect[:], result)
+}
+
+func Test_Externalities_MiscRuntimeVersion(t *testing.T) {
+	assert.Equal(t, []byte{0}, New().MiscRuntimeVersion([]byte{1}))
+}
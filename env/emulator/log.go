@@ -0,0 +1,74 @@
+package emulator
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// LogLevel is the verbosity of a log message, matching the levels of the runtime logger.
+type LogLevel int32
+
+const (
+	LogLevelOff LogLevel = iota
+	LogLevelError
+	LogLevelWarn
+	LogLevelInfo
+	LogLevelDebug
+	LogLevelTrace
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelError:
+		return "ERROR"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelTrace:
+		return "TRACE"
+	default:
+		return "OFF"
+	}
+}
+
+// Logger writes the log messages of the runtime, which do not exceed its maximum level.
+type Logger struct {
+	mu       sync.Mutex
+	out      io.Writer
+	maxLevel LogLevel
+}
+
+func NewLogger(out io.Writer, maxLevel LogLevel) *Logger {
+	return &Logger{
+		out:      out,
+		maxLevel: maxLevel,
+	}
+}
+
+func (l *Logger) Log(level LogLevel, target, message []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if level == LogLevelOff || level > l.maxLevel {
+		return
+	}
+	fmt.Fprintf(l.out, "%s  target=%s  message=%s\n", level, target, message)
+}
+
+func (l *Logger) MaxLevel() LogLevel {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.maxLevel
+}
+
+func (l *Logger) SetMaxLevel(level LogLevel) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.maxLevel = level
+}
@@ -0,0 +1,26 @@
+package emulator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Logger_Log(t *testing.T) {
+	out := &bytes.Buffer{}
+	target := NewLogger(out, LogLevelInfo)
+
+	target.Log(LogLevelInfo, []byte("runtime"), []byte("info"))
+	target.Log(LogLevelDebug, []byte("runtime"), []byte("debug"))
+
+	assert.Equal(t, "INFO  target=runtime  message=info\n", out.String())
+}
+
+func Test_Logger_SetMaxLevel(t *testing.T) {
+	target := NewLogger(&bytes.Buffer{}, LogLevelInfo)
+
+	target.SetMaxLevel(LogLevelTrace)
+
+	assert.Equal(t, LogLevelTrace, target.MaxLevel())
+}
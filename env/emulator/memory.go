@@ -0,0 +1,117 @@
+package emulator
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Memory emulates the linear memory shared between the runtime and the host.
+//
+// Native pointers do not fit in the 32-bit offsets of the wasm ABI, so every
+// region is registered under a synthetic offset instead. Regions passed with
+// Store are transient and released once they are loaded, which matches how both
+// sides use them: arguments are read once by the callee and results once by the
+// caller. Regions obtained with Malloc stay alive until Free is called.
+type Memory struct {
+	mu        sync.Mutex
+	next      int32
+	transient map[int32][]byte
+	allocated map[int32][]byte
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		next:      1,
+		transient: map[int32][]byte{},
+		allocated: map[int32][]byte{},
+	}
+}
+
+// Store registers data and returns its offset. The region is not copied, so
+// writes by the host through Load are visible to the owner of data.
+func (m *Memory) Store(data []byte) int32 {
+	if len(data) == 0 {
+		return 0
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	offset := m.nextOffset()
+	m.transient[offset] = data
+	return offset
+}
+
+// Load returns the region at offset, limited to size bytes.
+func (m *Memory) Load(offset int32, size int32) []byte {
+	if offset == 0 {
+		return []byte{}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if data, ok := m.allocated[offset]; ok {
+		return limit(data, size)
+	}
+	data, ok := m.transient[offset]
+	if !ok {
+		panic(fmt.Sprintf("emulator: access to unknown memory offset [%d]", offset))
+	}
+	delete(m.transient, offset)
+	return limit(data, size)
+}
+
+// Malloc allocates a zeroed region of size bytes, which is alive until freed.
+func (m *Memory) Malloc(size int32) int32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	offset := m.nextOffset()
+	m.allocated[offset] = make([]byte, size)
+	return offset
+}
+
+// Free releases a region allocated with Malloc.
+func (m *Memory) Free(offset int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.allocated[offset]; !ok {
+		panic(fmt.Sprintf("emulator: free of unallocated memory offset [%d]", offset))
+	}
+	delete(m.allocated, offset)
+}
+
+// Len returns the number of live regions.
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.transient) + len(m.allocated)
+}
+
+func (m *Memory) nextOffset() int32 {
+	for {
+		offset := m.next
+		if m.next == math.MaxInt32 {
+			m.next = 1
+		} else {
+			m.next++
+		}
+
+		_, isTransient := m.transient[offset]
+		_, isAllocated := m.allocated[offset]
+		if !isTransient && !isAllocated {
+			return offset
+		}
+	}
+}
+
+func limit(data []byte, size int32) []byte {
+	if size < 0 || int(size) > len(data) {
+		panic(fmt.Sprintf("emulator: out of bounds memory access of [%d] bytes in a region of [%d] bytes", size, len(data)))
+	}
+	return data[:size]
+}
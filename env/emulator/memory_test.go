@@ -0,0 +1,66 @@
+package emulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Memory_Store_Load(t *testing.T) {
+	target := NewMemory()
+	data := []byte{1, 2, 3}
+
+	offset := target.Store(data)
+	result := target.Load(offset, 2)
+
+	assert.Equal(t, []byte{1, 2}, result)
+	assert.Equal(t, 0, target.Len())
+}
+
+func Test_Memory_Load_SharesRegion(t *testing.T) {
+	target := NewMemory()
+	data := make([]byte, 2)
+
+	target.Load(target.Store(data), 2)[1] = 7
+
+	assert.Equal(t, []byte{0, 7}, data)
+}
+
+func Test_Memory_Store_Empty(t *testing.T) {
+	target := NewMemory()
+
+	offset := target.Store(nil)
+
+	assert.Equal(t, int32(0), offset)
+	assert.Equal(t, []byte{}, target.Load(offset, 0))
+}
+
+func Test_Memory_Load_Released(t *testing.T) {
+	target := NewMemory()
+	offset := target.Store([]byte{1})
+	target.Load(offset, 1)
+
+	assert.Panics(t, func() { target.Load(offset, 1) })
+}
+
+func Test_Memory_Load_OutOfBounds(t *testing.T) {
+	target := NewMemory()
+	offset := target.Store([]byte{1})
+
+	assert.Panics(t, func() { target.Load(offset, 2) })
+}
+
+func Test_Memory_Malloc_Free(t *testing.T) {
+	target := NewMemory()
+
+	offset := target.Malloc(4)
+	target.Load(offset, 4)[0] = 1
+
+	assert.Equal(t, []byte{1, 0, 0, 0}, target.Load(offset, 4))
+	assert.Equal(t, 1, target.Len())
+
+	target.Free(offset)
+
+	assert.Equal(t, 0, target.Len())
+	assert.Panics(t, func() { target.Free(offset) })
+}
@@ -0,0 +1,96 @@
+package emulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+var errInvalidCompact = errors.New("invalid compact encoding")
+
+// encodeCompact returns the SCALE compact encoding of n.
+func encodeCompact(n uint64) []byte {
+	switch {
+	case n < 1<<6:
+		return []byte{byte(n << 2)}
+	case n < 1<<14:
+		return binary.LittleEndian.AppendUint16(nil, uint16(n<<2|0b01))
+	case n < 1<<30:
+		return binary.LittleEndian.AppendUint32(nil, uint32(n<<2|0b10))
+	default:
+		size := (bits.Len64(n) + 7) / 8
+		result := []byte{byte((size-4)<<2 | 0b11)}
+		for i := 0; i < size; i++ {
+			result = append(result, byte(n>>(8*i)))
+		}
+		return result
+	}
+}
+
+// decodeCompact decodes a SCALE compact number and returns it with the number of consumed bytes.
+func decodeCompact(data []byte) (uint64, int, error) {
+	if len(data) == 0 {
+		return 0, 0, errInvalidCompact
+	}
+
+	switch data[0] & 0b11 {
+	case 0b00:
+		return uint64(data[0] >> 2), 1, nil
+	case 0b01:
+		if len(data) < 2 {
+			return 0, 0, errInvalidCompact
+		}
+		return uint64(binary.LittleEndian.Uint16(data) >> 2), 2, nil
+	case 0b10:
+		if len(data) < 4 {
+			return 0, 0, errInvalidCompact
+		}
+		return uint64(binary.LittleEndian.Uint32(data) >> 2), 4, nil
+	default:
+		size := int(data[0]>>2) + 4
+		if size > 8 || len(data) < size+1 {
+			return 0, 0, errInvalidCompact
+		}
+		var n uint64
+		for i := 0; i < size; i++ {
+			n |= uint64(data[1+i]) << (8 * i)
+		}
+		return n, size + 1, nil
+	}
+}
+
+// encodeBytes returns the SCALE encoding of a byte sequence.
+func encodeBytes(data []byte) []byte {
+	return append(encodeCompact(uint64(len(data))), data...)
+}
+
+// decodeBytes decodes a SCALE encoded byte sequence and returns it with the number of consumed bytes.
+func decodeBytes(data []byte) ([]byte, int, error) {
+	length, n, err := decodeCompact(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if uint64(len(data)-n) < length {
+		return nil, 0, errInvalidCompact
+	}
+	return data[n : n+int(length)], n + int(length), nil
+}
+
+// DecodeBytesSequence decodes a SCALE encoded sequence of byte sequences.
+func DecodeBytesSequence(data []byte) ([][]byte, error) {
+	count, offset, err := decodeCompact(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]byte, 0, count)
+	for i := uint64(0); i < count; i++ {
+		item, n, err := decodeBytes(data[offset:])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+		offset += n
+	}
+	return result, nil
+}
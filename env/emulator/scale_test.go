@@ -0,0 +1,52 @@
+package emulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EncodeCompact_DecodeCompact(t *testing.T) {
+	testExamples := []struct {
+		label  string
+		input  uint64
+		expect []byte
+	}{
+		{label: "single byte", input: 1, expect: []byte{0x04}},
+		{label: "two bytes", input: 64, expect: []byte{0x01, 0x01}},
+		{label: "four bytes", input: 16384, expect: []byte{0x02, 0x00, 0x01, 0x00}},
+		{label: "big integer", input: 1 << 30, expect: []byte{0x03, 0x00, 0x00, 0x00, 0x40}},
+		{label: "max", input: ^uint64(0), expect: []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			encoded := encodeCompact(testExample.input)
+			assert.Equal(t, testExample.expect, encoded)
+
+			decoded, n, err := decodeCompact(encoded)
+			assert.NoError(t, err)
+			assert.Equal(t, testExample.input, decoded)
+			assert.Equal(t, len(encoded), n)
+		})
+	}
+}
+
+func Test_DecodeCompact_Invalid(t *testing.T) {
+	_, _, err := decodeCompact([]byte{0x01})
+
+	assert.Equal(t, errInvalidCompact, err)
+}
+
+func Test_DecodeBytesSequence(t *testing.T) {
+	result, err := DecodeBytesSequence([]byte{0x08, 0x04, 0x01, 0x08, 0x02, 0x03})
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{{0x01}, {0x02, 0x03}}, result)
+}
+
+func Test_DecodeBytesSequence_Invalid(t *testing.T) {
+	_, err := DecodeBytesSequence([]byte{0x08, 0x04, 0x01, 0x08, 0x02})
+
+	assert.Equal(t, errInvalidCompact, err)
+}
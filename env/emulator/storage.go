@@ -0,0 +1,233 @@
+package emulator
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// Storage is an in-memory key-value storage with support for nested transactions.
+type Storage struct {
+	mu           sync.Mutex
+	committed    map[string][]byte
+	transactions []map[string]change
+}
+
+// change is a pending write within a transaction. A nil value marks a deletion.
+type change struct {
+	value []byte
+}
+
+func NewStorage() *Storage {
+	return &Storage{
+		committed: map[string][]byte{},
+	}
+}
+
+// Get returns a copy of the value stored under key and whether it exists.
+func (s *Storage) Get(key []byte) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.get(string(key))
+	if !ok {
+		return nil, false
+	}
+	return append([]byte{}, value...), true
+}
+
+// Exists returns whether a value is stored under key.
+func (s *Storage) Exists(key []byte) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+// Set stores a copy of value under key.
+func (s *Storage) Set(key []byte, value []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set(string(key), append([]byte{}, value...))
+}
+
+// Clear removes the value stored under key.
+func (s *Storage) Clear(key []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set(string(key), nil)
+}
+
+// Append appends an encoded item to the encoded sequence stored under key.
+// If the stored value is not a valid sequence, it is replaced by a sequence of the single item.
+func (s *Storage) Append(key []byte, item []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.get(string(key))
+	if ok {
+		if count, n, err := decodeCompact(current); err == nil {
+			value := append(encodeCompact(count+1), current[n:]...)
+			s.set(string(key), append(value, item...))
+			return
+		}
+	}
+
+	s.set(string(key), append(encodeCompact(1), item...))
+}
+
+// ClearPrefix removes up to limit values, whose key starts with prefix, in lexicographic order of the keys.
+// A nil limit removes all of them. Returns the number of removed values and the key of the first
+// remaining value, if any.
+func (s *Storage) ClearPrefix(prefix []byte, limit *uint32) (removed uint32, cursor []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.keys() {
+		if !bytes.HasPrefix([]byte(key), prefix) {
+			continue
+		}
+		if limit != nil && removed == *limit {
+			return removed, []byte(key)
+		}
+		s.set(key, nil)
+		removed++
+	}
+
+	return removed, nil
+}
+
+// NextKey returns the first key that is lexicographically greater than key.
+func (s *Storage) NextKey(key []byte) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := s.keys()
+	i := sort.SearchStrings(keys, string(key))
+	if i < len(keys) && keys[i] == string(key) {
+		i++
+	}
+	if i == len(keys) {
+		return nil, false
+	}
+	return []byte(keys[i]), true
+}
+
+// Entries returns a copy of all stored key-value pairs.
+func (s *Storage) Entries() map[string][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entries()
+}
+
+// Root returns the root of the trie which contains all stored key-value pairs.
+func (s *Storage) Root(version StateVersion) [32]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return TrieRoot(s.entries(), version)
+}
+
+// StartTransaction starts a new nested transaction.
+func (s *Storage) StartTransaction() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.transactions = append(s.transactions, map[string]change{})
+}
+
+// CommitTransaction applies the changes of the innermost transaction to its parent.
+// Panics if there is no open transaction.
+func (s *Storage) CommitTransaction() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.transactions) == 0 {
+		panic("emulator: no open transaction that can be committed")
+	}
+
+	last := len(s.transactions) - 1
+	changes := s.transactions[last]
+	s.transactions = s.transactions[:last]
+
+	for key, c := range changes {
+		s.set(key, c.value)
+	}
+}
+
+// RollbackTransaction discards the changes of the innermost transaction.
+// Panics if there is no open transaction.
+func (s *Storage) RollbackTransaction() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.transactions) == 0 {
+		panic("emulator: no open transaction that can be rolled back")
+	}
+
+	s.transactions = s.transactions[:len(s.transactions)-1]
+}
+
+// TransactionDepth returns the number of open transactions.
+func (s *Storage) TransactionDepth() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.transactions)
+}
+
+func (s *Storage) get(key string) ([]byte, bool) {
+	for i := len(s.transactions) - 1; i >= 0; i-- {
+		if c, ok := s.transactions[i][key]; ok {
+			return c.value, c.value != nil
+		}
+	}
+
+	value, ok := s.committed[key]
+	return value, ok
+}
+
+func (s *Storage) set(key string, value []byte) {
+	if len(s.transactions) > 0 {
+		s.transactions[len(s.transactions)-1][key] = change{value: value}
+		return
+	}
+
+	if value == nil {
+		delete(s.committed, key)
+		return
+	}
+	s.committed[key] = value
+}
+
+// keys returns all existing keys in lexicographic order.
+func (s *Storage) keys() []string {
+	candidates := map[string]struct{}{}
+	for key := range s.committed {
+		candidates[key] = struct{}{}
+	}
+	for _, changes := range s.transactions {
+		for key := range changes {
+			candidates[key] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(candidates))
+	for key := range candidates {
+		if _, ok := s.get(key); ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *Storage) entries() map[string][]byte {
+	result := map[string][]byte{}
+	for _, key := range s.keys() {
+		value, _ := s.get(key)
+		result[key] = append([]byte{}, value...)
+	}
+	return result
+}
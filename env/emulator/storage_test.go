@@ -0,0 +1,164 @@
+package emulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyA  = []byte("a")
+	keyAB = []byte("ab")
+	keyB  = []byte("b")
+)
+
+func Test_Storage_Set_Get(t *testing.T) {
+	target := NewStorage()
+
+	target.Set(keyA, []byte{1})
+
+	value, ok := target.Get(keyA)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{1}, value)
+	assert.True(t, target.Exists(keyA))
+	assert.False(t, target.Exists(keyB))
+}
+
+func Test_Storage_Set_Empty(t *testing.T) {
+	target := NewStorage()
+
+	target.Set(keyA, []byte{})
+
+	value, ok := target.Get(keyA)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{}, value)
+}
+
+func Test_Storage_Clear(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{1})
+
+	target.Clear(keyA)
+
+	assert.False(t, target.Exists(keyA))
+}
+
+func Test_Storage_Append(t *testing.T) {
+	target := NewStorage()
+
+	target.Append(keyA, []byte{1})
+	target.Append(keyA, []byte{2})
+
+	value, _ := target.Get(keyA)
+	assert.Equal(t, []byte{0x08, 1, 2}, value)
+}
+
+func Test_Storage_Append_InvalidSequence(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{0x01})
+
+	target.Append(keyA, []byte{2})
+
+	value, _ := target.Get(keyA)
+	assert.Equal(t, []byte{0x04, 2}, value)
+}
+
+func Test_Storage_ClearPrefix(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{1})
+	target.Set(keyAB, []byte{2})
+	target.Set(keyB, []byte{3})
+
+	removed, cursor := target.ClearPrefix(keyA, nil)
+
+	assert.Equal(t, uint32(2), removed)
+	assert.Nil(t, cursor)
+	assert.Equal(t, map[string][]byte{"b": {3}}, target.Entries())
+}
+
+func Test_Storage_ClearPrefix_Limit(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{1})
+	target.Set(keyAB, []byte{2})
+	limit := uint32(1)
+
+	removed, cursor := target.ClearPrefix(keyA, &limit)
+
+	assert.Equal(t, uint32(1), removed)
+	assert.Equal(t, keyAB, cursor)
+	assert.Equal(t, map[string][]byte{"ab": {2}}, target.Entries())
+}
+
+func Test_Storage_NextKey(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{1})
+	target.Set(keyB, []byte{2})
+
+	next, ok := target.NextKey(keyA)
+	assert.True(t, ok)
+	assert.Equal(t, keyB, next)
+
+	next, ok = target.NextKey(keyAB)
+	assert.True(t, ok)
+	assert.Equal(t, keyB, next)
+
+	next, ok = target.NextKey([]byte{})
+	assert.True(t, ok)
+	assert.Equal(t, keyA, next)
+
+	_, ok = target.NextKey(keyB)
+	assert.False(t, ok)
+}
+
+func Test_Storage_Transaction_Commit(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{1})
+
+	target.StartTransaction()
+	target.Set(keyB, []byte{2})
+	target.Clear(keyA)
+	target.StartTransaction()
+	target.Set(keyAB, []byte{3})
+
+	assert.Equal(t, 2, target.TransactionDepth())
+	next, _ := target.NextKey([]byte{})
+	assert.Equal(t, keyAB, next)
+
+	target.CommitTransaction()
+	target.CommitTransaction()
+
+	assert.Equal(t, 0, target.TransactionDepth())
+	assert.Equal(t, map[string][]byte{"ab": {3}, "b": {2}}, target.Entries())
+}
+
+func Test_Storage_Transaction_Rollback(t *testing.T) {
+	target := NewStorage()
+	target.Set(keyA, []byte{1})
+
+	target.StartTransaction()
+	target.Set(keyB, []byte{2})
+	target.StartTransaction()
+	target.Clear(keyA)
+	target.RollbackTransaction()
+
+	assert.Equal(t, map[string][]byte{"a": {1}, "b": {2}}, target.Entries())
+
+	target.RollbackTransaction()
+
+	assert.Equal(t, map[string][]byte{"a": {1}}, target.Entries())
+}
+
+func Test_Storage_Transaction_NoneOpen(t *testing.T) {
+	target := NewStorage()
+
+	assert.Panics(t, target.CommitTransaction)
+	assert.Panics(t, target.RollbackTransaction)
+}
+
+func Test_Storage_Root(t *testing.T) {
+	target := NewStorage()
+	assert.Equal(t, emptyTrieRoot, target.Root(StateVersionV0))
+
+	target.Set(keyA, []byte("b"))
+	assert.Equal(t, TrieRoot(map[string][]byte{"a": []byte("b")}, StateVersionV1), target.Root(StateVersionV1))
+}
@@ -0,0 +1,188 @@
+package emulator
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// StateVersion is the version of the trie layout.
+type StateVersion int32
+
+const (
+	// StateVersionV0 stores all values inline in the trie nodes.
+	StateVersionV0 StateVersion = iota
+	// StateVersionV1 stores values of at least 33 bytes as a hash in the trie nodes.
+	StateVersionV1
+)
+
+const (
+	emptyTrie = 0x00
+
+	// Node header prefixes and the number of bits they occupy.
+	leafPrefix                 = 0b01 << 6
+	branchWithoutValuePrefix   = 0b10 << 6
+	branchWithValuePrefix      = 0b11 << 6
+	hashedValueLeafPrefix      = 0b001 << 5
+	hashedValueBranchPrefix    = 0b0001 << 4
+	prefixBits                 = 2
+	hashedValueLeafPrefixBits  = 3
+	hashedValueBranchPrefixBit = 4
+
+	// valueHashThreshold is the minimum size of values that are hashed in StateVersionV1.
+	valueHashThreshold = 33
+	// hashLength is the size of node references above which the referenced node is hashed.
+	hashLength = 32
+)
+
+type trieEntry struct {
+	nibbles []byte
+	value   []byte
+}
+
+// TrieRoot returns the root of the base-16 modified Merkle Patricia trie, without extension nodes,
+// that contains the given key-value pairs. Nodes are hashed with blake2b-256.
+func TrieRoot(entries map[string][]byte, version StateVersion) [32]byte {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	trieEntries := make([]trieEntry, 0, len(keys))
+	for _, key := range keys {
+		trieEntries = append(trieEntries, trieEntry{nibbles: toNibbles([]byte(key)), value: entries[key]})
+	}
+
+	return Blake2256(encodeTrieNode(trieEntries, 0, version))
+}
+
+// OrderedTrieRoot returns the root of the trie, which has the compact encoded index of each value as a key.
+func OrderedTrieRoot(values [][]byte, version StateVersion) [32]byte {
+	entries := make(map[string][]byte, len(values))
+	for i, value := range values {
+		entries[string(encodeCompact(uint64(i)))] = value
+	}
+	return TrieRoot(entries, version)
+}
+
+// encodeTrieNode encodes the node which contains entries. All entries share the first depth nibbles.
+func encodeTrieNode(entries []trieEntry, depth int, version StateVersion) []byte {
+	if len(entries) == 0 {
+		return []byte{emptyTrie}
+	}
+
+	if len(entries) == 1 {
+		partial := entries[0].nibbles[depth:]
+		value := entries[0].value
+		if version == StateVersionV1 && len(value) >= valueHashThreshold {
+			encoded := encodeHeader(len(partial), hashedValueLeafPrefix, hashedValueLeafPrefixBits)
+			encoded = append(encoded, encodePartialKey(partial)...)
+			hash := Blake2256(value)
+			return append(encoded, hash[:]...)
+		}
+
+		encoded := encodeHeader(len(partial), leafPrefix, prefixBits)
+		encoded = append(encoded, encodePartialKey(partial)...)
+		return append(encoded, encodeBytes(value)...)
+	}
+
+	end := depth + commonPrefixLength(entries, depth)
+	partial := entries[0].nibbles[depth:end]
+
+	var value []byte
+	hasValue := false
+	if len(entries[0].nibbles) == end {
+		value, hasValue = entries[0].value, true
+		entries = entries[1:]
+	}
+
+	var encoded []byte
+	hashedValue := hasValue && version == StateVersionV1 && len(value) >= valueHashThreshold
+	switch {
+	case hashedValue:
+		encoded = encodeHeader(len(partial), hashedValueBranchPrefix, hashedValueBranchPrefixBit)
+	case hasValue:
+		encoded = encodeHeader(len(partial), branchWithValuePrefix, prefixBits)
+	default:
+		encoded = encodeHeader(len(partial), branchWithoutValuePrefix, prefixBits)
+	}
+	encoded = append(encoded, encodePartialKey(partial)...)
+
+	var children [16][]trieEntry
+	var bitmap uint16
+	for _, entry := range entries {
+		nibble := entry.nibbles[end]
+		children[nibble] = append(children[nibble], entry)
+		bitmap |= 1 << nibble
+	}
+	encoded = binary.LittleEndian.AppendUint16(encoded, bitmap)
+
+	switch {
+	case hashedValue:
+		hash := Blake2256(value)
+		encoded = append(encoded, hash[:]...)
+	case hasValue:
+		encoded = append(encoded, encodeBytes(value)...)
+	}
+
+	for _, child := range children {
+		if len(child) == 0 {
+			continue
+		}
+		childEncoded := encodeTrieNode(child, end+1, version)
+		if len(childEncoded) >= hashLength {
+			hash := Blake2256(childEncoded)
+			childEncoded = hash[:]
+		}
+		encoded = append(encoded, encodeBytes(childEncoded)...)
+	}
+
+	return encoded
+}
+
+// encodeHeader encodes the node prefix along with the number of nibbles in the partial key.
+func encodeHeader(nibbleCount int, prefix byte, bits int) []byte {
+	maxValue := 255 >> bits
+	if nibbleCount < maxValue {
+		return []byte{prefix | byte(nibbleCount)}
+	}
+
+	header := []byte{prefix | byte(maxValue)}
+	remainder := nibbleCount - maxValue
+	for remainder >= 255 {
+		header = append(header, 255)
+		remainder -= 255
+	}
+	return append(header, byte(remainder))
+}
+
+// encodePartialKey packs nibbles in bytes. An odd nibble count leaves the first nibble in its own byte.
+func encodePartialKey(nibbles []byte) []byte {
+	result := make([]byte, 0, (len(nibbles)+1)/2)
+	if len(nibbles)%2 == 1 {
+		result = append(result, nibbles[0])
+		nibbles = nibbles[1:]
+	}
+	for i := 0; i < len(nibbles); i += 2 {
+		result = append(result, nibbles[i]<<4|nibbles[i+1])
+	}
+	return result
+}
+
+// commonPrefixLength returns the number of nibbles after depth shared by all sorted entries.
+func commonPrefixLength(entries []trieEntry, depth int) int {
+	first, last := entries[0].nibbles[depth:], entries[len(entries)-1].nibbles[depth:]
+	length := 0
+	for length < len(first) && length < len(last) && first[length] == last[length] {
+		length++
+	}
+	return length
+}
+
+func toNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, 2*len(key))
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
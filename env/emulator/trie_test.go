@@ -0,0 +1,100 @@
+package emulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var emptyTrieRoot = hash32("03170a2e7597b7b7e3d84c05391d139a62b157e78786d8c082f29dcf4c111314")
+
+func hash32(s string) [32]byte {
+	var result [32]byte
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	copy(result[:], b)
+	return result
+}
+
+func Test_TrieRoot_Empty(t *testing.T) {
+	assert.Equal(t, emptyTrieRoot, TrieRoot(map[string][]byte{}, StateVersionV0))
+	assert.Equal(t, emptyTrieRoot, TrieRoot(map[string][]byte{}, StateVersionV1))
+}
+
+func Test_TrieRoot_Leaf(t *testing.T) {
+	// 0x42 (leaf, 2 nibbles) ++ 0x61 (partial key) ++ 0x04 0x62 (value)
+	expect := hash32("74cf25701ab239580f1d899e1d37a67df2121b4d532b7c7581215f5118370918")
+
+	assert.Equal(t, expect, TrieRoot(map[string][]byte{"a": []byte("b")}, StateVersionV0))
+}
+
+func Test_TrieRoot_Branch(t *testing.T) {
+	// 0x81 (branch without value, 1 nibble) ++ 0x01 (partial key) ++ 0x0c 0x00 (children 2 and 3) ++
+	// 0x0c 0x40 0x04 0x61 (inline leaf) ++ 0x0c 0x40 0x04 0x62 (inline leaf)
+	expect := hash32("3b3aaa42eab3ced12e252ed5edca0e34687f32ca4c1826ca2422c6e7070bf08e")
+
+	result := TrieRoot(map[string][]byte{"\x12": []byte("a"), "\x13": []byte("b")}, StateVersionV0)
+
+	assert.Equal(t, expect, result)
+}
+
+func Test_TrieRoot_HashedValue(t *testing.T) {
+	entries := map[string][]byte{"\x01": bytes.Repeat([]byte{0xff}, 33)}
+	// 0x22 (leaf with hashed value, 2 nibbles) ++ 0x01 (partial key) ++ blake2b-256 of the value
+	expect := hash32("c57731320fd56a7249620fb3dfb1d2bb6f03b30f2a6a538b9873bf4e82fb88bd")
+
+	assert.Equal(t, expect, TrieRoot(entries, StateVersionV1))
+	assert.NotEqual(t, expect, TrieRoot(entries, StateVersionV0))
+}
+
+func Test_TrieRoot_BranchWithValue(t *testing.T) {
+	entries := map[string][]byte{"\x12": []byte("a"), "\x12\x34": []byte("b")}
+
+	node := encodeTrieNode([]trieEntry{
+		{nibbles: toNibbles([]byte("\x12")), value: []byte("a")},
+		{nibbles: toNibbles([]byte("\x12\x34")), value: []byte("b")},
+	}, 0, StateVersionV0)
+
+	// 0xc2 (branch with value, 2 nibbles) ++ 0x12 (partial key) ++ 0x08 0x00 (child 3) ++
+	// 0x04 0x61 (value) ++ 0x10 0x41 0x04 0x04 0x62 (inline leaf with 1 nibble)
+	assert.Equal(t, []byte{0xc2, 0x12, 0x08, 0x00, 0x04, 0x61, 0x10, 0x41, 0x04, 0x04, 0x62}, node)
+	assert.Equal(t, Blake2256(node), TrieRoot(entries, StateVersionV0))
+}
+
+func Test_TrieRoot_HashedChild(t *testing.T) {
+	value := bytes.Repeat([]byte{0x01}, 32)
+	entries := map[string][]byte{"\x12": value, "\x13": value}
+
+	leaf := encodeTrieNode([]trieEntry{{nibbles: []byte{1, 2}, value: value}}, 2, StateVersionV0)
+	leafHash := Blake2256(leaf)
+	expect := append([]byte{0x81, 0x01, 0x0c, 0x00}, encodeBytes(leafHash[:])...)
+	expect = append(expect, encodeBytes(leafHash[:])...)
+
+	assert.Equal(t, Blake2256(expect), TrieRoot(entries, StateVersionV0))
+}
+
+func Test_OrderedTrieRoot(t *testing.T) {
+	values := [][]byte{[]byte("a"), []byte("b")}
+	entries := map[string][]byte{"\x00": []byte("a"), "\x04": []byte("b")}
+
+	assert.Equal(t, emptyTrieRoot, OrderedTrieRoot(nil, StateVersionV0))
+	assert.Equal(t, TrieRoot(entries, StateVersionV1), OrderedTrieRoot(values, StateVersionV1))
+}
+
+func Test_EncodeHeader(t *testing.T) {
+	assert.Equal(t, []byte{0x7e}, encodeHeader(62, leafPrefix, prefixBits))
+	assert.Equal(t, []byte{0x7f, 0x00}, encodeHeader(63, leafPrefix, prefixBits))
+	assert.Equal(t, []byte{0x7f, 0x01}, encodeHeader(64, leafPrefix, prefixBits))
+	assert.Equal(t, []byte{0x7f, 0xff, 0x00}, encodeHeader(63+255, leafPrefix, prefixBits))
+	assert.Equal(t, []byte{0x3f, 0x01}, encodeHeader(32, hashedValueLeafPrefix, hashedValueLeafPrefixBits))
+	assert.Equal(t, []byte{0x1e}, encodeHeader(14, hashedValueBranchPrefix, hashedValueBranchPrefixBit))
+}
+
+func Test_EncodePartialKey(t *testing.T) {
+	assert.Equal(t, []byte{0x01, 0x23}, encodePartialKey([]byte{1, 2, 3}))
+	assert.Equal(t, []byte{0x12, 0x34}, encodePartialKey([]byte{1, 2, 3, 4}))
+}
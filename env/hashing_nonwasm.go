@@ -2,26 +2,33 @@
 
 package env
 
+import "github.com/LimeChain/gosemble/env/emulator"
+
 /*
 	Hashing: Interface that provides functions for hashing with different algorithms.
 */
 
 func ExtHashingBlake2128Version1(data int64) int32 {
-	panic("not implemented")
+	hash := emulator.Blake2128(load(data))
+	return storeFixed(hash[:])
 }
 
 func ExtHashingBlake2256Version1(data int64) int32 {
-	panic("not implemented")
+	hash := emulator.Blake2256(load(data))
+	return storeFixed(hash[:])
 }
 
 func ExtHashingKeccak256Version1(data int64) int32 {
-	panic("not implemented")
+	hash := emulator.Keccak256(load(data))
+	return storeFixed(hash[:])
 }
 
 func ExtHashingTwox128Version1(data int64) int32 {
-	panic("not implemented")
+	hash := emulator.Twox128(load(data))
+	return storeFixed(hash[:])
 }
 
 func ExtHashingTwox64Version1(data int64) int32 {
-	panic("not implemented")
+	hash := emulator.Twox64(load(data))
+	return storeFixed(hash[:])
 }
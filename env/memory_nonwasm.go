@@ -0,0 +1,33 @@
+//go:build nonwasmenv
+
+package env
+
+import "github.com/LimeChain/gosemble/env/emulator"
+
+/*
+	Memory: Helpers for exchanging data with the emulated host through its shared memory.
+*/
+
+func load(offsetAndSize int64) []byte {
+	return emulator.Current().Memory.Load(int32(offsetAndSize), int32(offsetAndSize>>32))
+}
+
+func loadFixed(offset int32, size int32) []byte {
+	return emulator.Current().Memory.Load(offset, size)
+}
+
+func store(data []byte) int64 {
+	offset := emulator.Current().Memory.Store(data)
+	return int64(offset) | (int64(len(data)) << 32)
+}
+
+func storeFixed(data []byte) int32 {
+	return emulator.Current().Memory.Store(data)
+}
+
+func boolToInt32(value bool) int32 {
+	if value {
+		return 1
+	}
+	return 0
+}
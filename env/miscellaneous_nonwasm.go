@@ -2,18 +2,24 @@
 
 package env
 
+import (
+	"fmt"
+
+	"github.com/LimeChain/gosemble/env/emulator"
+)
+
 /*
 	Miscellaneous: Interface that provides miscellaneous functions for communicating between the runtime and the node.
 */
 
 func ExtMiscPrintHexVersion1(data int64) {
-	panic("not implemented")
+	fmt.Printf("%x\n", load(data))
 }
 
 func ExtMiscPrintUtf8Version1(data int64) {
-	panic("not implemented")
+	fmt.Println(string(load(data)))
 }
 
 func ExtMiscRuntimeVersionVersion1(data int64) int64 {
-	panic("not implemented")
+	return store(emulator.Current().MiscRuntimeVersion(load(data)))
 }
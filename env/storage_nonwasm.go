@@ -2,54 +2,57 @@
 
 package env
 
+import "github.com/LimeChain/gosemble/env/emulator"
+
 /*
 	Storage: Interface for manipulating the storage from within the runtime.
 */
 
 func ExtStorageAppendVersion1(key int64, value int64) int64 {
-	panic("not implemented")
+	emulator.Current().Storage.Append(load(key), load(value))
+	return 0
 }
 
 func ExtStorageClearVersion1(key_data int64) {
-	panic("not implemented")
+	emulator.Current().Storage.Clear(load(key_data))
 }
 
 func ExtStorageClearPrefixVersion2(prefix int64, limit int64) int64 {
-	panic("not implemented")
+	return store(emulator.Current().StorageClearPrefix(load(prefix), load(limit)))
 }
 
 func ExtStorageCommitTransactionVersion() {
-	panic("not implemented")
+	emulator.Current().Storage.CommitTransaction()
 }
 
 func ExtStorageExistsVersion1(key int64) int32 {
-	panic("not implemented")
+	return boolToInt32(emulator.Current().Storage.Exists(load(key)))
 }
 
 func ExtStorageGetVersion1(key int64) int64 {
-	panic("not implemented")
+	return store(emulator.Current().StorageGet(load(key)))
 }
 
 func ExtStorageNextKeyVersion1(key int64) int64 {
-	panic("not implemented")
+	return store(emulator.Current().StorageNextKey(load(key)))
 }
 
 func ExtStorageReadVersion1(key int64, value_out int64, offset int32) int64 {
-	panic("not implemented")
+	return store(emulator.Current().StorageRead(load(key), load(value_out), uint32(offset)))
 }
 
 func ExtStorageRollbackTransactionVersion1() {
-	panic("not implemented")
+	emulator.Current().Storage.RollbackTransaction()
 }
 
 func ExtStorageRootVersion2(key int32) int64 {
-	panic("not implemented")
+	return store(emulator.Current().StorageRoot(key))
 }
 
 func ExtStorageSetVersion1(key int64, value int64) {
-	panic("not implemented")
+	emulator.Current().Storage.Set(load(key), load(value))
 }
 
 func ExtStorageStartTransactionVersion1() {
-	panic("not implemented")
+	emulator.Current().Storage.StartTransaction()
 }
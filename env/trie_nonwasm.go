@@ -2,10 +2,12 @@
 
 package env
 
+import "github.com/LimeChain/gosemble/env/emulator"
+
 /*
 	Trie: Interface that provides trie related functionality
 */
 
 func ExtTrieBlake2256OrderedRootVersion2(input int64, version int32) int32 {
-	panic("not implemented")
+	return storeFixed(emulator.Current().TrieBlake2256OrderedRoot(load(input), version))
 }
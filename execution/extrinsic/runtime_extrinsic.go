@@ -4,6 +4,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -15,6 +16,7 @@ type RuntimeExtrinsic interface {
 	EnsureInherentsAreFirst(block primitives.Block) int
 	OnInitialize(n sc.U64) (primitives.Weight, error)
 	OnRuntimeUpgrade() primitives.Weight
+	TryOnRuntimeUpgrade(checks bool) (primitives.Weight, error)
 	OnFinalize(n sc.U64) error
 	OnIdle(n sc.U64, remainingWeight primitives.Weight) primitives.Weight
 	OffchainWorker(n sc.U64)
@@ -161,12 +163,11 @@ func (re runtimeExtrinsic) OnInitialize(n sc.U64) (primitives.Weight, error) {
 }
 
 func (re runtimeExtrinsic) OnRuntimeUpgrade() primitives.Weight {
-	weight := primitives.Weight{}
-	for _, m := range re.modules {
-		weight = weight.Add(m.OnRuntimeUpgrade())
-	}
+	return hooks.NewModulesOnRuntimeUpgrade(re.modules).OnRuntimeUpgrade()
+}
 
-	return weight
+func (re runtimeExtrinsic) TryOnRuntimeUpgrade(checks bool) (primitives.Weight, error) {
+	return hooks.NewModulesOnRuntimeUpgrade(re.modules).TryOnRuntimeUpgrade(checks)
 }
 
 func (re runtimeExtrinsic) OnFinalize(n sc.U64) error {
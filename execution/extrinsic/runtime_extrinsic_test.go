@@ -454,6 +454,20 @@ func Test_RuntimeExtrinsic_OnRuntimeUpgrade(t *testing.T) {
 	mockModuleTwo.AssertCalled(t, "OnRuntimeUpgrade")
 }
 
+func Test_RuntimeExtrinsic_TryOnRuntimeUpgrade(t *testing.T) {
+	target := setupRuntimeExtrinsic(mdGenerator)
+
+	mockModuleOne.On("OnRuntimeUpgrade").Return(weightOne)
+	mockModuleTwo.On("OnRuntimeUpgrade").Return(weightTwo)
+
+	result, err := target.TryOnRuntimeUpgrade(true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, weightOne.Add(weightTwo), result)
+	mockModuleOne.AssertCalled(t, "OnRuntimeUpgrade")
+	mockModuleTwo.AssertCalled(t, "OnRuntimeUpgrade")
+}
+
 func Test_RuntimeExtrinsic_OnFinalize(t *testing.T) {
 	target := setupRuntimeExtrinsic(mdGenerator)
 
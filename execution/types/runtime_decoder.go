@@ -11,9 +11,13 @@ import (
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// maxCallDepth is the maximum depth of nested calls (e.g. sudo(batch(sudo(...)))), which can be decoded.
+const maxCallDepth = 256
+
 var (
 	errInvalidExtrinsicVersion = errors.New("invalid Extrinsic version")
 	errInvalidLengthPrefix     = errors.New("invalid length prefix")
+	errMaxCallDepthExceeded    = errors.New("maximum call decoding depth exceeded")
 )
 
 type RuntimeDecoder interface {
@@ -25,13 +29,15 @@ type RuntimeDecoder interface {
 type runtimeDecoder struct {
 	modules []types.Module
 	extra   primitives.SignedExtra
+	lookup  primitives.StaticLookup
 	logger  log.WarnLogger
 }
 
-func NewRuntimeDecoder(modules []types.Module, extra primitives.SignedExtra, logger log.WarnLogger) RuntimeDecoder {
+func NewRuntimeDecoder(modules []types.Module, extra primitives.SignedExtra, lookup primitives.StaticLookup, logger log.WarnLogger) RuntimeDecoder {
 	return runtimeDecoder{
 		modules: modules,
 		extra:   extra,
+		lookup:  lookup,
 		logger:  logger,
 	}
 }
@@ -100,10 +106,18 @@ func (rd runtimeDecoder) DecodeUncheckedExtrinsic(buffer *bytes.Buffer) (primiti
 		return nil, errInvalidLengthPrefix
 	}
 
-	return NewUncheckedExtrinsic(sc.U8(version), extSignature, function, extra, rd.logger), nil
+	return NewUncheckedExtrinsic(sc.U8(version), extSignature, function, extra, rd.lookup, rd.logger), nil
 }
 
 func (rd runtimeDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return rd.decodeCall(buffer, 0)
+}
+
+func (rd runtimeDecoder) decodeCall(buffer *bytes.Buffer, depth int) (primitives.Call, error) {
+	if depth >= maxCallDepth {
+		return nil, errMaxCallDepthExceeded
+	}
+
 	moduleIndex, err := sc.DecodeU8(buffer)
 	if err != nil {
 		return nil, err
@@ -124,6 +138,12 @@ func (rd runtimeDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, erro
 		return nil, fmt.Errorf("function index [%d] for module [%d] not found", functionIndex, moduleIndex)
 	}
 
+	// Calls, which take other calls as arguments, decode them with the runtime decoder
+	// one level deeper.
+	if nestedCall, ok := function.(primitives.NestedCall); ok {
+		return nestedCall.DecodeNestedArgs(nestedCallDecoder{rd, depth + 1}, buffer)
+	}
+
 	function, err = function.DecodeArgs(buffer)
 	if err != nil {
 		return nil, err
@@ -131,3 +151,13 @@ func (rd runtimeDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, erro
 
 	return function, nil
 }
+
+// nestedCallDecoder decodes the calls nested at a given depth.
+type nestedCallDecoder struct {
+	decoder runtimeDecoder
+	depth   int
+}
+
+func (nd nestedCallDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return nd.decoder.decodeCall(buffer, nd.depth)
+}
@@ -101,7 +101,7 @@ func Test_RuntimeDecoder_DecodeBlock_Single_Extrinsic(t *testing.T) {
 	assert.NoError(t, err)
 
 	extrinsics := sc.Sequence[primitives.UncheckedExtrinsic]{
-		NewUncheckedExtrinsic(sc.U8(signedExtrinsicVersion), extrinsicSignature, mockCallOne, mockSignedExtra, logger),
+		NewUncheckedExtrinsic(sc.U8(signedExtrinsicVersion), extrinsicSignature, mockCallOne, mockSignedExtra, identityLookup, logger),
 	}
 
 	expectedBlock := NewBlock(header, extrinsics)
@@ -153,7 +153,7 @@ func Test_RuntimeDecoder_DecodeBlock_Multiple_Extrinsics(t *testing.T) {
 
 	extrinsics := sc.Sequence[primitives.UncheckedExtrinsic]{}
 	for i := 0; i < totalExtrinsicsInBlock; i++ {
-		extrinsics = append(extrinsics, NewUncheckedExtrinsic(sc.U8(signedExtrinsicVersion), extrinsicSignature, mockCallOne, mockSignedExtra, logger))
+		extrinsics = append(extrinsics, NewUncheckedExtrinsic(sc.U8(signedExtrinsicVersion), extrinsicSignature, mockCallOne, mockSignedExtra, identityLookup, logger))
 	}
 
 	expectedBlock := NewBlock(header, extrinsics)
@@ -195,7 +195,7 @@ func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_Unsigned(t *testing.T) {
 	result, err := target.DecodeUncheckedExtrinsic(buff)
 	assert.NoError(t, err)
 
-	expectedUnsignedExtrinsic := NewUncheckedExtrinsic(version, sc.Option[primitives.ExtrinsicSignature]{}, mockCallOne, mockSignedExtra, logger)
+	expectedUnsignedExtrinsic := NewUncheckedExtrinsic(version, sc.Option[primitives.ExtrinsicSignature]{}, mockCallOne, mockSignedExtra, identityLookup, logger)
 
 	assert.Equal(t, expectedUnsignedExtrinsic.IsSigned(), result.IsSigned())
 
@@ -225,7 +225,7 @@ func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_Signed(t *testing.T) {
 	result, err := target.DecodeUncheckedExtrinsic(buff)
 	assert.NoError(t, err)
 
-	expectedSignedExtrinsicsBytesAfterDecode := NewUncheckedExtrinsic(sc.U8(signedExtrinsicVersion), extrinsicSignature, mockCallOne, mockSignedExtra, logger)
+	expectedSignedExtrinsicsBytesAfterDecode := NewUncheckedExtrinsic(sc.U8(signedExtrinsicVersion), extrinsicSignature, mockCallOne, mockSignedExtra, identityLookup, logger)
 
 	assert.Equal(t, expectedSignedExtrinsicsBytesAfterDecode.IsSigned(), result.IsSigned())
 
@@ -352,6 +352,84 @@ func Test_RuntimeDecoder_DecodeCall(t *testing.T) {
 	mockCallOne.AssertCalled(t, "DecodeArgs", buf)
 }
 
+func Test_RuntimeDecoder_DecodeCall_NestedCall(t *testing.T) {
+	target := setupRuntimeDecoder()
+
+	callBytes := []byte{
+		uint8(moduleOneIdx), uint8(functionIdx),
+	}
+
+	buf := bytes.NewBuffer(callBytes)
+	mockNestedCall := new(mocks.NestedCall)
+	moduleFunctions[0] = mockNestedCall
+
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+	nestedDecoder := nestedCallDecoder{target.(runtimeDecoder), 1}
+	mockNestedCall.On("DecodeNestedArgs", nestedDecoder, buf).Return(mockNestedCall, nil)
+
+	result, err := target.DecodeCall(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, mockNestedCall, result)
+
+	mockNestedCall.AssertCalled(t, "DecodeNestedArgs", nestedDecoder, buf)
+	mockNestedCall.AssertNotCalled(t, "DecodeArgs", mock.Anything)
+}
+
+func Test_RuntimeDecoder_DecodeCall_NestedCall_MaxDepth(t *testing.T) {
+	target := setupRuntimeDecoder()
+
+	buf := bytes.NewBuffer(nestedCallBytes(maxCallDepth - 1))
+	moduleFunctions[0] = nestingCall{new(mocks.Call)}
+	moduleFunctions[1] = mockCallOne
+
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+	mockCallOne.On("DecodeArgs", buf).Return(mockCallOne, nil)
+
+	result, err := target.DecodeCall(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, mockCallOne, result)
+
+	mockCallOne.AssertCalled(t, "DecodeArgs", buf)
+}
+
+func Test_RuntimeDecoder_DecodeCall_NestedCall_MaxDepthExceeded(t *testing.T) {
+	target := setupRuntimeDecoder()
+
+	buf := bytes.NewBuffer(nestedCallBytes(maxCallDepth))
+	moduleFunctions[0] = nestingCall{new(mocks.Call)}
+	moduleFunctions[1] = mockCallOne
+
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+
+	result, err := target.DecodeCall(buf)
+	assert.Equal(t, errMaxCallDepthExceeded, err)
+	assert.Nil(t, result)
+
+	mockCallOne.AssertNotCalled(t, "DecodeArgs", mock.Anything)
+}
+
+// nestingCall takes a single call as its argument.
+type nestingCall struct {
+	*mocks.Call
+}
+
+func (c nestingCall) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	return decoder.DecodeCall(buffer)
+}
+
+// nestedCallBytes encodes `levels` nesting calls (function 0), wrapping a single leaf call (function 1).
+func nestedCallBytes(levels int) []byte {
+	var callBytes []byte
+	for i := 0; i < levels; i++ {
+		callBytes = append(callBytes, uint8(moduleOneIdx), uint8(functionIdx))
+	}
+
+	return append(callBytes, uint8(moduleOneIdx), uint8(functionIdx+1))
+}
+
 func setupRuntimeDecoder() RuntimeDecoder {
 	mockModuleOne = new(mocks.Module)
 
@@ -369,5 +447,5 @@ func setupRuntimeDecoder() RuntimeDecoder {
 
 	apis := []primitives.Module{mockModuleOne}
 
-	return NewRuntimeDecoder(apis, mockSignedExtra, logger)
+	return NewRuntimeDecoder(apis, mockSignedExtra, identityLookup, logger)
 }
@@ -38,6 +38,7 @@ type uncheckedExtrinsic struct {
 	signature         sc.Option[primitives.ExtrinsicSignature]
 	function          primitives.Call
 	extra             primitives.SignedExtra
+	lookup            primitives.StaticLookup
 	initializePayload PayloadInitializer
 	crypto            io.Crypto
 	hashing           io.Hashing
@@ -45,12 +46,14 @@ type uncheckedExtrinsic struct {
 }
 
 // NewUncheckedExtrinsic returns a new instance of an unchecked extrinsic.
-func NewUncheckedExtrinsic(version sc.U8, signature sc.Option[primitives.ExtrinsicSignature], function primitives.Call, extra primitives.SignedExtra, logger log.WarnLogger) primitives.UncheckedExtrinsic {
+// The signer address is resolved into an account with `lookup`.
+func NewUncheckedExtrinsic(version sc.U8, signature sc.Option[primitives.ExtrinsicSignature], function primitives.Call, extra primitives.SignedExtra, lookup primitives.StaticLookup, logger log.WarnLogger) primitives.UncheckedExtrinsic {
 	return uncheckedExtrinsic{
 		version:           version,
 		signature:         signature,
 		function:          function,
 		extra:             extra,
+		lookup:            lookup,
 		initializePayload: primitives.NewSignedPayload,
 		crypto:            io.NewCrypto(),
 		hashing:           io.NewHashing(),
@@ -119,7 +122,7 @@ func (uxt uncheckedExtrinsic) Check() (primitives.CheckedExtrinsic, error) {
 	if uxt.signature.HasValue {
 		signer, signature, extra := uxt.signature.Value.Signer, uxt.signature.Value.Signature, uxt.signature.Value.Extra
 
-		signerAddress, err := primitives.Lookup(signer)
+		signerAddress, err := uxt.lookup.Lookup(signer)
 		if err != nil {
 			return nil, err
 		}
@@ -144,6 +147,19 @@ func (uxt uncheckedExtrinsic) Check() (primitives.CheckedExtrinsic, error) {
 	return NewCheckedExtrinsic(sc.NewOption[primitives.AccountId](nil), uxt.function, uxt.extra, uxt.logger), nil
 }
 
+func (uxt uncheckedExtrinsic) UncheckedIntoChecked() (primitives.CheckedExtrinsic, error) {
+	if uxt.signature.HasValue {
+		signerAddress, err := uxt.lookup.Lookup(uxt.signature.Value.Signer)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCheckedExtrinsic(sc.NewOption[primitives.AccountId](signerAddress), uxt.function, uxt.signature.Value.Extra, uxt.logger), nil
+	}
+
+	return NewCheckedExtrinsic(sc.NewOption[primitives.AccountId](nil), uxt.function, uxt.extra, uxt.logger), nil
+}
+
 func (uxt uncheckedExtrinsic) verify(signature primitives.MultiSignature, msg sc.Sequence[sc.U8], signer primitives.AccountId) (bool, error) {
 	msgBytes := sc.SequenceU8ToBytes(msg)
 	signerBytes := signer.Bytes()
@@ -32,6 +32,8 @@ var (
 	signer25519Address, _ = types.NewAddress32(sc.BytesToSequenceU8(signerAddressBytes)...)
 	signerAccountId       = types.NewAccountIdFromAddress32(signer25519Address)
 	signer                = types.NewMultiAddressId(signerAccountId)
+	signerIndex           = types.NewMultiAddressIndex(3)
+	identityLookup        = types.NewIdentityLookup()
 
 	ecdsaAddressBytes = make([]byte, 33)
 	ecdsaPublicKey, _ = types.NewEcdsaPublicKey(sc.BytesToSequenceU8(ecdsaAddressBytes)...)
@@ -129,7 +131,7 @@ func newTestSignedExtrinsic(
 		return signedPayload, nil
 	}
 
-	uxt := NewUncheckedExtrinsic(version, signature, call, extra, logger).(uncheckedExtrinsic)
+	uxt := NewUncheckedExtrinsic(version, signature, call, extra, identityLookup, logger).(uncheckedExtrinsic)
 	uxt.initializePayload = initializer
 	uxt.crypto = crypto
 	uxt.hashing = hashing
@@ -329,6 +331,42 @@ func Test_Check_SignedUncheckedExtrinsic_Success(t *testing.T) {
 	mockCrypto.AssertCalled(t, "Ed25519Verify", signatureBytes, encodedPayloadBytes, signerAddressBytes)
 }
 
+func Test_Check_SignedUncheckedExtrinsic_AccountIndex(t *testing.T) {
+	setup(signatureEd25519)
+	mockLookup := new(mocks.StaticLookup)
+	targetSigned.lookup = mockLookup
+	targetSigned.signature.Value.Signer = signerIndex
+	expect := NewCheckedExtrinsic(sc.NewOption[types.AccountId](signerAccountId), mockCall, mockSignedExtra, logger).(checkedExtrinsic)
+
+	mockLookup.On("Lookup", signerIndex).Return(signerAccountId, nil)
+	mocksSignedPayload.On("Bytes").Return(encodedPayloadBytes)
+	mockCrypto.On("Ed25519Verify", signatureBytes, encodedPayloadBytes, signerAddressBytes).Return(true)
+
+	result, err := targetSigned.Check()
+
+	assert.Nil(t, err)
+	checked := result.(checkedExtrinsic)
+	assert.Equal(t, expect.signer, checked.signer)
+	mockLookup.AssertCalled(t, "Lookup", signerIndex)
+	mockCrypto.AssertCalled(t, "Ed25519Verify", signatureBytes, encodedPayloadBytes, signerAddressBytes)
+}
+
+func Test_UncheckedIntoChecked_SignedUncheckedExtrinsic(t *testing.T) {
+	setup(signatureEd25519)
+	expect := NewCheckedExtrinsic(sc.NewOption[types.AccountId](signerAccountId), mockCall, mockSignedExtra, logger).(checkedExtrinsic)
+
+	result, err := targetSigned.UncheckedIntoChecked()
+
+	assert.Nil(t, err)
+	checked := result.(checkedExtrinsic)
+	assert.Equal(t, expect.extra, checked.extra)
+	assert.Equal(t, expect.signer, checked.signer)
+	assert.Equal(t, expect.function, checked.function)
+
+	mocksSignedPayload.AssertNotCalled(t, "Bytes")
+	mockCrypto.AssertNotCalled(t, "Ed25519Verify", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_Check_SignedUncheckedExtrinsic_Success_Sr25519(t *testing.T) {
 	setup(signatureSr25519)
 	expect := NewCheckedExtrinsic(sc.NewOption[types.AccountId](signerAccountId), mockCall, mockSignedExtra, logger).(checkedExtrinsic)
@@ -12,9 +12,12 @@ type Config struct {
 	MaxAuthorities             sc.U32
 	AllowMultipleBlocksPerSlot bool
 	SystemDigest               func() (primitives.Digest, error)
+	DepositLog                 func(item primitives.DigestItem)
+	// DisabledValidators provides the validators, which are disabled in the current session.
+	DisabledValidators primitives.DisabledValidators
 }
 
-func NewConfig(keyType primitives.PublicKeyType, dbWeight primitives.RuntimeDbWeight, minimumPeriod sc.U64, maxAuthorities sc.U32, allowMultipleBlocksPerSlot bool, systemDigest func() (primitives.Digest, error)) *Config {
+func NewConfig(keyType primitives.PublicKeyType, dbWeight primitives.RuntimeDbWeight, minimumPeriod sc.U64, maxAuthorities sc.U32, allowMultipleBlocksPerSlot bool, systemDigest func() (primitives.Digest, error), depositLog func(item primitives.DigestItem), disabledValidators primitives.DisabledValidators) *Config {
 	return &Config{
 		KeyType:                    keyType,
 		DbWeight:                   dbWeight,
@@ -22,5 +25,7 @@ func NewConfig(keyType primitives.PublicKeyType, dbWeight primitives.RuntimeDbWe
 		MaxAuthorities:             maxAuthorities,
 		AllowMultipleBlocksPerSlot: allowMultipleBlocksPerSlot,
 		SystemDigest:               systemDigest,
+		DepositLog:                 depositLog,
+		DisabledValidators:         disabledValidators,
 	}
 }
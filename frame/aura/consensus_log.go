@@ -0,0 +1,18 @@
+package aura
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Aura consensus logs, deposited in the block digest.
+const (
+	// ConsensusLogAuthoritiesChange signals that the authorities have changed, starting from the next block.
+	ConsensusLogAuthoritiesChange sc.U8 = 1
+)
+
+func newConsensusLogAuthoritiesChange(authorities sc.Sequence[primitives.Sr25519PublicKey]) primitives.DigestItem {
+	message := append(ConsensusLogAuthoritiesChange.Bytes(), authorities.Bytes()...)
+
+	return primitives.NewDigestItemConsensusMessage(sc.BytesToFixedSequenceU8(EngineId[:]), sc.BytesToSequenceU8(message))
+}
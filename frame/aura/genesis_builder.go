@@ -67,7 +67,12 @@ func (m Module) BuildConfig(config []byte) error {
 		return err
 	}
 
-	if len(gc.Authorities) == 0 {
+	return m.initializeAuthorities(gc.Authorities)
+}
+
+// initializeAuthorities sets the genesis authorities. It fails if the authorities are already initialized.
+func (m Module) initializeAuthorities(authorities sc.Sequence[types.Sr25519PublicKey]) error {
+	if len(authorities) == 0 {
 		return nil
 	}
 
@@ -80,11 +85,11 @@ func (m Module) BuildConfig(config []byte) error {
 		return errAuthoritiesAlreadyInitialized
 	}
 
-	if len(gc.Authorities) > int(m.config.MaxAuthorities) {
+	if len(authorities) > int(m.config.MaxAuthorities) {
 		return errAuthoritiesExceedMaxAuthorities
 	}
 
-	m.storage.Authorities.Put(gc.Authorities)
+	m.storage.Authorities.Put(authorities)
 
 	return nil
 }
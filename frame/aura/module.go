@@ -7,6 +7,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -22,6 +23,7 @@ var (
 	errTimestampSlotMismatch = errors.New("Timestamp slot must match `CurrentSlot`")
 	errEmptyAuthorities      = errors.New("empty storage authorities")
 	errZeroAuthorities       = errors.New("zero storage authorities")
+	errDisabledValidator     = errors.New("Validator is disabled and should not be attempting to author blocks.")
 )
 
 type AuraModule interface {
@@ -37,9 +39,14 @@ type AuraModule interface {
 	StorageCurrentSlot() (sc.U64, error)
 }
 
+const (
+	storageVersion = sc.U16(0)
+)
+
 type Module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	index       sc.U8
 	config      *Config
 	storage     *storage
@@ -51,11 +58,12 @@ func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenera
 	storage := newStorage()
 
 	return Module{
-		index:       index,
-		config:      config,
-		storage:     storage,
-		constants:   newConstants(config.DbWeight, config.MinimumPeriod),
-		mdGenerator: mdGenerator,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyAura, storageVersion),
+		index:                index,
+		config:               config,
+		storage:              storage,
+		constants:            newConstants(config.DbWeight, config.MinimumPeriod),
+		mdGenerator:          mdGenerator,
 	}
 }
 
@@ -87,6 +95,45 @@ func (m Module) KeyTypeId() [4]byte {
 	return KeyTypeId
 }
 
+// OnGenesisSession initializes the authorities with the keys of the genesis validators.
+func (m Module) OnGenesisSession(validators sc.Sequence[primitives.SessionValidator]) error {
+	authorities, err := toAuthorities(validators)
+	if err != nil {
+		return err
+	}
+
+	return m.initializeAuthorities(authorities)
+}
+
+// OnNewSession changes the authorities to the keys of the new validators, if they have changed.
+// Validators above MaxAuthorities are ignored.
+func (m Module) OnNewSession(changed bool, validators sc.Sequence[primitives.SessionValidator], _ sc.Sequence[primitives.SessionValidator]) error {
+	if !changed {
+		return nil
+	}
+
+	nextAuthorities, err := toAuthorities(validators)
+	if err != nil {
+		return err
+	}
+	if len(nextAuthorities) > int(m.config.MaxAuthorities) {
+		nextAuthorities = nextAuthorities[:m.config.MaxAuthorities]
+	}
+
+	lastAuthorities, err := m.storage.Authorities.Get()
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(lastAuthorities, nextAuthorities) {
+		return nil
+	}
+
+	m.storage.Authorities.Put(nextAuthorities)
+	m.config.DepositLog(newConsensusLogAuthoritiesChange(nextAuthorities))
+
+	return nil
+}
+
 func (m Module) OnInitialize(_ sc.U64) (primitives.Weight, error) {
 	slot, err := m.currentSlotFromDigests()
 	if err != nil {
@@ -111,18 +158,16 @@ func (m Module) OnInitialize(_ sc.U64) (primitives.Weight, error) {
 		if err != nil {
 			return primitives.Weight{}, err
 		}
-		if totalAuthorities.HasValue {
-			_ = currentSlot % totalAuthorities.Value
-
-			// TODO: implement once  Session module is added
-			/*
-				if T::DisabledValidators::is_disabled(authority_index as u32) {
-							panic!(
-								"Validator with index {:?} is disabled and should not be attempting to author blocks.",
-								authority_index,
-							);
-						}
-			*/
+		if totalAuthorities.HasValue && totalAuthorities.Value > 0 {
+			authorityIndex := newSlot % totalAuthorities.Value
+
+			disabled, err := m.config.DisabledValidators.IsDisabled(sc.U32(authorityIndex))
+			if err != nil {
+				return primitives.Weight{}, err
+			}
+			if disabled {
+				return primitives.Weight{}, errDisabledValidator
+			}
 		}
 
 		return m.constants.DbWeight.ReadsWrites(2, 1), nil
@@ -339,3 +384,17 @@ func (m Module) currentSlotFromDigests() (sc.Option[slot], error) {
 
 	return sc.NewOption[slot](nil), nil
 }
+
+// toAuthorities returns the session keys of `validators` as authorities.
+func toAuthorities(validators sc.Sequence[primitives.SessionValidator]) (sc.Sequence[primitives.Sr25519PublicKey], error) {
+	authorities := sc.Sequence[primitives.Sr25519PublicKey]{}
+	for _, validator := range validators {
+		authority, err := primitives.NewSr25519PublicKey(validator.Key...)
+		if err != nil {
+			return nil, err
+		}
+		authorities = append(authorities, authority)
+	}
+
+	return authorities, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/mocks"
 	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -31,7 +32,10 @@ var (
 			Message:           sc.BytesToSequenceU8(sc.U64(currentSlot).Bytes()),
 		},
 	}
-	mdGenerator = types.NewMetadataTypeGenerator()
+	mdGenerator       = types.NewMetadataTypeGenerator()
+	sessionValidators = sc.Sequence[types.SessionValidator]{
+		{Key: sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)},
+	}
 )
 
 var (
@@ -47,6 +51,8 @@ var (
 	mockStorageDigest      *mocks.StorageValue[types.Digest]
 	mockStorageCurrentSlot *mocks.StorageValue[sc.U64]
 	mockStorageAuthorities *mocks.StorageValue[sc.Sequence[types.Sr25519PublicKey]]
+	mockSystemModule       *mocks.SystemModule
+	mockDisabledValidators *mocks.DisabledValidators
 )
 
 var (
@@ -150,6 +156,8 @@ func setup(minimumPeriod sc.U64) {
 	mockStorageDigest = new(mocks.StorageValue[types.Digest])
 	mockStorageCurrentSlot = new(mocks.StorageValue[sc.U64])
 	mockStorageAuthorities = new(mocks.StorageValue[sc.Sequence[types.Sr25519PublicKey]])
+	mockSystemModule = new(mocks.SystemModule)
+	mockDisabledValidators = new(mocks.DisabledValidators)
 
 	config := NewConfig(
 		keyType,
@@ -158,6 +166,8 @@ func setup(minimumPeriod sc.U64) {
 		maxAuthorities,
 		allowMultipleBlocksPerSlot,
 		mockStorageDigest.Get,
+		mockSystemModule.DepositLog,
+		mockDisabledValidators,
 	)
 	module = New(moduleId, config, mdGenerator)
 	module.storage.CurrentSlot = mockStorageCurrentSlot
@@ -226,6 +236,93 @@ func Test_Aura_KeyTypeId(t *testing.T) {
 	assert.Equal(t, [4]byte{'a', 'u', 'r', 'a'}, module.KeyTypeId())
 }
 
+func Test_Aura_OnGenesisSession(t *testing.T) {
+	setup(timestampMinimumPeriod)
+
+	mockStorageAuthorities.On("DecodeLen").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAuthorities.On("Put", authorities).Return()
+
+	err := module.OnGenesisSession(sessionValidators)
+	assert.Nil(t, err)
+
+	mockStorageAuthorities.AssertCalled(t, "DecodeLen")
+	mockStorageAuthorities.AssertCalled(t, "Put", authorities)
+}
+
+func Test_Aura_OnGenesisSession_InvalidKey(t *testing.T) {
+	setup(timestampMinimumPeriod)
+
+	_, expectErr := types.NewSr25519PublicKey(sc.U8(1))
+
+	err := module.OnGenesisSession(sc.Sequence[types.SessionValidator]{{Key: sc.Sequence[sc.U8]{1}}})
+	assert.Equal(t, expectErr, err)
+
+	mockStorageAuthorities.AssertNotCalled(t, "DecodeLen")
+}
+
+func Test_Aura_OnNewSession(t *testing.T) {
+	setup(timestampMinimumPeriod)
+
+	mockStorageAuthorities.On("Get").Return(sc.Sequence[types.Sr25519PublicKey]{}, nil)
+	mockStorageAuthorities.On("Put", authorities).Return()
+	mockSystemModule.On("DepositLog", newConsensusLogAuthoritiesChange(authorities)).Return()
+
+	err := module.OnNewSession(true, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStorageAuthorities.AssertCalled(t, "Put", authorities)
+	mockSystemModule.AssertCalled(t, "DepositLog", newConsensusLogAuthoritiesChange(authorities))
+}
+
+func Test_Aura_OnNewSession_MaxAuthorities(t *testing.T) {
+	setup(timestampMinimumPeriod)
+	module.config.MaxAuthorities = 1
+
+	validators := append(sessionValidators, sessionValidators...)
+	mockStorageAuthorities.On("Get").Return(sc.Sequence[types.Sr25519PublicKey]{}, nil)
+	mockStorageAuthorities.On("Put", authorities).Return()
+	mockSystemModule.On("DepositLog", newConsensusLogAuthoritiesChange(authorities)).Return()
+
+	err := module.OnNewSession(true, validators, validators)
+	assert.Nil(t, err)
+
+	mockStorageAuthorities.AssertCalled(t, "Put", authorities)
+}
+
+func Test_Aura_OnNewSession_NotChanged(t *testing.T) {
+	setup(timestampMinimumPeriod)
+
+	err := module.OnNewSession(false, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStorageAuthorities.AssertNotCalled(t, "Get")
+	mockSystemModule.AssertNotCalled(t, "DepositLog", mock.Anything)
+}
+
+func Test_Aura_OnNewSession_SameAuthorities(t *testing.T) {
+	setup(timestampMinimumPeriod)
+
+	mockStorageAuthorities.On("Get").Return(authorities, nil)
+
+	err := module.OnNewSession(true, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStorageAuthorities.AssertNotCalled(t, "Put", mock.Anything)
+	mockSystemModule.AssertNotCalled(t, "DepositLog", mock.Anything)
+}
+
+func Test_Aura_OnNewSession_StorageError(t *testing.T) {
+	setup(timestampMinimumPeriod)
+
+	expectErr := errors.New("err")
+	mockStorageAuthorities.On("Get").Return(sc.Sequence[types.Sr25519PublicKey]{}, expectErr)
+
+	err := module.OnNewSession(true, sessionValidators, sessionValidators)
+	assert.Equal(t, expectErr, err)
+
+	mockStorageAuthorities.AssertNotCalled(t, "Put", mock.Anything)
+}
+
 func Test_Aura_Metadata(t *testing.T) {
 	setup(timestampMinimumPeriod)
 
@@ -297,6 +394,7 @@ func Test_Aura_OnInitialize_CurrentSlotUpdate(t *testing.T) {
 	mockStorageCurrentSlot.On("Get").Return(sc.U64(0), nil)
 	mockStorageCurrentSlot.On("Put", sc.U64(1)).Return()
 	mockStorageAuthorities.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(3)), nil)
+	mockDisabledValidators.On("IsDisabled", sc.U32(1)).Return(false, nil)
 
 	onInit, err := module.OnInitialize(blockNumber)
 	assert.Nil(t, err)
@@ -304,6 +402,36 @@ func Test_Aura_OnInitialize_CurrentSlotUpdate(t *testing.T) {
 	assert.Equal(t, types.WeightFromParts(13_000, 0), onInit)
 	mockStorageDigest.AssertCalled(t, "Get")
 	mockStorageCurrentSlot.AssertCalled(t, "Put", sc.U64(1))
+	mockDisabledValidators.AssertCalled(t, "IsDisabled", sc.U32(1))
+}
+
+func Test_Aura_OnInitialize_DisabledValidator(t *testing.T) {
+	setup(timestampMinimumPeriod)
+	mockStorageDigest.On("Get").Return(newPreRuntimeDigest(sc.U64(5)), nil)
+	mockStorageCurrentSlot.On("Get").Return(sc.U64(4), nil)
+	mockStorageCurrentSlot.On("Put", sc.U64(5)).Return()
+	mockStorageAuthorities.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(3)), nil)
+	mockDisabledValidators.On("IsDisabled", sc.U32(2)).Return(true, nil)
+
+	_, err := module.OnInitialize(blockNumber)
+
+	assert.Equal(t, errDisabledValidator, err)
+	mockDisabledValidators.AssertCalled(t, "IsDisabled", sc.U32(2))
+}
+
+func Test_Aura_OnInitialize_DisabledValidatorsError(t *testing.T) {
+	setup(timestampMinimumPeriod)
+	expectError := errors.New("disabled validators error")
+	mockStorageDigest.On("Get").Return(newPreRuntimeDigest(sc.U64(1)), nil)
+	mockStorageCurrentSlot.On("Get").Return(sc.U64(0), nil)
+	mockStorageCurrentSlot.On("Put", sc.U64(1)).Return()
+	mockStorageAuthorities.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(3)), nil)
+	mockDisabledValidators.On("IsDisabled", sc.U32(1)).Return(false, expectError)
+
+	result, err := module.OnInitialize(blockNumber)
+
+	assert.Equal(t, expectError, err)
+	assert.Equal(t, types.Weight{}, result)
 }
 
 func Test_Aura_OnTimestampSet_DurationCannotBeZero(t *testing.T) {
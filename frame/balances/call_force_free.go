@@ -17,14 +17,14 @@ type callForceFree struct {
 	logger log.DebugLogger
 }
 
-func newCallForceFree(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, constants *consts, mutator accountMutator, logger log.DebugLogger) primitives.Call {
+func newCallForceFree(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, lookup primitives.StaticLookup, constants *consts, mutator accountMutator, logger log.DebugLogger) primitives.Call {
 	call := callForceFree{
 		Callable: primitives.Callable{
 			ModuleId:   moduleId,
 			FunctionId: functionId,
 			Arguments:  sc.NewVaryingData(types.MultiAddress{}, sc.U128{}),
 		},
-		transfer: newTransfer(moduleId, storedMap, constants, mutator),
+		transfer: newTransfer(moduleId, storedMap, lookup, constants, mutator),
 		logger:   logger,
 	}
 
@@ -103,7 +103,7 @@ func (c callForceFree) forceFree(origin types.RawOrigin, who types.MultiAddress,
 		return types.NewDispatchErrorBadOrigin()
 	}
 
-	target, err := types.Lookup(who)
+	target, err := c.lookup.Lookup(who)
 	if err != nil {
 		c.logger.Debugf("Failed to lookup [%s]", who.Bytes())
 		return types.NewDispatchErrorCannotLookup()
@@ -50,6 +50,7 @@ func Test_Call_ForceFree_new(t *testing.T) {
 		transfer: transfer{
 			moduleId:       moduleId,
 			storedMap:      mockStoredMap,
+			lookup:         testLookup,
 			constants:      testConstants,
 			accountMutator: mockMutator,
 		},
@@ -291,5 +292,5 @@ func setupCallForceFree() primitives.Call {
 	mockStoredMap = new(mocks.StoredMap)
 	mockMutator = new(mockAccountMutator)
 
-	return newCallForceFree(moduleId, sc.U8(functionForceFreeIndex), mockStoredMap, testConstants, mockMutator, logger)
+	return newCallForceFree(moduleId, sc.U8(functionForceFreeIndex), mockStoredMap, testLookup, testConstants, mockMutator, logger)
 }
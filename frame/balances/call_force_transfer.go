@@ -14,14 +14,14 @@ type callForceTransfer struct {
 	transfer
 }
 
-func newCallForceTransfer(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, constants *consts, mutator accountMutator) primitives.Call {
+func newCallForceTransfer(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, lookup primitives.StaticLookup, constants *consts, mutator accountMutator) primitives.Call {
 	call := callForceTransfer{
 		Callable: primitives.Callable{
 			ModuleId:   moduleId,
 			FunctionId: functionId,
 			Arguments:  sc.NewVaryingData(types.MultiAddress{}, types.MultiAddress{}, sc.Compact{Number: sc.U128{}}),
 		},
-		transfer: newTransfer(moduleId, storedMap, constants, mutator),
+		transfer: newTransfer(moduleId, storedMap, lookup, constants, mutator),
 	}
 
 	return call
@@ -107,11 +107,11 @@ func (c callForceTransfer) forceTransfer(origin types.RawOrigin, source types.Mu
 		return types.NewDispatchErrorBadOrigin()
 	}
 
-	sourceAddress, err := types.Lookup(source)
+	sourceAddress, err := c.lookup.Lookup(source)
 	if err != nil {
 		return types.NewDispatchErrorCannotLookup()
 	}
-	destinationAddress, err := types.Lookup(dest)
+	destinationAddress, err := c.lookup.Lookup(dest)
 	if err != nil {
 		return types.NewDispatchErrorCannotLookup()
 	}
@@ -27,6 +27,7 @@ func Test_Call_ForceTransfer_new(t *testing.T) {
 		transfer: transfer{
 			moduleId:       moduleId,
 			storedMap:      mockStoredMap,
+			lookup:         testLookup,
 			constants:      testConstants,
 			accountMutator: mockMutator,
 		},
@@ -207,5 +208,5 @@ func setupCallForceTransfer() primitives.Call {
 	mockStoredMap = new(mocks.StoredMap)
 	mockMutator = new(mockAccountMutator)
 
-	return newCallForceTransfer(moduleId, functionForceTransferIndex, mockStoredMap, testConstants, mockMutator)
+	return newCallForceTransfer(moduleId, functionForceTransferIndex, mockStoredMap, testLookup, testConstants, mockMutator)
 }
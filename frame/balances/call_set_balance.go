@@ -13,11 +13,12 @@ type callSetBalance struct {
 	types.Callable
 	constants      *consts
 	storedMap      types.StoredMap
+	lookup         types.StaticLookup
 	accountMutator accountMutator
 	issuance       support.StorageValue[sc.U128]
 }
 
-func newCallSetBalance(moduleId sc.U8, functionId sc.U8, storedMap types.StoredMap, constants *consts, mutator accountMutator, issuance support.StorageValue[sc.U128]) types.Call {
+func newCallSetBalance(moduleId sc.U8, functionId sc.U8, storedMap types.StoredMap, lookup types.StaticLookup, constants *consts, mutator accountMutator, issuance support.StorageValue[sc.U128]) types.Call {
 	call := callSetBalance{
 		Callable: types.Callable{
 			ModuleId:   moduleId,
@@ -26,6 +27,7 @@ func newCallSetBalance(moduleId sc.U8, functionId sc.U8, storedMap types.StoredM
 		},
 		constants:      constants,
 		storedMap:      storedMap,
+		lookup:         lookup,
 		accountMutator: mutator,
 		issuance:       issuance,
 	}
@@ -129,7 +131,7 @@ func (c callSetBalance) setBalance(origin types.RawOrigin, who types.MultiAddres
 		return types.NewDispatchErrorBadOrigin()
 	}
 
-	address, err := types.Lookup(who)
+	address, err := c.lookup.Lookup(who)
 	if err != nil {
 		return types.NewDispatchErrorCannotLookup()
 	}
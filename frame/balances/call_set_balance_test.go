@@ -31,6 +31,7 @@ func Test_Call_SetBalance_new(t *testing.T) {
 		},
 		constants:      testConstants,
 		storedMap:      mockStoredMap,
+		lookup:         testLookup,
 		accountMutator: mockMutator,
 		issuance:       mockStorageTotalIssuance,
 	}
@@ -478,5 +479,5 @@ func setupCallSetBalance() primitives.Call {
 	mockMutator = new(mockAccountMutator)
 	mockStorageTotalIssuance = new(mocks.StorageValue[sc.U128])
 
-	return newCallSetBalance(moduleId, functionSetBalanceIndex, mockStoredMap, testConstants, mockMutator, mockStorageTotalIssuance).(callSetBalance)
+	return newCallSetBalance(moduleId, functionSetBalanceIndex, mockStoredMap, testLookup, testConstants, mockMutator, mockStorageTotalIssuance).(callSetBalance)
 }
@@ -16,7 +16,7 @@ type callTransfer struct {
 	transfer
 }
 
-func newCallTransfer(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, constants *consts,
+func newCallTransfer(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, lookup primitives.StaticLookup, constants *consts,
 	mutator accountMutator) primitives.Call {
 	call := callTransfer{
 		Callable: primitives.Callable{
@@ -24,7 +24,7 @@ func newCallTransfer(moduleId sc.U8, functionId sc.U8, storedMap primitives.Stor
 			FunctionId: functionId,
 			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.Compact{Number: sc.U128{}}),
 		},
-		transfer: newTransfer(moduleId, storedMap, constants, mutator),
+		transfer: newTransfer(moduleId, storedMap, lookup, constants, mutator),
 	}
 
 	return call
@@ -101,14 +101,16 @@ func (_ transfer) Docs() string {
 type transfer struct {
 	moduleId       sc.U8
 	storedMap      primitives.StoredMap
+	lookup         primitives.StaticLookup
 	constants      *consts
 	accountMutator accountMutator
 }
 
-func newTransfer(moduleId sc.U8, storedMap primitives.StoredMap, constants *consts, mutator accountMutator) transfer {
+func newTransfer(moduleId sc.U8, storedMap primitives.StoredMap, lookup primitives.StaticLookup, constants *consts, mutator accountMutator) transfer {
 	return transfer{
 		moduleId:       moduleId,
 		storedMap:      storedMap,
+		lookup:         lookup,
 		constants:      constants,
 		accountMutator: mutator,
 	}
@@ -122,7 +124,7 @@ func (t transfer) transfer(origin types.RawOrigin, dest types.MultiAddress, valu
 		return types.NewDispatchErrorBadOrigin()
 	}
 
-	to, err := types.Lookup(dest)
+	to, err := t.lookup.Lookup(dest)
 	if err != nil {
 		return types.NewDispatchErrorCannotLookup()
 	}
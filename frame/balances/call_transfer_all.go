@@ -15,14 +15,14 @@ type callTransferAll struct {
 	logger log.DebugLogger
 }
 
-func newCallTransferAll(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, constants *consts, mutator accountMutator, logger log.DebugLogger) primitives.Call {
+func newCallTransferAll(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, lookup primitives.StaticLookup, constants *consts, mutator accountMutator, logger log.DebugLogger) primitives.Call {
 	call := callTransferAll{
 		Callable: primitives.Callable{
 			ModuleId:   moduleId,
 			FunctionId: functionId,
 			Arguments:  sc.NewVaryingData(types.MultiAddress{}, sc.Bool(true)),
 		},
-		transfer: newTransfer(moduleId, storedMap, constants, mutator),
+		transfer: newTransfer(moduleId, storedMap, lookup, constants, mutator),
 		logger:   logger,
 	}
 
@@ -110,7 +110,7 @@ func (c callTransferAll) transferAll(origin types.RawOrigin, dest types.MultiAdd
 		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
 	}
 
-	to, errLookup := types.Lookup(dest)
+	to, errLookup := c.lookup.Lookup(dest)
 	if errLookup != nil {
 		c.logger.Debugf("Failed to lookup [%s]", dest.Bytes())
 		return types.NewDispatchErrorCannotLookup()
@@ -26,6 +26,7 @@ func Test_Call_TransferAll_new(t *testing.T) {
 		transfer: transfer{
 			moduleId:       moduleId,
 			storedMap:      mockStoredMap,
+			lookup:         testLookup,
 			constants:      testConstants,
 			accountMutator: mockMutator,
 		},
@@ -238,5 +239,5 @@ func setupCallTransferAll() primitives.Call {
 	mockStoredMap = new(mocks.StoredMap)
 	mockMutator = new(mockAccountMutator)
 
-	return newCallTransferAll(moduleId, functionTransferAllIndex, mockStoredMap, testConstants, mockMutator, logger)
+	return newCallTransferAll(moduleId, functionTransferAllIndex, mockStoredMap, testLookup, testConstants, mockMutator, logger)
 }
@@ -14,14 +14,14 @@ type callTransferKeepAlive struct {
 	transfer
 }
 
-func newCallTransferKeepAlive(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, constants *consts, mutator accountMutator) primitives.Call {
+func newCallTransferKeepAlive(moduleId sc.U8, functionId sc.U8, storedMap primitives.StoredMap, lookup primitives.StaticLookup, constants *consts, mutator accountMutator) primitives.Call {
 	call := callTransferKeepAlive{
 		Callable: primitives.Callable{
 			ModuleId:   moduleId,
 			FunctionId: functionId,
 			Arguments:  sc.NewVaryingData(types.MultiAddress{}, sc.Compact{Number: sc.U128{}}),
 		},
-		transfer: newTransfer(moduleId, storedMap, constants, mutator),
+		transfer: newTransfer(moduleId, storedMap, lookup, constants, mutator),
 	}
 
 	return call
@@ -105,7 +105,7 @@ func (c callTransferKeepAlive) transferKeepAlive(origin types.RawOrigin, dest ty
 		return primitives.NewDispatchErrorOther(sc.Str(originErr.Error()))
 	}
 
-	address, err := types.Lookup(dest)
+	address, err := c.lookup.Lookup(dest)
 	if err != nil {
 		return types.NewDispatchErrorCannotLookup()
 	}
@@ -27,6 +27,7 @@ func Test_Call_TransferKeepAlive_new(t *testing.T) {
 		transfer: transfer{
 			moduleId:       moduleId,
 			storedMap:      mockStoredMap,
+			lookup:         testLookup,
 			constants:      testConstants,
 			accountMutator: mockMutator,
 		},
@@ -205,5 +206,5 @@ func setupCallTransferKeepAlive() primitives.Call {
 	mockStoredMap = new(mocks.StoredMap)
 	mockMutator = new(mockAccountMutator)
 
-	return newCallTransferKeepAlive(moduleId, functionTransferKeepAliveIndex, mockStoredMap, testConstants, mockMutator)
+	return newCallTransferKeepAlive(moduleId, functionTransferKeepAliveIndex, mockStoredMap, testLookup, testConstants, mockMutator)
 }
@@ -16,9 +16,12 @@ import (
 var (
 	maxLocks           = sc.U32(5)
 	maxReserves        = sc.U32(6)
+	maxHolds           = sc.U32(7)
+	maxFreezes         = sc.U32(8)
 	existentialDeposit = sc.NewU128(1)
 	mockMutator        *mockAccountMutator
-	testConstants      = newConstants(dbWeight, maxLocks, maxReserves, existentialDeposit)
+	testConstants      = newConstants(dbWeight, maxLocks, maxReserves, maxHolds, maxFreezes, existentialDeposit)
+	testLookup         = primitives.NewIdentityLookup()
 
 	fromAccountData *primitives.AccountData
 	toAccountData   *primitives.AccountData
@@ -43,6 +46,7 @@ func Test_Call_Transfer_New(t *testing.T) {
 		transfer: transfer{
 			moduleId:       moduleId,
 			storedMap:      mockStoredMap,
+			lookup:         testLookup,
 			constants:      testConstants,
 			accountMutator: mockMutator,
 		},
@@ -171,6 +175,7 @@ func Test_transfer_New(t *testing.T) {
 	expected := transfer{
 		moduleId:       moduleId,
 		storedMap:      mockStoredMap,
+		lookup:         testLookup,
 		constants:      testConstants,
 		accountMutator: mockMutator,
 	}
@@ -189,6 +194,23 @@ func Test_transfer_Success(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func Test_transfer_AccountIndex(t *testing.T) {
+	target := setupTransfer()
+	mockLookup := new(mocks.StaticLookup)
+	target.lookup = mockLookup
+	indexAddress := primitives.NewMultiAddressIndex(1)
+
+	fromAddressId, err := fromAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	mockLookup.On("Lookup", indexAddress).Return(fromAddressId, nil)
+
+	result := target.transfer(primitives.NewRawOriginSigned(fromAddressId), indexAddress, targetValue)
+
+	assert.Nil(t, result)
+	mockLookup.AssertCalled(t, "Lookup", indexAddress)
+}
+
 func Test_transfer_InvalidOrigin(t *testing.T) {
 	target := setupTransfer()
 
@@ -480,7 +502,7 @@ func setupCallTransfer() primitives.Call {
 		Free: sc.NewU128(1),
 	}
 
-	return newCallTransfer(moduleId, functionTransferIndex, mockStoredMap, testConstants, mockMutator)
+	return newCallTransfer(moduleId, functionTransferIndex, mockStoredMap, testLookup, testConstants, mockMutator)
 }
 
 func setupTransfer() transfer {
@@ -495,5 +517,5 @@ func setupTransfer() transfer {
 		Free: sc.NewU128(1),
 	}
 
-	return newTransfer(moduleId, mockStoredMap, testConstants, mockMutator)
+	return newTransfer(moduleId, mockStoredMap, testLookup, testConstants, mockMutator)
 }
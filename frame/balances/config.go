@@ -9,16 +9,22 @@ type Config struct {
 	DbWeight           primitives.RuntimeDbWeight
 	MaxLocks           sc.U32
 	MaxReserves        sc.U32
+	MaxHolds           sc.U32
+	MaxFreezes         sc.U32
 	ExistentialDeposit sc.U128
 	StoredMap          primitives.StoredMap
+	Lookup             primitives.StaticLookup
 }
 
-func NewConfig(dbWeight primitives.RuntimeDbWeight, maxLocks sc.U32, maxReserves sc.U32, existentialDeposit sc.U128, storedMap primitives.StoredMap) *Config {
+func NewConfig(dbWeight primitives.RuntimeDbWeight, maxLocks sc.U32, maxReserves sc.U32, maxHolds sc.U32, maxFreezes sc.U32, existentialDeposit sc.U128, storedMap primitives.StoredMap, lookup primitives.StaticLookup) *Config {
 	return &Config{
 		DbWeight:           dbWeight,
 		MaxLocks:           maxLocks,
 		MaxReserves:        maxReserves,
+		MaxHolds:           maxHolds,
+		MaxFreezes:         maxFreezes,
 		ExistentialDeposit: existentialDeposit,
 		StoredMap:          storedMap,
+		Lookup:             lookup,
 	}
 }
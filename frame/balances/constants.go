@@ -9,6 +9,8 @@ type consts struct {
 	DbWeight           primitives.RuntimeDbWeight
 	MaxLocks           sc.U32
 	MaxReserves        sc.U32
+	MaxHolds           sc.U32
+	MaxFreezes         sc.U32
 	ExistentialDeposit sc.U128
 }
 
@@ -16,13 +18,17 @@ type metadataConstants struct {
 	ExistentialDeposit primitives.ExistentialDeposit
 	MaxLocks           primitives.MaxLocks
 	MaxReserves        primitives.MaxReserves
+	MaxHolds           primitives.MaxHolds
+	MaxFreezes         primitives.MaxFreezes
 }
 
-func newConstants(dbWeight primitives.RuntimeDbWeight, maxLocks sc.U32, maxReserves sc.U32, existentialDeposit sc.U128) *consts {
+func newConstants(dbWeight primitives.RuntimeDbWeight, maxLocks sc.U32, maxReserves sc.U32, maxHolds sc.U32, maxFreezes sc.U32, existentialDeposit sc.U128) *consts {
 	return &consts{
 		DbWeight:           dbWeight,
 		MaxLocks:           maxLocks,
 		MaxReserves:        maxReserves,
+		MaxHolds:           maxHolds,
+		MaxFreezes:         maxFreezes,
 		ExistentialDeposit: existentialDeposit,
 	}
 }
@@ -0,0 +1,38 @@
+package balances
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// CurrencyAdapter implements primitives.CurrencyAdapter over the balances module.
+// Unlike fungible.Balanced, withdrawals respect only the balance frozen for the given `reasons`.
+// It is a separate type, because its Withdraw differs from the fungible.Balanced one of Module.
+type CurrencyAdapter struct {
+	module Module
+}
+
+func NewCurrencyAdapter(module Module) CurrencyAdapter {
+	return CurrencyAdapter{module: module}
+}
+
+// DepositIntoExisting deposits `value` into the free balance of an existing target account `who`.
+// If `value` is 0, it does nothing.
+func (ca CurrencyAdapter) DepositIntoExisting(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	return ca.module.DepositIntoExisting(who, value)
+}
+
+// Withdraw withdraws `value` free balance from `who`, respecting existence requirements.
+// Does not do anything if value is 0.
+func (ca CurrencyAdapter) Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return sc.NewU128(0), nil
+	}
+
+	result, err := ca.module.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		return ca.module.withdraw(who, value, account, reasons, liveness)
+	})
+
+	return result.(primitives.Balance), err
+}
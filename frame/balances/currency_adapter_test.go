@@ -0,0 +1,83 @@
+package balances
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_CurrencyAdapter_DepositIntoExisting(t *testing.T) {
+	target := NewCurrencyAdapter(setupModule())
+
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), targetValue)
+
+	fromAddressId, err := fromAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	mockStoredMap.On("TryMutateExists", fromAddressId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+
+	result, err := target.DepositIntoExisting(fromAddressId, targetValue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, targetValue, result)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddressId, mockTypeMutateAccountData)
+}
+
+func Test_CurrencyAdapter_Withdraw_Success(t *testing.T) {
+	module := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	module.storage.TotalIssuance = mockTotalIssuance
+	target := NewCurrencyAdapter(module)
+
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), targetValue)
+
+	fromAddressId, err := fromAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	mockStoredMap.On("TryMutateExists", fromAddressId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+
+	result, errWithdraw := target.Withdraw(fromAddressId, targetValue, sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
+	assert.Nil(t, errWithdraw)
+
+	assert.Equal(t, targetValue, result)
+	assert.Nil(t, err)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddressId, mockTypeMutateAccountData)
+	mockTotalIssuance.AssertNotCalled(t, "Get")
+	mockTotalIssuance.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_CurrencyAdapter_Withdraw_ZeroValue(t *testing.T) {
+	target := NewCurrencyAdapter(setupModule())
+
+	fromAddressId, err := fromAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	result, errWithdraw := target.Withdraw(fromAddressId, sc.NewU128(0), sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
+	assert.Nil(t, errWithdraw)
+
+	assert.Equal(t, sc.NewU128(0), result)
+	assert.Nil(t, err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_CurrencyAdapter_Withdraw_TryMutateAccount_Fails(t *testing.T) {
+	target := NewCurrencyAdapter(setupModule())
+
+	expectedErr := primitives.NewDispatchErrorCannotLookup()
+
+	fromAddressId, err := fromAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	mockStoredMap.On("TryMutateExists", fromAddressId, mockTypeMutateAccountData).Return(sc.NewU128(1), expectedErr)
+
+	_, errWithdraw := target.Withdraw(fromAddressId, targetValue, sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
+
+	assert.Equal(t, expectedErr, errWithdraw)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddressId, mockTypeMutateAccountData)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
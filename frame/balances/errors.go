@@ -1,6 +1,9 @@
 package balances
 
-import sc "github.com/LimeChain/goscale"
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
 
 // Balances module errors.
 const (
@@ -12,4 +15,39 @@ const (
 	ErrorExistingVestingSchedule
 	ErrorDeadAccount
 	ErrorTooManyReserves
+	ErrorTooManyLocks
+	ErrorTooManyHolds
+	ErrorTooManyFreezes
 )
+
+func NewDispatchErrorTooManyReserves(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyReserves),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorTooManyLocks(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyLocks),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorTooManyHolds(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyHolds),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorTooManyFreezes(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyFreezes),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
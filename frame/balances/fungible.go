@@ -0,0 +1,606 @@
+package balances
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/balances/types"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// TotalIssuance returns the total amount of balance in existence.
+func (m Module) TotalIssuance() (primitives.Balance, error) {
+	return m.storage.TotalIssuance.Get()
+}
+
+// MinimumBalance returns the existential deposit.
+func (m Module) MinimumBalance() primitives.Balance {
+	return m.constants.ExistentialDeposit
+}
+
+// TotalBalance returns the sum of the free and reserved balance of `who`.
+func (m Module) TotalBalance(who primitives.AccountId) (primitives.Balance, error) {
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return account.Data.Total(), nil
+}
+
+// Balance returns the free balance of `who`.
+func (m Module) Balance(who primitives.AccountId) (primitives.Balance, error) {
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return account.Data.Free, nil
+}
+
+// ReducibleBalance returns the part of the free balance of `who` which can be withdrawn.
+// Frozen balance is untouchable, unless `force` is FortitudeForce. The existential deposit is untouchable,
+// unless `preservation` is PreservationExpendable and the account can lose its provider reference.
+func (m Module) ReducibleBalance(who primitives.AccountId, preservation fungible.Preservation, force fungible.Fortitude) (primitives.Balance, error) {
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+	accountData := account.Data
+
+	untouchable := constants.Zero
+	if force == fungible.FortitudePolite {
+		untouchable = sc.Max128(accountData.MiscFrozen, accountData.FeeFrozen)
+	}
+
+	canDecProviders, err := m.Config.StoredMap.CanDecProviders(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	isProvider := !accountData.Free.Eq(constants.Zero)
+	mustRemain := !canDecProviders || preservation == fungible.PreservationPreserve
+	stayAlive := isProvider && mustRemain
+
+	if preservation != fungible.PreservationExpendable || stayAlive {
+		untouchable = sc.Max128(untouchable, m.constants.ExistentialDeposit)
+	}
+
+	return sc.SaturatingSubU128(accountData.Free, untouchable), nil
+}
+
+// CanDeposit returns an error if `amount` cannot be deposited into `who`.
+func (m Module) CanDeposit(who primitives.AccountId, amount sc.U128, provenance fungible.Provenance) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	if provenance == fungible.ProvenanceMinted {
+		totalIssuance, err := m.storage.TotalIssuance.Get()
+		if err != nil {
+			return err
+		}
+		if _, err := sc.CheckedAddU128(totalIssuance, amount); err != nil {
+			return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+		}
+	}
+
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return err
+	}
+
+	free, err := sc.CheckedAddU128(account.Data.Free, amount)
+	if err != nil {
+		return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+	}
+
+	if free.Lt(m.constants.ExistentialDeposit) {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorBelowMinimum())
+	}
+
+	return nil
+}
+
+// CanWithdraw returns an error if `amount` cannot be withdrawn from `who`.
+func (m Module) CanWithdraw(who primitives.AccountId, amount sc.U128) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	totalIssuance, err := m.storage.TotalIssuance.Get()
+	if err != nil {
+		return err
+	}
+	if _, err := sc.CheckedSubU128(totalIssuance, amount); err != nil {
+		return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorUnderflow())
+	}
+
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return err
+	}
+	if _, err := sc.CheckedSubU128(account.Data.Free, amount); err != nil {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds())
+	}
+
+	liquid, err := m.ReducibleBalance(who, fungible.PreservationExpendable, fungible.FortitudePolite)
+	if err != nil {
+		return err
+	}
+	if amount.Gt(liquid) {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorFrozen())
+	}
+
+	return nil
+}
+
+// MintInto increases the free balance of `who` and the total issuance by `amount`.
+func (m Module) MintInto(who primitives.AccountId, amount sc.U128) (primitives.Balance, error) {
+	if err := m.CanDeposit(who, amount, fungible.ProvenanceMinted); err != nil {
+		return sc.U128{}, err
+	}
+
+	actual, err := m.increaseBalance(who, amount, fungible.PrecisionExact)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if err := newPositiveImbalance(actual, m.storage.TotalIssuance).Drop(); err != nil {
+		return sc.U128{}, err
+	}
+	m.Config.StoredMap.DepositEvent(newEventDeposit(m.Index, who, actual))
+
+	return actual, nil
+}
+
+// BurnFrom decreases the free balance of `who` and the total issuance by up to `amount`.
+// Returns the amount burned.
+func (m Module) BurnFrom(who primitives.AccountId, amount sc.U128, precision fungible.Precision, force fungible.Fortitude) (primitives.Balance, error) {
+	actual, err := m.decreaseBalance(who, amount, precision, fungible.PreservationExpendable, force)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if err := newNegativeImbalance(actual, m.storage.TotalIssuance).Drop(); err != nil {
+		return sc.U128{}, err
+	}
+	m.Config.StoredMap.DepositEvent(newEventWithdraw(m.Index, who, actual))
+
+	return actual, nil
+}
+
+// Transfer transfers `amount` free balance from `source` to `dest`.
+// `source` is kept alive, unless `preservation` is PreservationExpendable.
+func (m Module) Transfer(source primitives.AccountId, dest primitives.AccountId, amount sc.U128, preservation fungible.Preservation) (primitives.Balance, error) {
+	existenceRequirement := primitives.ExistenceRequirementKeepAlive
+	if preservation == fungible.PreservationExpendable {
+		existenceRequirement = primitives.ExistenceRequirementAllowDeath
+	}
+
+	err := newTransfer(m.Index, m.Config.StoredMap, m.Config.Lookup, m.constants, m).trans(source, dest, amount, existenceRequirement)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return amount, nil
+}
+
+// Deposit increases the free balance of `who` by `value`, without changing the total issuance.
+// Creates the account if it does not exist.
+func (m Module) Deposit(who primitives.AccountId, value sc.U128, precision fungible.Precision) (primitives.Balance, error) {
+	actual, err := m.increaseBalance(who, value, precision)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if !actual.Eq(constants.Zero) {
+		m.Config.StoredMap.DepositEvent(newEventDeposit(m.Index, who, actual))
+	}
+
+	return actual, nil
+}
+
+// Withdraw decreases the free balance of `who` by up to `value`, without changing the total issuance.
+func (m Module) Withdraw(who primitives.AccountId, value sc.U128, precision fungible.Precision, preservation fungible.Preservation, force fungible.Fortitude) (primitives.Balance, error) {
+	actual, err := m.decreaseBalance(who, value, precision, preservation, force)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if !actual.Eq(constants.Zero) {
+		m.Config.StoredMap.DepositEvent(newEventWithdraw(m.Index, who, actual))
+	}
+
+	return actual, nil
+}
+
+// TotalBalanceOnHold returns the reserved balance of `who`, which includes the funds held for all reasons.
+func (m Module) TotalBalanceOnHold(who primitives.AccountId) (primitives.Balance, error) {
+	return m.ReservedBalance(who)
+}
+
+// BalanceOnHold returns the balance of `who` which is held for `reason`.
+func (m Module) BalanceOnHold(reason [8]byte, who primitives.AccountId) (primitives.Balance, error) {
+	holds, err := m.storage.Holds.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return idAmountOf(holds, reason), nil
+}
+
+// CanHold returns an error if `amount` of the free balance of `who` cannot be held for `reason`.
+func (m Module) CanHold(reason [8]byte, who primitives.AccountId, amount sc.U128) error {
+	holds, err := m.storage.Holds.Get(who)
+	if err != nil {
+		return err
+	}
+
+	if _, found := idAmountIndex(holds, reason); !found && sc.U32(len(holds)) >= m.constants.MaxHolds {
+		return NewDispatchErrorTooManyHolds(m.Index)
+	}
+
+	canReserve, err := m.CanReserve(who, amount)
+	if err != nil {
+		return err
+	}
+	if !canReserve {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds())
+	}
+
+	return nil
+}
+
+// Hold moves `amount` from the free balance of `who` to be held for `reason`.
+// Held funds are part of the reserved balance of `who`.
+func (m Module) Hold(reason [8]byte, who primitives.AccountId, amount sc.U128) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	if err := m.CanHold(reason, who, amount); err != nil {
+		return err
+	}
+
+	held, err := m.BalanceOnHold(reason, who)
+	if err != nil {
+		return err
+	}
+	newHeld, err := sc.CheckedAddU128(held, amount)
+	if err != nil {
+		return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+	}
+
+	if err := m.Reserve(who, amount); err != nil {
+		return err
+	}
+
+	return m.setHold(reason, who, newHeld)
+}
+
+// Release moves up to `amount` held for `reason` back to the free balance of `who`.
+// Returns the amount released.
+func (m Module) Release(reason [8]byte, who primitives.AccountId, amount sc.U128, precision fungible.Precision) (primitives.Balance, error) {
+	held, toRelease, err := m.onHold(reason, who, amount, precision)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	remaining, err := m.Unreserve(who, toRelease)
+	if err != nil {
+		return sc.U128{}, err
+	}
+	actual := toRelease.Sub(remaining)
+
+	return actual, m.setHold(reason, who, held.Sub(actual))
+}
+
+// BurnHeld destroys up to `amount` held for `reason` and reduces the total issuance.
+// Held balance is not subject to freezes, so `force` has no effect.
+// Returns the amount burned.
+func (m Module) BurnHeld(reason [8]byte, who primitives.AccountId, amount sc.U128, precision fungible.Precision, _ fungible.Fortitude) (primitives.Balance, error) {
+	held, toBurn, err := m.onHold(reason, who, amount, precision)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	remaining, err := m.SlashReserved(who, toBurn)
+	if err != nil {
+		return sc.U128{}, err
+	}
+	actual := toBurn.Sub(remaining)
+
+	return actual, m.setHold(reason, who, held.Sub(actual))
+}
+
+// TransferOnHold moves up to `amount` held for `reason` from `source` to `dest`.
+// If `mode` is RestrictionOnHold, the funds are held for the same `reason` in `dest`.
+// Held balance is not subject to freezes, so `force` has no effect.
+// Returns the amount transferred.
+func (m Module) TransferOnHold(reason [8]byte, source primitives.AccountId, dest primitives.AccountId, amount sc.U128, precision fungible.Precision, mode fungible.Restriction, _ fungible.Fortitude) (primitives.Balance, error) {
+	held, toTransfer, err := m.onHold(reason, source, amount, precision)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	status := types.BalanceStatusFree
+	if mode == fungible.RestrictionOnHold {
+		status = types.BalanceStatusReserved
+
+		destHolds, err := m.storage.Holds.Get(dest)
+		if err != nil {
+			return sc.U128{}, err
+		}
+		if _, found := idAmountIndex(destHolds, reason); !found && sc.U32(len(destHolds)) >= m.constants.MaxHolds {
+			return sc.U128{}, NewDispatchErrorTooManyHolds(m.Index)
+		}
+	}
+
+	remaining, err := m.RepatriateReserved(source, dest, toTransfer, status)
+	if err != nil {
+		return sc.U128{}, err
+	}
+	actual := toTransfer.Sub(remaining)
+
+	if err := m.setHold(reason, source, held.Sub(actual)); err != nil {
+		return sc.U128{}, err
+	}
+
+	if mode == fungible.RestrictionOnHold {
+		destHeld, err := m.BalanceOnHold(reason, dest)
+		if err != nil {
+			return sc.U128{}, err
+		}
+		if err := m.setHold(reason, dest, sc.SaturatingAddU128(destHeld, actual)); err != nil {
+			return sc.U128{}, err
+		}
+	}
+
+	return actual, nil
+}
+
+// BalanceFrozen returns the balance of `who` which is frozen under `id`.
+func (m Module) BalanceFrozen(id [8]byte, who primitives.AccountId) (primitives.Balance, error) {
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return idAmountOf(freezes, id), nil
+}
+
+// CanFreeze returns whether a freeze under `id` can be placed on `who`.
+func (m Module) CanFreeze(id [8]byte, who primitives.AccountId) (bool, error) {
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return false, err
+	}
+
+	if _, found := idAmountIndex(freezes, id); found {
+		return true, nil
+	}
+
+	return sc.U32(len(freezes)) < m.constants.MaxFreezes, nil
+}
+
+// SetFreeze freezes `amount` of `who` under `id`, replacing any existing freeze with that id.
+// Freezes apply to all withdraw reasons. If `amount` is zero, the freeze is removed.
+func (m Module) SetFreeze(id [8]byte, who primitives.AccountId, amount sc.U128) error {
+	if amount.Eq(constants.Zero) {
+		return m.Thaw(id, who)
+	}
+
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return err
+	}
+
+	if _, found := idAmountIndex(freezes, id); !found && sc.U32(len(freezes)) >= m.constants.MaxFreezes {
+		return NewDispatchErrorTooManyFreezes(m.Index)
+	}
+
+	return m.updateFreezes(who, setIdAmount(freezes, id, amount))
+}
+
+// ExtendFreeze freezes at least `amount` of `who` under `id`. Creates a new freeze if one with `id` does not exist.
+// If `amount` is zero, it does nothing.
+func (m Module) ExtendFreeze(id [8]byte, who primitives.AccountId, amount sc.U128) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return err
+	}
+
+	if _, found := idAmountIndex(freezes, id); !found && sc.U32(len(freezes)) >= m.constants.MaxFreezes {
+		return NewDispatchErrorTooManyFreezes(m.Index)
+	}
+
+	return m.updateFreezes(who, setIdAmount(freezes, id, sc.Max128(idAmountOf(freezes, id), amount)))
+}
+
+// Thaw removes the freeze under `id` from `who`.
+func (m Module) Thaw(id [8]byte, who primitives.AccountId) error {
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return err
+	}
+
+	return m.updateFreezes(who, setIdAmount(freezes, id, constants.Zero))
+}
+
+// increaseBalance adds up to `amount` to the free balance of `who`, creating the account if needed.
+// Returns the amount added.
+func (m Module) increaseBalance(who primitives.AccountId, amount sc.U128, precision fungible.Precision) (primitives.Balance, error) {
+	if amount.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	result, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		free, err := sc.CheckedAddU128(account.Free, amount)
+		if err != nil {
+			if precision == fungible.PrecisionExact {
+				return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+			}
+			free = sc.SaturatingAddU128(account.Free, amount)
+		}
+
+		if free.Lt(m.constants.ExistentialDeposit) {
+			if precision == fungible.PrecisionBestEffort {
+				return constants.Zero, nil
+			}
+			return nil, primitives.NewDispatchErrorToken(primitives.NewTokenErrorBelowMinimum())
+		}
+
+		actual := free.Sub(account.Free)
+		account.Free = free
+
+		return actual, nil
+	})
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return result.(primitives.Balance), nil
+}
+
+// decreaseBalance removes up to `amount` from the free balance of `who`, limited by the reducible balance.
+// Returns the amount removed.
+func (m Module) decreaseBalance(who primitives.AccountId, amount sc.U128, precision fungible.Precision, preservation fungible.Preservation, force fungible.Fortitude) (primitives.Balance, error) {
+	if amount.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	reducible, err := m.ReducibleBalance(who, preservation, force)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if precision == fungible.PrecisionBestEffort {
+		amount = sc.Min128(amount, reducible)
+	} else if amount.Gt(reducible) {
+		return sc.U128{}, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds())
+	}
+
+	result, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		free, err := sc.CheckedSubU128(account.Free, amount)
+		if err != nil {
+			return nil, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds())
+		}
+		account.Free = free
+
+		return amount, nil
+	})
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return result.(primitives.Balance), nil
+}
+
+// onHold returns the balance of `who` held for `reason` and the part of it, up to `amount`, which can be changed.
+// Returns an error if `precision` is PrecisionExact and less than `amount` is held.
+func (m Module) onHold(reason [8]byte, who primitives.AccountId, amount sc.U128, precision fungible.Precision) (primitives.Balance, primitives.Balance, error) {
+	held, err := m.BalanceOnHold(reason, who)
+	if err != nil {
+		return sc.U128{}, sc.U128{}, err
+	}
+
+	if precision == fungible.PrecisionExact && amount.Gt(held) {
+		return sc.U128{}, sc.U128{}, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds())
+	}
+
+	return held, sc.Min128(held, amount), nil
+}
+
+// setHold stores `amount` as the balance of `who` held for `reason`. A zero `amount` removes the hold.
+func (m Module) setHold(reason [8]byte, who primitives.AccountId, amount sc.U128) error {
+	holds, err := m.storage.Holds.Get(who)
+	if err != nil {
+		return err
+	}
+
+	holds = setIdAmount(holds, reason, amount)
+	if len(holds) == 0 {
+		m.storage.Holds.Remove(who)
+	} else {
+		m.storage.Holds.Put(who, holds)
+	}
+
+	return nil
+}
+
+// updateFreezes stores `freezes` for `who` and recomputes the frozen amounts of the account.
+// Acquires a consumer reference when the first freeze is placed and releases it when the last freeze is removed.
+func (m Module) updateFreezes(who primitives.AccountId, freezes sc.Sequence[types.IdAmount]) error {
+	_, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		locks, err := m.storage.Locks.Get(who)
+		if err != nil {
+			return nil, err
+		}
+		account.MiscFrozen, account.FeeFrozen = frozenBalances(locks, freezes)
+		return sc.Empty{}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	existed := m.storage.Freezes.Exists(who)
+	if len(freezes) == 0 {
+		m.storage.Freezes.Remove(who)
+		if existed {
+			m.Config.StoredMap.DecConsumers(who)
+		}
+		return nil
+	}
+
+	m.storage.Freezes.Put(who, freezes)
+	if !existed {
+		if err := m.Config.StoredMap.IncConsumers(who); err != nil {
+			m.logger.Debug("Warning: Attempt to introduce freeze consumer reference, yet no providers. This is unexpected but should be safe.")
+		}
+	}
+
+	return nil
+}
+
+// idAmountIndex returns the position of the entry with `id` in `entries`.
+func idAmountIndex(entries sc.Sequence[types.IdAmount], id [8]byte) (int, bool) {
+	for i, entry := range entries {
+		if entry.Id == id {
+			return i, true
+		}
+	}
+
+	return len(entries), false
+}
+
+// idAmountOf returns the amount of the entry with `id` in `entries`, or zero if there is none.
+func idAmountOf(entries sc.Sequence[types.IdAmount], id [8]byte) primitives.Balance {
+	if index, found := idAmountIndex(entries, id); found {
+		return entries[index].Amount
+	}
+
+	return constants.Zero
+}
+
+// setIdAmount sets the amount of the entry with `id` in `entries`. The entry is appended if it does not
+// exist and removed if `amount` is zero.
+func setIdAmount(entries sc.Sequence[types.IdAmount], id [8]byte, amount sc.U128) sc.Sequence[types.IdAmount] {
+	index, found := idAmountIndex(entries, id)
+
+	switch {
+	case found && amount.Eq(constants.Zero):
+		return append(entries[:index], entries[index+1:]...)
+	case found:
+		entries[index].Amount = amount
+		return entries
+	case amount.Eq(constants.Zero):
+		return entries
+	default:
+		return append(entries, types.IdAmount{Id: id, Amount: amount})
+	}
+}
@@ -0,0 +1,509 @@
+package balances
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/balances/types"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	fungibleAccountId   = constants.OneAccountId
+	fungibleAccountInfo = primitives.AccountInfo{
+		Data: primitives.AccountData{
+			Free:       sc.NewU128(100),
+			Reserved:   sc.NewU128(30),
+			MiscFrozen: sc.NewU128(20),
+			FeeFrozen:  sc.NewU128(10),
+		},
+	}
+	holdReason = [8]byte{'p', 'r', 'e', 'i', 'm', 'a', 'g', 'e'}
+)
+
+func Test_Module_TotalBalance(t *testing.T) {
+	target := setupModule()
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+
+	result, err := target.TotalBalance(fungibleAccountId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(130), result)
+}
+
+func Test_Module_Balance(t *testing.T) {
+	target := setupModule()
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+
+	result, err := target.Balance(fungibleAccountId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(100), result)
+}
+
+func Test_Module_MinimumBalance(t *testing.T) {
+	assert.Equal(t, existentialDeposit, setupModule().MinimumBalance())
+}
+
+func Test_Module_ReducibleBalance(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		preservation    fungible.Preservation
+		force           fungible.Fortitude
+		canDecProviders bool
+		expected        sc.U128
+	}{
+		{"Expendable_Polite", fungible.PreservationExpendable, fungible.FortitudePolite, true, sc.NewU128(80)},
+		{"Expendable_Force", fungible.PreservationExpendable, fungible.FortitudeForce, true, sc.NewU128(100)},
+		{"Expendable_Force_CannotDecProviders", fungible.PreservationExpendable, fungible.FortitudeForce, false, sc.NewU128(99)},
+		{"Protect_Force", fungible.PreservationProtect, fungible.FortitudeForce, true, sc.NewU128(99)},
+		{"Preserve_Polite", fungible.PreservationPreserve, fungible.FortitudePolite, true, sc.NewU128(80)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+			mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+			mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(tt.canDecProviders, nil)
+
+			result, err := target.ReducibleBalance(fungibleAccountId, tt.preservation, tt.force)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Module_CanDeposit_Success(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+
+	err := target.CanDeposit(fungibleAccountId, sc.NewU128(10), fungible.ProvenanceMinted)
+
+	assert.NoError(t, err)
+	mockTotalIssuance.AssertCalled(t, "Get")
+}
+
+func Test_Module_CanDeposit_IssuanceOverflow(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+
+	mockTotalIssuance.On("Get").Return(sc.MaxU128(), nil)
+
+	err := target.CanDeposit(fungibleAccountId, sc.NewU128(10), fungible.ProvenanceMinted)
+
+	assert.Equal(t, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow()), err)
+	mockStoredMap.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_CanDeposit_BelowMinimum(t *testing.T) {
+	target := setupModule()
+	target.constants.ExistentialDeposit = sc.NewU128(20)
+
+	mockStoredMap.On("Get", fungibleAccountId).Return(primitives.AccountInfo{}, nil)
+
+	err := target.CanDeposit(fungibleAccountId, sc.NewU128(10), fungible.ProvenanceExtant)
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorBelowMinimum()), err)
+}
+
+func Test_Module_CanWithdraw_Frozen(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(true, nil)
+
+	err := target.CanWithdraw(fungibleAccountId, sc.NewU128(90))
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorFrozen()), err)
+}
+
+func Test_Module_CanWithdraw_NoFunds(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+
+	err := target.CanWithdraw(fungibleAccountId, sc.NewU128(101))
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds()), err)
+	mockStoredMap.AssertNotCalled(t, "CanDecProviders", mock.Anything)
+}
+
+func Test_Module_Withdraw_Success(t *testing.T) {
+	target := setupModule()
+	value := sc.NewU128(50)
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), value)
+
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(true, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventWithdraw(moduleId, fungibleAccountId, value))
+
+	result, err := target.Withdraw(fungibleAccountId, value, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
+
+	assert.NoError(t, err)
+	assert.Equal(t, value, result)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventWithdraw(moduleId, fungibleAccountId, value))
+}
+
+func Test_Module_Withdraw_Exact_NoFunds(t *testing.T) {
+	target := setupModule()
+
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(true, nil)
+
+	_, err := target.Withdraw(fungibleAccountId, sc.NewU128(81), fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds()), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_Withdraw_Polite_MiscFrozen(t *testing.T) {
+	target := setupModule()
+	accountInfo := primitives.AccountInfo{
+		Data: primitives.AccountData{
+			Free:       sc.NewU128(100),
+			MiscFrozen: sc.NewU128(50),
+		},
+	}
+
+	mockStoredMap.On("Get", fungibleAccountId).Return(accountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(true, nil)
+
+	_, err := target.Withdraw(fungibleAccountId, sc.NewU128(60), fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds()), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_Withdraw_BestEffort(t *testing.T) {
+	target := setupModule()
+	reducible := sc.NewU128(80)
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), reducible)
+
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(true, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventWithdraw(moduleId, fungibleAccountId, reducible))
+
+	result, err := target.Withdraw(fungibleAccountId, sc.NewU128(500), fungible.PrecisionBestEffort, fungible.PreservationPreserve, fungible.FortitudePolite)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reducible, result)
+}
+
+func Test_Module_Deposit_ZeroValue(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.Deposit(fungibleAccountId, constants.Zero, fungible.PrecisionExact)
+
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, result)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_MintInto(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+	value := sc.NewU128(10)
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), value)
+
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(1010)).Return()
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventDeposit(moduleId, fungibleAccountId, value))
+
+	result, err := target.MintInto(fungibleAccountId, value)
+
+	assert.NoError(t, err)
+	assert.Equal(t, value, result)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(1010))
+}
+
+func Test_Module_BurnFrom(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+	value := sc.NewU128(10)
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), value)
+
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fungibleAccountId).Return(true, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(990)).Return()
+	mockStoredMap.On("DepositEvent", newEventWithdraw(moduleId, fungibleAccountId, value))
+
+	result, err := target.BurnFrom(fungibleAccountId, value, fungible.PrecisionExact, fungible.FortitudePolite)
+
+	assert.NoError(t, err)
+	assert.Equal(t, value, result)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(990))
+}
+
+func Test_Module_BalanceOnHold(t *testing.T) {
+	target := setupModule()
+	mockHolds := setupMockHolds(target)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: sc.NewU128(7)}}, nil)
+
+	result, err := target.BalanceOnHold(holdReason, fungibleAccountId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(7), result)
+}
+
+func Test_Module_BalanceOnHold_NoHold(t *testing.T) {
+	target := setupModule()
+	mockHolds := setupMockHolds(target)
+	mockReserves := setupMockReserves(target)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{}, nil)
+
+	result, err := target.BalanceOnHold(holdReason, fungibleAccountId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, result)
+	mockReserves.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_Hold(t *testing.T) {
+	target := setupModule()
+	mockHolds := setupMockHolds(target)
+	value := sc.NewU128(10)
+	otherHold := types.IdAmount{Id: reserveId, Amount: sc.NewU128(5)}
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{otherHold}, nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, fungibleAccountId, value)).Return()
+	mockHolds.On("Put", fungibleAccountId, sc.Sequence[types.IdAmount]{otherHold, {Id: holdReason, Amount: value}}).Return()
+
+	err := target.Hold(holdReason, fungibleAccountId, value)
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventReserved(moduleId, fungibleAccountId, value))
+	mockHolds.AssertCalled(t, "Put", fungibleAccountId, sc.Sequence[types.IdAmount]{otherHold, {Id: holdReason, Amount: value}})
+}
+
+func Test_Module_Hold_TooManyHolds(t *testing.T) {
+	target := setupModule()
+	target.constants.MaxHolds = 1
+	mockHolds := setupMockHolds(target)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: reserveId, Amount: sc.NewU128(7)}}, nil)
+
+	err := target.Hold(holdReason, fungibleAccountId, sc.NewU128(1))
+
+	assert.Equal(t, NewDispatchErrorTooManyHolds(moduleId), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockHolds.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_CanHold_IgnoresNamedReserves(t *testing.T) {
+	target := setupModule()
+	target.constants.MaxHolds = 1
+	mockHolds := setupMockHolds(target)
+	mockReserves := setupMockReserves(target)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{}, nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+
+	err := target.CanHold(holdReason, fungibleAccountId, sc.NewU128(1))
+
+	assert.NoError(t, err)
+	mockReserves.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_Release(t *testing.T) {
+	target := setupModule()
+	mockHolds := setupMockHolds(target)
+	held := sc.NewU128(7)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: held}}, nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), held), nil)
+	mockStoredMap.On("DepositEvent", newEventUnreserved(moduleId, fungibleAccountId, held)).Return()
+	mockHolds.On("Remove", fungibleAccountId).Return()
+
+	result, err := target.Release(holdReason, fungibleAccountId, sc.NewU128(10), fungible.PrecisionBestEffort)
+
+	assert.NoError(t, err)
+	assert.Equal(t, held, result)
+	mockHolds.AssertCalled(t, "Remove", fungibleAccountId)
+}
+
+func Test_Module_Release_Exact_NoFunds(t *testing.T) {
+	target := setupModule()
+	mockHolds := setupMockHolds(target)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: sc.NewU128(7)}}, nil)
+
+	_, err := target.Release(holdReason, fungibleAccountId, sc.NewU128(8), fungible.PrecisionExact)
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFunds()), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_BurnHeld(t *testing.T) {
+	target := setupModule()
+	mockHolds := setupMockHolds(target)
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+	value := sc.NewU128(3)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: sc.NewU128(7)}}, nil)
+	mockStoredMap.On("Get", fungibleAccountId).Return(fungibleAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), value), nil)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(997)).Return()
+	mockStoredMap.On("DepositEvent", newEventSlashed(moduleId, fungibleAccountId, value)).Return()
+	mockHolds.On("Put", fungibleAccountId, sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: sc.NewU128(4)}}).Return()
+
+	result, err := target.BurnHeld(holdReason, fungibleAccountId, value, fungible.PrecisionExact, fungible.FortitudePolite)
+
+	assert.NoError(t, err)
+	assert.Equal(t, value, result)
+	mockHolds.AssertCalled(t, "Put", fungibleAccountId, sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: sc.NewU128(4)}})
+}
+
+func Test_Module_TransferOnHold_TooManyHolds(t *testing.T) {
+	target := setupModule()
+	target.constants.MaxHolds = 1
+	mockHolds := setupMockHolds(target)
+
+	mockHolds.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: holdReason, Amount: sc.NewU128(7)}}, nil)
+	mockHolds.On("Get", beneficiaryAccountId).Return(sc.Sequence[types.IdAmount]{{Id: reserveId, Amount: sc.NewU128(1)}}, nil)
+
+	_, err := target.TransferOnHold(holdReason, fungibleAccountId, beneficiaryAccountId, sc.NewU128(5), fungible.PrecisionExact, fungible.RestrictionOnHold, fungible.FortitudePolite)
+
+	assert.Equal(t, NewDispatchErrorTooManyHolds(moduleId), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockHolds.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_BalanceFrozen(t *testing.T) {
+	target := setupModule()
+	mockFreezes := setupMockFreezes(target)
+	mockLocks := setupMockLocks(target)
+
+	mockFreezes.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: lockId, Amount: sc.NewU128(9)}}, nil)
+
+	result, err := target.BalanceFrozen(lockId, fungibleAccountId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(9), result)
+	mockLocks.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_CanFreeze(t *testing.T) {
+	target := setupModule()
+	target.constants.MaxFreezes = 1
+	mockFreezes := setupMockFreezes(target)
+
+	mockFreezes.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: lockId, Amount: sc.NewU128(9)}}, nil)
+
+	existing, err := target.CanFreeze(lockId, fungibleAccountId)
+	assert.NoError(t, err)
+	assert.True(t, existing)
+
+	other, err := target.CanFreeze(otherLock.Id, fungibleAccountId)
+	assert.NoError(t, err)
+	assert.False(t, other)
+}
+
+func Test_Module_SetFreeze(t *testing.T) {
+	target := setupModule()
+	mockFreezes := setupMockFreezes(target)
+	otherFreeze := types.IdAmount{Id: otherLock.Id, Amount: sc.NewU128(20)}
+	freeze := types.IdAmount{Id: lockId, Amount: sc.NewU128(50)}
+
+	mockFreezes.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{otherFreeze}, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockFreezes.On("Exists", fungibleAccountId).Return(true)
+	mockFreezes.On("Put", fungibleAccountId, sc.Sequence[types.IdAmount]{otherFreeze, freeze}).Return()
+
+	err := target.SetFreeze(lockId, fungibleAccountId, freeze.Amount)
+
+	assert.NoError(t, err)
+	mockFreezes.AssertCalled(t, "Put", fungibleAccountId, sc.Sequence[types.IdAmount]{otherFreeze, freeze})
+	mockStoredMap.AssertNotCalled(t, "IncConsumers", mock.Anything)
+}
+
+func Test_Module_SetFreeze_TooManyFreezes(t *testing.T) {
+	target := setupModule()
+	target.constants.MaxFreezes = 1
+	mockFreezes := setupMockFreezes(target)
+
+	mockFreezes.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: otherLock.Id, Amount: sc.NewU128(20)}}, nil)
+
+	err := target.SetFreeze(lockId, fungibleAccountId, sc.NewU128(50))
+
+	assert.Equal(t, NewDispatchErrorTooManyFreezes(moduleId), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockFreezes.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ExtendFreeze(t *testing.T) {
+	target := setupModule()
+	mockFreezes := setupMockFreezes(target)
+
+	mockFreezes.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: lockId, Amount: sc.NewU128(50)}}, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockFreezes.On("Exists", fungibleAccountId).Return(true)
+	mockFreezes.On("Put", fungibleAccountId, sc.Sequence[types.IdAmount]{{Id: lockId, Amount: sc.NewU128(50)}}).Return()
+
+	err := target.ExtendFreeze(lockId, fungibleAccountId, sc.NewU128(10))
+
+	assert.NoError(t, err)
+	mockFreezes.AssertCalled(t, "Put", fungibleAccountId, sc.Sequence[types.IdAmount]{{Id: lockId, Amount: sc.NewU128(50)}})
+}
+
+func Test_Module_Thaw_LastFreeze(t *testing.T) {
+	target := setupModule()
+	mockFreezes := setupMockFreezes(target)
+
+	mockFreezes.On("Get", fungibleAccountId).Return(sc.Sequence[types.IdAmount]{{Id: lockId, Amount: sc.NewU128(50)}}, nil)
+	mockStoredMap.On("TryMutateExists", fungibleAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockFreezes.On("Exists", fungibleAccountId).Return(true)
+	mockFreezes.On("Remove", fungibleAccountId).Return()
+	mockStoredMap.On("DecConsumers", fungibleAccountId).Return()
+
+	err := target.Thaw(lockId, fungibleAccountId)
+
+	assert.NoError(t, err)
+	mockFreezes.AssertCalled(t, "Remove", fungibleAccountId)
+	mockStoredMap.AssertCalled(t, "DecConsumers", fungibleAccountId)
+}
+
+func setupMockHolds(target Module) *mocks.StorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]] {
+	mockHolds := new(mocks.StorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]])
+	target.storage.Holds = mockHolds
+	return mockHolds
+}
+
+func setupMockFreezes(target Module) *mocks.StorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]] {
+	mockFreezes := new(mocks.StorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]])
+	target.storage.Freezes = mockFreezes
+	return mockFreezes
+}
@@ -1,12 +1,14 @@
 package balances
 
 import (
+	"bytes"
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/balances/types"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -22,38 +24,43 @@ const (
 )
 
 const (
-	name = sc.Str("Balances")
+	name           = sc.Str("Balances")
+	storageVersion = sc.U16(0)
 )
 
 type Module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	Index       sc.U8
 	Config      *Config
 	constants   *consts
 	storage     *storage
 	functions   map[sc.U8]primitives.Call
 	mdGenerator *primitives.MetadataTypeGenerator
+	logger      log.WarnLogger
 }
 
-func New(index sc.U8, config *Config, logger log.DebugLogger, mdGenerator *primitives.MetadataTypeGenerator) Module {
-	constants := newConstants(config.DbWeight, config.MaxLocks, config.MaxReserves, config.ExistentialDeposit)
+func New(index sc.U8, config *Config, logger log.WarnLogger, mdGenerator *primitives.MetadataTypeGenerator) Module {
+	constants := newConstants(config.DbWeight, config.MaxLocks, config.MaxReserves, config.MaxHolds, config.MaxFreezes, config.ExistentialDeposit)
 	storage := newStorage()
 
 	module := Module{
-		Index:       index,
-		Config:      config,
-		constants:   constants,
-		storage:     storage,
-		mdGenerator: mdGenerator,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyBalances, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		mdGenerator:          mdGenerator,
+		logger:               logger,
 	}
 	functions := make(map[sc.U8]primitives.Call)
-	functions[functionTransferIndex] = newCallTransfer(index, functionTransferIndex, config.StoredMap, constants, module)
-	functions[functionSetBalanceIndex] = newCallSetBalance(index, functionSetBalanceIndex, config.StoredMap, constants, module, storage.TotalIssuance)
-	functions[functionForceTransferIndex] = newCallForceTransfer(index, functionForceTransferIndex, config.StoredMap, constants, module)
-	functions[functionTransferKeepAliveIndex] = newCallTransferKeepAlive(index, functionTransferKeepAliveIndex, config.StoredMap, constants, module)
-	functions[functionTransferAllIndex] = newCallTransferAll(index, functionTransferAllIndex, config.StoredMap, constants, module, logger)
-	functions[functionForceFreeIndex] = newCallForceFree(index, functionForceFreeIndex, config.StoredMap, constants, module, logger)
+	functions[functionTransferIndex] = newCallTransfer(index, functionTransferIndex, config.StoredMap, config.Lookup, constants, module)
+	functions[functionSetBalanceIndex] = newCallSetBalance(index, functionSetBalanceIndex, config.StoredMap, config.Lookup, constants, module, storage.TotalIssuance)
+	functions[functionForceTransferIndex] = newCallForceTransfer(index, functionForceTransferIndex, config.StoredMap, config.Lookup, constants, module)
+	functions[functionTransferKeepAliveIndex] = newCallTransferKeepAlive(index, functionTransferKeepAliveIndex, config.StoredMap, config.Lookup, constants, module)
+	functions[functionTransferAllIndex] = newCallTransferAll(index, functionTransferAllIndex, config.StoredMap, config.Lookup, constants, module, logger)
+	functions[functionForceFreeIndex] = newCallForceFree(index, functionForceFreeIndex, config.StoredMap, config.Lookup, constants, module, logger)
 
 	module.functions = functions
 
@@ -97,18 +104,514 @@ func (m Module) DepositIntoExisting(who primitives.AccountId, value sc.U128) (pr
 	return result.(primitives.Balance), err
 }
 
-// Withdraw withdraws `value` free balance from `who`, respecting existence requirements.
+// SetLock creates a new balance lock on `who` or replaces the existing one with the same `id`.
+// If `amount` is zero, the lock is removed.
+func (m Module) SetLock(id [8]byte, who primitives.AccountId, amount sc.U128, reasons primitives.Reasons) error {
+	if amount.Eq(constants.Zero) {
+		return m.RemoveLock(id, who)
+	}
+
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return err
+	}
+
+	newLock := types.BalanceLock{Id: id, Amount: amount, Reasons: reasons}
+
+	replaced := false
+	for i, lock := range locks {
+		if lock.Id == id {
+			locks[i] = newLock
+			replaced = true
+		}
+	}
+	if !replaced {
+		locks = append(locks, newLock)
+	}
+
+	return m.updateLocks(who, locks)
+}
+
+// ExtendLock changes the balance lock with `id` on `who` to be at least as strict as the given
+// `amount` and `reasons`. Creates a new lock if one with `id` does not exist.
+// If `amount` is zero, it does nothing.
+func (m Module) ExtendLock(id [8]byte, who primitives.AccountId, amount sc.U128, reasons primitives.Reasons) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return err
+	}
+
+	extended := false
+	for i, lock := range locks {
+		if lock.Id == id {
+			locks[i] = types.BalanceLock{
+				Id:      id,
+				Amount:  sc.Max128(lock.Amount, amount),
+				Reasons: types.CombineReasons(lock.Reasons, reasons),
+			}
+			extended = true
+		}
+	}
+	if !extended {
+		locks = append(locks, types.BalanceLock{Id: id, Amount: amount, Reasons: reasons})
+	}
+
+	return m.updateLocks(who, locks)
+}
+
+// RemoveLock removes the balance lock with `id` from `who`, if it exists.
+func (m Module) RemoveLock(id [8]byte, who primitives.AccountId) error {
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return err
+	}
+
+	retained := sc.Sequence[types.BalanceLock]{}
+	for _, lock := range locks {
+		if lock.Id != id {
+			retained = append(retained, lock)
+		}
+	}
+
+	return m.updateLocks(who, retained)
+}
+
+// updateLocks stores `locks` for `who` and recomputes the frozen amounts of the account.
+// Acquires a consumer reference when the first lock is placed and releases it when the last lock is removed.
+// Exceeding MaxLocks is not an error, as locks are placed by other modules, which cannot handle it. Only a warning is logged.
+func (m Module) updateLocks(who primitives.AccountId, locks sc.Sequence[types.BalanceLock]) error {
+	if sc.U32(len(locks)) > m.constants.MaxLocks {
+		m.logger.Warnf("Balance locks of [%s] exceed MaxLocks [%d]. This is unexpected but should be safe.", who.Bytes(), m.constants.MaxLocks)
+	}
+
+	_, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		freezes, err := m.storage.Freezes.Get(who)
+		if err != nil {
+			return nil, err
+		}
+		account.MiscFrozen, account.FeeFrozen = frozenBalances(locks, freezes)
+		return sc.Empty{}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	existed := m.storage.Locks.Exists(who)
+	if len(locks) == 0 {
+		m.storage.Locks.Remove(who)
+		if existed {
+			m.Config.StoredMap.DecConsumers(who)
+		}
+		return nil
+	}
+
+	m.storage.Locks.Put(who, locks)
+	if !existed {
+		if err := m.Config.StoredMap.IncConsumers(who); err != nil {
+			m.logger.Debug("Warning: Attempt to introduce lock consumer reference, yet no providers. This is unexpected but should be safe.")
+		}
+	}
+
+	return nil
+}
+
+// CanReserve returns whether `who` can reserve `value` from their free balance,
+// respecting any balance locks.
+func (m Module) CanReserve(who primitives.AccountId, value sc.U128) (bool, error) {
+	if value.Eq(constants.Zero) {
+		return true, nil
+	}
+
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return false, err
+	}
+
+	newBalance, err := sc.CheckedSubU128(account.Data.Free, value)
+	if err != nil {
+		return false, nil
+	}
+
+	return m.ensureCanWithdraw(who, value, primitives.ReasonsMisc, newBalance) == nil, nil
+}
+
+// ReservedBalance returns the amount of balance of `who` which is reserved.
+func (m Module) ReservedBalance(who primitives.AccountId) (primitives.Balance, error) {
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return account.Data.Reserved, nil
+}
+
+// Reserve moves `value` from the free balance of `who` to their reserved balance.
 // Does not do anything if value is 0.
-func (m Module) Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error) {
+func (m Module) Reserve(who primitives.AccountId, value sc.U128) error {
 	if value.Eq(constants.Zero) {
-		return sc.NewU128(0), nil
+		return nil
+	}
+
+	_, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		return sc.Empty{}, m.reserve(who, account, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.Config.StoredMap.DepositEvent(newEventReserved(m.Index, who, value))
+	return nil
+}
+
+// Unreserve moves up to `value` from the reserved balance of `who` to their free balance.
+// Returns the amount which could not be unreserved.
+func (m Module) Unreserve(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if account.Data.Total().Eq(constants.Zero) {
+		return value, nil
 	}
 
 	result, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
-		return m.withdraw(who, value, account, reasons, liveness)
+		return removeReserveAndFree(account, value), nil
 	})
+	if err != nil {
+		return sc.U128{}, err
+	}
 
-	return result.(primitives.Balance), err
+	actual := result.(primitives.Balance)
+	m.Config.StoredMap.DepositEvent(newEventUnreserved(m.Index, who, actual))
+
+	return value.Sub(actual), nil
+}
+
+// SlashReserved deducts up to `value` from the reserved balance of `who` and reduces the total issuance.
+// Returns the amount which could not be slashed.
+func (m Module) SlashReserved(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	account, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	if account.Data.Total().Eq(constants.Zero) {
+		return value, nil
+	}
+
+	result, err := m.tryMutateAccount(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		actual := sc.Min128(account.Reserved, value)
+		account.Reserved = account.Reserved.Sub(actual)
+		return actual, nil
+	})
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	actual := result.(primitives.Balance)
+	if err := newNegativeImbalance(actual, m.storage.TotalIssuance).Drop(); err != nil {
+		return sc.U128{}, err
+	}
+	m.Config.StoredMap.DepositEvent(newEventSlashed(m.Index, who, actual))
+
+	return value.Sub(actual), nil
+}
+
+// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to the balance of `beneficiary`.
+// `status` determines whether the funds end up in the free or the reserved balance of `beneficiary`.
+// Returns the amount which could not be moved.
+func (m Module) RepatriateReserved(slashed primitives.AccountId, beneficiary primitives.AccountId, value sc.U128, status types.BalanceStatus) (primitives.Balance, error) {
+	actual, err := m.transferReserved(slashed, beneficiary, value, status)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	return sc.SaturatingSubU128(value, actual), nil
+}
+
+// ReservedBalanceNamed returns the amount of balance of `who` which is reserved under `id`.
+func (m Module) ReservedBalanceNamed(id [8]byte, who primitives.AccountId) (primitives.Balance, error) {
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	index, found := reserveIndex(reserves, id)
+	if !found {
+		return constants.Zero, nil
+	}
+
+	return reserves[index].Amount, nil
+}
+
+// ReserveNamed moves `value` from the free balance of `who` to their reserved balance under `id`.
+// Does not do anything if value is 0.
+func (m Module) ReserveNamed(id [8]byte, who primitives.AccountId, value sc.U128) error {
+	if value.Eq(constants.Zero) {
+		return nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return err
+	}
+
+	index, found := reserveIndex(reserves, id)
+	if found {
+		amount, err := sc.CheckedAddU128(reserves[index].Amount, value)
+		if err != nil {
+			return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+		}
+		reserves[index].Amount = amount
+	} else {
+		if sc.U32(len(reserves)) >= m.constants.MaxReserves {
+			return NewDispatchErrorTooManyReserves(m.Index)
+		}
+		reserves = insertReserve(reserves, index, types.ReserveData{Id: id, Amount: value})
+	}
+
+	if err := m.Reserve(who, value); err != nil {
+		return err
+	}
+
+	m.storage.Reserves.Put(who, reserves)
+	return nil
+}
+
+// UnreserveNamed moves up to `value` reserved under `id` back to the free balance of `who`.
+// Returns the amount which could not be unreserved.
+func (m Module) UnreserveNamed(id [8]byte, who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	index, found := reserveIndex(reserves, id)
+	if !found {
+		return value, nil
+	}
+
+	toChange := sc.Min128(reserves[index].Amount, value)
+	remaining, err := m.Unreserve(who, toChange)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	actual := sc.SaturatingSubU128(toChange, remaining)
+	reserves[index].Amount = reserves[index].Amount.Sub(actual)
+
+	if reserves[index].Amount.Eq(constants.Zero) {
+		reserves = append(reserves[:index], reserves[index+1:]...)
+	}
+
+	if len(reserves) == 0 {
+		m.storage.Reserves.Remove(who)
+	} else {
+		m.storage.Reserves.Put(who, reserves)
+	}
+
+	return value.Sub(actual), nil
+}
+
+// SlashReservedNamed deducts up to `value` reserved under `id` from `who` and reduces the total issuance.
+// Returns the amount which could not be slashed.
+func (m Module) SlashReservedNamed(id [8]byte, who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	index, found := reserveIndex(reserves, id)
+	if !found {
+		return value, nil
+	}
+
+	toChange := sc.Min128(reserves[index].Amount, value)
+	remaining, err := m.SlashReserved(who, toChange)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	actual := sc.SaturatingSubU128(toChange, remaining)
+	reserves[index].Amount = reserves[index].Amount.Sub(actual)
+	m.storage.Reserves.Put(who, reserves)
+
+	return value.Sub(actual), nil
+}
+
+// RepatriateReservedNamed moves up to `value` reserved under `id` from `slashed` to `beneficiary`.
+// If `status` is reserved, the funds are reserved under the same `id` in `beneficiary`.
+// Returns the amount which could not be moved.
+func (m Module) RepatriateReservedNamed(id [8]byte, slashed primitives.AccountId, beneficiary primitives.AccountId, value sc.U128, status types.BalanceStatus) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	if reflect.DeepEqual(slashed, beneficiary) {
+		if status == types.BalanceStatusFree {
+			return m.UnreserveNamed(id, slashed, value)
+		}
+
+		reserved, err := m.ReservedBalanceNamed(id, slashed)
+		if err != nil {
+			return sc.U128{}, err
+		}
+		return sc.SaturatingSubU128(value, reserved), nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(slashed)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	index, found := reserveIndex(reserves, id)
+	if !found {
+		return value, nil
+	}
+
+	toChange := sc.Min128(reserves[index].Amount, value)
+
+	var actual primitives.Balance
+	if status == types.BalanceStatusReserved {
+		actual, err = m.repatriateReservedNamedToReserved(id, slashed, beneficiary, toChange)
+	} else {
+		var remaining primitives.Balance
+		remaining, err = m.RepatriateReserved(slashed, beneficiary, toChange, status)
+		actual = sc.SaturatingSubU128(toChange, remaining)
+	}
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	reserves[index].Amount = reserves[index].Amount.Sub(actual)
+	m.storage.Reserves.Put(slashed, reserves)
+
+	return value.Sub(actual), nil
+}
+
+// repatriateReservedNamedToReserved moves up to `value` reserved balance from `slashed` to the reserve
+// under `id` in `beneficiary`. Returns the amount which has been moved.
+func (m Module) repatriateReservedNamedToReserved(id [8]byte, slashed primitives.AccountId, beneficiary primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	reserves, err := m.storage.Reserves.Get(beneficiary)
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	index, found := reserveIndex(reserves, id)
+	if !found && sc.U32(len(reserves)) >= m.constants.MaxReserves {
+		return sc.U128{}, NewDispatchErrorTooManyReserves(m.Index)
+	}
+
+	remaining, err := m.RepatriateReserved(slashed, beneficiary, value, types.BalanceStatusReserved)
+	if err != nil {
+		return sc.U128{}, err
+	}
+	actual := sc.SaturatingSubU128(value, remaining)
+
+	if found {
+		reserves[index].Amount = sc.SaturatingAddU128(reserves[index].Amount, actual)
+	} else {
+		reserves = insertReserve(reserves, index, types.ReserveData{Id: id, Amount: actual})
+	}
+	m.storage.Reserves.Put(beneficiary, reserves)
+
+	return actual, nil
+}
+
+// reserve moves `value` from the free to the reserved balance of `account`.
+// Checks that the free balance is sufficient and not restricted by locks.
+func (m Module) reserve(who primitives.AccountId, account *primitives.AccountData, value sc.U128) error {
+	free, err := sc.CheckedSubU128(account.Free, value)
+	if err != nil {
+		return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+			Index:   m.Index,
+			Err:     sc.U32(ErrorInsufficientBalance),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	reserved, err := sc.CheckedAddU128(account.Reserved, value)
+	if err != nil {
+		return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+	}
+
+	account.Free = free
+	account.Reserved = reserved
+
+	return m.ensureCanWithdraw(who, value, primitives.ReasonsMisc, free)
+}
+
+// transferReserved moves up to `value` from the reserved balance of `slashed` to the balance of `beneficiary`.
+// Returns the amount which has been moved.
+func (m Module) transferReserved(slashed primitives.AccountId, beneficiary primitives.AccountId, value sc.U128, status types.BalanceStatus) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	if reflect.DeepEqual(slashed, beneficiary) {
+		if status == types.BalanceStatusFree {
+			remaining, err := m.Unreserve(slashed, value)
+			if err != nil {
+				return sc.U128{}, err
+			}
+			return value.Sub(remaining), nil
+		}
+
+		reserved, err := m.ReservedBalance(slashed)
+		if err != nil {
+			return sc.U128{}, err
+		}
+		return sc.Min128(value, reserved), nil
+	}
+
+	result, err := m.tryMutateAccountWithDust(beneficiary, func(toAccount *primitives.AccountData, isNew bool) (sc.Encodable, error) {
+		if isNew {
+			return nil, primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+				Index:   m.Index,
+				Err:     sc.U32(ErrorDeadAccount),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+
+		return m.tryMutateAccountWithDust(slashed, func(fromAccount *primitives.AccountData, _ bool) (sc.Encodable, error) {
+			return moveReserved(fromAccount, toAccount, value, status)
+		})
+	})
+	if err != nil {
+		return sc.U128{}, err
+	}
+
+	beneficiaryResult := result.(sc.VaryingData)
+	slashedResult := beneficiaryResult[0].(sc.VaryingData)
+	actual := slashedResult[0].(primitives.Balance)
+
+	slashedResult[1].(dustCleaner).Drop()
+	beneficiaryResult[1].(dustCleaner).Drop()
+
+	m.Config.StoredMap.DepositEvent(newEventReserveRepatriated(m.Index, slashed, beneficiary, actual, status))
+
+	return actual, nil
 }
 
 // ensureCanWithdraw checks that an account can withdraw from their balance given any existing withdraw restrictions.
@@ -273,6 +776,73 @@ func (m Module) deposit(who primitives.AccountId, account *primitives.AccountDat
 	return value, nil
 }
 
+// moveReserved moves up to `value` from the reserved balance of `from` to the balance of `to`
+// determined by `status`. Returns the amount which has been moved.
+func moveReserved(from *primitives.AccountData, to *primitives.AccountData, value sc.U128, status types.BalanceStatus) (primitives.Balance, error) {
+	actual := sc.Min128(from.Reserved, value)
+
+	switch status {
+	case types.BalanceStatusFree:
+		free, err := sc.CheckedAddU128(to.Free, actual)
+		if err != nil {
+			return sc.U128{}, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+		}
+		to.Free = free
+	case types.BalanceStatusReserved:
+		reserved, err := sc.CheckedAddU128(to.Reserved, actual)
+		if err != nil {
+			return sc.U128{}, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+		}
+		to.Reserved = reserved
+	}
+
+	from.Reserved = from.Reserved.Sub(actual)
+
+	return actual, nil
+}
+
+// reserveIndex returns the position of the reserve with `id`, or the position at which
+// it should be inserted to keep `reserves` sorted by identifier.
+func reserveIndex(reserves sc.Sequence[types.ReserveData], id [8]byte) (int, bool) {
+	for i, reserve := range reserves {
+		switch bytes.Compare(reserve.Id[:], id[:]) {
+		case 0:
+			return i, true
+		case 1:
+			return i, false
+		}
+	}
+
+	return len(reserves), false
+}
+
+// insertReserve inserts `reserve` at `index` in `reserves`.
+func insertReserve(reserves sc.Sequence[types.ReserveData], index int, reserve types.ReserveData) sc.Sequence[types.ReserveData] {
+	return append(reserves[:index], append(sc.Sequence[types.ReserveData]{reserve}, reserves[index:]...)...)
+}
+
+// frozenBalances returns the misc and fee frozen amounts implied by `locks` and `freezes`.
+// Freezes apply to all withdraw reasons.
+func frozenBalances(locks sc.Sequence[types.BalanceLock], freezes sc.Sequence[types.IdAmount]) (primitives.Balance, primitives.Balance) {
+	miscFrozen, feeFrozen := sc.NewU128(0), sc.NewU128(0)
+
+	for _, lock := range locks {
+		if lock.Reasons == primitives.ReasonsAll || lock.Reasons == primitives.ReasonsMisc {
+			miscFrozen = sc.Max128(miscFrozen, lock.Amount)
+		}
+		if lock.Reasons == primitives.ReasonsAll || lock.Reasons == primitives.ReasonsFee {
+			feeFrozen = sc.Max128(feeFrozen, lock.Amount)
+		}
+	}
+
+	for _, freeze := range freezes {
+		miscFrozen = sc.Max128(miscFrozen, freeze.Amount)
+		feeFrozen = sc.Max128(feeFrozen, freeze.Amount)
+	}
+
+	return miscFrozen, feeFrozen
+}
+
 func (m Module) Metadata() primitives.MetadataModule {
 	metadataIdBalancesCalls := m.mdGenerator.BuildCallsMetadata("Balances", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
 		primitives.NewMetadataEmptyTypeParameter("T"),
@@ -282,6 +852,8 @@ func (m Module) Metadata() primitives.MetadataModule {
 		ExistentialDeposit: primitives.ExistentialDeposit{U128: m.constants.ExistentialDeposit},
 		MaxLocks:           primitives.MaxLocks{U32: m.constants.MaxLocks},
 		MaxReserves:        primitives.MaxReserves{U32: m.constants.MaxReserves},
+		MaxHolds:           primitives.MaxHolds{U32: m.constants.MaxHolds},
+		MaxFreezes:         primitives.MaxFreezes{U32: m.constants.MaxFreezes},
 	}
 
 	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
@@ -436,6 +1008,75 @@ func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 						types.BalanceStatusReserved,
 						"BalanceStatus.Reserved"),
 				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReasons,
+			"Reasons",
+			sc.Sequence[sc.Str]{"pallet_balances", "Reasons"}, primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Fee",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsFee),
+						"Reasons.Fee"),
+					primitives.NewMetadataDefinitionVariant(
+						"Misc",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsMisc),
+						"Reasons.Misc"),
+					primitives.NewMetadataDefinitionVariant(
+						"All",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsAll),
+						"Reasons.All"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalanceLock,
+			"BalanceLock",
+			sc.Sequence[sc.Str]{"pallet_balances", "BalanceLock"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "LockIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalancesReasons, "reasons", "Reasons"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesWeakBoundedVecBalanceLock,
+			"WeakBoundedVec<BalanceLock>",
+			sc.Sequence[sc.Str]{"bounded_collections", "weak_bounded_vec", "WeakBoundedVec"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceBalanceLock),
+				})),
+		primitives.NewMetadataType(metadata.TypesSequenceBalanceLock,
+			"[]BalanceLock",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalanceLock))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesReserveData,
+			"ReserveData",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "ReserveData"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "ReserveIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBoundedVecReserveData,
+			"BoundedVec<ReserveData>",
+			sc.Sequence[sc.Str]{"bounded_collections", "bounded_vec", "BoundedVec"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceReserveData),
+				})),
+		primitives.NewMetadataType(metadata.TypesSequenceReserveData,
+			"[]ReserveData",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesReserveData))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesIdAmount,
+			"IdAmount",
+			sc.Sequence[sc.Str]{"frame_support", "traits", "tokens", "misc", "IdAmount"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "Id"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBoundedVecIdAmount,
+			"BoundedVec<IdAmount>",
+			sc.Sequence[sc.Str]{"bounded_collections", "bounded_vec", "BoundedVec"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceIdAmount),
+				})),
+		primitives.NewMetadataType(metadata.TypesSequenceIdAmount,
+			"[]IdAmount",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesIdAmount))),
 
 		primitives.NewMetadataTypeWithParams(metadata.TypesBalancesErrors,
 			"pallet_balances pallet Error",
@@ -482,6 +1123,21 @@ func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
 						ErrorTooManyReserves,
 						"Number of named reserves exceed MaxReserves"),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyLocks",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyLocks,
+						"Number of balance locks exceed MaxLocks"),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyHolds",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyHolds,
+						"Number of holds exceed MaxHolds"),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyFreezes",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyFreezes,
+						"Number of freezes exceed MaxFreezes"),
 				}),
 			sc.Sequence[primitives.MetadataTypeParameter]{
 				primitives.NewMetadataEmptyTypeParameter("T"),
@@ -499,6 +1155,42 @@ func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
 				primitives.MetadataModuleStorageEntryModifierDefault,
 				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
 				"The total units issued in the system."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Locks",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesWeakBoundedVecBalanceLock),
+				),
+				"Any liquidity locks on some account balances."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Reserves",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesBoundedVecReserveData),
+				),
+				"Named reserves on some account balances."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Holds",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesBoundedVecIdAmount),
+				),
+				"Holds on account balances."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Freezes",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesBoundedVecIdAmount),
+				),
+				"Freeze locks on account balances."),
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/balances/types"
 	"github.com/LimeChain/gosemble/mocks"
@@ -26,6 +27,27 @@ var (
 	logger                    = log.NewLogger()
 )
 
+var (
+	lockId           = [8]byte{'s', 't', 'a', 'k', 'i', 'n', 'g', ' '}
+	lockAccountId    = constants.OneAccountId
+	targetLock       = types.BalanceLock{Id: lockId, Amount: sc.NewU128(50), Reasons: primitives.ReasonsMisc}
+	otherLock        = types.BalanceLock{Id: [8]byte{'v', 'e', 's', 't', 'i', 'n', 'g', ' '}, Amount: sc.NewU128(20), Reasons: primitives.ReasonsAll}
+	lockMutateResult = sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), sc.Empty{})
+)
+
+var (
+	reserveId            = [8]byte{'m', 'u', 'l', 't', 'i', 's', 'i', 'g'}
+	otherReserveId       = [8]byte{'i', 'd', 'e', 'n', 't', 'i', 't', 'y'}
+	reserveAccountId     = constants.OneAccountId
+	beneficiaryAccountId = constants.TwoAccountId
+	reserveAccountInfo   = primitives.AccountInfo{
+		Data: primitives.AccountData{
+			Free:     sc.NewU128(100),
+			Reserved: sc.NewU128(30),
+		},
+	}
+)
+
 func Test_Module_GetIndex(t *testing.T) {
 	assert.Equal(t, sc.U8(moduleId), setupModule().GetIndex())
 }
@@ -110,60 +132,6 @@ func Test_Module_DepositIntoExisting_TryMutateAccount_Fails(t *testing.T) {
 	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
 }
 
-func Test_Module_Withdraw_Success(t *testing.T) {
-	target := setupModule()
-	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
-	target.storage.TotalIssuance = mockTotalIssuance
-
-	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), targetValue)
-
-	fromAddressId, err := fromAddress.AsAccountId()
-	assert.Nil(t, err)
-
-	mockStoredMap.On("TryMutateExists", fromAddressId, mockTypeMutateAccountData).Return(tryMutateResult, nil)
-
-	result, errWithdraw := target.Withdraw(fromAddressId, targetValue, sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
-	assert.Nil(t, errWithdraw)
-
-	assert.Equal(t, targetValue, result)
-	assert.Nil(t, err)
-	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddressId, mockTypeMutateAccountData)
-	mockTotalIssuance.AssertNotCalled(t, "Get")
-	mockTotalIssuance.AssertNotCalled(t, "Put", mock.Anything)
-}
-
-func Test_Module_Withdraw_ZeroValue(t *testing.T) {
-	target := setupModule()
-
-	fromAddressId, err := fromAddress.AsAccountId()
-	assert.Nil(t, err)
-
-	result, errWithdraw := target.Withdraw(fromAddressId, sc.NewU128(0), sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
-	assert.Nil(t, errWithdraw)
-
-	assert.Equal(t, sc.NewU128(0), result)
-	assert.Nil(t, err)
-	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
-	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
-}
-
-func Test_Module_Withdraw_TryMutateAccount_Fails(t *testing.T) {
-	target := setupModule()
-
-	expectedErr := primitives.NewDispatchErrorCannotLookup()
-
-	fromAddressId, err := fromAddress.AsAccountId()
-	assert.Nil(t, err)
-
-	mockStoredMap.On("TryMutateExists", fromAddressId, mockTypeMutateAccountData).Return(sc.NewU128(1), expectedErr)
-
-	_, errWithdraw := target.Withdraw(fromAddressId, targetValue, sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
-
-	assert.Equal(t, expectedErr, errWithdraw)
-	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddressId, mockTypeMutateAccountData)
-	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
-}
-
 func Test_Module_ensureCanWithdraw_Success(t *testing.T) {
 	target := setupModule()
 
@@ -485,6 +453,30 @@ func Test_Module_withdraw_CannotWithdraw(t *testing.T) {
 	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
 }
 
+func Test_Module_withdraw_FeeReasons_IgnoresMiscFrozen(t *testing.T) {
+	target := setupModule()
+	value := sc.NewU128(3)
+
+	miscFrozenAccountInfo := primitives.AccountInfo{
+		Data: primitives.AccountData{
+			MiscFrozen: sc.NewU128(5),
+		},
+	}
+
+	fromAddressId, err := fromAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	mockStoredMap.On("Get", fromAddressId).Return(miscFrozenAccountInfo, nil)
+	mockStoredMap.On("DepositEvent", newEventWithdraw(moduleId, fromAddressId, value))
+
+	result, err := target.withdraw(fromAddressId, value, fromAccountData, sc.U8(primitives.ReasonsFee), primitives.ExistenceRequirementKeepAlive)
+
+	assert.NoError(t, err)
+	assert.Equal(t, value, result)
+	assert.Equal(t, sc.NewU128(2), fromAccountData.Free)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventWithdraw(moduleId, fromAddressId, value))
+}
+
 func Test_Module_deposit_Success(t *testing.T) {
 	target := setupModule()
 
@@ -705,6 +697,75 @@ func Test_Module_Metadata(t *testing.T) {
 						types.BalanceStatusReserved,
 						"BalanceStatus.Reserved"),
 				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReasons,
+			"Reasons",
+			sc.Sequence[sc.Str]{"pallet_balances", "Reasons"}, primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Fee",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsFee),
+						"Reasons.Fee"),
+					primitives.NewMetadataDefinitionVariant(
+						"Misc",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsMisc),
+						"Reasons.Misc"),
+					primitives.NewMetadataDefinitionVariant(
+						"All",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsAll),
+						"Reasons.All"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalanceLock,
+			"BalanceLock",
+			sc.Sequence[sc.Str]{"pallet_balances", "BalanceLock"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "LockIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalancesReasons, "reasons", "Reasons"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesWeakBoundedVecBalanceLock,
+			"WeakBoundedVec<BalanceLock>",
+			sc.Sequence[sc.Str]{"bounded_collections", "weak_bounded_vec", "WeakBoundedVec"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceBalanceLock),
+				})),
+		primitives.NewMetadataType(metadata.TypesSequenceBalanceLock,
+			"[]BalanceLock",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalanceLock))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesReserveData,
+			"ReserveData",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "ReserveData"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "ReserveIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBoundedVecReserveData,
+			"BoundedVec<ReserveData>",
+			sc.Sequence[sc.Str]{"bounded_collections", "bounded_vec", "BoundedVec"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceReserveData),
+				})),
+		primitives.NewMetadataType(metadata.TypesSequenceReserveData,
+			"[]ReserveData",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesReserveData))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesIdAmount,
+			"IdAmount",
+			sc.Sequence[sc.Str]{"frame_support", "traits", "tokens", "misc", "IdAmount"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "Id"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBoundedVecIdAmount,
+			"BoundedVec<IdAmount>",
+			sc.Sequence[sc.Str]{"bounded_collections", "bounded_vec", "BoundedVec"}, primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceIdAmount),
+				})),
+		primitives.NewMetadataType(metadata.TypesSequenceIdAmount,
+			"[]IdAmount",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesIdAmount))),
 
 		primitives.NewMetadataTypeWithParams(metadata.TypesBalancesErrors,
 			"pallet_balances pallet Error",
@@ -751,6 +812,21 @@ func Test_Module_Metadata(t *testing.T) {
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
 						ErrorTooManyReserves,
 						"Number of named reserves exceed MaxReserves"),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyLocks",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyLocks,
+						"Number of balance locks exceed MaxLocks"),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyHolds",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyHolds,
+						"Number of holds exceed MaxHolds"),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyFreezes",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyFreezes,
+						"Number of freezes exceed MaxFreezes"),
 				}),
 			sc.Sequence[primitives.MetadataTypeParameter]{
 				primitives.NewMetadataEmptyTypeParameter("T"),
@@ -767,6 +843,42 @@ func Test_Module_Metadata(t *testing.T) {
 					primitives.MetadataModuleStorageEntryModifierDefault,
 					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
 					"The total units issued in the system."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Locks",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesWeakBoundedVecBalanceLock),
+					),
+					"Any liquidity locks on some account balances."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Reserves",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesBoundedVecReserveData),
+					),
+					"Named reserves on some account balances."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Holds",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesBoundedVecIdAmount),
+					),
+					"Holds on account balances."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Freezes",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesBoundedVecIdAmount),
+					),
+					"Freeze locks on account balances."),
 			},
 		}),
 		Call: sc.NewOption[sc.Compact](sc.ToCompact(expectedBalancesCallsMetadataId)),
@@ -800,7 +912,7 @@ func Test_Module_Metadata(t *testing.T) {
 				"MaxLocks",
 				sc.ToCompact(metadata.PrimitiveTypesU32),
 				sc.BytesToSequenceU8(maxLocks.Bytes()),
-				"The maximum number of locks that should exist on an account.  Not strictly enforced, but used for weight estimation.",
+				"The maximum number of locks that can exist on an account.",
 			),
 			primitives.NewMetadataModuleConstant(
 				"MaxReserves",
@@ -808,6 +920,18 @@ func Test_Module_Metadata(t *testing.T) {
 				sc.BytesToSequenceU8(maxReserves.Bytes()),
 				"The maximum number of named reserves that can exist on an account.",
 			),
+			primitives.NewMetadataModuleConstant(
+				"MaxHolds",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(maxHolds.Bytes()),
+				"The maximum number of holds that can exist on an account at any time.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxFreezes",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(maxFreezes.Bytes()),
+				"The maximum number of individual freeze locks that can exist on an account at any time.",
+			),
 		},
 		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesBalancesErrors)),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
@@ -836,7 +960,7 @@ func Test_Module_Metadata(t *testing.T) {
 
 func setupModule() Module {
 	mockStoredMap = new(mocks.StoredMap)
-	config := NewConfig(dbWeight, maxLocks, maxReserves, existentialDeposit, mockStoredMap)
+	config := NewConfig(dbWeight, maxLocks, maxReserves, maxHolds, maxFreezes, existentialDeposit, mockStoredMap, testLookup)
 
 	fromAccountData = &primitives.AccountData{
 		Free: sc.NewU128(5),
@@ -848,3 +972,723 @@ func setupModule() Module {
 
 	return New(moduleId, config, logger, mdGenerator)
 }
+
+func Test_Module_SetLock_NewLock(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{otherLock}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Put", lockAccountId, sc.Sequence[types.BalanceLock]{otherLock, targetLock}).Return()
+
+	err := target.SetLock(lockId, lockAccountId, targetLock.Amount, targetLock.Reasons)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", lockAccountId, sc.Sequence[types.BalanceLock]{otherLock, targetLock})
+	mockStoredMap.AssertNotCalled(t, "IncConsumers", mock.Anything)
+}
+
+func Test_Module_SetLock_ReplacesLock(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+	existingLock := types.BalanceLock{Id: lockId, Amount: sc.NewU128(100), Reasons: primitives.ReasonsAll}
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{existingLock, otherLock}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Put", lockAccountId, sc.Sequence[types.BalanceLock]{targetLock, otherLock}).Return()
+
+	err := target.SetLock(lockId, lockAccountId, targetLock.Amount, targetLock.Reasons)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", lockAccountId, sc.Sequence[types.BalanceLock]{targetLock, otherLock})
+}
+
+func Test_Module_SetLock_FirstLock_IncConsumers(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(false)
+	mockLocks.On("Put", lockAccountId, sc.Sequence[types.BalanceLock]{targetLock}).Return()
+	mockStoredMap.On("IncConsumers", lockAccountId).Return(nil)
+
+	err := target.SetLock(lockId, lockAccountId, targetLock.Amount, targetLock.Reasons)
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertCalled(t, "IncConsumers", lockAccountId)
+}
+
+func Test_Module_SetLock_ZeroAmount_RemovesLock(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{targetLock}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Remove", lockAccountId).Return()
+	mockStoredMap.On("DecConsumers", lockAccountId).Return()
+
+	err := target.SetLock(lockId, lockAccountId, sc.NewU128(0), primitives.ReasonsAll)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Remove", lockAccountId)
+	mockLocks.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStoredMap.AssertCalled(t, "DecConsumers", lockAccountId)
+}
+
+func Test_Module_SetLock_TooManyLocks(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	locks := sc.Sequence[types.BalanceLock]{}
+	for i := 0; i < int(maxLocks); i++ {
+		locks = append(locks, types.BalanceLock{Id: [8]byte{byte(i)}, Amount: sc.NewU128(1), Reasons: primitives.ReasonsMisc})
+	}
+	expectedLocks := append(locks, targetLock)
+
+	mockLocks.On("Get", lockAccountId).Return(locks, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Put", lockAccountId, expectedLocks).Return()
+
+	err := target.SetLock(lockId, lockAccountId, targetLock.Amount, targetLock.Reasons)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", lockAccountId, expectedLocks)
+}
+
+func Test_Module_SetLock_TryMutateAccount_Fails(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+	expectedErr := primitives.NewDispatchErrorCannotLookup()
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, expectedErr)
+
+	err := target.SetLock(lockId, lockAccountId, targetLock.Amount, targetLock.Reasons)
+
+	assert.Equal(t, expectedErr, err)
+	mockLocks.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ExtendLock(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+	existingLock := types.BalanceLock{Id: lockId, Amount: sc.NewU128(100), Reasons: primitives.ReasonsFee}
+	expectedLock := types.BalanceLock{Id: lockId, Amount: sc.NewU128(100), Reasons: primitives.ReasonsAll}
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{existingLock}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Put", lockAccountId, sc.Sequence[types.BalanceLock]{expectedLock}).Return()
+
+	err := target.ExtendLock(lockId, lockAccountId, sc.NewU128(10), primitives.ReasonsMisc)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", lockAccountId, sc.Sequence[types.BalanceLock]{expectedLock})
+}
+
+func Test_Module_ExtendLock_NewLock(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{otherLock}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Put", lockAccountId, sc.Sequence[types.BalanceLock]{otherLock, targetLock}).Return()
+
+	err := target.ExtendLock(lockId, lockAccountId, targetLock.Amount, targetLock.Reasons)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", lockAccountId, sc.Sequence[types.BalanceLock]{otherLock, targetLock})
+}
+
+func Test_Module_ExtendLock_ZeroAmount(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	err := target.ExtendLock(lockId, lockAccountId, sc.NewU128(0), primitives.ReasonsAll)
+
+	assert.NoError(t, err)
+	mockLocks.AssertNotCalled(t, "Get", mock.Anything)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_RemoveLock_RetainsOtherLocks(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{targetLock, otherLock}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(true)
+	mockLocks.On("Put", lockAccountId, sc.Sequence[types.BalanceLock]{otherLock}).Return()
+
+	err := target.RemoveLock(lockId, lockAccountId)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", lockAccountId, sc.Sequence[types.BalanceLock]{otherLock})
+	mockStoredMap.AssertNotCalled(t, "DecConsumers", mock.Anything)
+}
+
+func Test_Module_RemoveLock_NoLocks(t *testing.T) {
+	target := setupModule()
+	mockLocks := setupMockLocks(target)
+
+	mockLocks.On("Get", lockAccountId).Return(sc.Sequence[types.BalanceLock]{}, nil)
+	mockStoredMap.On("TryMutateExists", lockAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockLocks.On("Exists", lockAccountId).Return(false)
+	mockLocks.On("Remove", lockAccountId).Return()
+
+	err := target.RemoveLock(lockId, lockAccountId)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Remove", lockAccountId)
+	mockStoredMap.AssertNotCalled(t, "DecConsumers", mock.Anything)
+}
+
+func Test_frozenBalances(t *testing.T) {
+	locks := sc.Sequence[types.BalanceLock]{
+		{Id: [8]byte{1}, Amount: sc.NewU128(10), Reasons: primitives.ReasonsFee},
+		{Id: [8]byte{2}, Amount: sc.NewU128(7), Reasons: primitives.ReasonsMisc},
+		{Id: [8]byte{3}, Amount: sc.NewU128(8), Reasons: primitives.ReasonsAll},
+	}
+
+	miscFrozen, feeFrozen := frozenBalances(locks, sc.Sequence[types.IdAmount]{})
+
+	assert.Equal(t, sc.NewU128(8), miscFrozen)
+	assert.Equal(t, sc.NewU128(10), feeFrozen)
+}
+
+func Test_frozenBalances_Freezes(t *testing.T) {
+	locks := sc.Sequence[types.BalanceLock]{
+		{Id: [8]byte{1}, Amount: sc.NewU128(10), Reasons: primitives.ReasonsFee},
+	}
+	freezes := sc.Sequence[types.IdAmount]{
+		{Id: [8]byte{2}, Amount: sc.NewU128(9)},
+		{Id: [8]byte{3}, Amount: sc.NewU128(4)},
+	}
+
+	miscFrozen, feeFrozen := frozenBalances(locks, freezes)
+
+	assert.Equal(t, sc.NewU128(9), miscFrozen)
+	assert.Equal(t, sc.NewU128(10), feeFrozen)
+}
+
+func Test_frozenBalances_NoLocks(t *testing.T) {
+	miscFrozen, feeFrozen := frozenBalances(sc.Sequence[types.BalanceLock]{}, sc.Sequence[types.IdAmount]{})
+
+	assert.Equal(t, sc.NewU128(0), miscFrozen)
+	assert.Equal(t, sc.NewU128(0), feeFrozen)
+}
+
+func setupMockLocks(target Module) *mocks.StorageMap[primitives.AccountId, sc.Sequence[types.BalanceLock]] {
+	mockLocks := new(mocks.StorageMap[primitives.AccountId, sc.Sequence[types.BalanceLock]])
+	target.storage.Locks = mockLocks
+	return mockLocks
+}
+
+func Test_Module_CanReserve(t *testing.T) {
+	target := setupModule()
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+
+	result, err := target.CanReserve(reserveAccountId, sc.NewU128(50))
+
+	assert.NoError(t, err)
+	assert.True(t, result)
+	mockStoredMap.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func Test_Module_CanReserve_ZeroValue(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.CanReserve(reserveAccountId, sc.NewU128(0))
+
+	assert.NoError(t, err)
+	assert.True(t, result)
+	mockStoredMap.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_CanReserve_InsufficientBalance(t *testing.T) {
+	target := setupModule()
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+
+	result, err := target.CanReserve(reserveAccountId, sc.NewU128(101))
+
+	assert.NoError(t, err)
+	assert.False(t, result)
+}
+
+func Test_Module_CanReserve_LiquidityRestrictions(t *testing.T) {
+	target := setupModule()
+	lockedAccountInfo := reserveAccountInfo
+	lockedAccountInfo.Data.MiscFrozen = sc.NewU128(80)
+
+	mockStoredMap.On("Get", reserveAccountId).Return(lockedAccountInfo, nil)
+
+	result, err := target.CanReserve(reserveAccountId, sc.NewU128(50))
+
+	assert.NoError(t, err)
+	assert.False(t, result)
+}
+
+func Test_Module_ReservedBalance(t *testing.T) {
+	target := setupModule()
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+
+	result, err := target.ReservedBalance(reserveAccountId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(30), result)
+}
+
+func Test_Module_Reserve(t *testing.T) {
+	target := setupModule()
+	value := sc.NewU128(10)
+
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, reserveAccountId, value)).Return()
+
+	err := target.Reserve(reserveAccountId, value)
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventReserved(moduleId, reserveAccountId, value))
+}
+
+func Test_Module_Reserve_ZeroValue(t *testing.T) {
+	target := setupModule()
+
+	err := target.Reserve(reserveAccountId, sc.NewU128(0))
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_Reserve_Fails(t *testing.T) {
+	target := setupModule()
+	expectedErr := primitives.NewDispatchErrorCannotLookup()
+
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).Return(lockMutateResult, expectedErr)
+
+	err := target.Reserve(reserveAccountId, sc.NewU128(10))
+
+	assert.Equal(t, expectedErr, err)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_reserve(t *testing.T) {
+	target := setupModule()
+	account := &primitives.AccountData{Free: sc.NewU128(100), Reserved: sc.NewU128(30)}
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+
+	err := target.reserve(reserveAccountId, account, sc.NewU128(10))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(90), account.Free)
+	assert.Equal(t, sc.NewU128(40), account.Reserved)
+}
+
+func Test_Module_reserve_InsufficientBalance(t *testing.T) {
+	target := setupModule()
+	account := &primitives.AccountData{Free: sc.NewU128(5)}
+	expectedErr := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorInsufficientBalance),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	err := target.reserve(reserveAccountId, account, sc.NewU128(10))
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, sc.NewU128(5), account.Free)
+}
+
+func Test_Module_Unreserve(t *testing.T) {
+	target := setupModule()
+	actual := sc.NewU128(30)
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), actual), nil)
+	mockStoredMap.On("DepositEvent", newEventUnreserved(moduleId, reserveAccountId, actual)).Return()
+
+	result, err := target.Unreserve(reserveAccountId, sc.NewU128(50))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(20), result)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventUnreserved(moduleId, reserveAccountId, actual))
+}
+
+func Test_Module_Unreserve_DeadAccount(t *testing.T) {
+	target := setupModule()
+
+	mockStoredMap.On("Get", reserveAccountId).Return(primitives.AccountInfo{}, nil)
+
+	result, err := target.Unreserve(reserveAccountId, sc.NewU128(50))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(50), result)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_SlashReserved(t *testing.T) {
+	target := setupModule()
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+	actual := sc.NewU128(30)
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), actual), nil)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(970)).Return()
+	mockStoredMap.On("DepositEvent", newEventSlashed(moduleId, reserveAccountId, actual)).Return()
+
+	result, err := target.SlashReserved(reserveAccountId, sc.NewU128(50))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(20), result)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(970))
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventSlashed(moduleId, reserveAccountId, actual))
+}
+
+func Test_Module_RepatriateReserved(t *testing.T) {
+	target := setupModule()
+	actual := sc.NewU128(30)
+	slashedResult := sc.NewVaryingData(actual, newDustCleaner(moduleId, reserveAccountId, sc.NewOption[negativeImbalance](nil), mockStoredMap))
+	expectedEvent := newEventReserveRepatriated(moduleId, reserveAccountId, beneficiaryAccountId, actual, types.BalanceStatusFree)
+
+	mockStoredMap.On("TryMutateExists", beneficiaryAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), slashedResult), nil)
+	mockStoredMap.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.RepatriateReserved(reserveAccountId, beneficiaryAccountId, sc.NewU128(50), types.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(20), result)
+	mockStoredMap.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Module_RepatriateReserved_Fails(t *testing.T) {
+	target := setupModule()
+	expectedErr := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorDeadAccount),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	mockStoredMap.On("TryMutateExists", beneficiaryAccountId, mockTypeMutateAccountData).Return(sc.NewU128(0), expectedErr)
+
+	_, err := target.RepatriateReserved(reserveAccountId, beneficiaryAccountId, sc.NewU128(50), types.BalanceStatusFree)
+
+	assert.Equal(t, expectedErr, err)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_RepatriateReserved_SameAccount_Reserved(t *testing.T) {
+	target := setupModule()
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+
+	result, err := target.RepatriateReserved(reserveAccountId, reserveAccountId, sc.NewU128(50), types.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(20), result)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_RepatriateReserved_SameAccount_Free(t *testing.T) {
+	target := setupModule()
+	actual := sc.NewU128(30)
+
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), actual), nil)
+	mockStoredMap.On("DepositEvent", newEventUnreserved(moduleId, reserveAccountId, actual)).Return()
+
+	result, err := target.RepatriateReserved(reserveAccountId, reserveAccountId, sc.NewU128(50), types.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(20), result)
+}
+
+func Test_Module_ReservedBalanceNamed(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(7)}}, nil)
+
+	result, err := target.ReservedBalanceNamed(reserveId, reserveAccountId)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(7), result)
+
+	result, err = target.ReservedBalanceNamed(otherReserveId, reserveAccountId)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(0), result)
+}
+
+func Test_Module_ReserveNamed_NewReserve(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	value := sc.NewU128(10)
+	expectedReserves := sc.Sequence[types.ReserveData]{{Id: otherReserveId, Amount: value}, {Id: reserveId, Amount: sc.NewU128(5)}}
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, reserveAccountId, value)).Return()
+	mockReserves.On("Put", reserveAccountId, expectedReserves).Return()
+
+	err := target.ReserveNamed(otherReserveId, reserveAccountId, value)
+
+	assert.NoError(t, err)
+	mockReserves.AssertCalled(t, "Put", reserveAccountId, expectedReserves)
+}
+
+func Test_Module_ReserveNamed_ExistingReserve(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	value := sc.NewU128(10)
+	expectedReserves := sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(15)}}
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).Return(lockMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, reserveAccountId, value)).Return()
+	mockReserves.On("Put", reserveAccountId, expectedReserves).Return()
+
+	err := target.ReserveNamed(reserveId, reserveAccountId, value)
+
+	assert.NoError(t, err)
+	mockReserves.AssertCalled(t, "Put", reserveAccountId, expectedReserves)
+}
+
+func Test_Module_ReserveNamed_TooManyReserves(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+
+	reserves := sc.Sequence[types.ReserveData]{}
+	for i := 0; i < int(maxReserves); i++ {
+		reserves = append(reserves, types.ReserveData{Id: [8]byte{byte(i)}, Amount: sc.NewU128(1)})
+	}
+
+	mockReserves.On("Get", reserveAccountId).Return(reserves, nil)
+
+	err := target.ReserveNamed(reserveId, reserveAccountId, sc.NewU128(10))
+
+	assert.Equal(t, NewDispatchErrorTooManyReserves(moduleId), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockReserves.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ReserveNamed_Reserve_Fails(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	expectedErr := primitives.NewDispatchErrorCannotLookup()
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{}, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).Return(lockMutateResult, expectedErr)
+
+	err := target.ReserveNamed(reserveId, reserveAccountId, sc.NewU128(10))
+
+	assert.Equal(t, expectedErr, err)
+	mockReserves.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_UnreserveNamed_RemovesEmptyReserves(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	actual := sc.NewU128(5)
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: actual}}, nil)
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), actual), nil)
+	mockStoredMap.On("DepositEvent", newEventUnreserved(moduleId, reserveAccountId, actual)).Return()
+	mockReserves.On("Remove", reserveAccountId).Return()
+
+	result, err := target.UnreserveNamed(reserveId, reserveAccountId, sc.NewU128(10))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(5), result)
+	mockReserves.AssertCalled(t, "Remove", reserveAccountId)
+	mockReserves.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_UnreserveNamed_Partial(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	actual := sc.NewU128(4)
+	expectedReserves := sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(6)}}
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(10)}}, nil)
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), actual), nil)
+	mockStoredMap.On("DepositEvent", newEventUnreserved(moduleId, reserveAccountId, actual)).Return()
+	mockReserves.On("Put", reserveAccountId, expectedReserves).Return()
+
+	result, err := target.UnreserveNamed(reserveId, reserveAccountId, actual)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(0), result)
+	mockReserves.AssertCalled(t, "Put", reserveAccountId, expectedReserves)
+}
+
+func Test_Module_UnreserveNamed_NotFound(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{}, nil)
+
+	result, err := target.UnreserveNamed(reserveId, reserveAccountId, sc.NewU128(10))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(10), result)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_SlashReservedNamed(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	mockTotalIssuance := new(mocks.StorageValue[sc.U128])
+	target.storage.TotalIssuance = mockTotalIssuance
+	actual := sc.NewU128(5)
+	expectedReserves := sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(0)}}
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: actual}}, nil)
+	mockStoredMap.On("Get", reserveAccountId).Return(reserveAccountInfo, nil)
+	mockStoredMap.On("TryMutateExists", reserveAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), actual), nil)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(1000), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(995)).Return()
+	mockStoredMap.On("DepositEvent", newEventSlashed(moduleId, reserveAccountId, actual)).Return()
+	mockReserves.On("Put", reserveAccountId, expectedReserves).Return()
+
+	result, err := target.SlashReservedNamed(reserveId, reserveAccountId, sc.NewU128(10))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(5), result)
+	mockReserves.AssertCalled(t, "Put", reserveAccountId, expectedReserves)
+}
+
+func Test_Module_RepatriateReservedNamed_Free(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	actual := sc.NewU128(10)
+	slashedResult := sc.NewVaryingData(actual, newDustCleaner(moduleId, reserveAccountId, sc.NewOption[negativeImbalance](nil), mockStoredMap))
+	expectedEvent := newEventReserveRepatriated(moduleId, reserveAccountId, beneficiaryAccountId, actual, types.BalanceStatusFree)
+	expectedReserves := sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(15)}}, nil)
+	mockStoredMap.On("TryMutateExists", beneficiaryAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), slashedResult), nil)
+	mockStoredMap.On("DepositEvent", expectedEvent).Return()
+	mockReserves.On("Put", reserveAccountId, expectedReserves).Return()
+
+	result, err := target.RepatriateReservedNamed(reserveId, reserveAccountId, beneficiaryAccountId, actual, types.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(0), result)
+	mockReserves.AssertCalled(t, "Put", reserveAccountId, expectedReserves)
+	mockReserves.AssertNotCalled(t, "Get", beneficiaryAccountId)
+}
+
+func Test_Module_RepatriateReservedNamed_Reserved(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+	actual := sc.NewU128(10)
+	slashedResult := sc.NewVaryingData(actual, newDustCleaner(moduleId, reserveAccountId, sc.NewOption[negativeImbalance](nil), mockStoredMap))
+	expectedEvent := newEventReserveRepatriated(moduleId, reserveAccountId, beneficiaryAccountId, actual, types.BalanceStatusReserved)
+	expectedSlashedReserves := sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}
+	expectedBeneficiaryReserves := sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: actual}}
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{{Id: reserveId, Amount: sc.NewU128(15)}}, nil)
+	mockReserves.On("Get", beneficiaryAccountId).Return(sc.Sequence[types.ReserveData]{}, nil)
+	mockStoredMap.On("TryMutateExists", beneficiaryAccountId, mockTypeMutateAccountData).
+		Return(sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[negativeImbalance](nil), slashedResult), nil)
+	mockStoredMap.On("DepositEvent", expectedEvent).Return()
+	mockReserves.On("Put", beneficiaryAccountId, expectedBeneficiaryReserves).Return()
+	mockReserves.On("Put", reserveAccountId, expectedSlashedReserves).Return()
+
+	result, err := target.RepatriateReservedNamed(reserveId, reserveAccountId, beneficiaryAccountId, actual, types.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(0), result)
+	mockReserves.AssertCalled(t, "Put", beneficiaryAccountId, expectedBeneficiaryReserves)
+	mockReserves.AssertCalled(t, "Put", reserveAccountId, expectedSlashedReserves)
+}
+
+func Test_Module_RepatriateReservedNamed_NotFound(t *testing.T) {
+	target := setupModule()
+	mockReserves := setupMockReserves(target)
+
+	mockReserves.On("Get", reserveAccountId).Return(sc.Sequence[types.ReserveData]{}, nil)
+
+	result, err := target.RepatriateReservedNamed(reserveId, reserveAccountId, beneficiaryAccountId, sc.NewU128(10), types.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(10), result)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_moveReserved(t *testing.T) {
+	from := &primitives.AccountData{Reserved: sc.NewU128(10)}
+	to := &primitives.AccountData{Free: sc.NewU128(1), Reserved: sc.NewU128(2)}
+
+	actual, err := moveReserved(from, to, sc.NewU128(15), types.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(10), actual)
+	assert.Equal(t, sc.NewU128(0), from.Reserved)
+	assert.Equal(t, sc.NewU128(1), to.Free)
+	assert.Equal(t, sc.NewU128(12), to.Reserved)
+}
+
+func Test_moveReserved_Free(t *testing.T) {
+	from := &primitives.AccountData{Reserved: sc.NewU128(10)}
+	to := &primitives.AccountData{Free: sc.NewU128(1)}
+
+	actual, err := moveReserved(from, to, sc.NewU128(4), types.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(4), actual)
+	assert.Equal(t, sc.NewU128(6), from.Reserved)
+	assert.Equal(t, sc.NewU128(5), to.Free)
+}
+
+func Test_reserveIndex(t *testing.T) {
+	reserves := sc.Sequence[types.ReserveData]{{Id: otherReserveId}, {Id: reserveId}}
+
+	index, found := reserveIndex(reserves, reserveId)
+	assert.True(t, found)
+	assert.Equal(t, 1, index)
+
+	index, found = reserveIndex(reserves, [8]byte{'a'})
+	assert.False(t, found)
+	assert.Equal(t, 0, index)
+
+	index, found = reserveIndex(reserves, [8]byte{'z'})
+	assert.False(t, found)
+	assert.Equal(t, 2, index)
+}
+
+func Test_insertReserve(t *testing.T) {
+	first := types.ReserveData{Id: [8]byte{'a'}}
+	second := types.ReserveData{Id: [8]byte{'b'}}
+	third := types.ReserveData{Id: [8]byte{'c'}}
+
+	result := insertReserve(sc.Sequence[types.ReserveData]{first, third}, 1, second)
+
+	assert.Equal(t, sc.Sequence[types.ReserveData]{first, second, third}, result)
+}
+
+func setupMockReserves(target Module) *mocks.StorageMap[primitives.AccountId, sc.Sequence[types.ReserveData]] {
+	mockReserves := new(mocks.StorageMap[primitives.AccountId, sc.Sequence[types.ReserveData]])
+	target.storage.Reserves = mockReserves
+	return mockReserves
+}
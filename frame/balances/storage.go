@@ -1,21 +1,49 @@
 package balances
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/balances/types"
 	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 var (
 	keyBalances      = []byte("Balances")
 	keyTotalIssuance = []byte("TotalIssuance")
+	keyLocks         = []byte("Locks")
+	keyReserves      = []byte("Reserves")
+	keyHolds         = []byte("Holds")
+	keyFreezes       = []byte("Freezes")
 )
 
 type storage struct {
 	TotalIssuance support.StorageValue[sc.U128]
+	Locks         support.StorageMap[primitives.AccountId, sc.Sequence[types.BalanceLock]]
+	Reserves      support.StorageMap[primitives.AccountId, sc.Sequence[types.ReserveData]]
+	Holds         support.StorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]]
+	Freezes       support.StorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]]
 }
 
 func newStorage() *storage {
 	return &storage{
 		TotalIssuance: support.NewHashStorageValue(keyBalances, keyTotalIssuance, sc.DecodeU128),
+		Locks:         support.NewHashStorageMap[primitives.AccountId, sc.Sequence[types.BalanceLock]](keyBalances, keyLocks, support.NewHasherBlake128Concat(), primitives.DecodeAccountId, decodeBalanceLocks),
+		Reserves:      support.NewHashStorageMap[primitives.AccountId, sc.Sequence[types.ReserveData]](keyBalances, keyReserves, support.NewHasherBlake128Concat(), primitives.DecodeAccountId, decodeReserves),
+		Holds:         support.NewHashStorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]](keyBalances, keyHolds, support.NewHasherBlake128Concat(), primitives.DecodeAccountId, decodeIdAmounts),
+		Freezes:       support.NewHashStorageMap[primitives.AccountId, sc.Sequence[types.IdAmount]](keyBalances, keyFreezes, support.NewHasherBlake128Concat(), primitives.DecodeAccountId, decodeIdAmounts),
 	}
 }
+
+func decodeBalanceLocks(buffer *bytes.Buffer) (sc.Sequence[types.BalanceLock], error) {
+	return sc.DecodeSequenceWith(buffer, types.DecodeBalanceLock)
+}
+
+func decodeReserves(buffer *bytes.Buffer) (sc.Sequence[types.ReserveData], error) {
+	return sc.DecodeSequenceWith(buffer, types.DecodeReserveData)
+}
+
+func decodeIdAmounts(buffer *bytes.Buffer) (sc.Sequence[types.IdAmount], error) {
+	return sc.DecodeSequenceWith(buffer, types.DecodeIdAmount)
+}
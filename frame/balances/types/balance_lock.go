@@ -0,0 +1,82 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const lockIdentifierLength = 8
+
+var (
+	errInvalidReasonsType = errors.New("invalid reasons type")
+)
+
+// BalanceLock is a single lock on a balance. There can be many of these on an account,
+// and they "overlap", so the same balance is frozen by multiple locks.
+type BalanceLock struct {
+	// An identifier for this lock. Only one lock may be in existence for each identifier.
+	Id [8]byte
+	// The amount which the free balance may not drop below when this lock is in effect.
+	Amount sc.U128
+	// If true, then the lock remains in effect even for payment of transaction fees.
+	Reasons primitives.Reasons
+}
+
+func (bl BalanceLock) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		sc.BytesToFixedSequenceU8(bl.Id[:]),
+		bl.Amount,
+		sc.U8(bl.Reasons),
+	)
+}
+
+func (bl BalanceLock) Bytes() []byte {
+	return sc.EncodedBytes(bl)
+}
+
+func DecodeBalanceLock(buffer *bytes.Buffer) (BalanceLock, error) {
+	id, err := sc.DecodeFixedSequence[sc.U8](lockIdentifierLength, buffer)
+	if err != nil {
+		return BalanceLock{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return BalanceLock{}, err
+	}
+	reasons, err := DecodeReasons(buffer)
+	if err != nil {
+		return BalanceLock{}, err
+	}
+
+	lock := BalanceLock{
+		Amount:  amount,
+		Reasons: reasons,
+	}
+	copy(lock.Id[:], sc.FixedSequenceU8ToBytes(id))
+
+	return lock, nil
+}
+
+func DecodeReasons(buffer *bytes.Buffer) (primitives.Reasons, error) {
+	value, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Reasons(0), err
+	}
+	switch primitives.Reasons(value) {
+	case primitives.ReasonsFee, primitives.ReasonsMisc, primitives.ReasonsAll:
+		return primitives.Reasons(value), nil
+	default:
+		return primitives.Reasons(0), errInvalidReasonsType
+	}
+}
+
+// CombineReasons returns the reasons which are in effect for either `r` or `other`.
+func CombineReasons(r, other primitives.Reasons) primitives.Reasons {
+	if r == other {
+		return r
+	}
+	return primitives.ReasonsAll
+}
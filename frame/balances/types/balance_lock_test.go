@@ -0,0 +1,57 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetBalanceLock = BalanceLock{
+		Id:      [8]byte{'s', 't', 'a', 'k', 'i', 'n', 'g', ' '},
+		Amount:  sc.NewU128(5),
+		Reasons: primitives.ReasonsMisc,
+	}
+	expectedBalanceLockBytes = append(
+		append([]byte("staking "), sc.NewU128(5).Bytes()...),
+		byte(primitives.ReasonsMisc),
+	)
+)
+
+func Test_BalanceLock_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetBalanceLock.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBalanceLockBytes, buffer.Bytes())
+}
+
+func Test_BalanceLock_Bytes(t *testing.T) {
+	assert.Equal(t, expectedBalanceLockBytes, targetBalanceLock.Bytes())
+}
+
+func Test_DecodeBalanceLock(t *testing.T) {
+	result, err := DecodeBalanceLock(bytes.NewBuffer(expectedBalanceLockBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetBalanceLock, result)
+}
+
+func Test_DecodeBalanceLock_InvalidReasons(t *testing.T) {
+	input := append(append([]byte("staking "), sc.NewU128(5).Bytes()...), 0x03)
+
+	_, err := DecodeBalanceLock(bytes.NewBuffer(input))
+
+	assert.Equal(t, errInvalidReasonsType, err)
+}
+
+func Test_CombineReasons(t *testing.T) {
+	assert.Equal(t, primitives.ReasonsFee, CombineReasons(primitives.ReasonsFee, primitives.ReasonsFee))
+	assert.Equal(t, primitives.ReasonsMisc, CombineReasons(primitives.ReasonsMisc, primitives.ReasonsMisc))
+	assert.Equal(t, primitives.ReasonsAll, CombineReasons(primitives.ReasonsFee, primitives.ReasonsMisc))
+	assert.Equal(t, primitives.ReasonsAll, CombineReasons(primitives.ReasonsAll, primitives.ReasonsFee))
+}
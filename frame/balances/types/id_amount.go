@@ -0,0 +1,44 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const idAmountIdentifierLength = 8
+
+// IdAmount is an amount of balance, held or frozen for the reason with the given identifier.
+type IdAmount struct {
+	// The identifier of the hold reason or the freeze.
+	Id [8]byte
+	// The amount of the hold or the freeze.
+	Amount sc.U128
+}
+
+func (ia IdAmount) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		sc.BytesToFixedSequenceU8(ia.Id[:]),
+		ia.Amount,
+	)
+}
+
+func (ia IdAmount) Bytes() []byte {
+	return sc.EncodedBytes(ia)
+}
+
+func DecodeIdAmount(buffer *bytes.Buffer) (IdAmount, error) {
+	id, err := sc.DecodeFixedSequence[sc.U8](idAmountIdentifierLength, buffer)
+	if err != nil {
+		return IdAmount{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return IdAmount{}, err
+	}
+
+	idAmount := IdAmount{Amount: amount}
+	copy(idAmount.Id[:], sc.FixedSequenceU8ToBytes(id))
+
+	return idAmount, nil
+}
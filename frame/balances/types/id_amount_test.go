@@ -0,0 +1,37 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetIdAmount = IdAmount{
+		Id:     [8]byte{'p', 'r', 'e', 'i', 'm', 'a', 'g', 'e'},
+		Amount: sc.NewU128(9),
+	}
+	expectedIdAmountBytes = append([]byte("preimage"), sc.NewU128(9).Bytes()...)
+)
+
+func Test_IdAmount_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetIdAmount.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIdAmountBytes, buffer.Bytes())
+}
+
+func Test_IdAmount_Bytes(t *testing.T) {
+	assert.Equal(t, expectedIdAmountBytes, targetIdAmount.Bytes())
+}
+
+func Test_DecodeIdAmount(t *testing.T) {
+	result, err := DecodeIdAmount(bytes.NewBuffer(expectedIdAmountBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetIdAmount, result)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const reserveIdentifierLength = 8
+
+// ReserveData is a balance reserved under an identifier.
+type ReserveData struct {
+	// The identifier for the named reserve.
+	Id [8]byte
+	// The amount of the named reserve.
+	Amount sc.U128
+}
+
+func (rd ReserveData) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		sc.BytesToFixedSequenceU8(rd.Id[:]),
+		rd.Amount,
+	)
+}
+
+func (rd ReserveData) Bytes() []byte {
+	return sc.EncodedBytes(rd)
+}
+
+func DecodeReserveData(buffer *bytes.Buffer) (ReserveData, error) {
+	id, err := sc.DecodeFixedSequence[sc.U8](reserveIdentifierLength, buffer)
+	if err != nil {
+		return ReserveData{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return ReserveData{}, err
+	}
+
+	reserve := ReserveData{Amount: amount}
+	copy(reserve.Id[:], sc.FixedSequenceU8ToBytes(id))
+
+	return reserve, nil
+}
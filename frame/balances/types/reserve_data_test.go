@@ -0,0 +1,37 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetReserveData = ReserveData{
+		Id:     [8]byte{'m', 'u', 'l', 't', 'i', 's', 'i', 'g'},
+		Amount: sc.NewU128(7),
+	}
+	expectedReserveDataBytes = append([]byte("multisig"), sc.NewU128(7).Bytes()...)
+)
+
+func Test_ReserveData_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetReserveData.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedReserveDataBytes, buffer.Bytes())
+}
+
+func Test_ReserveData_Bytes(t *testing.T) {
+	assert.Equal(t, expectedReserveDataBytes, targetReserveData.Bytes())
+}
+
+func Test_DecodeReserveData(t *testing.T) {
+	result, err := DecodeReserveData(bytes.NewBuffer(expectedReserveDataBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetReserveData, result)
+}
@@ -8,6 +8,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/execution/extrinsic"
 	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -28,20 +29,24 @@ type Module interface {
 	FinalizeBlock() (primitives.Header, error)
 	ValidateTransaction(source primitives.TransactionSource, uxt primitives.UncheckedExtrinsic, blockHash primitives.Blake2bHash) (primitives.ValidTransaction, error)
 	OffchainWorker(header primitives.Header) error
+	TryRuntimeUpgrade(checks bool) (primitives.Weight, error)
+	TryExecuteBlock(block primitives.Block, stateRootCheck bool, signatureCheck bool) (primitives.Weight, error)
 }
 
 type module struct {
 	system           system.Module
 	onRuntimeUpgrade primitives.OnRuntimeUpgrade
+	migrator         primitives.MultiBlockMigrator
 	runtimeExtrinsic extrinsic.RuntimeExtrinsic
 	hashing          io.Hashing
 	logger           log.TraceLogger
 }
 
-func New(systemModule system.Module, runtimeExtrinsic extrinsic.RuntimeExtrinsic, onRuntimeUpgrade primitives.OnRuntimeUpgrade, logger log.TraceLogger) Module {
+func New(systemModule system.Module, runtimeExtrinsic extrinsic.RuntimeExtrinsic, onRuntimeUpgrade primitives.OnRuntimeUpgrade, migrator primitives.MultiBlockMigrator, logger log.TraceLogger) Module {
 	return module{
 		system:           systemModule,
 		onRuntimeUpgrade: onRuntimeUpgrade,
+		migrator:         migrator,
 		runtimeExtrinsic: runtimeExtrinsic,
 		hashing:          io.NewHashing(),
 		logger:           logger,
@@ -96,32 +101,105 @@ func (m module) ExecuteBlock(block primitives.Block) error {
 	}
 
 	// todo: handle err
-	m.executeExtrinsicsWithBookKeeping(block)
+	m.executeExtrinsicsWithBookKeeping(block, true)
 
 	header := block.Header()
-	err = m.finalChecks(&header)
+	err = m.finalChecks(&header, true)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// TryRuntimeUpgrade executes the OnRuntimeUpgrade hooks of the runtime migrations and the modules,
+// running their PreUpgrade and PostUpgrade checks if checks is set. Returns the aggregate weight.
+//
+// Used only to dry-run runtime upgrades with try-runtime.
+func (m module) TryRuntimeUpgrade(checks bool) (primitives.Weight, error) {
+	m.logger.Trace("try_runtime_upgrade")
+
+	weight, err := hooks.TryOnRuntimeUpgrade(m.onRuntimeUpgrade, checks)
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	modulesWeight, err := m.runtimeExtrinsic.TryOnRuntimeUpgrade(checks)
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	return weight.SaturatingAdd(modulesWeight), nil
+}
+
+// TryExecuteBlock executes the provided block like ExecuteBlock, optionally skipping the state root
+// check and the verification of the extrinsic signatures. Returns the consumed block weight.
+//
+// Used only to dry-run blocks with try-runtime.
+func (m module) TryExecuteBlock(block primitives.Block, stateRootCheck bool, signatureCheck bool) (primitives.Weight, error) {
+	m.logger.Tracef("try_execute_block %v", block.Header().Number)
+
+	err := m.InitializeBlock(block.Header())
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	err = m.initialChecks(block)
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	err = m.executeExtrinsicsWithBookKeeping(block, signatureCheck)
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	header := block.Header()
+	err = m.finalChecks(&header, stateRootCheck)
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	blockWeight, err := m.system.StorageBlockWeight()
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	return blockWeight.Total()
+}
+
 // ApplyExtrinsic applies extrinsic outside the block execution function.
 //
 // This doesn't attempt to validate anything regarding the block, but it builds a list of uxt
 // hashes.
 func (m module) ApplyExtrinsic(uxt primitives.UncheckedExtrinsic) error {
+	return m.applyExtrinsic(uxt, true)
+}
+
+func (m module) applyExtrinsic(uxt primitives.UncheckedExtrinsic, signatureCheck bool) error {
 	encoded := uxt.Bytes()
 	encodedLen := sc.ToCompact(len(encoded))
 
 	m.logger.Trace("apply_extrinsic")
 
-	// Verify that the signature is good.
-	checked, err := uxt.Check()
+	var checked primitives.CheckedExtrinsic
+	var err error
+	if signatureCheck {
+		// Verify that the signature is good.
+		checked, err = uxt.Check()
+	} else {
+		checked, err = uxt.UncheckedIntoChecked()
+	}
 	if err != nil {
 		return err
 	}
 
+	dispatchInfo := primitives.GetDispatchInfo(checked.Function())
+	m.logger.Tracef("get_dispatch_info: weight ref time %d", dispatchInfo.Weight.RefTime)
+
+	if err := m.ensureNotFilteredByMigrations(dispatchInfo); err != nil {
+		return err
+	}
+
 	// We don't need to make sure to `note_extrinsic` only after we know it's going to be
 	// executed to prevent it from leaking in storage since at this point, it will either
 	// execute or panic (and revert storage changes).
@@ -130,8 +208,6 @@ func (m module) ApplyExtrinsic(uxt primitives.UncheckedExtrinsic) error {
 	// AUDIT: Under no circumstances may this function panic from here onwards.
 
 	// Decode parameters and dispatch
-	dispatchInfo := primitives.GetDispatchInfo(checked.Function())
-	m.logger.Tracef("get_dispatch_info: weight ref time %d", dispatchInfo.Weight.RefTime)
 	unsignedValidator := extrinsic.NewUnsignedValidatorForChecked(m.runtimeExtrinsic)
 	res, err := checked.Apply(unsignedValidator, &dispatchInfo, encodedLen)
 	if err != nil {
@@ -209,6 +285,10 @@ func (m module) ValidateTransaction(source primitives.TransactionSource, uxt pri
 		return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionMandatoryValidation())
 	}
 
+	if err := m.ensureNotFilteredByMigrations(dispatchInfo); err != nil {
+		return primitives.ValidTransaction{}, err
+	}
+
 	m.logger.Trace("validate")
 	unsignedValidator := extrinsic.NewUnsignedValidatorForChecked(m.runtimeExtrinsic)
 	return checked.Validate(unsignedValidator, source, &dispatchInfo, encodedLen)
@@ -259,10 +339,10 @@ func (m module) idleAndFinalizeHook(blockNumber sc.U64) error {
 	return nil
 }
 
-func (m module) executeExtrinsicsWithBookKeeping(block primitives.Block) error {
+func (m module) executeExtrinsicsWithBookKeeping(block primitives.Block, signatureCheck bool) error {
 	for _, ext := range block.Extrinsics() {
 
-		if err := m.ApplyExtrinsic(ext); err != nil {
+		if err := m.applyExtrinsic(ext, signatureCheck); err != nil {
 			return err
 		}
 	}
@@ -274,6 +354,26 @@ func (m module) executeExtrinsicsWithBookKeeping(block primitives.Block) error {
 	return m.idleAndFinalizeHook(block.Header().Number)
 }
 
+// ensureNotFilteredByMigrations rejects non-mandatory extrinsics while multi-block migrations are ongoing.
+func (m module) ensureNotFilteredByMigrations(dispatchInfo primitives.DispatchInfo) error {
+	ongoing, err := m.migrator.Ongoing()
+	if err != nil {
+		return err
+	}
+	if !ongoing {
+		return nil
+	}
+
+	isMandatory, err := dispatchInfo.IsMendatory()
+	if err != nil {
+		return err
+	}
+	if !isMandatory {
+		return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionCall())
+	}
+	return nil
+}
+
 func (m module) initialChecks(block primitives.Block) error {
 	m.logger.Trace("initial_checks")
 
@@ -325,7 +425,7 @@ func (m module) runtimeUpgrade() (sc.Bool, error) {
 	return false, nil
 }
 
-func (m module) finalChecks(header *primitives.Header) error {
+func (m module) finalChecks(header *primitives.Header, stateRootCheck bool) error {
 	newHeader, err := m.system.Finalize()
 	if err != nil {
 		return err
@@ -342,7 +442,7 @@ func (m module) finalChecks(header *primitives.Header) error {
 		}
 	}
 
-	if !reflect.DeepEqual(header.StateRoot, newHeader.StateRoot) {
+	if stateRootCheck && !reflect.DeepEqual(header.StateRoot, newHeader.StateRoot) {
 		return errInvalidStorageRoot
 	}
 
@@ -103,6 +103,10 @@ var (
 		primitives.NewInvalidTransactionMandatoryValidation(),
 	)
 
+	invalidTransactionCall = primitives.NewTransactionValidityError(
+		primitives.NewInvalidTransactionCall(),
+	)
+
 	defaultDispatchOutcome  = primitives.DispatchOutcome{}
 	defaultValidTransaction = primitives.ValidTransaction{}
 )
@@ -113,6 +117,7 @@ var (
 	mockSystemModule                  *mocks.SystemModule
 	mockRuntimeExtrinsic              *mocks.RuntimeExtrinsic
 	mockOnRuntimeUpgradeHook          *mocks.DefaultOnRuntimeUpgrade
+	mockMultiBlockMigrator            *mocks.MultiBlockMigrator
 	mockUncheckedExtrinsic            *mocks.UncheckedExtrinsic
 	mockSignedExtra                   *mocks.SignedExtra
 	mockCheckedExtrinsic              *mocks.CheckedExtrinsic
@@ -128,6 +133,7 @@ func setup() {
 	mockSystemModule = new(mocks.SystemModule)
 	mockRuntimeExtrinsic = new(mocks.RuntimeExtrinsic)
 	mockOnRuntimeUpgradeHook = new(mocks.DefaultOnRuntimeUpgrade)
+	mockMultiBlockMigrator = new(mocks.MultiBlockMigrator)
 	mockUncheckedExtrinsic = new(mocks.UncheckedExtrinsic)
 	mockSignedExtra = new(mocks.SignedExtra)
 	mockCheckedExtrinsic = new(mocks.CheckedExtrinsic)
@@ -143,10 +149,13 @@ func setup() {
 		mockSystemModule,
 		mockRuntimeExtrinsic,
 		mockOnRuntimeUpgradeHook,
+		mockMultiBlockMigrator,
 		logger,
 	).(module)
 	target.hashing = mockIoHashing
 
+	mockMultiBlockMigrator.On("Ongoing").Return(false, nil)
+
 	unsignedValidator = extrinsic.NewUnsignedValidatorForChecked(mockRuntimeExtrinsic)
 }
 
@@ -449,6 +458,130 @@ func Test_Executive_ExecuteBlock_Finalize_Error(t *testing.T) {
 	mockSystemModule.AssertCalled(t, "Finalize")
 }
 
+func Test_Executive_TryRuntimeUpgrade(t *testing.T) {
+	setup()
+
+	state := sc.Sequence[sc.U8]{1, 2}
+	mockOnRuntimeUpgradeHook.On("PreUpgrade").Return(state, nil)
+	mockOnRuntimeUpgradeHook.On("OnRuntimeUpgrade").Return(primitives.WeightFromParts(1, 2))
+	mockOnRuntimeUpgradeHook.On("PostUpgrade", state).Return(nil)
+	mockRuntimeExtrinsic.On("TryOnRuntimeUpgrade", true).Return(primitives.WeightFromParts(3, 4), nil)
+
+	result, err := target.TryRuntimeUpgrade(true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightFromParts(4, 6), result)
+	mockOnRuntimeUpgradeHook.AssertCalled(t, "PreUpgrade")
+	mockOnRuntimeUpgradeHook.AssertCalled(t, "PostUpgrade", state)
+	mockRuntimeExtrinsic.AssertCalled(t, "TryOnRuntimeUpgrade", true)
+}
+
+func Test_Executive_TryRuntimeUpgrade_NoChecks(t *testing.T) {
+	setup()
+
+	mockOnRuntimeUpgradeHook.On("OnRuntimeUpgrade").Return(primitives.WeightFromParts(1, 2))
+	mockRuntimeExtrinsic.On("TryOnRuntimeUpgrade", false).Return(primitives.WeightFromParts(3, 4), nil)
+
+	result, err := target.TryRuntimeUpgrade(false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightFromParts(4, 6), result)
+	mockOnRuntimeUpgradeHook.AssertNotCalled(t, "PreUpgrade")
+	mockOnRuntimeUpgradeHook.AssertNotCalled(t, "PostUpgrade", mock.Anything)
+}
+
+func Test_Executive_TryRuntimeUpgrade_PostUpgrade_Error(t *testing.T) {
+	setup()
+
+	state := sc.Sequence[sc.U8]{1, 2}
+	mockOnRuntimeUpgradeHook.On("PreUpgrade").Return(state, nil)
+	mockOnRuntimeUpgradeHook.On("OnRuntimeUpgrade").Return(primitives.WeightFromParts(1, 2))
+	mockOnRuntimeUpgradeHook.On("PostUpgrade", state).Return(errPanic)
+
+	_, err := target.TryRuntimeUpgrade(true)
+
+	assert.Equal(t, errPanic, err)
+	mockRuntimeExtrinsic.AssertNotCalled(t, "TryOnRuntimeUpgrade", mock.Anything)
+}
+
+func Test_Executive_TryRuntimeUpgrade_Modules_Error(t *testing.T) {
+	setup()
+
+	mockOnRuntimeUpgradeHook.On("OnRuntimeUpgrade").Return(primitives.WeightFromParts(1, 2))
+	mockRuntimeExtrinsic.On("TryOnRuntimeUpgrade", false).Return(primitives.WeightZero(), errPanic)
+
+	_, err := target.TryRuntimeUpgrade(false)
+
+	assert.Equal(t, errPanic, err)
+}
+
+func Test_Executive_TryExecuteBlock_WithoutStateRootCheck(t *testing.T) {
+	setup()
+
+	blockWeights := primitives.BlockWeights{
+		BaseBlock: primitives.WeightFromParts(1, 1),
+		MaxBlock:  primitives.WeightFromParts(6, 6),
+	}
+	header := primitives.Header{
+		Number:     sc.U64(0),
+		ParentHash: blockHash,
+		Digest:     testDigest(),
+	}
+	newHeader := header
+	newHeader.StateRoot = primitives.H256{FixedSequence: sc.NewFixedSequence[sc.U8](1, sc.U8(2))}
+
+	block := types.NewBlock(header, sc.Sequence[primitives.UncheckedExtrinsic]{})
+
+	mockSystemModule.On("ResetEvents").Return()
+	mockSystemModule.On("StorageLastRuntimeUpgrade").Return(currentUpgradeInfo, nil)
+	mockSystemModule.On("Version").Return(*runtimeVersion)
+	mockSystemModule.On("Initialize", header.Number, header.ParentHash, header.Digest)
+	mockRuntimeExtrinsic.On("OnInitialize", header.Number).Return(primitives.WeightFromParts(3, 3), nil)
+	mockSystemModule.On("BlockWeights").Return(blockWeights)
+	mockSystemModule.On("RegisterExtraWeightUnchecked", primitives.WeightFromParts(4, 4), dispatchClassMandatory).Return(nil)
+	mockSystemModule.On("NoteFinishedInitialize")
+	mockRuntimeExtrinsic.On("EnsureInherentsAreFirst", block).Return(-1)
+	mockSystemModule.On("NoteFinishedExtrinsics").Return(nil)
+	mockSystemModule.On("StorageBlockWeight").Return(consumedWeight, nil)
+	mockRuntimeExtrinsic.On("OnFinalize", header.Number).Return(nil)
+	mockSystemModule.On("Finalize").Return(newHeader, nil)
+
+	result, err := target.TryExecuteBlock(block, false, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightFromParts(6, 6), result)
+	mockSystemModule.AssertCalled(t, "Finalize")
+
+	_, err = target.TryExecuteBlock(block, true, true)
+
+	assert.Equal(t, errInvalidStorageRoot, err)
+}
+
+func Test_Executive_applyExtrinsic_WithoutSignatureCheck(t *testing.T) {
+	setup()
+
+	postInfo := primitives.PostDispatchInfo{}
+
+	mockUncheckedExtrinsic.On("Bytes").Return(encodedExtrinsic)
+	mockUncheckedExtrinsic.On("UncheckedIntoChecked").Return(mockCheckedExtrinsic, nil)
+	mockSystemModule.On("NoteExtrinsic", mockUncheckedExtrinsic.Bytes())
+
+	mockCheckedExtrinsic.On("Function").Return(mockCall)
+	mockCall.On("BaseWeight").Return(baseWeight)
+	mockCall.On("WeighData", baseWeight).Return(dispatchInfo.Weight)
+	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfo.Class)
+	mockCall.On("PaysFee", baseWeight).Return(dispatchInfo.PaysFee)
+	mockCheckedExtrinsic.On("Apply", unsignedValidator, &dispatchInfo, encodedExtrinsicLen).
+		Return(postInfo, nil)
+	mockSystemModule.On("NoteAppliedExtrinsic", postInfo, nil, dispatchInfo).Return(nil)
+
+	err := target.applyExtrinsic(mockUncheckedExtrinsic, false)
+	assert.Nil(t, err)
+
+	mockUncheckedExtrinsic.AssertCalled(t, "UncheckedIntoChecked")
+	mockUncheckedExtrinsic.AssertNotCalled(t, "Check")
+}
+
 func Test_Executive_ApplyExtrinsic_UnknownTransactionCannotLookupError(t *testing.T) {
 	setup()
 
@@ -603,6 +736,78 @@ func Test_Executive_ApplyExtrinsic_Success_DispatchOutcomeNil(t *testing.T) {
 	mockSystemModule.AssertCalled(t, "NoteAppliedExtrinsic", postInfo, nil, dispatchInfo)
 }
 
+func Test_Executive_ApplyExtrinsic_MigrationsOngoing(t *testing.T) {
+	setup()
+	mockMultiBlockMigrator = new(mocks.MultiBlockMigrator)
+	target.migrator = mockMultiBlockMigrator
+
+	mockMultiBlockMigrator.On("Ongoing").Return(true, nil)
+	mockUncheckedExtrinsic.On("Bytes").Return(encodedExtrinsic)
+	mockUncheckedExtrinsic.On("Check").Return(mockCheckedExtrinsic, nil)
+	mockCheckedExtrinsic.On("Function").Return(mockCall)
+	mockCall.On("BaseWeight").Return(baseWeight)
+	mockCall.On("WeighData", baseWeight).Return(dispatchInfo.Weight)
+	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfo.Class)
+	mockCall.On("PaysFee", baseWeight).Return(dispatchInfo.PaysFee)
+
+	err := target.ApplyExtrinsic(mockUncheckedExtrinsic)
+	assert.Equal(t, invalidTransactionCall, err)
+
+	mockMultiBlockMigrator.AssertCalled(t, "Ongoing")
+	mockSystemModule.AssertNotCalled(t, "NoteExtrinsic", mock.Anything)
+	mockCheckedExtrinsic.AssertNotCalled(t, "Apply", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Executive_ApplyExtrinsic_MigrationsOngoing_Mandatory(t *testing.T) {
+	setup()
+	mockMultiBlockMigrator = new(mocks.MultiBlockMigrator)
+	target.migrator = mockMultiBlockMigrator
+
+	dispatchInfo := primitives.DispatchInfo{
+		Weight:  primitives.WeightFromParts(2, 2),
+		Class:   dispatchClassMandatory,
+		PaysFee: primitives.PaysYes,
+	}
+	postInfo := primitives.PostDispatchInfo{}
+
+	mockMultiBlockMigrator.On("Ongoing").Return(true, nil)
+	mockUncheckedExtrinsic.On("Bytes").Return(encodedExtrinsic)
+	mockUncheckedExtrinsic.On("Check").Return(mockCheckedExtrinsic, nil)
+	mockSystemModule.On("NoteExtrinsic", mockUncheckedExtrinsic.Bytes())
+	mockCheckedExtrinsic.On("Function").Return(mockCall)
+	mockCall.On("BaseWeight").Return(baseWeight)
+	mockCall.On("WeighData", baseWeight).Return(dispatchInfo.Weight)
+	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfo.Class)
+	mockCall.On("PaysFee", baseWeight).Return(dispatchInfo.PaysFee)
+	mockCheckedExtrinsic.On("Apply", unsignedValidator, &dispatchInfo, encodedExtrinsicLen).Return(postInfo, nil)
+	mockSystemModule.On("NoteAppliedExtrinsic", postInfo, nil, dispatchInfo).Return(nil)
+
+	err := target.ApplyExtrinsic(mockUncheckedExtrinsic)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertCalled(t, "NoteAppliedExtrinsic", postInfo, nil, dispatchInfo)
+}
+
+func Test_Executive_ApplyExtrinsic_MigrationsOngoing_Error(t *testing.T) {
+	setup()
+	mockMultiBlockMigrator = new(mocks.MultiBlockMigrator)
+	target.migrator = mockMultiBlockMigrator
+
+	mockMultiBlockMigrator.On("Ongoing").Return(false, errPanic)
+	mockUncheckedExtrinsic.On("Bytes").Return(encodedExtrinsic)
+	mockUncheckedExtrinsic.On("Check").Return(mockCheckedExtrinsic, nil)
+	mockCheckedExtrinsic.On("Function").Return(mockCall)
+	mockCall.On("BaseWeight").Return(baseWeight)
+	mockCall.On("WeighData", baseWeight).Return(dispatchInfo.Weight)
+	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfo.Class)
+	mockCall.On("PaysFee", baseWeight).Return(dispatchInfo.PaysFee)
+
+	err := target.ApplyExtrinsic(mockUncheckedExtrinsic)
+	assert.Equal(t, errPanic, err)
+
+	mockSystemModule.AssertNotCalled(t, "NoteExtrinsic", mock.Anything)
+}
+
 func Test_Executive_FinalizeBlock(t *testing.T) {
 	setup()
 
@@ -812,6 +1017,30 @@ func Test_Executive_ValidateTransaction(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_Executive_ValidateTransaction_MigrationsOngoing(t *testing.T) {
+	setup()
+	mockMultiBlockMigrator = new(mocks.MultiBlockMigrator)
+	target.migrator = mockMultiBlockMigrator
+
+	mockMultiBlockMigrator.On("Ongoing").Return(true, nil)
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockSystemModule.On("Initialize", blockNumber+1, header.ParentHash, defaultDigest)
+	mockUncheckedExtrinsic.On("Bytes").Return(encodedExtrinsic)
+	mockUncheckedExtrinsic.On("Check").Return(mockCheckedExtrinsic, nil)
+	mockCheckedExtrinsic.On("Function").Return(mockCall)
+	mockCall.On("BaseWeight").Return(baseWeight)
+	mockCall.On("WeighData", baseWeight).Return(dispatchInfo.Weight)
+	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfo.Class)
+	mockCall.On("PaysFee", baseWeight).Return(dispatchInfo.PaysFee)
+
+	outcome, err := target.ValidateTransaction(txSource, mockUncheckedExtrinsic, header.ParentHash)
+
+	mockMultiBlockMigrator.AssertCalled(t, "Ongoing")
+	mockCheckedExtrinsic.AssertNotCalled(t, "Validate", unsignedValidator, txSource, &dispatchInfo, encodedExtrinsicLen)
+	assert.Equal(t, defaultValidTransaction, outcome)
+	assert.Equal(t, invalidTransactionCall, err)
+}
+
 func Test_Executive_OffchainWorker(t *testing.T) {
 	setup()
 
@@ -885,7 +1114,7 @@ func Test_executeExtrinsicsWithBookKeeping_ApplyExtrinsic_Error(t *testing.T) {
 	mockUncheckedExtrinsic.On("Bytes").Return(encodedExtrinsic)
 	mockUncheckedExtrinsic.On("Check").Return(nil, errPanic)
 
-	err := target.executeExtrinsicsWithBookKeeping(block)
+	err := target.executeExtrinsicsWithBookKeeping(block, true)
 
 	assert.Equal(t, errPanic, err)
 	mockUncheckedExtrinsic.AssertCalled(t, "Bytes")
@@ -912,7 +1141,7 @@ func Test_executeExtrinsicsWithBookKeepingNoteFinishedExtrinsics_Error(t *testin
 	mockSystemModule.On("NoteAppliedExtrinsic", postInfo, nil, dispatchInfo).Return(nil)
 	mockSystemModule.On("NoteFinishedExtrinsics").Return(expectedErr)
 
-	err := target.executeExtrinsicsWithBookKeeping(block)
+	err := target.executeExtrinsicsWithBookKeeping(block, true)
 
 	assert.Equal(t, expectedErr, err)
 	mockUncheckedExtrinsic.AssertCalled(t, "Bytes")
@@ -927,7 +1156,7 @@ func Test_Executive_finalChecks_Finalize_Error(t *testing.T) {
 
 	mockSystemModule.On("Finalize").Return(header, errPanic)
 
-	err := target.finalChecks(&primitives.Header{})
+	err := target.finalChecks(&primitives.Header{}, true)
 	assert.Equal(t, errPanic, err)
 
 	mockSystemModule.AssertCalled(t, "Finalize")
@@ -938,7 +1167,7 @@ func Test_Executive_finalChecks_ErrorInvalidDigestNum(t *testing.T) {
 
 	mockSystemModule.On("Finalize").Return(header, nil)
 
-	err := target.finalChecks(&primitives.Header{})
+	err := target.finalChecks(&primitives.Header{}, true)
 	assert.Equal(t, errInvalidDigestNum, err)
 
 	mockSystemModule.AssertCalled(t, "Finalize")
@@ -959,7 +1188,7 @@ func Test_Executive_finalChecks_ErrorInvalidDigestItem(t *testing.T) {
 	}
 	mockSystemModule.On("Finalize").Return(newHeader, nil)
 
-	err := target.finalChecks(&header)
+	err := target.finalChecks(&header, true)
 	assert.Equal(t, errInvalidDigestItem, err)
 
 	mockSystemModule.AssertCalled(t, "Finalize")
@@ -976,7 +1205,7 @@ func Test_Executive_finalChecks_ErrorInvalidStorageRoot(t *testing.T) {
 	}
 	mockSystemModule.On("Finalize").Return(newHeader, nil)
 
-	err := target.finalChecks(&header)
+	err := target.finalChecks(&header, true)
 	assert.Equal(t, errInvalidStorageRoot, err)
 
 	mockSystemModule.AssertCalled(t, "Finalize")
@@ -993,7 +1222,7 @@ func Test_Executive_finalChecks_ErrorInvalidTxTrie(t *testing.T) {
 	}
 	mockSystemModule.On("Finalize").Return(newHeader, nil)
 
-	err := target.finalChecks(&header)
+	err := target.finalChecks(&header, true)
 	assert.Equal(t, errInvalidTxTrie, err)
 
 	mockSystemModule.AssertCalled(t, "Finalize")
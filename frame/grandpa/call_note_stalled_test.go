@@ -0,0 +1,120 @@
+package grandpa
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_NoteStalled_New(t *testing.T) {
+	target := setupCallNoteStalled()
+	expected := callNoteStalled{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionNoteStalledIndex,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.U64(0)),
+		},
+		constants: newConstants(dbWeight),
+		stalled:   mockStorageStalled,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_NoteStalled_DecodeArgs(t *testing.T) {
+	target := setupCallNoteStalled()
+	buffer := bytes.NewBuffer(stall.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(delay, median), call.Args())
+}
+
+func Test_Call_NoteStalled_Encode(t *testing.T) {
+	target := setupCallNoteStalled()
+	call, err := target.DecodeArgs(bytes.NewBuffer(stall.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionNoteStalledIndex}, stall.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_NoteStalled_Bytes(t *testing.T) {
+	target := setupCallNoteStalled()
+	call, err := target.DecodeArgs(bytes.NewBuffer(stall.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionNoteStalledIndex}, stall.Bytes()...), call.Bytes())
+}
+
+func Test_Call_NoteStalled_ModuleIndex(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_NoteStalled_FunctionIndex(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	assert.Equal(t, sc.U8(functionNoteStalledIndex), target.FunctionIndex())
+}
+
+func Test_Call_NoteStalled_BaseWeight(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	assert.Equal(t, callNoteStalledWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_NoteStalled_WeighData(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_NoteStalled_ClassifyDispatch(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_NoteStalled_PaysFee(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_NoteStalled_Dispatch(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	mockStorageStalled.On("Put", stall).Return()
+
+	result, err := target.Dispatch(rootOrigin, sc.NewVaryingData(delay, median))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageStalled.AssertCalled(t, "Put", stall)
+}
+
+func Test_Call_NoteStalled_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallNoteStalled()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(delay, median))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageStalled.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func setupCallNoteStalled() primitives.Call {
+	setup()
+
+	return newCallNoteStalled(moduleId, functionNoteStalledIndex, newConstants(dbWeight), mockStorageStalled)
+}
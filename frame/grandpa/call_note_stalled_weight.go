@@ -0,0 +1,12 @@
+// Reference weight, to be replaced by the output of the BenchmarkGrandpaNoteStalled benchmark.
+
+package grandpa
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callNoteStalledWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(3000000, 0).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,26 @@
+package grandpa
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight           primitives.RuntimeDbWeight
+	EventDepositor     primitives.EventDepositor
+	StorageBlockNumber func() (sc.U64, error)
+	// DepositLog deposits the GRANDPA consensus logs in the block digest.
+	DepositLog func(item primitives.DigestItem)
+	// CurrentSessionIndex provides the index of the current session, to which the set id is mapped.
+	CurrentSessionIndex func() (sc.U32, error)
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, storageBlockNumber func() (sc.U64, error), depositLog func(item primitives.DigestItem), currentSessionIndex func() (sc.U32, error)) *Config {
+	return &Config{
+		DbWeight:            dbWeight,
+		EventDepositor:      eventDepositor,
+		StorageBlockNumber:  storageBlockNumber,
+		DepositLog:          depositLog,
+		CurrentSessionIndex: currentSessionIndex,
+	}
+}
@@ -0,0 +1,50 @@
+package grandpa
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// GRANDPA consensus logs, deposited in the block digest.
+const (
+	// ConsensusLogScheduledChange signals that the authority set will change after `delay` blocks
+	// are finalized.
+	ConsensusLogScheduledChange sc.U8 = iota + 1
+	// ConsensusLogForcedChange signals that the authority set will change after `delay` blocks
+	// are imported, regardless of finality. The `median` last finalized block is finalized
+	// by the new authority set.
+	ConsensusLogForcedChange
+	// ConsensusLogOnDisabled signals that an authority has been disabled.
+	ConsensusLogOnDisabled
+	// ConsensusLogPause signals that finality will pause after `delay` blocks.
+	ConsensusLogPause
+	// ConsensusLogResume signals that finality will resume after `delay` blocks.
+	ConsensusLogResume
+)
+
+func newConsensusLogScheduledChange(nextAuthorities sc.Sequence[primitives.Authority], delay sc.U64) primitives.DigestItem {
+	message := append(ConsensusLogScheduledChange.Bytes(), nextAuthorities.Bytes()...)
+	message = append(message, delay.Bytes()...)
+
+	return newConsensusMessage(message)
+}
+
+func newConsensusLogForcedChange(median sc.U64, nextAuthorities sc.Sequence[primitives.Authority], delay sc.U64) primitives.DigestItem {
+	message := append(ConsensusLogForcedChange.Bytes(), median.Bytes()...)
+	message = append(message, nextAuthorities.Bytes()...)
+	message = append(message, delay.Bytes()...)
+
+	return newConsensusMessage(message)
+}
+
+func newConsensusLogPause(delay sc.U64) primitives.DigestItem {
+	return newConsensusMessage(append(ConsensusLogPause.Bytes(), delay.Bytes()...))
+}
+
+func newConsensusLogResume(delay sc.U64) primitives.DigestItem {
+	return newConsensusMessage(append(ConsensusLogResume.Bytes(), delay.Bytes()...))
+}
+
+func newConsensusMessage(message []byte) primitives.DigestItem {
+	return primitives.NewDigestItemConsensusMessage(sc.BytesToFixedSequenceU8(EngineId[:]), sc.BytesToSequenceU8(message))
+}
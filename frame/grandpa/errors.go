@@ -0,0 +1,49 @@
+package grandpa
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Grandpa module errors.
+const (
+	PauseFailedError sc.U8 = iota
+	ResumeFailedError
+	ChangePendingError
+	TooSoonError
+	InvalidKeyOwnershipProofError
+	InvalidEquivocationProofError
+	DuplicateOffenceReportError
+)
+
+func NewDispatchErrorPauseFailed(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(PauseFailedError),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorResumeFailed(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ResumeFailedError),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorChangePending(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ChangePendingError),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorTooSoon(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(TooSoonError),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
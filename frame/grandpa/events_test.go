@@ -0,0 +1,72 @@
+package grandpa
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Grandpa_DecodeEvent_NewAuthorities(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventNewAuthorities.Bytes())
+	buffer.Write(authorities.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventNewAuthorities, authorities)},
+		result,
+	)
+}
+
+func Test_Grandpa_DecodeEvent_Paused(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventPaused.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventPaused)},
+		result,
+	)
+}
+
+func Test_Grandpa_DecodeEvent_Resumed(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventResumed.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventResumed)},
+		result,
+	)
+}
+
+func Test_Grandpa_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Grandpa_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
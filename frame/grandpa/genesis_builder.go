@@ -82,7 +82,11 @@ func (m Module) BuildConfig(config []byte) error {
 	// todo missing
 	// CurrentSetId::<T>::put(SetId::default());
 
-	if len(gc.Authorities) == 0 {
+	return m.initializeAuthorities(gc.Authorities)
+}
+
+func (m Module) initializeAuthorities(authorities sc.Sequence[types.Authority]) error {
+	if len(authorities) == 0 {
 		return nil
 	}
 
@@ -101,15 +105,13 @@ func (m Module) BuildConfig(config []byte) error {
 	// ),
 
 	m.storage.Authorities.Put(types.VersionedAuthorityList{
-		AuthorityList: gc.Authorities,
+		AuthorityList: authorities,
 		Version:       AuthorityVersion,
 	})
 
-	// todo missing
-	//// NOTE: initialize first session of first set. this is necessary for
-	//// the genesis set and session since we only update the set -> session
-	//// mapping whenever a new session starts, i.e. through `on_new_session`.
-	// SetIdSession::<T>::insert(0, 0);
+	// The genesis set and session are mapped here, since the mapping is otherwise
+	// only updated on a new session.
+	m.storage.SetIdSession.Put(0, 0)
 
 	return nil
 }
@@ -72,6 +72,7 @@ func Test_GenesisConfig_BuildConfig(t *testing.T) {
 			setup()
 			mockStorageAuthorities.On("Get").Return(tt.storageAuthorities, tt.storageAuthoritiesGetErr)
 			mockStorageAuthorities.On("Put", versionedAuthorityList).Return()
+			mockStorageSetIdSession.On("Put", sc.U64(0), sc.U32(0)).Return()
 
 			err := target.BuildConfig([]byte(tt.gcJson))
 			assert.Equal(t, tt.expectedErr, err)
@@ -79,6 +80,7 @@ func Test_GenesisConfig_BuildConfig(t *testing.T) {
 			if tt.shouldAssertCalled {
 				mockStorageAuthorities.AssertCalled(t, "Get")
 				mockStorageAuthorities.AssertCalled(t, "Put", versionedAuthorityList)
+				mockStorageSetIdSession.AssertCalled(t, "Put", sc.U64(0), sc.U32(0))
 			}
 		})
 	}
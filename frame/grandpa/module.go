@@ -3,23 +3,21 @@ package grandpa
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// Function indices follow the ones in `pallet_grandpa`, so that the calls are encoded
+// the same way as in Substrate based chains.
 const (
-	name = sc.Str("Grandpa")
+	functionNoteStalledIndex = 2
 )
 
 const (
-	PauseFailedError = iota
-	ResumeFailedError
-	ChangePendingError
-	TooSoonError
-	InvalidKeyOwnershipProofError
-	InvalidEquivocationProofError
-	DuplicateOffenceReportError
+	name           = sc.Str("Grandpa")
+	storageVersion = sc.U16(0)
 )
 
 var (
@@ -34,25 +32,46 @@ type GrandpaModule interface {
 	KeyType() primitives.PublicKeyType
 	KeyTypeId() [4]byte
 	Authorities() (sc.Sequence[primitives.Authority], error)
+	CurrentSetId() (sc.U64, error)
 }
 
+// Module stores the GRANDPA authorities and manages the changes of the authority set.
+//
+// Changes of the authority set, as well as pauses and resumes of finality, are scheduled to be
+// enacted a number of blocks after the block, in which they are scheduled. In OnFinalize, the
+// corresponding GRANDPA consensus log is deposited in the digest of the scheduling block, so that
+// the finality gadget can follow the change, and the change is enacted once its delay has passed.
+// On every new session, a change to the keys of the new validators is scheduled and the set id
+// is incremented. If finality has stalled, as noted by Root with note_stalled, the change is forced.
 type Module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	Index       sc.U8
+	config      *Config
+	constants   *consts
 	storage     *storage
 	functions   map[sc.U8]primitives.Call
 	mdGenerator *primitives.MetadataTypeGenerator
 	logger      log.WarnLogger
 }
 
-func New(index sc.U8, logger log.WarnLogger, mdGenerator *primitives.MetadataTypeGenerator) Module {
+func New(index sc.U8, config *Config, logger log.WarnLogger, mdGenerator *primitives.MetadataTypeGenerator) Module {
+	constants := newConstants(config.DbWeight)
+	storage := newStorage()
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionNoteStalledIndex] = newCallNoteStalled(index, functionNoteStalledIndex, constants, storage.Stalled)
 
 	return Module{
-		Index:       index,
-		storage:     newStorage(),
-		mdGenerator: mdGenerator,
-		logger:      logger,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyGrandpa, storageVersion),
+		Index:                index,
+		config:               config,
+		constants:            constants,
+		storage:              storage,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+		logger:               logger,
 	}
 }
 
@@ -64,6 +83,229 @@ func (m Module) KeyTypeId() [4]byte {
 	return KeyTypeId
 }
 
+// OnGenesisSession initializes the authorities with the keys of the genesis validators.
+func (m Module) OnGenesisSession(validators sc.Sequence[primitives.SessionValidator]) error {
+	authorities, err := toAuthorities(validators)
+	if err != nil {
+		return err
+	}
+
+	return m.initializeAuthorities(authorities)
+}
+
+// OnNewSession schedules a change of the authorities to the keys of the new validators, if they
+// have changed or finality has stalled, and increments the set id. The change is enacted immediately,
+// unless it is forced because of a stall, in which case it is delayed as noted by note_stalled.
+// The current set id is mapped to the new session, which is the latest session of the set.
+func (m Module) OnNewSession(changed bool, validators sc.Sequence[primitives.SessionValidator], _ sc.Sequence[primitives.SessionValidator]) error {
+	currentSetId, err := m.changeAuthorities(changed, validators)
+	if err != nil {
+		return err
+	}
+
+	sessionIndex, err := m.config.CurrentSessionIndex()
+	if err != nil {
+		return err
+	}
+	m.storage.SetIdSession.Put(currentSetId, sessionIndex)
+
+	return nil
+}
+
+// changeAuthorities schedules the change of the authorities on a new session and returns the current set id.
+func (m Module) changeAuthorities(changed bool, validators sc.Sequence[primitives.SessionValidator]) (sc.U64, error) {
+	stalled, err := m.storage.Stalled.TryGet()
+	if err != nil {
+		return 0, err
+	}
+
+	if !changed && !stalled.HasValue {
+		return m.storage.CurrentSetId.Get()
+	}
+
+	nextAuthorities, err := toAuthorities(validators)
+	if err != nil {
+		return 0, err
+	}
+
+	if stalled.HasValue {
+		m.storage.Stalled.Clear()
+		err = m.ScheduleForcedChange(nextAuthorities, stalled.Value.FurtherWait, stalled.Value.Median)
+	} else {
+		err = m.ScheduleChange(nextAuthorities, 0)
+	}
+	if err != nil {
+		// The change is not scheduled, if there is already a pending one or a forced change
+		// is too soon. The set id stays the same, since the authorities do not change.
+		if _, ok := err.(primitives.DispatchError); ok {
+			return m.storage.CurrentSetId.Get()
+		}
+		return 0, err
+	}
+
+	currentSetId, err := m.storage.CurrentSetId.Get()
+	if err != nil {
+		return 0, err
+	}
+	currentSetId = sc.SaturatingAddU64(currentSetId, 1)
+	m.storage.CurrentSetId.Put(currentSetId)
+
+	return currentSetId, nil
+}
+
+// OnFinalize deposits the consensus log of the pending authority set change, pause or resume
+// in the block, in which it is scheduled, and enacts it after its delay.
+func (m Module) OnFinalize(n sc.U64) error {
+	pendingChange, err := m.storage.PendingChange.TryGet()
+	if err != nil {
+		return err
+	}
+
+	if pendingChange.HasValue {
+		change := pendingChange.Value
+		if n == change.ScheduledAt {
+			if change.Forced.HasValue {
+				m.config.DepositLog(newConsensusLogForcedChange(change.Forced.Value, change.NextAuthorities, change.Delay))
+			} else {
+				m.config.DepositLog(newConsensusLogScheduledChange(change.NextAuthorities, change.Delay))
+			}
+		}
+
+		if n == sc.SaturatingAddU64(change.ScheduledAt, change.Delay) {
+			m.storage.Authorities.Put(primitives.VersionedAuthorityList{
+				AuthorityList: change.NextAuthorities,
+				Version:       AuthorityVersion,
+			})
+			m.config.EventDepositor.DepositEvent(newEventNewAuthorities(m.Index, change.NextAuthorities))
+			m.storage.PendingChange.Clear()
+		}
+	}
+
+	state, err := m.storage.State.Get()
+	if err != nil {
+		return err
+	}
+
+	if state.IsPendingPause() || state.IsPendingResume() {
+		transition, err := state.AsScheduledTransition()
+		if err != nil {
+			return err
+		}
+
+		if n == transition.ScheduledAt {
+			if state.IsPendingPause() {
+				m.config.DepositLog(newConsensusLogPause(transition.Delay))
+			} else {
+				m.config.DepositLog(newConsensusLogResume(transition.Delay))
+			}
+		}
+
+		if n == sc.SaturatingAddU64(transition.ScheduledAt, transition.Delay) {
+			if state.IsPendingPause() {
+				m.storage.State.Put(NewStoredStatePaused())
+				m.config.EventDepositor.DepositEvent(newEventPaused(m.Index))
+			} else {
+				m.storage.State.Put(NewStoredStateLive())
+				m.config.EventDepositor.DepositEvent(newEventResumed(m.Index))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ScheduleChange schedules a change of the authority set to `nextAuthorities`, to be enacted after
+// `inBlocks` blocks are finalized. Only one change can be pending at a time.
+func (m Module) ScheduleChange(nextAuthorities sc.Sequence[primitives.Authority], inBlocks sc.U64) error {
+	return m.scheduleChange(nextAuthorities, inBlocks, sc.NewOption[sc.U64](nil))
+}
+
+// ScheduleForcedChange schedules a change of the authority set to `nextAuthorities`, to be enacted
+// after `inBlocks` blocks are imported, regardless of finality. The new authorities finalize blocks
+// on top of the `median` last finalized block. A forced change can only be scheduled after the
+// delay of the previous one has passed twice.
+func (m Module) ScheduleForcedChange(nextAuthorities sc.Sequence[primitives.Authority], inBlocks sc.U64, median sc.U64) error {
+	return m.scheduleChange(nextAuthorities, inBlocks, sc.NewOption[sc.U64](median))
+}
+
+// SchedulePause schedules a pause of finality after `inBlocks` blocks. Finality can only be paused when it is live.
+func (m Module) SchedulePause(inBlocks sc.U64) error {
+	state, err := m.storage.State.Get()
+	if err != nil {
+		return err
+	}
+
+	if !state.IsLive() {
+		return NewDispatchErrorPauseFailed(m.Index)
+	}
+
+	scheduledAt, err := m.config.StorageBlockNumber()
+	if err != nil {
+		return err
+	}
+	m.storage.State.Put(NewStoredStatePendingPause(scheduledAt, inBlocks))
+
+	return nil
+}
+
+// ScheduleResume schedules a resume of the paused finality after `inBlocks` blocks.
+func (m Module) ScheduleResume(inBlocks sc.U64) error {
+	state, err := m.storage.State.Get()
+	if err != nil {
+		return err
+	}
+
+	if !state.IsPaused() {
+		return NewDispatchErrorResumeFailed(m.Index)
+	}
+
+	scheduledAt, err := m.config.StorageBlockNumber()
+	if err != nil {
+		return err
+	}
+	m.storage.State.Put(NewStoredStatePendingResume(scheduledAt, inBlocks))
+
+	return nil
+}
+
+// CurrentSetId returns the id of the current authority set. It is incremented on every authority set change.
+func (m Module) CurrentSetId() (sc.U64, error) {
+	return m.storage.CurrentSetId.Get()
+}
+
+func (m Module) scheduleChange(nextAuthorities sc.Sequence[primitives.Authority], inBlocks sc.U64, forced sc.Option[sc.U64]) error {
+	if m.storage.PendingChange.Exists() {
+		return NewDispatchErrorChangePending(m.Index)
+	}
+
+	scheduledAt, err := m.config.StorageBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	if forced.HasValue {
+		nextForced, err := m.storage.NextForced.TryGet()
+		if err != nil {
+			return err
+		}
+		if nextForced.HasValue && scheduledAt < nextForced.Value {
+			return NewDispatchErrorTooSoon(m.Index)
+		}
+
+		// Only allow the next forced change when twice the window has passed since this one.
+		m.storage.NextForced.Put(sc.SaturatingAddU64(scheduledAt, sc.SaturatingAddU64(inBlocks, inBlocks)))
+	}
+
+	m.storage.PendingChange.Put(StoredPendingChange{
+		ScheduledAt:     scheduledAt,
+		Delay:           inBlocks,
+		NextAuthorities: nextAuthorities,
+		Forced:          forced,
+	})
+
+	return nil
+}
+
 func (m Module) GetIndex() sc.U8 {
 	return m.Index
 }
@@ -73,7 +315,7 @@ func (m Module) name() sc.Str {
 }
 
 func (m Module) Functions() map[sc.U8]primitives.Call {
-	return map[sc.U8]primitives.Call{}
+	return m.functions
 }
 
 func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
@@ -100,20 +342,36 @@ func (m Module) Authorities() (sc.Sequence[primitives.Authority], error) {
 }
 
 func (m Module) Metadata() primitives.MetadataModule {
-	m.mdGenerator.BuildCallsMetadata("Grandpa", m.Functions(), &sc.Sequence[primitives.MetadataTypeParameter]{
+	metadataIdGrandpaCalls := m.mdGenerator.BuildCallsMetadata("Grandpa", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
 		primitives.NewMetadataEmptyTypeParameter("T"),
 		primitives.NewMetadataEmptyTypeParameter("I"),
 	})
 
 	dataV14 := primitives.MetadataModuleV14{
-		Name:      m.name(),
-		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
-		Call:      sc.NewOption[sc.Compact](nil),
-		CallDef:   sc.NewOption[primitives.MetadataDefinitionVariant](nil),
-		Event:     sc.NewOption[sc.Compact](nil),
-		EventDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdGrandpaCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdGrandpaCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Grandpa, Runtime>"),
+				},
+				m.Index,
+				"Call.Grandpa"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesGrandpaEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesGrandpaEvent, "pallet_grandpa::Event"),
+				},
+				m.Index,
+				"Events.Grandpa"),
+		),
 		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
-		Error:     sc.NewOption[sc.Compact](nil),
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesGrandpaErrors)),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
 			primitives.NewMetadataDefinitionVariantStr(
 				m.name(),
@@ -134,13 +392,18 @@ func (m Module) Metadata() primitives.MetadataModule {
 }
 
 func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	transitionFields := sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "scheduled_at", "N"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "N"),
+	}
+
 	return sc.Sequence[primitives.MetadataType]{
 		primitives.NewMetadataTypeWithParams(metadata.TypesGrandpaErrors, "The `Error` enum of this pallet.", sc.Sequence[sc.Str]{"pallet_grandpa", "pallet", "Error"}, primitives.NewMetadataTypeDefinitionVariant(
 			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant("PauseFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, PauseFailedError, ""),
-				primitives.NewMetadataDefinitionVariant("ResumeFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ResumeFailedError, ""),
-				primitives.NewMetadataDefinitionVariant("ChangePending", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ChangePendingError, ""),
-				primitives.NewMetadataDefinitionVariant("TooSoon", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, TooSoonError, ""),
+				primitives.NewMetadataDefinitionVariant("PauseFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, PauseFailedError, "Attempt to signal GRANDPA pause when the authority set isn't live (either paused or already pending pause)."),
+				primitives.NewMetadataDefinitionVariant("ResumeFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ResumeFailedError, "Attempt to signal GRANDPA resume when the authority set isn't paused (either live or already pending resume)."),
+				primitives.NewMetadataDefinitionVariant("ChangePending", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ChangePendingError, "Attempt to signal GRANDPA change with one already pending."),
+				primitives.NewMetadataDefinitionVariant("TooSoon", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, TooSoonError, "Cannot signal forced change so soon after last."),
 				primitives.NewMetadataDefinitionVariant("InvalidKeyOwnershipProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, InvalidKeyOwnershipProofError, ""),
 				primitives.NewMetadataDefinitionVariant("InvalidEquivocationProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, InvalidEquivocationProofError, ""),
 				primitives.NewMetadataDefinitionVariant("DuplicateOffenceReport", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, DuplicateOffenceReportError, ""),
@@ -155,5 +418,121 @@ func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 		primitives.NewMetadataType(metadata.TypesTupleGrandpaAppPublicU64, "(GrandpaAppPublic, U64)",
 			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesGrandpaAppPublic), sc.ToCompact(metadata.PrimitiveTypesU64)})),
 		primitives.NewMetadataType(metadata.TypesSequenceTupleGrandpaAppPublic, "[]byte (GrandpaAppPublic, U64)", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleGrandpaAppPublicU64))),
+		metadataTypeOption(metadata.TypesOptionU64, "Option<u64>", metadata.PrimitiveTypesU64),
+		primitives.NewMetadataType(metadata.TypesTupleU64U64, "(BlockNumber, BlockNumber)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.PrimitiveTypesU64), sc.ToCompact(metadata.PrimitiveTypesU64)})),
+		primitives.NewMetadataTypeWithParam(metadata.TypesGrandpaStoredState, "StoredState", sc.Sequence[sc.Str]{"pallet_grandpa", "StoredState"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("Live", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, StoredStateLive, "StoredState.Live"),
+				primitives.NewMetadataDefinitionVariant("PendingPause", transitionFields, StoredStatePendingPause, "StoredState.PendingPause"),
+				primitives.NewMetadataDefinitionVariant("Paused", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, StoredStatePaused, "StoredState.Paused"),
+				primitives.NewMetadataDefinitionVariant("PendingResume", transitionFields, StoredStatePendingResume, "StoredState.PendingResume"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "N")),
+		primitives.NewMetadataTypeWithParam(metadata.TypesGrandpaStoredPendingChange, "StoredPendingChange", sc.Sequence[sc.Str]{"pallet_grandpa", "StoredPendingChange"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "scheduled_at", "N"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "N"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleGrandpaAppPublic, "next_authorities", "BoundedAuthorityList<Limit>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU64, "forced", "Option<N>"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "N")),
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaEvent, "pallet_grandpa pallet Event", sc.Sequence[sc.Str]{"pallet_grandpa", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"NewAuthorities",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleGrandpaAppPublic, "authority_set", "AuthorityList"),
+					},
+					EventNewAuthorities,
+					"Events.NewAuthorities"),
+				primitives.NewMetadataDefinitionVariant(
+					"Paused",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventPaused,
+					"Events.Paused"),
+				primitives.NewMetadataDefinitionVariant(
+					"Resumed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventResumed,
+					"Events.Resumed"),
+			},
+		)),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"State",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesGrandpaStoredState)),
+				"State of the current authority set."),
+			primitives.NewMetadataModuleStorageEntry(
+				"PendingChange",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesGrandpaStoredPendingChange)),
+				"Pending change: (signaled at, scheduled change)."),
+			primitives.NewMetadataModuleStorageEntry(
+				"NextForced",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				"next block number where we can force a change."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Stalled",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesTupleU64U64)),
+				"`true` if we are currently stalled."),
+			primitives.NewMetadataModuleStorageEntry(
+				"CurrentSetId",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				"The number of changes (both in terms of keys and underlying economic responsibilities) in the \"set\" of Grandpa validators from genesis."),
+			primitives.NewMetadataModuleStorageEntry(
+				"SetIdSession",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU64),
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+				),
+				"A mapping from grandpa set ID to the index of the *most recent* session for which its members were responsible.  This is only used for validating equivocation proofs. An equivocation proof must contains a key-ownership proof for a given session, therefore we need a way to tie together sessions and GRANDPA set ids, i.e. we need to validate that a validator was the owner of a given key on a given session, and what the active set ID was during that session."),
+		},
+	})
+}
+
+// toAuthorities returns the session keys of `validators` as authorities with equal weight.
+func toAuthorities(validators sc.Sequence[primitives.SessionValidator]) (sc.Sequence[primitives.Authority], error) {
+	authorities := sc.Sequence[primitives.Authority]{}
+	for _, validator := range validators {
+		id, err := primitives.NewAccountId(validator.Key...)
+		if err != nil {
+			return nil, err
+		}
+		authorities = append(authorities, primitives.Authority{Id: id, Weight: 1})
 	}
+
+	return authorities, nil
+}
+
+// metadataTypeOption returns the metadata type of an option of the type `typeId`.
+func metadataTypeOption(id int, docs string, typeId int) primitives.MetadataType {
+	return primitives.NewMetadataTypeWithParam(id, docs, sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+		sc.Sequence[primitives.MetadataDefinitionVariant]{
+			primitives.NewMetadataDefinitionVariant(
+				"None",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+				0,
+				""),
+			primitives.NewMetadataDefinitionVariant(
+				"Some",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(typeId),
+				},
+				1,
+				""),
+		}),
+		primitives.NewMetadataTypeParameter(typeId, "T"))
 }
@@ -1,22 +1,54 @@
 package grandpa
 
 import (
+	"errors"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/mocks"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const moduleId = sc.U8(3)
 
 var (
-	mdGenerator = primitives.NewMetadataTypeGenerator()
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	blockNumber       = sc.U64(5)
+	delay             = sc.U64(10)
+	median            = sc.U64(3)
+	sessionIndex      = sc.U32(4)
+	rootOrigin        = primitives.NewRawOriginRoot()
+	mdGenerator       = primitives.NewMetadataTypeGenerator()
+	sessionValidators = sc.Sequence[primitives.SessionValidator]{
+		{Key: sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)},
+	}
+	pendingChange = StoredPendingChange{
+		ScheduledAt:     blockNumber,
+		Delay:           0,
+		NextAuthorities: authorities,
+		Forced:          sc.NewOption[sc.U64](nil),
+	}
+	forcedChange = StoredPendingChange{
+		ScheduledAt:     blockNumber,
+		Delay:           delay,
+		NextAuthorities: authorities,
+		Forced:          sc.NewOption[sc.U64](median),
+	}
+	stall = Stall{
+		FurtherWait: delay,
+		Median:      median,
+	}
 )
 
 var (
@@ -24,21 +56,44 @@ var (
 )
 
 var (
-	mockStorageAuthorities *mocks.StorageValue[primitives.VersionedAuthorityList]
-	target                 Module
-	logger                 = log.NewLogger()
+	mockStorageAuthorities   *mocks.StorageValue[primitives.VersionedAuthorityList]
+	mockStorageState         *mocks.StorageValue[StoredState]
+	mockStoragePendingChange *mocks.StorageValue[StoredPendingChange]
+	mockStorageNextForced    *mocks.StorageValue[sc.U64]
+	mockStorageStalled       *mocks.StorageValue[Stall]
+	mockStorageCurrentSetId  *mocks.StorageValue[sc.U64]
+	mockStorageSetIdSession  *mocks.StorageMap[sc.U64, sc.U32]
+	mockSystemModule         *mocks.SystemModule
+	mockEventDepositor       *mocks.EventDepositor
+	mockStorageBlockNumber   func() (sc.U64, error)
+	mockSessionIndex         func() (sc.U32, error)
+	target                   Module
+	logger                   = log.NewLogger()
 )
 
 func Test_Module_New(t *testing.T) {
 	setup()
 
+	assert.Equal(t, storageVersion, target.InCodeStorageVersion())
+
+	// The storage version holds a decode function, which is not comparable.
+	target.ModuleStorageVersion = support.ModuleStorageVersion{}
 	assert.Equal(t, Module{
 		DefaultInherentProvider: primitives.DefaultInherentProvider{},
 		DefaultDispatchModule:   hooks.DefaultDispatchModule{},
 		Index:                   moduleId,
+		config:                  target.config,
+		constants:               newConstants(dbWeight),
 		storage: &storage{
-			mockStorageAuthorities,
+			Authorities:   mockStorageAuthorities,
+			State:         mockStorageState,
+			PendingChange: mockStoragePendingChange,
+			NextForced:    mockStorageNextForced,
+			Stalled:       mockStorageStalled,
+			CurrentSetId:  mockStorageCurrentSetId,
+			SetIdSession:  mockStorageSetIdSession,
 		},
+		functions:   target.functions,
 		logger:      logger,
 		mdGenerator: mdGenerator,
 	}, target)
@@ -54,6 +109,395 @@ func Test_Module_KeyTypeId(t *testing.T) {
 	assert.Equal(t, KeyTypeId, target.KeyTypeId())
 }
 
+func Test_Module_OnGenesisSession(t *testing.T) {
+	setup()
+
+	mockStorageAuthorities.On("Get").Return(primitives.VersionedAuthorityList{}, nil)
+	mockStorageAuthorities.On("Put", versionedAuthorityList).Return()
+	mockStorageSetIdSession.On("Put", sc.U64(0), sc.U32(0)).Return()
+
+	err := target.OnGenesisSession(sessionValidators)
+	assert.Nil(t, err)
+
+	mockStorageAuthorities.AssertCalled(t, "Put", versionedAuthorityList)
+	mockStorageSetIdSession.AssertCalled(t, "Put", sc.U64(0), sc.U32(0))
+}
+
+func Test_Module_OnGenesisSession_InvalidKey(t *testing.T) {
+	setup()
+
+	_, expectErr := primitives.NewAccountId(sc.U8(1))
+
+	err := target.OnGenesisSession(sc.Sequence[primitives.SessionValidator]{{Key: sc.Sequence[sc.U8]{1}}})
+	assert.Equal(t, expectErr, err)
+
+	mockStorageAuthorities.AssertNotCalled(t, "Get")
+}
+
+func Test_Module_OnNewSession(t *testing.T) {
+	setup()
+
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](nil), nil)
+	mockStoragePendingChange.On("Exists").Return(false)
+	mockStoragePendingChange.On("Put", pendingChange).Return()
+	mockStorageCurrentSetId.On("Get").Return(sc.U64(1), nil)
+	mockStorageCurrentSetId.On("Put", sc.U64(2)).Return()
+	mockStorageSetIdSession.On("Put", sc.U64(2), sessionIndex).Return()
+
+	err := target.OnNewSession(true, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStoragePendingChange.AssertCalled(t, "Put", pendingChange)
+	mockStorageCurrentSetId.AssertCalled(t, "Put", sc.U64(2))
+	mockStorageSetIdSession.AssertCalled(t, "Put", sc.U64(2), sessionIndex)
+	mockStorageNextForced.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageAuthorities.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnNewSession_Stalled(t *testing.T) {
+	setup()
+
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](stall), nil)
+	mockStorageStalled.On("Clear").Return()
+	mockStoragePendingChange.On("Exists").Return(false)
+	mockStorageNextForced.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageNextForced.On("Put", blockNumber+2*delay).Return()
+	mockStoragePendingChange.On("Put", forcedChange).Return()
+	mockStorageCurrentSetId.On("Get").Return(sc.U64(0), nil)
+	mockStorageCurrentSetId.On("Put", sc.U64(1)).Return()
+	mockStorageSetIdSession.On("Put", sc.U64(1), sessionIndex).Return()
+
+	err := target.OnNewSession(false, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStorageStalled.AssertCalled(t, "Clear")
+	mockStorageNextForced.AssertCalled(t, "Put", blockNumber+2*delay)
+	mockStoragePendingChange.AssertCalled(t, "Put", forcedChange)
+	mockStorageCurrentSetId.AssertCalled(t, "Put", sc.U64(1))
+	mockStorageSetIdSession.AssertCalled(t, "Put", sc.U64(1), sessionIndex)
+}
+
+func Test_Module_OnNewSession_ChangePending(t *testing.T) {
+	setup()
+
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](nil), nil)
+	mockStoragePendingChange.On("Exists").Return(true)
+	mockStorageCurrentSetId.On("Get").Return(sc.U64(1), nil)
+	mockStorageSetIdSession.On("Put", sc.U64(1), sessionIndex).Return()
+
+	err := target.OnNewSession(true, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStoragePendingChange.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageCurrentSetId.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageSetIdSession.AssertCalled(t, "Put", sc.U64(1), sessionIndex)
+}
+
+func Test_Module_OnNewSession_NotChanged(t *testing.T) {
+	setup()
+
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](nil), nil)
+	mockStorageCurrentSetId.On("Get").Return(sc.U64(1), nil)
+	mockStorageSetIdSession.On("Put", sc.U64(1), sessionIndex).Return()
+
+	err := target.OnNewSession(false, sessionValidators, sessionValidators)
+	assert.Nil(t, err)
+
+	mockStoragePendingChange.AssertNotCalled(t, "Exists")
+	mockStorageCurrentSetId.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageSetIdSession.AssertCalled(t, "Put", sc.U64(1), sessionIndex)
+	mockSystemModule.AssertNotCalled(t, "DepositLog", mock.Anything)
+}
+
+func Test_Module_OnNewSession_SessionIndexError(t *testing.T) {
+	setup()
+
+	expectErr := errors.New("err")
+	mockSessionIndex = func() (sc.U32, error) { return 0, expectErr }
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](nil), nil)
+	mockStorageCurrentSetId.On("Get").Return(sc.U64(1), nil)
+
+	err := target.OnNewSession(false, sessionValidators, sessionValidators)
+	assert.Equal(t, expectErr, err)
+
+	mockStorageSetIdSession.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_OnNewSession_InvalidKey(t *testing.T) {
+	setup()
+
+	_, expectErr := primitives.NewAccountId(sc.U8(1))
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](nil), nil)
+
+	err := target.OnNewSession(true, sc.Sequence[primitives.SessionValidator]{{Key: sc.Sequence[sc.U8]{1}}}, sessionValidators)
+	assert.Equal(t, expectErr, err)
+
+	mockStoragePendingChange.AssertNotCalled(t, "Exists")
+}
+
+func Test_Module_OnNewSession_BlockNumberError(t *testing.T) {
+	setup()
+
+	expectErr := errors.New("err")
+	mockStorageBlockNumber = func() (sc.U64, error) { return 0, expectErr }
+	mockStorageStalled.On("TryGet").Return(sc.NewOption[Stall](nil), nil)
+	mockStoragePendingChange.On("Exists").Return(false)
+
+	err := target.OnNewSession(true, sessionValidators, sessionValidators)
+	assert.Equal(t, expectErr, err)
+
+	mockStorageCurrentSetId.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageSetIdSession.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_OnFinalize_ScheduledChange(t *testing.T) {
+	setup()
+
+	change := pendingChange
+	change.Delay = delay
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](change), nil)
+	mockSystemModule.On("DepositLog", newConsensusLogScheduledChange(authorities, delay)).Return()
+	mockStorageState.On("Get").Return(NewStoredStateLive(), nil)
+
+	err := target.OnFinalize(blockNumber)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertCalled(t, "DepositLog", newConsensusLogScheduledChange(authorities, delay))
+	mockStorageAuthorities.AssertNotCalled(t, "Put", mock.Anything)
+	mockStoragePendingChange.AssertNotCalled(t, "Clear")
+}
+
+func Test_Module_OnFinalize_ScheduledChange_NoDelay(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](pendingChange), nil)
+	mockSystemModule.On("DepositLog", newConsensusLogScheduledChange(authorities, 0)).Return()
+	mockStorageAuthorities.On("Put", versionedAuthorityList).Return()
+	mockEventDepositor.On("DepositEvent", newEventNewAuthorities(moduleId, authorities)).Return()
+	mockStoragePendingChange.On("Clear").Return()
+	mockStorageState.On("Get").Return(NewStoredStateLive(), nil)
+
+	err := target.OnFinalize(blockNumber)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertCalled(t, "DepositLog", newConsensusLogScheduledChange(authorities, 0))
+	mockStorageAuthorities.AssertCalled(t, "Put", versionedAuthorityList)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventNewAuthorities(moduleId, authorities))
+	mockStoragePendingChange.AssertCalled(t, "Clear")
+}
+
+func Test_Module_OnFinalize_ForcedChange(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](forcedChange), nil)
+	mockSystemModule.On("DepositLog", newConsensusLogForcedChange(median, authorities, delay)).Return()
+	mockStorageState.On("Get").Return(NewStoredStateLive(), nil)
+
+	err := target.OnFinalize(blockNumber)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertCalled(t, "DepositLog", newConsensusLogForcedChange(median, authorities, delay))
+}
+
+func Test_Module_OnFinalize_EnactChange(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](forcedChange), nil)
+	mockStorageAuthorities.On("Put", versionedAuthorityList).Return()
+	mockEventDepositor.On("DepositEvent", newEventNewAuthorities(moduleId, authorities)).Return()
+	mockStoragePendingChange.On("Clear").Return()
+	mockStorageState.On("Get").Return(NewStoredStateLive(), nil)
+
+	err := target.OnFinalize(blockNumber + delay)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertNotCalled(t, "DepositLog", mock.Anything)
+	mockStorageAuthorities.AssertCalled(t, "Put", versionedAuthorityList)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventNewAuthorities(moduleId, authorities))
+	mockStoragePendingChange.AssertCalled(t, "Clear")
+}
+
+func Test_Module_OnFinalize_PendingPause(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](nil), nil)
+	mockStorageState.On("Get").Return(NewStoredStatePendingPause(blockNumber, delay), nil)
+	mockSystemModule.On("DepositLog", newConsensusLogPause(delay)).Return()
+
+	err := target.OnFinalize(blockNumber)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertCalled(t, "DepositLog", newConsensusLogPause(delay))
+	mockStorageState.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnFinalize_Paused(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](nil), nil)
+	mockStorageState.On("Get").Return(NewStoredStatePendingPause(blockNumber, delay), nil)
+	mockStorageState.On("Put", NewStoredStatePaused()).Return()
+	mockEventDepositor.On("DepositEvent", newEventPaused(moduleId)).Return()
+
+	err := target.OnFinalize(blockNumber + delay)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertNotCalled(t, "DepositLog", mock.Anything)
+	mockStorageState.AssertCalled(t, "Put", NewStoredStatePaused())
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventPaused(moduleId))
+}
+
+func Test_Module_OnFinalize_PendingResume(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](nil), nil)
+	mockStorageState.On("Get").Return(NewStoredStatePendingResume(blockNumber, delay), nil)
+	mockSystemModule.On("DepositLog", newConsensusLogResume(delay)).Return()
+
+	err := target.OnFinalize(blockNumber)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertCalled(t, "DepositLog", newConsensusLogResume(delay))
+	mockStorageState.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnFinalize_Resumed(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](nil), nil)
+	mockStorageState.On("Get").Return(NewStoredStatePendingResume(blockNumber, delay), nil)
+	mockStorageState.On("Put", NewStoredStateLive()).Return()
+	mockEventDepositor.On("DepositEvent", newEventResumed(moduleId)).Return()
+
+	err := target.OnFinalize(blockNumber + delay)
+	assert.Nil(t, err)
+
+	mockStorageState.AssertCalled(t, "Put", NewStoredStateLive())
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventResumed(moduleId))
+}
+
+func Test_Module_OnFinalize_Live(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("TryGet").Return(sc.NewOption[StoredPendingChange](nil), nil)
+	mockStorageState.On("Get").Return(NewStoredStateLive(), nil)
+
+	err := target.OnFinalize(blockNumber)
+	assert.Nil(t, err)
+
+	mockSystemModule.AssertNotCalled(t, "DepositLog", mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_ScheduleChange(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("Exists").Return(false)
+	mockStoragePendingChange.On("Put", pendingChange).Return()
+
+	err := target.ScheduleChange(authorities, 0)
+	assert.Nil(t, err)
+
+	mockStoragePendingChange.AssertCalled(t, "Put", pendingChange)
+	mockStorageNextForced.AssertNotCalled(t, "TryGet")
+}
+
+func Test_Module_ScheduleChange_ChangePending(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("Exists").Return(true)
+
+	err := target.ScheduleChange(authorities, 0)
+	assert.Equal(t, NewDispatchErrorChangePending(moduleId), err)
+
+	mockStoragePendingChange.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_ScheduleForcedChange(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("Exists").Return(false)
+	mockStorageNextForced.On("TryGet").Return(sc.NewOption[sc.U64](blockNumber), nil)
+	mockStorageNextForced.On("Put", blockNumber+2*delay).Return()
+	mockStoragePendingChange.On("Put", forcedChange).Return()
+
+	err := target.ScheduleForcedChange(authorities, delay, median)
+	assert.Nil(t, err)
+
+	mockStorageNextForced.AssertCalled(t, "Put", blockNumber+2*delay)
+	mockStoragePendingChange.AssertCalled(t, "Put", forcedChange)
+}
+
+func Test_Module_ScheduleForcedChange_TooSoon(t *testing.T) {
+	setup()
+
+	mockStoragePendingChange.On("Exists").Return(false)
+	mockStorageNextForced.On("TryGet").Return(sc.NewOption[sc.U64](blockNumber+1), nil)
+
+	err := target.ScheduleForcedChange(authorities, delay, median)
+	assert.Equal(t, NewDispatchErrorTooSoon(moduleId), err)
+
+	mockStorageNextForced.AssertNotCalled(t, "Put", mock.Anything)
+	mockStoragePendingChange.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_SchedulePause(t *testing.T) {
+	setup()
+
+	mockStorageState.On("Get").Return(NewStoredStateLive(), nil)
+	mockStorageState.On("Put", NewStoredStatePendingPause(blockNumber, delay)).Return()
+
+	err := target.SchedulePause(delay)
+	assert.Nil(t, err)
+
+	mockStorageState.AssertCalled(t, "Put", NewStoredStatePendingPause(blockNumber, delay))
+}
+
+func Test_Module_SchedulePause_NotLive(t *testing.T) {
+	setup()
+
+	mockStorageState.On("Get").Return(NewStoredStatePaused(), nil)
+
+	err := target.SchedulePause(delay)
+	assert.Equal(t, NewDispatchErrorPauseFailed(moduleId), err)
+
+	mockStorageState.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_ScheduleResume(t *testing.T) {
+	setup()
+
+	mockStorageState.On("Get").Return(NewStoredStatePaused(), nil)
+	mockStorageState.On("Put", NewStoredStatePendingResume(blockNumber, delay)).Return()
+
+	err := target.ScheduleResume(delay)
+	assert.Nil(t, err)
+
+	mockStorageState.AssertCalled(t, "Put", NewStoredStatePendingResume(blockNumber, delay))
+}
+
+func Test_Module_ScheduleResume_NotPaused(t *testing.T) {
+	setup()
+
+	mockStorageState.On("Get").Return(NewStoredStatePendingPause(blockNumber, delay), nil)
+
+	err := target.ScheduleResume(delay)
+	assert.Equal(t, NewDispatchErrorResumeFailed(moduleId), err)
+
+	mockStorageState.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_CurrentSetId(t *testing.T) {
+	setup()
+
+	mockStorageCurrentSetId.On("Get").Return(sc.U64(7), nil)
+
+	result, err := target.CurrentSetId()
+	assert.Nil(t, err)
+
+	assert.Equal(t, sc.U64(7), result)
+}
+
 func Test_Module_GetIndex(t *testing.T) {
 	setup()
 	assert.Equal(t, moduleId, target.GetIndex())
@@ -62,7 +506,10 @@ func Test_Module_GetIndex(t *testing.T) {
 func Test_Module_Functions(t *testing.T) {
 	setup()
 
-	assert.Equal(t, map[sc.U8]primitives.Call{}, target.Functions())
+	functions := target.Functions()
+
+	assert.Equal(t, 1, len(functions))
+	assert.Equal(t, sc.U8(functionNoteStalledIndex), functions[functionNoteStalledIndex].FunctionIndex())
 }
 
 func Test_Module_PreDispatch(t *testing.T) {
@@ -131,19 +578,33 @@ func Test_Module_Metadata(t *testing.T) {
 
 	expectedGrandpaCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
 
+	transitionFields := sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "scheduled_at", "N"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "N"),
+	}
+
 	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
 		primitives.NewMetadataTypeWithParams(expectedGrandpaCallsMetadataId, "Grandpa calls", sc.Sequence[sc.Str]{"pallet_grandpa", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{}),
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"note_stalled",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+					},
+					functionNoteStalledIndex,
+					"Note that the current authority set of the GRANDPA finality gadget has stalled."),
+			}),
 			sc.Sequence[primitives.MetadataTypeParameter]{
 				primitives.NewMetadataEmptyTypeParameter("T"),
 				primitives.NewMetadataEmptyTypeParameter("I"),
 			}),
 		primitives.NewMetadataTypeWithParams(metadata.TypesGrandpaErrors, "The `Error` enum of this pallet.", sc.Sequence[sc.Str]{"pallet_grandpa", "pallet", "Error"}, primitives.NewMetadataTypeDefinitionVariant(
 			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant("PauseFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, PauseFailedError, ""),
-				primitives.NewMetadataDefinitionVariant("ResumeFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ResumeFailedError, ""),
-				primitives.NewMetadataDefinitionVariant("ChangePending", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ChangePendingError, ""),
-				primitives.NewMetadataDefinitionVariant("TooSoon", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, TooSoonError, ""),
+				primitives.NewMetadataDefinitionVariant("PauseFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, PauseFailedError, "Attempt to signal GRANDPA pause when the authority set isn't live (either paused or already pending pause)."),
+				primitives.NewMetadataDefinitionVariant("ResumeFailed", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ResumeFailedError, "Attempt to signal GRANDPA resume when the authority set isn't paused (either live or already pending resume)."),
+				primitives.NewMetadataDefinitionVariant("ChangePending", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ChangePendingError, "Attempt to signal GRANDPA change with one already pending."),
+				primitives.NewMetadataDefinitionVariant("TooSoon", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, TooSoonError, "Cannot signal forced change so soon after last."),
 				primitives.NewMetadataDefinitionVariant("InvalidKeyOwnershipProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, InvalidKeyOwnershipProofError, ""),
 				primitives.NewMetadataDefinitionVariant("InvalidEquivocationProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, InvalidEquivocationProofError, ""),
 				primitives.NewMetadataDefinitionVariant("DuplicateOffenceReport", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, DuplicateOffenceReportError, ""),
@@ -158,16 +619,72 @@ func Test_Module_Metadata(t *testing.T) {
 		primitives.NewMetadataType(metadata.TypesTupleGrandpaAppPublicU64, "(GrandpaAppPublic, U64)",
 			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesGrandpaAppPublic), sc.ToCompact(metadata.PrimitiveTypesU64)})),
 		primitives.NewMetadataType(metadata.TypesSequenceTupleGrandpaAppPublic, "[]byte (GrandpaAppPublic, U64)", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleGrandpaAppPublicU64))),
+		metadataTypeOption(metadata.TypesOptionU64, "Option<u64>", metadata.PrimitiveTypesU64),
+		primitives.NewMetadataType(metadata.TypesTupleU64U64, "(BlockNumber, BlockNumber)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.PrimitiveTypesU64), sc.ToCompact(metadata.PrimitiveTypesU64)})),
+		primitives.NewMetadataTypeWithParam(metadata.TypesGrandpaStoredState, "StoredState", sc.Sequence[sc.Str]{"pallet_grandpa", "StoredState"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("Live", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, StoredStateLive, "StoredState.Live"),
+				primitives.NewMetadataDefinitionVariant("PendingPause", transitionFields, StoredStatePendingPause, "StoredState.PendingPause"),
+				primitives.NewMetadataDefinitionVariant("Paused", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, StoredStatePaused, "StoredState.Paused"),
+				primitives.NewMetadataDefinitionVariant("PendingResume", transitionFields, StoredStatePendingResume, "StoredState.PendingResume"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "N")),
+		primitives.NewMetadataTypeWithParam(metadata.TypesGrandpaStoredPendingChange, "StoredPendingChange", sc.Sequence[sc.Str]{"pallet_grandpa", "StoredPendingChange"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "scheduled_at", "N"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "N"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleGrandpaAppPublic, "next_authorities", "BoundedAuthorityList<Limit>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU64, "forced", "Option<N>"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "N")),
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaEvent, "pallet_grandpa pallet Event", sc.Sequence[sc.Str]{"pallet_grandpa", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"NewAuthorities",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleGrandpaAppPublic, "authority_set", "AuthorityList"),
+					},
+					EventNewAuthorities,
+					"Events.NewAuthorities"),
+				primitives.NewMetadataDefinitionVariant(
+					"Paused",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventPaused,
+					"Events.Paused"),
+				primitives.NewMetadataDefinitionVariant(
+					"Resumed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventResumed,
+					"Events.Resumed"),
+			},
+		)),
 	}
 	moduleV14 := primitives.MetadataModuleV14{
-		Name:      name,
-		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
-		Call:      sc.NewOption[sc.Compact](nil),
-		CallDef:   sc.NewOption[primitives.MetadataDefinitionVariant](nil),
-		Event:     sc.NewOption[sc.Compact](nil),
-		EventDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedGrandpaCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedGrandpaCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Grandpa, Runtime>"),
+				},
+				moduleId,
+				"Call.Grandpa"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesGrandpaEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesGrandpaEvent, "pallet_grandpa::Event"),
+				},
+				moduleId,
+				"Events.Grandpa"),
+		),
 		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
-		Error:     sc.NewOption[sc.Compact](nil),
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesGrandpaErrors)),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
 			primitives.NewMetadataDefinitionVariantStr(
 				name,
@@ -192,9 +709,84 @@ func Test_Module_Metadata(t *testing.T) {
 	assert.Equal(t, expectMetadataModule, metadataModule)
 }
 
+func Test_Module_metadataStorage(t *testing.T) {
+	setup()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"State",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesGrandpaStoredState)),
+				"State of the current authority set."),
+			primitives.NewMetadataModuleStorageEntry(
+				"PendingChange",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesGrandpaStoredPendingChange)),
+				"Pending change: (signaled at, scheduled change)."),
+			primitives.NewMetadataModuleStorageEntry(
+				"NextForced",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				"next block number where we can force a change."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Stalled",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesTupleU64U64)),
+				"`true` if we are currently stalled."),
+			primitives.NewMetadataModuleStorageEntry(
+				"CurrentSetId",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				"The number of changes (both in terms of keys and underlying economic responsibilities) in the \"set\" of Grandpa validators from genesis."),
+			primitives.NewMetadataModuleStorageEntry(
+				"SetIdSession",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU64),
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+				),
+				"A mapping from grandpa set ID to the index of the *most recent* session for which its members were responsible.  This is only used for validating equivocation proofs. An equivocation proof must contains a key-ownership proof for a given session, therefore we need a way to tie together sessions and GRANDPA set ids, i.e. we need to validate that a validator was the owner of a given key on a given session, and what the active set ID was during that session."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
 func setup() {
 	mockStorageAuthorities = new(mocks.StorageValue[primitives.VersionedAuthorityList])
-	target = New(moduleId, logger, mdGenerator)
+	mockStorageState = new(mocks.StorageValue[StoredState])
+	mockStoragePendingChange = new(mocks.StorageValue[StoredPendingChange])
+	mockStorageNextForced = new(mocks.StorageValue[sc.U64])
+	mockStorageStalled = new(mocks.StorageValue[Stall])
+	mockStorageCurrentSetId = new(mocks.StorageValue[sc.U64])
+	mockStorageSetIdSession = new(mocks.StorageMap[sc.U64, sc.U32])
+	mockSystemModule = new(mocks.SystemModule)
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockStorageBlockNumber = func() (sc.U64, error) { return blockNumber, nil }
+	mockSessionIndex = func() (sc.U32, error) { return sessionIndex, nil }
+
+	mdGenerator.ClearMetadata()
+
+	target = New(moduleId, newTestConfig(), logger, mdGenerator)
 
 	target.storage.Authorities = mockStorageAuthorities
+	target.storage.State = mockStorageState
+	target.storage.PendingChange = mockStoragePendingChange
+	target.storage.NextForced = mockStorageNextForced
+	target.storage.Stalled = mockStorageStalled
+	target.storage.CurrentSetId = mockStorageCurrentSetId
+	target.storage.SetIdSession = mockStorageSetIdSession
+}
+
+func newTestConfig() *Config {
+	return NewConfig(
+		dbWeight,
+		mockEventDepositor,
+		func() (sc.U64, error) { return mockStorageBlockNumber() },
+		mockSystemModule.DepositLog,
+		func() (sc.U32, error) { return mockSessionIndex() },
+	)
 }
@@ -1,20 +1,44 @@
 package grandpa
 
 import (
+	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/support"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 var (
 	keyGrandpaAuthorities = []byte(":grandpa_authorities")
+	keyGrandpa            = []byte("Grandpa")
+	keyState              = []byte("State")
+	keyPendingChange      = []byte("PendingChange")
+	keyNextForced         = []byte("NextForced")
+	keyStalled            = []byte("Stalled")
+	keyCurrentSetId       = []byte("CurrentSetId")
+	keySetIdSession       = []byte("SetIdSession")
+)
+
+var (
+	defaultState = NewStoredStateLive()
 )
 
 type storage struct {
-	Authorities support.StorageValue[primitives.VersionedAuthorityList]
+	Authorities   support.StorageValue[primitives.VersionedAuthorityList]
+	State         support.StorageValue[StoredState]
+	PendingChange support.StorageValue[StoredPendingChange]
+	NextForced    support.StorageValue[sc.U64]
+	Stalled       support.StorageValue[Stall]
+	CurrentSetId  support.StorageValue[sc.U64]
+	SetIdSession  support.StorageMap[sc.U64, sc.U32]
 }
 
 func newStorage() *storage {
 	return &storage{
-		Authorities: support.NewSimpleStorageValue(keyGrandpaAuthorities, primitives.DecodeVersionedAuthorityList),
+		Authorities:   support.NewSimpleStorageValue(keyGrandpaAuthorities, primitives.DecodeVersionedAuthorityList),
+		State:         support.NewHashStorageValueWithDefault(keyGrandpa, keyState, DecodeStoredState, &defaultState),
+		PendingChange: support.NewHashStorageValue(keyGrandpa, keyPendingChange, DecodeStoredPendingChange),
+		NextForced:    support.NewHashStorageValue(keyGrandpa, keyNextForced, sc.DecodeU64),
+		Stalled:       support.NewHashStorageValue(keyGrandpa, keyStalled, DecodeStall),
+		CurrentSetId:  support.NewHashStorageValue(keyGrandpa, keyCurrentSetId, sc.DecodeU64),
+		SetIdSession:  support.NewHashStorageMap[sc.U64, sc.U32](keyGrandpa, keySetIdSession, support.NewHasherTwox64Concat(), sc.DecodeU64, sc.DecodeU32),
 	}
 }
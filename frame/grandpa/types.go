@@ -0,0 +1,218 @@
+package grandpa
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// StoredStateLive is the state, in which the current authorities are finalizing blocks.
+	StoredStateLive sc.U8 = iota
+	// StoredStatePendingPause is the state, in which a pause is scheduled.
+	StoredStatePendingPause
+	// StoredStatePaused is the state, in which finality is paused.
+	StoredStatePaused
+	// StoredStatePendingResume is the state, in which a resume of the paused finality is scheduled.
+	StoredStatePendingResume
+)
+
+var (
+	errInvalidStoredStateType = errors.New("invalid grandpa.StoredState type")
+	errNotPendingStoredState  = errors.New("not a pending grandpa.StoredState")
+)
+
+// ScheduledTransition is a pending pause or resume of finality, encoded as the
+// `{scheduled_at, delay}` fields of the pending `StoredState` variants.
+type ScheduledTransition struct {
+	// ScheduledAt is the block number, at which the transition was scheduled.
+	ScheduledAt sc.U64
+	// Delay is the number of blocks after ScheduledAt, at which the transition is enacted.
+	Delay sc.U64
+}
+
+func (st ScheduledTransition) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		st.ScheduledAt,
+		st.Delay,
+	)
+}
+
+func DecodeScheduledTransition(buffer *bytes.Buffer) (ScheduledTransition, error) {
+	scheduledAt, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return ScheduledTransition{}, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return ScheduledTransition{}, err
+	}
+	return ScheduledTransition{
+		ScheduledAt: scheduledAt,
+		Delay:       delay,
+	}, nil
+}
+
+func (st ScheduledTransition) Bytes() []byte {
+	return sc.EncodedBytes(st)
+}
+
+// StoredState is the state of the current authority set. It is either live, paused or transitioning between them.
+type StoredState struct {
+	sc.VaryingData
+}
+
+func NewStoredStateLive() StoredState {
+	return StoredState{sc.NewVaryingData(StoredStateLive)}
+}
+
+func NewStoredStatePendingPause(scheduledAt sc.U64, delay sc.U64) StoredState {
+	return StoredState{sc.NewVaryingData(StoredStatePendingPause, ScheduledTransition{ScheduledAt: scheduledAt, Delay: delay})}
+}
+
+func NewStoredStatePaused() StoredState {
+	return StoredState{sc.NewVaryingData(StoredStatePaused)}
+}
+
+func NewStoredStatePendingResume(scheduledAt sc.U64, delay sc.U64) StoredState {
+	return StoredState{sc.NewVaryingData(StoredStatePendingResume, ScheduledTransition{ScheduledAt: scheduledAt, Delay: delay})}
+}
+
+func DecodeStoredState(buffer *bytes.Buffer) (StoredState, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return StoredState{}, err
+	}
+
+	switch b {
+	case StoredStateLive:
+		return NewStoredStateLive(), nil
+	case StoredStatePendingPause:
+		transition, err := DecodeScheduledTransition(buffer)
+		if err != nil {
+			return StoredState{}, err
+		}
+		return NewStoredStatePendingPause(transition.ScheduledAt, transition.Delay), nil
+	case StoredStatePaused:
+		return NewStoredStatePaused(), nil
+	case StoredStatePendingResume:
+		transition, err := DecodeScheduledTransition(buffer)
+		if err != nil {
+			return StoredState{}, err
+		}
+		return NewStoredStatePendingResume(transition.ScheduledAt, transition.Delay), nil
+	default:
+		return StoredState{}, errInvalidStoredStateType
+	}
+}
+
+func (ss StoredState) IsLive() bool {
+	return ss.VaryingData[0] == StoredStateLive
+}
+
+func (ss StoredState) IsPendingPause() bool {
+	return ss.VaryingData[0] == StoredStatePendingPause
+}
+
+func (ss StoredState) IsPaused() bool {
+	return ss.VaryingData[0] == StoredStatePaused
+}
+
+func (ss StoredState) IsPendingResume() bool {
+	return ss.VaryingData[0] == StoredStatePendingResume
+}
+
+// AsScheduledTransition returns the scheduled transition of a pending pause or resume.
+func (ss StoredState) AsScheduledTransition() (ScheduledTransition, error) {
+	if !ss.IsPendingPause() && !ss.IsPendingResume() {
+		return ScheduledTransition{}, errNotPendingStoredState
+	}
+	return ss.VaryingData[1].(ScheduledTransition), nil
+}
+
+// StoredPendingChange is a scheduled change of the authority set.
+type StoredPendingChange struct {
+	// ScheduledAt is the block number, at which the change was scheduled.
+	ScheduledAt sc.U64
+	// Delay is the number of blocks after ScheduledAt, at which the change is enacted.
+	Delay sc.U64
+	// NextAuthorities is the authority set after the change.
+	NextAuthorities sc.Sequence[primitives.Authority]
+	// Forced is the median last finalized block number, if the change is forced.
+	Forced sc.Option[sc.U64]
+}
+
+func (spc StoredPendingChange) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		spc.ScheduledAt,
+		spc.Delay,
+		spc.NextAuthorities,
+		spc.Forced,
+	)
+}
+
+func DecodeStoredPendingChange(buffer *bytes.Buffer) (StoredPendingChange, error) {
+	scheduledAt, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return StoredPendingChange{}, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return StoredPendingChange{}, err
+	}
+	nextAuthorities, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAuthority)
+	if err != nil {
+		return StoredPendingChange{}, err
+	}
+	forced, err := sc.DecodeOptionWith(buffer, sc.DecodeU64)
+	if err != nil {
+		return StoredPendingChange{}, err
+	}
+	return StoredPendingChange{
+		ScheduledAt:     scheduledAt,
+		Delay:           delay,
+		NextAuthorities: nextAuthorities,
+		Forced:          forced,
+	}, nil
+}
+
+func (spc StoredPendingChange) Bytes() []byte {
+	return sc.EncodedBytes(spc)
+}
+
+// Stall is a stall of finality, noted by Root, encoded as the `(further_wait, median)` tuple.
+// The next authority set change is forced with it.
+type Stall struct {
+	// FurtherWait is the delay of the forced change.
+	FurtherWait sc.U64
+	// Median is the median last finalized block number.
+	Median sc.U64
+}
+
+func (s Stall) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		s.FurtherWait,
+		s.Median,
+	)
+}
+
+func DecodeStall(buffer *bytes.Buffer) (Stall, error) {
+	furtherWait, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Stall{}, err
+	}
+	median, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Stall{}, err
+	}
+	return Stall{
+		FurtherWait: furtherWait,
+		Median:      median,
+	}, nil
+}
+
+func (s Stall) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
@@ -0,0 +1,62 @@
+package grandpa
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StoredState_Decode(t *testing.T) {
+	for _, state := range []StoredState{
+		NewStoredStateLive(),
+		NewStoredStatePendingPause(blockNumber, delay),
+		NewStoredStatePaused(),
+		NewStoredStatePendingResume(blockNumber, delay),
+	} {
+		result, err := DecodeStoredState(bytes.NewBuffer(state.Bytes()))
+
+		assert.Nil(t, err)
+		assert.Equal(t, state, result)
+	}
+}
+
+func Test_StoredState_Decode_InvalidType(t *testing.T) {
+	_, err := DecodeStoredState(bytes.NewBuffer([]byte{4}))
+
+	assert.Equal(t, errInvalidStoredStateType, err)
+}
+
+func Test_StoredState_AsScheduledTransition(t *testing.T) {
+	expect := ScheduledTransition{ScheduledAt: blockNumber, Delay: delay}
+
+	result, err := NewStoredStatePendingPause(blockNumber, delay).AsScheduledTransition()
+	assert.Nil(t, err)
+	assert.Equal(t, expect, result)
+
+	result, err = NewStoredStatePendingResume(blockNumber, delay).AsScheduledTransition()
+	assert.Nil(t, err)
+	assert.Equal(t, expect, result)
+}
+
+func Test_StoredState_AsScheduledTransition_NotPending(t *testing.T) {
+	_, err := NewStoredStatePaused().AsScheduledTransition()
+
+	assert.Equal(t, errNotPendingStoredState, err)
+}
+
+func Test_StoredPendingChange_Decode(t *testing.T) {
+	for _, change := range []StoredPendingChange{pendingChange, forcedChange} {
+		result, err := DecodeStoredPendingChange(bytes.NewBuffer(change.Bytes()))
+
+		assert.Nil(t, err)
+		assert.Equal(t, change, result)
+	}
+}
+
+func Test_Stall_Decode(t *testing.T) {
+	result, err := DecodeStall(bytes.NewBuffer(stall.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, stall, result)
+}
@@ -0,0 +1,157 @@
+package indices
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	balancestypes "github.com/LimeChain/gosemble/frame/balances/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// assignments holds the dependencies and logic, shared by the calls of the module.
+type assignments struct {
+	moduleId       sc.U8
+	constants      *consts
+	storage        *storage
+	eventDepositor primitives.EventDepositor
+	currency       primitives.ReservableCurrency
+	lookup         primitives.StaticLookup
+}
+
+func newAssignments(moduleId sc.U8, config *Config, constants *consts, storage *storage, lookup primitives.StaticLookup) assignments {
+	return assignments{
+		moduleId:       moduleId,
+		constants:      constants,
+		storage:        storage,
+		eventDepositor: config.EventDepositor,
+		currency:       config.Currency,
+		lookup:         lookup,
+	}
+}
+
+// doClaim assigns the free `index` to `who`, reserving the deposit.
+func (a assignments) doClaim(who primitives.AccountId, index primitives.AccountIndex) error {
+	if a.storage.Accounts.Exists(index) {
+		return NewDispatchErrorInUse(a.moduleId)
+	}
+
+	if err := a.currency.Reserve(who, a.constants.Deposit); err != nil {
+		return err
+	}
+	a.storage.Accounts.Put(index, IndexInfo{
+		Account: who,
+		Deposit: a.constants.Deposit,
+		Frozen:  false,
+	})
+
+	a.eventDepositor.DepositEvent(newEventIndexAssigned(a.moduleId, who, index))
+
+	return nil
+}
+
+// doTransfer assigns `index`, owned by `who`, to `target`, moving the reserved deposit along with it.
+func (a assignments) doTransfer(who primitives.AccountId, target primitives.AccountId, index primitives.AccountIndex) error {
+	if reflect.DeepEqual(who, target) {
+		return NewDispatchErrorNotTransfer(a.moduleId)
+	}
+
+	info, err := a.ownedIndex(who, index)
+	if err != nil {
+		return err
+	}
+
+	lost, err := a.currency.RepatriateReserved(who, target, info.Deposit, balancestypes.BalanceStatusReserved)
+	if err != nil {
+		return err
+	}
+	a.storage.Accounts.Put(index, IndexInfo{
+		Account: target,
+		Deposit: info.Deposit.Sub(lost),
+		Frozen:  false,
+	})
+
+	a.eventDepositor.DepositEvent(newEventIndexAssigned(a.moduleId, target, index))
+
+	return nil
+}
+
+// doFree releases `index`, owned by `who`, and unreserves its deposit.
+func (a assignments) doFree(who primitives.AccountId, index primitives.AccountIndex) error {
+	info, err := a.ownedIndex(who, index)
+	if err != nil {
+		return err
+	}
+
+	a.storage.Accounts.Remove(index)
+	if _, err := a.currency.Unreserve(who, info.Deposit); err != nil {
+		return err
+	}
+
+	a.eventDepositor.DepositEvent(newEventIndexFreed(a.moduleId, index))
+
+	return nil
+}
+
+// doForceTransfer assigns `index` to `target` without a deposit, regardless of its current owner.
+// The deposit of the previous owner, if any, is unreserved.
+func (a assignments) doForceTransfer(target primitives.AccountId, index primitives.AccountIndex, freeze sc.Bool) error {
+	info, err := a.storage.Accounts.TryGet(index)
+	if err != nil {
+		return err
+	}
+	if info.HasValue {
+		if _, err := a.currency.Unreserve(info.Value.Account, info.Value.Deposit); err != nil {
+			return err
+		}
+	}
+
+	a.storage.Accounts.Put(index, IndexInfo{
+		Account: target,
+		Deposit: sc.NewU128(0),
+		Frozen:  freeze,
+	})
+
+	a.eventDepositor.DepositEvent(newEventIndexAssigned(a.moduleId, target, index))
+
+	return nil
+}
+
+// doFreeze makes `index`, owned by `who`, permanent and slashes its deposit.
+func (a assignments) doFreeze(who primitives.AccountId, index primitives.AccountIndex) error {
+	info, err := a.ownedIndex(who, index)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.currency.SlashReserved(who, info.Deposit); err != nil {
+		return err
+	}
+	a.storage.Accounts.Put(index, IndexInfo{
+		Account: who,
+		Deposit: sc.NewU128(0),
+		Frozen:  true,
+	})
+
+	a.eventDepositor.DepositEvent(newEventIndexFrozen(a.moduleId, index, who))
+
+	return nil
+}
+
+// ownedIndex returns the assignment of `index`, if it is owned by `who` and is not frozen.
+func (a assignments) ownedIndex(who primitives.AccountId, index primitives.AccountIndex) (IndexInfo, error) {
+	info, err := a.storage.Accounts.TryGet(index)
+	if err != nil {
+		return IndexInfo{}, err
+	}
+	if !info.HasValue {
+		return IndexInfo{}, NewDispatchErrorNotAssigned(a.moduleId)
+	}
+	if info.Value.Frozen {
+		return IndexInfo{}, NewDispatchErrorPermanent(a.moduleId)
+	}
+	if !reflect.DeepEqual(info.Value.Account, who) {
+		return IndexInfo{}, NewDispatchErrorNotOwner(a.moduleId)
+	}
+
+	return info.Value, nil
+}
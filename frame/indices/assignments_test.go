@@ -0,0 +1,261 @@
+package indices
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	balancestypes "github.com/LimeChain/gosemble/frame/balances/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Assignments_doClaim(t *testing.T) {
+	target := setupAssignments()
+	expectedEvent := newEventIndexAssigned(moduleId, whoAccountId, index)
+
+	mockStorageAccounts.On("Exists", index).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageAccounts.On("Put", index, whoIndexInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doClaim(whoAccountId, index)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "Reserve", whoAccountId, deposit)
+	mockStorageAccounts.AssertCalled(t, "Put", index, whoIndexInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Assignments_doClaim_InUse(t *testing.T) {
+	target := setupAssignments()
+
+	mockStorageAccounts.On("Exists", index).Return(true)
+
+	err := target.doClaim(whoAccountId, index)
+
+	assert.Equal(t, NewDispatchErrorInUse(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Assignments_doClaim_ReserveError(t *testing.T) {
+	target := setupAssignments()
+
+	mockStorageAccounts.On("Exists", index).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(expectedErr)
+
+	err := target.doClaim(whoAccountId, index)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Assignments_doTransfer(t *testing.T) {
+	target := setupAssignments()
+	lost := sc.NewU128(10)
+	expectedInfo := IndexInfo{
+		Account: targetAccountId,
+		Deposit: sc.NewU128(90),
+		Frozen:  false,
+	}
+	expectedEvent := newEventIndexAssigned(moduleId, targetAccountId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("RepatriateReserved", whoAccountId, targetAccountId, deposit, balancestypes.BalanceStatusReserved).Return(lost, nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doTransfer(whoAccountId, targetAccountId, index)
+
+	assert.Nil(t, err)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Assignments_doTransfer_NotTransfer(t *testing.T) {
+	target := setupAssignments()
+
+	err := target.doTransfer(whoAccountId, whoAccountId, index)
+
+	assert.Equal(t, NewDispatchErrorNotTransfer(moduleId), err)
+	mockStorageAccounts.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Assignments_doTransfer_RepatriateReservedError(t *testing.T) {
+	target := setupAssignments()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("RepatriateReserved", whoAccountId, targetAccountId, deposit, balancestypes.BalanceStatusReserved).Return(sc.NewU128(0), expectedErr)
+
+	err := target.doTransfer(whoAccountId, targetAccountId, index)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Assignments_doFree(t *testing.T) {
+	target := setupAssignments()
+	expectedEvent := newEventIndexFreed(moduleId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockStorageAccounts.On("Remove", index).Return()
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doFree(whoAccountId, index)
+
+	assert.Nil(t, err)
+	mockStorageAccounts.AssertCalled(t, "Remove", index)
+	mockCurrency.AssertCalled(t, "Unreserve", whoAccountId, deposit)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Assignments_doFree_UnreserveError(t *testing.T) {
+	target := setupAssignments()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockStorageAccounts.On("Remove", index).Return()
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), expectedErr)
+
+	err := target.doFree(whoAccountId, index)
+
+	assert.Equal(t, expectedErr, err)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Assignments_doForceTransfer(t *testing.T) {
+	target := setupAssignments()
+	expectedInfo := IndexInfo{
+		Account: targetAccountId,
+		Deposit: sc.NewU128(0),
+		Frozen:  true,
+	}
+	expectedEvent := newEventIndexAssigned(moduleId, targetAccountId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doForceTransfer(targetAccountId, index, true)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "Unreserve", whoAccountId, deposit)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Assignments_doForceTransfer_Unassigned(t *testing.T) {
+	target := setupAssignments()
+	expectedInfo := IndexInfo{
+		Account: targetAccountId,
+		Deposit: sc.NewU128(0),
+		Frozen:  false,
+	}
+	expectedEvent := newEventIndexAssigned(moduleId, targetAccountId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](nil), nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doForceTransfer(targetAccountId, index, false)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Assignments_doFreeze(t *testing.T) {
+	target := setupAssignments()
+	expectedInfo := IndexInfo{
+		Account: whoAccountId,
+		Deposit: sc.NewU128(0),
+		Frozen:  true,
+	}
+	expectedEvent := newEventIndexFrozen(moduleId, index, whoAccountId)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("SlashReserved", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doFreeze(whoAccountId, index)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "SlashReserved", whoAccountId, deposit)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Assignments_doFreeze_SlashReservedError(t *testing.T) {
+	target := setupAssignments()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("SlashReserved", whoAccountId, deposit).Return(sc.NewU128(0), expectedErr)
+
+	err := target.doFreeze(whoAccountId, index)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Assignments_ownedIndex(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		info        sc.Option[IndexInfo]
+		storageErr  error
+		expectedErr error
+	}{
+		{
+			name:        "not assigned",
+			info:        sc.NewOption[IndexInfo](nil),
+			expectedErr: NewDispatchErrorNotAssigned(moduleId),
+		},
+		{
+			name: "permanent",
+			info: sc.NewOption[IndexInfo](IndexInfo{
+				Account: whoAccountId,
+				Deposit: sc.NewU128(0),
+				Frozen:  true,
+			}),
+			expectedErr: NewDispatchErrorPermanent(moduleId),
+		},
+		{
+			name: "not owner",
+			info: sc.NewOption[IndexInfo](IndexInfo{
+				Account: targetAccountId,
+				Deposit: deposit,
+				Frozen:  false,
+			}),
+			expectedErr: NewDispatchErrorNotOwner(moduleId),
+		},
+		{
+			name:        "storage error",
+			info:        sc.NewOption[IndexInfo](nil),
+			storageErr:  expectedErr,
+			expectedErr: expectedErr,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupAssignments()
+
+			mockStorageAccounts.On("TryGet", index).Return(tt.info, tt.storageErr)
+
+			_, err := target.ownedIndex(whoAccountId, index)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func Test_Assignments_ownedIndex_Owned(t *testing.T) {
+	target := setupAssignments()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+
+	result, err := target.ownedIndex(whoAccountId, index)
+
+	assert.Nil(t, err)
+	assert.Equal(t, whoIndexInfo, result)
+}
@@ -0,0 +1,96 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Assign a previously unassigned index.
+// The dispatch origin for this call must be `Signed`.
+type callClaim struct {
+	primitives.Callable
+	assignments
+}
+
+func newCallClaim(moduleId sc.U8, functionId sc.U8, assignments assignments) primitives.Call {
+	call := callClaim{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U32(0)),
+		},
+		assignments: assignments,
+	}
+
+	return call
+}
+
+func (c callClaim) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		index,
+	)
+	return c, nil
+}
+
+func (c callClaim) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callClaim) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callClaim) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callClaim) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callClaim) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callClaim) BaseWeight() primitives.Weight {
+	return callClaimWeight(c.constants.DbWeight)
+}
+
+func (_ callClaim) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callClaim) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callClaim) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callClaim) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.doClaim(who, args[0].(sc.U32))
+}
+
+func (_ callClaim) Docs() string {
+	return "Assign an previously unassigned index. " +
+		"Payment: `Deposit` is reserved from the sender account. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`index`: the index to be claimed. This must not be in use. " +
+		"Emits `IndexAssigned` if successful."
+}
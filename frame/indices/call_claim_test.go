@@ -0,0 +1,119 @@
+package indices
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Claim_New(t *testing.T) {
+	target := setupCallClaim()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionClaimIndex,
+		Arguments:  sc.NewVaryingData(sc.U32(0)),
+	}
+
+	assert.Equal(t, expected, target.(callClaim).Callable)
+}
+
+func Test_Call_Claim_DecodeArgs(t *testing.T) {
+	target := setupCallClaim()
+	buffer := bytes.NewBuffer(index.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(index), call.Args())
+}
+
+func Test_Call_Claim_Encode(t *testing.T) {
+	target := setupCallClaim()
+	call, err := target.DecodeArgs(bytes.NewBuffer(index.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionClaimIndex}, index.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_Claim_Bytes(t *testing.T) {
+	target := setupCallClaim()
+	call, err := target.DecodeArgs(bytes.NewBuffer(index.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionClaimIndex}, index.Bytes()...), call.Bytes())
+}
+
+func Test_Call_Claim_ModuleIndex(t *testing.T) {
+	target := setupCallClaim()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Claim_FunctionIndex(t *testing.T) {
+	target := setupCallClaim()
+
+	assert.Equal(t, sc.U8(functionClaimIndex), target.FunctionIndex())
+}
+
+func Test_Call_Claim_BaseWeight(t *testing.T) {
+	target := setupCallClaim()
+
+	assert.Equal(t, callClaimWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Claim_WeighData(t *testing.T) {
+	target := setupCallClaim()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Claim_ClassifyDispatch(t *testing.T) {
+	target := setupCallClaim()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Claim_PaysFee(t *testing.T) {
+	target := setupCallClaim()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Claim_Dispatch(t *testing.T) {
+	target := setupCallClaim()
+	expectedEvent := newEventIndexAssigned(moduleId, whoAccountId, index)
+
+	mockStorageAccounts.On("Exists", index).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageAccounts.On("Put", index, whoIndexInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(index))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAccounts.AssertCalled(t, "Put", index, whoIndexInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Claim_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallClaim()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(index))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func setupCallClaim() primitives.Call {
+	return newCallClaim(moduleId, functionClaimIndex, setupAssignments())
+}
@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkIndicesClaim benchmark.
+
+package indices
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callClaimWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(20000000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,108 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Force an index to an account, without a deposit.
+// The dispatch origin for this call must be `Root`.
+type callForceTransfer struct {
+	primitives.Callable
+	assignments
+}
+
+func newCallForceTransfer(moduleId sc.U8, functionId sc.U8, assignments assignments) primitives.Call {
+	call := callForceTransfer{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U32(0), sc.Bool(false)),
+		},
+		assignments: assignments,
+	}
+
+	return call
+}
+
+func (c callForceTransfer) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	freeze, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		target,
+		index,
+		freeze,
+	)
+	return c, nil
+}
+
+func (c callForceTransfer) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callForceTransfer) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callForceTransfer) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callForceTransfer) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callForceTransfer) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callForceTransfer) BaseWeight() primitives.Weight {
+	return callForceTransferWeight(c.constants.DbWeight)
+}
+
+func (_ callForceTransfer) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callForceTransfer) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callForceTransfer) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callForceTransfer) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	target, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doForceTransfer(target, args[1].(sc.U32), args[2].(sc.Bool))
+}
+
+func (_ callForceTransfer) Docs() string {
+	return "Force an index to an account. This doesn't require a deposit. If the index is already " +
+		"held, then any deposit is reimbursed to its current owner. " +
+		"The dispatch origin for this call must be _Root_. " +
+		"`index`: the index to be (re-)assigned. " +
+		"`new`: the new owner of the index. " +
+		"`freeze`: if set to `true`, will freeze the index so it cannot be transferred. " +
+		"Emits `IndexAssigned` if successful."
+}
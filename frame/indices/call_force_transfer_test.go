@@ -0,0 +1,133 @@
+package indices
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ForceTransfer_New(t *testing.T) {
+	target := setupCallForceTransfer()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionForceTransferIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U32(0), sc.Bool(false)),
+	}
+
+	assert.Equal(t, expected, target.(callForceTransfer).Callable)
+}
+
+func Test_Call_ForceTransfer_DecodeArgs(t *testing.T) {
+	target := setupCallForceTransfer()
+	buffer := bytes.NewBuffer(append(append(targetAddress.Bytes(), index.Bytes()...), sc.Bool(true).Bytes()...))
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(targetAddress, index, sc.Bool(true)), call.Args())
+}
+
+func Test_Call_ForceTransfer_Encode(t *testing.T) {
+	target := setupCallForceTransfer()
+	call, err := target.DecodeArgs(bytes.NewBuffer(append(append(targetAddress.Bytes(), index.Bytes()...), sc.Bool(true).Bytes()...)))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionForceTransferIndex}, append(append(targetAddress.Bytes(), index.Bytes()...), sc.Bool(true).Bytes()...)...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_ForceTransfer_Bytes(t *testing.T) {
+	target := setupCallForceTransfer()
+	call, err := target.DecodeArgs(bytes.NewBuffer(append(append(targetAddress.Bytes(), index.Bytes()...), sc.Bool(true).Bytes()...)))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionForceTransferIndex}, append(append(targetAddress.Bytes(), index.Bytes()...), sc.Bool(true).Bytes()...)...), call.Bytes())
+}
+
+func Test_Call_ForceTransfer_ModuleIndex(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ForceTransfer_FunctionIndex(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	assert.Equal(t, sc.U8(functionForceTransferIndex), target.FunctionIndex())
+}
+
+func Test_Call_ForceTransfer_BaseWeight(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	assert.Equal(t, callForceTransferWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_ForceTransfer_WeighData(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_ForceTransfer_ClassifyDispatch(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ForceTransfer_PaysFee(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ForceTransfer_Dispatch(t *testing.T) {
+	target := setupCallForceTransfer()
+	expectedInfo := IndexInfo{
+		Account: targetAccountId,
+		Deposit: sc.NewU128(0),
+		Frozen:  true,
+	}
+	expectedEvent := newEventIndexAssigned(moduleId, targetAccountId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(targetAddress, index, sc.Bool(true)))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_ForceTransfer_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(targetAddress, index, sc.Bool(true)))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_ForceTransfer_Dispatch_CannotLookup(t *testing.T) {
+	target := setupCallForceTransfer()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(primitives.NewMultiAddress20(primitives.Address20{}), index, sc.Bool(false)))
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func setupCallForceTransfer() primitives.Call {
+	return newCallForceTransfer(moduleId, functionForceTransferIndex, setupAssignments())
+}
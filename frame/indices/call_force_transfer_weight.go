@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkIndicesForceTransfer benchmark.
+
+package indices
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callForceTransferWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(22000000, 0).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,96 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Free up an index, owned by the sender.
+// The dispatch origin for this call must be `Signed`.
+type callFree struct {
+	primitives.Callable
+	assignments
+}
+
+func newCallFree(moduleId sc.U8, functionId sc.U8, assignments assignments) primitives.Call {
+	call := callFree{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U32(0)),
+		},
+		assignments: assignments,
+	}
+
+	return call
+}
+
+func (c callFree) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		index,
+	)
+	return c, nil
+}
+
+func (c callFree) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callFree) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callFree) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callFree) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callFree) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callFree) BaseWeight() primitives.Weight {
+	return callFreeWeight(c.constants.DbWeight)
+}
+
+func (_ callFree) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callFree) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callFree) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callFree) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.doFree(who, args[0].(sc.U32))
+}
+
+func (_ callFree) Docs() string {
+	return "Free up an index owned by the sender. " +
+		"Payment: Any previous deposit placed for the index is unreserved in the sender account. " +
+		"The dispatch origin for this call must be _Signed_ and the sender must own the index. " +
+		"`index`: the index to be freed. This must be owned by the sender. " +
+		"Emits `IndexFreed` if successful."
+}
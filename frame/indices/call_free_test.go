@@ -0,0 +1,119 @@
+package indices
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Free_New(t *testing.T) {
+	target := setupCallFree()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionFreeIndex,
+		Arguments:  sc.NewVaryingData(sc.U32(0)),
+	}
+
+	assert.Equal(t, expected, target.(callFree).Callable)
+}
+
+func Test_Call_Free_DecodeArgs(t *testing.T) {
+	target := setupCallFree()
+	buffer := bytes.NewBuffer(index.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(index), call.Args())
+}
+
+func Test_Call_Free_Encode(t *testing.T) {
+	target := setupCallFree()
+	call, err := target.DecodeArgs(bytes.NewBuffer(index.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionFreeIndex}, index.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_Free_Bytes(t *testing.T) {
+	target := setupCallFree()
+	call, err := target.DecodeArgs(bytes.NewBuffer(index.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionFreeIndex}, index.Bytes()...), call.Bytes())
+}
+
+func Test_Call_Free_ModuleIndex(t *testing.T) {
+	target := setupCallFree()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Free_FunctionIndex(t *testing.T) {
+	target := setupCallFree()
+
+	assert.Equal(t, sc.U8(functionFreeIndex), target.FunctionIndex())
+}
+
+func Test_Call_Free_BaseWeight(t *testing.T) {
+	target := setupCallFree()
+
+	assert.Equal(t, callFreeWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Free_WeighData(t *testing.T) {
+	target := setupCallFree()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Free_ClassifyDispatch(t *testing.T) {
+	target := setupCallFree()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Free_PaysFee(t *testing.T) {
+	target := setupCallFree()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Free_Dispatch(t *testing.T) {
+	target := setupCallFree()
+	expectedEvent := newEventIndexFreed(moduleId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockStorageAccounts.On("Remove", index).Return()
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(index))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAccounts.AssertCalled(t, "Remove", index)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Free_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallFree()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(index))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func setupCallFree() primitives.Call {
+	return newCallFree(moduleId, functionFreeIndex, setupAssignments())
+}
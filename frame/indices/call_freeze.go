@@ -0,0 +1,96 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Freeze an index, so that it always points to the sender account.
+// The dispatch origin for this call must be `Signed`.
+type callFreeze struct {
+	primitives.Callable
+	assignments
+}
+
+func newCallFreeze(moduleId sc.U8, functionId sc.U8, assignments assignments) primitives.Call {
+	call := callFreeze{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U32(0)),
+		},
+		assignments: assignments,
+	}
+
+	return call
+}
+
+func (c callFreeze) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		index,
+	)
+	return c, nil
+}
+
+func (c callFreeze) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callFreeze) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callFreeze) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callFreeze) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callFreeze) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callFreeze) BaseWeight() primitives.Weight {
+	return callFreezeWeight(c.constants.DbWeight)
+}
+
+func (_ callFreeze) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callFreeze) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callFreeze) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callFreeze) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.doFreeze(who, args[0].(sc.U32))
+}
+
+func (_ callFreeze) Docs() string {
+	return "Freeze an index so it will always point to the sender account. This consumes the deposit. " +
+		"The dispatch origin for this call must be _Signed_ and the signing account must have a " +
+		"non-frozen account `index`. " +
+		"`index`: the index to be frozen in place. " +
+		"Emits `IndexFrozen` if successful."
+}
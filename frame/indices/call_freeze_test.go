@@ -0,0 +1,124 @@
+package indices
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Freeze_New(t *testing.T) {
+	target := setupCallFreeze()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionFreezeIndex,
+		Arguments:  sc.NewVaryingData(sc.U32(0)),
+	}
+
+	assert.Equal(t, expected, target.(callFreeze).Callable)
+}
+
+func Test_Call_Freeze_DecodeArgs(t *testing.T) {
+	target := setupCallFreeze()
+	buffer := bytes.NewBuffer(index.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(index), call.Args())
+}
+
+func Test_Call_Freeze_Encode(t *testing.T) {
+	target := setupCallFreeze()
+	call, err := target.DecodeArgs(bytes.NewBuffer(index.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionFreezeIndex}, index.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_Freeze_Bytes(t *testing.T) {
+	target := setupCallFreeze()
+	call, err := target.DecodeArgs(bytes.NewBuffer(index.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionFreezeIndex}, index.Bytes()...), call.Bytes())
+}
+
+func Test_Call_Freeze_ModuleIndex(t *testing.T) {
+	target := setupCallFreeze()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Freeze_FunctionIndex(t *testing.T) {
+	target := setupCallFreeze()
+
+	assert.Equal(t, sc.U8(functionFreezeIndex), target.FunctionIndex())
+}
+
+func Test_Call_Freeze_BaseWeight(t *testing.T) {
+	target := setupCallFreeze()
+
+	assert.Equal(t, callFreezeWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Freeze_WeighData(t *testing.T) {
+	target := setupCallFreeze()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Freeze_ClassifyDispatch(t *testing.T) {
+	target := setupCallFreeze()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Freeze_PaysFee(t *testing.T) {
+	target := setupCallFreeze()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Freeze_Dispatch(t *testing.T) {
+	target := setupCallFreeze()
+	expectedInfo := IndexInfo{
+		Account: whoAccountId,
+		Deposit: sc.NewU128(0),
+		Frozen:  true,
+	}
+	expectedEvent := newEventIndexFrozen(moduleId, index, whoAccountId)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("SlashReserved", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(index))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Freeze_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallFreeze()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(index))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func setupCallFreeze() primitives.Call {
+	return newCallFreeze(moduleId, functionFreezeIndex, setupAssignments())
+}
@@ -0,0 +1,107 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Assign an index, owned by the sender, to another account.
+// The dispatch origin for this call must be `Signed`.
+type callTransfer struct {
+	primitives.Callable
+	assignments
+}
+
+func newCallTransfer(moduleId sc.U8, functionId sc.U8, assignments assignments) primitives.Call {
+	call := callTransfer{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U32(0)),
+		},
+		assignments: assignments,
+	}
+
+	return call
+}
+
+func (c callTransfer) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		target,
+		index,
+	)
+	return c, nil
+}
+
+func (c callTransfer) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callTransfer) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callTransfer) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callTransfer) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callTransfer) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callTransfer) BaseWeight() primitives.Weight {
+	return callTransferWeight(c.constants.DbWeight)
+}
+
+func (_ callTransfer) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callTransfer) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callTransfer) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callTransfer) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	target, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doTransfer(who, target, args[1].(sc.U32))
+}
+
+func (_ callTransfer) Docs() string {
+	return "Assign an index already owned by the sender to another account. " +
+		"The balance reservation is effectively transferred to the new account. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`index`: the index to be re-assigned. This must be owned by the sender. " +
+		"`new`: the new owner of the index. This must not be equal to the sender. " +
+		"Emits `IndexAssigned` if successful."
+}
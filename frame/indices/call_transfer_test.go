@@ -0,0 +1,137 @@
+package indices
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	balancestypes "github.com/LimeChain/gosemble/frame/balances/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Transfer_New(t *testing.T) {
+	target := setupCallTransfer()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionTransferIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U32(0)),
+	}
+
+	assert.Equal(t, expected, target.(callTransfer).Callable)
+}
+
+func Test_Call_Transfer_DecodeArgs(t *testing.T) {
+	target := setupCallTransfer()
+	buffer := bytes.NewBuffer(append(targetAddress.Bytes(), index.Bytes()...))
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(targetAddress, index), call.Args())
+}
+
+func Test_Call_Transfer_Encode(t *testing.T) {
+	target := setupCallTransfer()
+	call, err := target.DecodeArgs(bytes.NewBuffer(append(targetAddress.Bytes(), index.Bytes()...)))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionTransferIndex}, append(targetAddress.Bytes(), index.Bytes()...)...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_Transfer_Bytes(t *testing.T) {
+	target := setupCallTransfer()
+	call, err := target.DecodeArgs(bytes.NewBuffer(append(targetAddress.Bytes(), index.Bytes()...)))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionTransferIndex}, append(targetAddress.Bytes(), index.Bytes()...)...), call.Bytes())
+}
+
+func Test_Call_Transfer_ModuleIndex(t *testing.T) {
+	target := setupCallTransfer()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Transfer_FunctionIndex(t *testing.T) {
+	target := setupCallTransfer()
+
+	assert.Equal(t, sc.U8(functionTransferIndex), target.FunctionIndex())
+}
+
+func Test_Call_Transfer_BaseWeight(t *testing.T) {
+	target := setupCallTransfer()
+
+	assert.Equal(t, callTransferWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Transfer_WeighData(t *testing.T) {
+	target := setupCallTransfer()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Transfer_ClassifyDispatch(t *testing.T) {
+	target := setupCallTransfer()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Transfer_PaysFee(t *testing.T) {
+	target := setupCallTransfer()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Transfer_Dispatch(t *testing.T) {
+	target := setupCallTransfer()
+	expectedInfo := IndexInfo{
+		Account: targetAccountId,
+		Deposit: deposit,
+		Frozen:  false,
+	}
+	expectedEvent := newEventIndexAssigned(moduleId, targetAccountId, index)
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+	mockCurrency.On("RepatriateReserved", whoAccountId, targetAccountId, deposit, balancestypes.BalanceStatusReserved).Return(sc.NewU128(0), nil)
+	mockStorageAccounts.On("Put", index, expectedInfo).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(targetAddress, index))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAccounts.AssertCalled(t, "Put", index, expectedInfo)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Transfer_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallTransfer()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(targetAddress, index))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAccounts.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Transfer_Dispatch_CannotLookup(t *testing.T) {
+	target := setupCallTransfer()
+	otherIndex := sc.U32(8)
+
+	mockStorageAccounts.On("TryGet", otherIndex).Return(sc.NewOption[IndexInfo](nil), nil)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(primitives.NewMultiAddressIndex(otherIndex), index))
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockCurrency.AssertNotCalled(t, "RepatriateReserved", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallTransfer() primitives.Call {
+	return newCallTransfer(moduleId, functionTransferIndex, setupAssignments())
+}
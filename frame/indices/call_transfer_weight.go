@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkIndicesTransfer benchmark.
+
+package indices
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callTransferWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(25000000, 0).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
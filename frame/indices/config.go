@@ -0,0 +1,22 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight       primitives.RuntimeDbWeight
+	EventDepositor primitives.EventDepositor
+	Currency       primitives.ReservableCurrency
+	Deposit        sc.U128
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, currency primitives.ReservableCurrency, deposit sc.U128) *Config {
+	return &Config{
+		DbWeight:       dbWeight,
+		EventDepositor: eventDepositor,
+		Currency:       currency,
+		Deposit:        deposit,
+	}
+}
@@ -0,0 +1,22 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight primitives.RuntimeDbWeight
+	Deposit  sc.U128
+}
+
+type metadataConstants struct {
+	Deposit primitives.IndexDeposit
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, deposit sc.U128) *consts {
+	return &consts{
+		DbWeight: dbWeight,
+		Deposit:  deposit,
+	}
+}
@@ -0,0 +1,55 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Indices module errors.
+const (
+	ErrorNotAssigned sc.U8 = iota
+	ErrorNotOwner
+	ErrorInUse
+	ErrorNotTransfer
+	ErrorPermanent
+)
+
+func NewDispatchErrorNotAssigned(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotAssigned),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotOwner(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotOwner),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorInUse(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorInUse),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotTransfer(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotTransfer),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorPermanent(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorPermanent),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,79 @@
+package indices
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Indices module events.
+const (
+	EventIndexAssigned sc.U8 = iota
+	EventIndexFreed
+	EventIndexFrozen
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid indices.Event module")
+	errInvalidEventType   = errors.New("invalid indices.Event type")
+)
+
+func newEventIndexAssigned(moduleIndex sc.U8, who primitives.AccountId, index primitives.AccountIndex) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventIndexAssigned, who, index)
+}
+
+func newEventIndexFreed(moduleIndex sc.U8, index primitives.AccountIndex) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventIndexFreed, index)
+}
+
+func newEventIndexFrozen(moduleIndex sc.U8, index primitives.AccountIndex, who primitives.AccountId) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventIndexFrozen, index, who)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventIndexAssigned:
+		who, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventIndexAssigned(moduleIndex, who, index), nil
+	case EventIndexFreed:
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventIndexFreed(moduleIndex, index), nil
+	case EventIndexFrozen:
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		who, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventIndexFrozen(moduleIndex, index, who), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
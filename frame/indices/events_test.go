@@ -0,0 +1,76 @@
+package indices
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Indices_DecodeEvent_IndexAssigned(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventIndexAssigned.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(index.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventIndexAssigned, whoAccountId, index)},
+		result,
+	)
+}
+
+func Test_Indices_DecodeEvent_IndexFreed(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventIndexFreed.Bytes())
+	buffer.Write(index.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventIndexFreed, index)},
+		result,
+	)
+}
+
+func Test_Indices_DecodeEvent_IndexFrozen(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventIndexFrozen.Bytes())
+	buffer.Write(index.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventIndexFrozen, index, whoAccountId)},
+		result,
+	)
+}
+
+func Test_Indices_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Indices_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,39 @@
+package indices
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StaticLookup is a primitives.StaticLookup, which resolves both AccountId addresses
+// and the account indices, assigned in this module.
+type StaticLookup struct {
+	storage *storage
+}
+
+func NewStaticLookup() StaticLookup {
+	return StaticLookup{
+		storage: newStorage(),
+	}
+}
+
+func (l StaticLookup) Lookup(a primitives.MultiAddress) (primitives.AccountId, error) {
+	switch {
+	case a.IsAccountId():
+		return a.AsAccountId()
+	case a.IsAccountIndex():
+		index, err := a.AsAccountIndex()
+		if err != nil {
+			return primitives.AccountId{}, err
+		}
+		info, err := l.storage.Accounts.TryGet(index)
+		if err != nil {
+			return primitives.AccountId{}, err
+		}
+		if !info.HasValue {
+			return primitives.AccountId{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionCannotLookup())
+		}
+		return info.Value.Account, nil
+	default:
+		return primitives.AccountId{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionCannotLookup())
+	}
+}
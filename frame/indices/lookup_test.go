@@ -0,0 +1,70 @@
+package indices
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_StaticLookup_Lookup_AccountId(t *testing.T) {
+	target := setupStaticLookup()
+
+	result, err := target.Lookup(targetAddress)
+
+	assert.Nil(t, err)
+	assert.Equal(t, targetAccountId, result)
+	mockStorageAccounts.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_StaticLookup_Lookup_AccountIndex(t *testing.T) {
+	target := setupStaticLookup()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](whoIndexInfo), nil)
+
+	result, err := target.Lookup(primitives.NewMultiAddressIndex(index))
+
+	assert.Nil(t, err)
+	assert.Equal(t, whoAccountId, result)
+}
+
+func Test_StaticLookup_Lookup_AccountIndex_NotAssigned(t *testing.T) {
+	target := setupStaticLookup()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](nil), nil)
+
+	_, err := target.Lookup(primitives.NewMultiAddressIndex(index))
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionCannotLookup()), err)
+}
+
+func Test_StaticLookup_Lookup_AccountIndex_StorageError(t *testing.T) {
+	target := setupStaticLookup()
+
+	mockStorageAccounts.On("TryGet", index).Return(sc.NewOption[IndexInfo](nil), expectedErr)
+
+	_, err := target.Lookup(primitives.NewMultiAddressIndex(index))
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_StaticLookup_Lookup_Unsupported(t *testing.T) {
+	target := setupStaticLookup()
+
+	_, err := target.Lookup(primitives.NewMultiAddress20(primitives.Address20{}))
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionCannotLookup()), err)
+	mockStorageAccounts.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupStaticLookup() StaticLookup {
+	setupMocks()
+
+	return StaticLookup{
+		storage: &storage{
+			Accounts: mockStorageAccounts,
+		},
+	}
+}
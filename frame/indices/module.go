@@ -0,0 +1,208 @@
+package indices
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_indices`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionClaimIndex         = 0
+	functionTransferIndex      = 1
+	functionFreeIndex          = 2
+	functionForceTransferIndex = 3
+	functionFreezeIndex        = 4
+)
+
+const (
+	name           = sc.Str("Indices")
+	storageVersion = sc.U16(0)
+)
+
+// Module allocates short account indices, which can be used instead of the full account ids
+// in MultiAddress, by reserving a deposit from the claiming account.
+//
+// The indices are resolved by StaticLookup, which the runtime uses when decoding the signer of
+// an extrinsic and the addresses in call arguments.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.Deposit)
+	storage := newStorage()
+	assignments := newAssignments(index, config, constants, storage, StaticLookup{storage: storage})
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionClaimIndex] = newCallClaim(index, functionClaimIndex, assignments)
+	functions[functionTransferIndex] = newCallTransfer(index, functionTransferIndex, assignments)
+	functions[functionFreeIndex] = newCallFree(index, functionFreeIndex, assignments)
+	functions[functionForceTransferIndex] = newCallForceTransfer(index, functionForceTransferIndex, assignments)
+	functions[functionFreezeIndex] = newCallFreeze(index, functionFreezeIndex, assignments)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyIndices, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdIndicesCalls := m.mdGenerator.BuildCallsMetadata("Indices", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	mdConstants := metadataConstants{
+		Deposit: primitives.IndexDeposit{U128: m.constants.Deposit},
+	}
+
+	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdIndicesCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdIndicesCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Indices, Runtime>"),
+				},
+				m.Index,
+				"Call.Indices"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesIndicesEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesIndicesEvent, "pallet_indices::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Indices"),
+		),
+		Constants: moduleMdConstants,
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesIndicesErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesIndicesErrors),
+				},
+				m.Index,
+				"Errors.Indices"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesTupleAddress32U128Bool, "(AccountId, Balance, bool)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesAddress32), sc.ToCompact(metadata.PrimitiveTypesU128), sc.ToCompact(metadata.PrimitiveTypesBool)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesIndicesEvent, "pallet_indices pallet Event", sc.Sequence[sc.Str]{"pallet_indices", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"IndexAssigned",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+					},
+					EventIndexAssigned,
+					"Events.IndexAssigned"),
+				primitives.NewMetadataDefinitionVariant(
+					"IndexFreed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+					},
+					EventIndexFreed,
+					"Events.IndexFreed"),
+				primitives.NewMetadataDefinitionVariant(
+					"IndexFrozen",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+					},
+					EventIndexFrozen,
+					"Events.IndexFrozen"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesIndicesErrors,
+			"pallet_indices pallet Error",
+			sc.Sequence[sc.Str]{"pallet_indices", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("NotAssigned", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotAssigned, "The index was not already assigned."),
+					primitives.NewMetadataDefinitionVariant("NotOwner", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotOwner, "The index is assigned to another account."),
+					primitives.NewMetadataDefinitionVariant("InUse", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorInUse, "The index was not available."),
+					primitives.NewMetadataDefinitionVariant("NotTransfer", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotTransfer, "The source and destination accounts are identical."),
+					primitives.NewMetadataDefinitionVariant("Permanent", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorPermanent, "The index is permanent and may not be freed/changed."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Accounts",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.PrimitiveTypesU32,
+					metadata.TypesTupleAddress32U128Bool,
+					support.NewHasherBlake128Concat(),
+				),
+				"The lookup from index to account."),
+		},
+	})
+}
@@ -0,0 +1,258 @@
+package indices
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	moduleId = 13
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	deposit = sc.NewU128(100)
+	index   = sc.U32(7)
+
+	whoAccountId    = constants.OneAccountId
+	targetAccountId = constants.TwoAccountId
+	targetAddress   = primitives.NewMultiAddressId(targetAccountId)
+
+	whoIndexInfo = IndexInfo{
+		Account: whoAccountId,
+		Deposit: deposit,
+		Frozen:  false,
+	}
+
+	expectedErr  = errors.New("error")
+	mdGenerator  = primitives.NewMetadataTypeGenerator()
+	logger       = log.NewLogger()
+	signedOrigin = primitives.NewRawOriginSigned(whoAccountId)
+)
+
+var (
+	mockEventDepositor  *mocks.EventDepositor
+	mockCurrency        *mocks.ReservableCurrency
+	mockStorageAccounts *mocks.StorageMap[primitives.AccountIndex, IndexInfo]
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 5, len(functions))
+	assert.Equal(t, sc.U8(functionClaimIndex), functions[functionClaimIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionTransferIndex), functions[functionTransferIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionFreeIndex), functions[functionFreeIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionForceTransferIndex), functions[functionForceTransferIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionFreezeIndex), functions[functionFreezeIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(new(mocks.Call))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, new(mocks.Call))
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedIndicesCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedIndicesCallsMetadataId, "Indices calls", sc.Sequence[sc.Str]{"pallet_indices", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"claim",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					functionClaimIndex,
+					target.functions[functionClaimIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"transfer",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					functionTransferIndex,
+					target.functions[functionTransferIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"free",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					functionFreeIndex,
+					target.functions[functionFreeIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"force_transfer",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesBool),
+					},
+					functionForceTransferIndex,
+					target.functions[functionForceTransferIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"freeze",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					functionFreezeIndex,
+					target.functions[functionFreezeIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedIndicesCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedIndicesCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Indices, Runtime>"),
+				},
+				moduleId,
+				"Call.Indices"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesIndicesEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesIndicesEvent, "pallet_indices::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Indices"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"Deposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(deposit.Bytes()),
+				"The deposit needed for reserving an index.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesIndicesErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesIndicesErrors),
+				},
+				moduleId,
+				"Errors.Indices"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Accounts",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiBlake128Concat,
+					},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.TypesTupleAddress32U128Bool),
+				),
+				"The lookup from index to account."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	target := New(moduleId, newTestConfig(), mdGenerator, logger)
+	target.storage.Accounts = mockStorageAccounts
+
+	return target
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockCurrency = new(mocks.ReservableCurrency)
+	mockStorageAccounts = new(mocks.StorageMap[primitives.AccountIndex, IndexInfo])
+}
+
+func newTestConfig() *Config {
+	return NewConfig(
+		dbWeight,
+		mockEventDepositor,
+		mockCurrency,
+		deposit,
+	)
+}
+
+// setupAssignments returns an assignments, which uses the mocked storage.
+func setupAssignments() assignments {
+	setupMocks()
+
+	storage := &storage{
+		Accounts: mockStorageAccounts,
+	}
+	constants := newConstants(dbWeight, deposit)
+
+	return newAssignments(moduleId, newTestConfig(), constants, storage, StaticLookup{storage: storage})
+}
@@ -0,0 +1,22 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyIndices  = []byte("Indices")
+	keyAccounts = []byte("Accounts")
+)
+
+type storage struct {
+	Accounts support.StorageMap[primitives.AccountIndex, IndexInfo]
+}
+
+func newStorage() *storage {
+	return &storage{
+		Accounts: support.NewHashStorageMap[primitives.AccountIndex, IndexInfo](keyIndices, keyAccounts, support.NewHasherBlake128Concat(), sc.DecodeU32, DecodeIndexInfo),
+	}
+}
@@ -0,0 +1,51 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// IndexInfo is the assignment of an account index, encoded as the
+// `(AccountId, Balance, bool)` tuple in `pallet_indices`.
+type IndexInfo struct {
+	// Account is the account, to which the index resolves.
+	Account primitives.AccountId
+	// Deposit is the amount reserved from Account for holding the index.
+	Deposit primitives.Balance
+	// Frozen marks the index as permanent. A frozen index can be changed only by Root.
+	Frozen sc.Bool
+}
+
+func (ii IndexInfo) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		ii.Account,
+		ii.Deposit,
+		ii.Frozen,
+	)
+}
+
+func DecodeIndexInfo(buffer *bytes.Buffer) (IndexInfo, error) {
+	account, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return IndexInfo{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return IndexInfo{}, err
+	}
+	frozen, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return IndexInfo{}, err
+	}
+	return IndexInfo{
+		Account: account,
+		Deposit: deposit,
+		Frozen:  frozen,
+	}, nil
+}
+
+func (ii IndexInfo) Bytes() []byte {
+	return sc.EncodedBytes(ii)
+}
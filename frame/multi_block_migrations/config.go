@@ -0,0 +1,24 @@
+package multi_block_migrations
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight           primitives.RuntimeDbWeight
+	EventDepositor     primitives.EventDepositor
+	BlockWeights       primitives.BlockWeights
+	StorageBlockNumber func() (sc.U64, error)
+	Migrations         []SteppedMigration
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, blockWeights primitives.BlockWeights, storageBlockNumber func() (sc.U64, error), migrations []SteppedMigration) *Config {
+	return &Config{
+		DbWeight:           dbWeight,
+		EventDepositor:     eventDepositor,
+		BlockWeights:       blockWeights,
+		StorageBlockNumber: storageBlockNumber,
+		Migrations:         migrations,
+	}
+}
@@ -0,0 +1,111 @@
+package multi_block_migrations
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// MigrationCursorActive is the cursor of an ongoing upgrade.
+	MigrationCursorActive sc.U8 = iota
+
+	// MigrationCursorStuck marks an upgrade, in which a migration failed.
+	MigrationCursorStuck
+)
+
+var (
+	errInvalidMigrationCursorType = errors.New("invalid MigrationCursor type")
+	errNotActiveMigrationCursor   = errors.New("not an active MigrationCursor")
+)
+
+// ActiveCursor points to the currently executed migration of an ongoing upgrade.
+type ActiveCursor struct {
+	// Index of the migration in the configured migrations.
+	Index sc.U32
+	// InnerCursor is the cursor returned by the last step of the migration, if any.
+	InnerCursor sc.Option[sc.Sequence[sc.U8]]
+	// StartedAt is the block number, in which the migration started.
+	StartedAt sc.U64
+}
+
+func (ac ActiveCursor) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		ac.Index,
+		ac.InnerCursor,
+		ac.StartedAt,
+	)
+}
+
+func DecodeActiveCursor(buffer *bytes.Buffer) (ActiveCursor, error) {
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return ActiveCursor{}, err
+	}
+	innerCursor, err := sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8])
+	if err != nil {
+		return ActiveCursor{}, err
+	}
+	startedAt, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return ActiveCursor{}, err
+	}
+	return ActiveCursor{
+		Index:       index,
+		InnerCursor: innerCursor,
+		StartedAt:   startedAt,
+	}, nil
+}
+
+func (ac ActiveCursor) Bytes() []byte {
+	return sc.EncodedBytes(ac)
+}
+
+// MigrationCursor is the progress of an upgrade. It is either active or stuck.
+type MigrationCursor struct {
+	sc.VaryingData
+}
+
+func NewMigrationCursorActive(cursor ActiveCursor) MigrationCursor {
+	return MigrationCursor{sc.NewVaryingData(MigrationCursorActive, cursor)}
+}
+
+func NewMigrationCursorStuck() MigrationCursor {
+	return MigrationCursor{sc.NewVaryingData(MigrationCursorStuck)}
+}
+
+func DecodeMigrationCursor(buffer *bytes.Buffer) (MigrationCursor, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return MigrationCursor{}, err
+	}
+
+	switch b {
+	case MigrationCursorActive:
+		cursor, err := DecodeActiveCursor(buffer)
+		if err != nil {
+			return MigrationCursor{}, err
+		}
+		return NewMigrationCursorActive(cursor), nil
+	case MigrationCursorStuck:
+		return NewMigrationCursorStuck(), nil
+	default:
+		return MigrationCursor{}, errInvalidMigrationCursorType
+	}
+}
+
+func (mc MigrationCursor) IsActive() bool {
+	return mc.VaryingData[0] == MigrationCursorActive
+}
+
+func (mc MigrationCursor) IsStuck() bool {
+	return mc.VaryingData[0] == MigrationCursorStuck
+}
+
+func (mc MigrationCursor) AsActive() (ActiveCursor, error) {
+	if !mc.IsActive() {
+		return ActiveCursor{}, errNotActiveMigrationCursor
+	}
+	return mc.VaryingData[1].(ActiveCursor), nil
+}
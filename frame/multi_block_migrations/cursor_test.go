@@ -0,0 +1,38 @@
+package multi_block_migrations
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MigrationCursor_Active_EncodeDecode(t *testing.T) {
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 1, InnerCursor: innerCursor, StartedAt: startedAt})
+
+	result, err := DecodeMigrationCursor(bytes.NewBuffer(cursor.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, result)
+	assert.True(t, result.IsActive())
+	assert.False(t, result.IsStuck())
+}
+
+func Test_MigrationCursor_Stuck_EncodeDecode(t *testing.T) {
+	cursor := NewMigrationCursorStuck()
+
+	result, err := DecodeMigrationCursor(bytes.NewBuffer(cursor.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, result)
+	assert.True(t, result.IsStuck())
+
+	_, err = result.AsActive()
+	assert.Equal(t, errNotActiveMigrationCursor, err)
+}
+
+func Test_MigrationCursor_Decode_InvalidType(t *testing.T) {
+	_, err := DecodeMigrationCursor(bytes.NewBuffer([]byte{2}))
+
+	assert.Equal(t, errInvalidMigrationCursorType, err)
+}
@@ -0,0 +1,88 @@
+package multi_block_migrations
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Multi-block migrations module events.
+const (
+	EventUpgradeStarted sc.U8 = iota
+	EventUpgradeCompleted
+	EventUpgradeFailed
+	EventMigrationAdvanced
+	EventMigrationCompleted
+	EventMigrationFailed
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid multi_block_migrations.Event module")
+	errInvalidEventType   = errors.New("invalid multi_block_migrations.Event type")
+)
+
+func newEventUpgradeStarted(moduleIndex sc.U8, migrations sc.U32) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventUpgradeStarted, migrations)
+}
+
+func newEventUpgradeCompleted(moduleIndex sc.U8) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventUpgradeCompleted)
+}
+
+func newEventUpgradeFailed(moduleIndex sc.U8) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventUpgradeFailed)
+}
+
+func newEventMigrationAdvanced(moduleIndex sc.U8, index sc.U32, took sc.U64) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventMigrationAdvanced, index, took)
+}
+
+func newEventMigrationCompleted(moduleIndex sc.U8, index sc.U32, took sc.U64) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventMigrationCompleted, index, took)
+}
+
+func newEventMigrationFailed(moduleIndex sc.U8, index sc.U32, took sc.U64) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventMigrationFailed, index, took)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventUpgradeStarted:
+		migrations, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventUpgradeStarted(moduleIndex, migrations), nil
+	case EventUpgradeCompleted:
+		return newEventUpgradeCompleted(moduleIndex), nil
+	case EventUpgradeFailed:
+		return newEventUpgradeFailed(moduleIndex), nil
+	case EventMigrationAdvanced, EventMigrationCompleted, EventMigrationFailed:
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		took, err := sc.DecodeU64(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return primitives.NewEvent(moduleIndex, b, index, took), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,82 @@
+package multi_block_migrations
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MultiBlockMigrations_DecodeEvent_UpgradeStarted(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventUpgradeStarted.Bytes())
+	buffer.Write(sc.U32(2).Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventUpgradeStarted, sc.U32(2))},
+		result,
+	)
+}
+
+func Test_MultiBlockMigrations_DecodeEvent_UpgradeCompleted(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventUpgradeCompleted.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventUpgradeCompleted)}, result)
+}
+
+func Test_MultiBlockMigrations_DecodeEvent_UpgradeFailed(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventUpgradeFailed.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventUpgradeFailed)}, result)
+}
+
+func Test_MultiBlockMigrations_DecodeEvent_Migration(t *testing.T) {
+	for _, eventType := range []sc.U8{EventMigrationAdvanced, EventMigrationCompleted, EventMigrationFailed} {
+		buffer := &bytes.Buffer{}
+		buffer.WriteByte(moduleId)
+		buffer.Write(eventType.Bytes())
+		buffer.Write(sc.U32(1).Bytes())
+		buffer.Write(sc.U64(3).Bytes())
+
+		result, err := DecodeEvent(moduleId, buffer)
+		assert.Nil(t, err)
+
+		assert.Equal(t,
+			primitives.Event{sc.NewVaryingData(sc.U8(moduleId), eventType, sc.U32(1), sc.U64(3))},
+			result,
+		)
+	}
+}
+
+func Test_MultiBlockMigrations_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_MultiBlockMigrations_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,289 @@
+package multi_block_migrations
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	name           = sc.Str("MultiBlockMigrations")
+	storageVersion = sc.U16(0)
+)
+
+// Module executes migrations, which do not fit in a single block, over multiple blocks.
+//
+// On runtime upgrade, the configured migrations are scheduled and a cursor is persisted in storage.
+// In OnInitialize of each following block, the migrations are stepped in order, until the weight
+// limit of the block is reached. While an upgrade is ongoing, the executive applies only mandatory
+// extrinsics. If a migration fails, the cursor is marked as stuck and no further steps are executed
+// until the next runtime upgrade, which starts the migrations over.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	storage     *storage
+	mdGenerator *primitives.MetadataTypeGenerator
+	logger      log.WarnLogger
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyMultiBlockMigrations, storageVersion),
+		Index:                index,
+		Config:               config,
+		storage:              newStorage(),
+		mdGenerator:          mdGenerator,
+		logger:               logger,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return map[sc.U8]primitives.Call{}
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnRuntimeUpgrade starts the configured migrations, unless an upgrade is already ongoing.
+func (m Module) OnRuntimeUpgrade() primitives.Weight {
+	if len(m.Config.Migrations) == 0 {
+		return primitives.WeightZero()
+	}
+
+	cursor, err := m.storage.Cursor.TryGet()
+	if err != nil {
+		m.logger.Warnf("failed to read migration cursor, not starting migrations: %v", err)
+		return m.Config.DbWeight.Reads(1)
+	}
+	if cursor.HasValue && cursor.Value.IsActive() {
+		m.logger.Warn("multi-block migrations are ongoing, not starting new migrations")
+		return m.Config.DbWeight.Reads(1)
+	}
+
+	// The runtime upgrade is executed before the block number of the
+	// current block is set.
+	blockNumber, err := m.Config.StorageBlockNumber()
+	if err != nil {
+		m.logger.Warnf("failed to read block number, not starting migrations: %v", err)
+		return m.Config.DbWeight.Reads(2)
+	}
+
+	m.storage.Cursor.Put(NewMigrationCursorActive(ActiveCursor{
+		Index:       0,
+		InnerCursor: sc.NewOption[sc.Sequence[sc.U8]](nil),
+		StartedAt:   blockNumber + 1,
+	}))
+	m.Config.EventDepositor.DepositEvent(newEventUpgradeStarted(m.Index, sc.U32(len(m.Config.Migrations))))
+
+	return m.Config.DbWeight.ReadsWrites(2, 1)
+}
+
+// OnInitialize steps the ongoing migrations within the maximum block weight. A migration, which
+// returns a cursor, is continued in the next block.
+func (m Module) OnInitialize(n sc.U64) (primitives.Weight, error) {
+	weight := m.Config.DbWeight.Reads(1)
+
+	cursor, err := m.storage.Cursor.TryGet()
+	if err != nil {
+		return weight, err
+	}
+	if !cursor.HasValue || cursor.Value.IsStuck() {
+		return weight, nil
+	}
+
+	active, err := cursor.Value.AsActive()
+	if err != nil {
+		return weight, err
+	}
+
+	weightLimit := m.maxStepsWeight()
+	weight = weight.SaturatingAdd(m.Config.DbWeight.Writes(1))
+
+	for {
+		if int(active.Index) >= len(m.Config.Migrations) {
+			m.storage.Cursor.Clear()
+			m.Config.EventDepositor.DepositEvent(newEventUpgradeCompleted(m.Index))
+			return weight, nil
+		}
+
+		remainingWeight := weightLimit.SaturatingSub(weight)
+		if !remainingWeight.AllGt(primitives.WeightZero()) {
+			break
+		}
+
+		migration := m.Config.Migrations[active.Index]
+		next, consumed, err := migration.Step(active.InnerCursor, remainingWeight)
+		weight = weight.SaturatingAdd(consumed)
+		took := n - active.StartedAt
+
+		if err != nil {
+			m.logger.Warnf("multi-block migration [%s] failed: %v", string(sc.SequenceU8ToBytes(migration.Id())), err)
+			m.storage.Cursor.Put(NewMigrationCursorStuck())
+			m.Config.EventDepositor.DepositEvent(newEventMigrationFailed(m.Index, active.Index, took))
+			m.Config.EventDepositor.DepositEvent(newEventUpgradeFailed(m.Index))
+			return weight, nil
+		}
+
+		if next.HasValue {
+			active.InnerCursor = next
+			m.Config.EventDepositor.DepositEvent(newEventMigrationAdvanced(m.Index, active.Index, took))
+			break
+		}
+
+		m.Config.EventDepositor.DepositEvent(newEventMigrationCompleted(m.Index, active.Index, took))
+		active = ActiveCursor{
+			Index:       active.Index + 1,
+			InnerCursor: sc.NewOption[sc.Sequence[sc.U8]](nil),
+			StartedAt:   n,
+		}
+	}
+
+	m.storage.Cursor.Put(NewMigrationCursorActive(active))
+
+	return weight, nil
+}
+
+// Ongoing returns whether an upgrade is ongoing, during which only mandatory extrinsics are applied.
+func (m Module) Ongoing() (bool, error) {
+	cursor, err := m.storage.Cursor.TryGet()
+	if err != nil {
+		return false, err
+	}
+	return cursor.HasValue && cursor.Value.IsActive(), nil
+}
+
+// maxStepsWeight returns the weight available to the migration steps in a block.
+func (m Module) maxStepsWeight() primitives.Weight {
+	return m.Config.BlockWeights.MaxBlock.SaturatingSub(m.Config.BlockWeights.BaseBlock)
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](nil),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Event:   sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultiBlockMigrationsEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMultiBlockMigrationsEvent, "pallet_migrations::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.MultiBlockMigrations"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](nil),
+		ErrorDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Index:     m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	indexAndTookFields := sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "took", "BlockNumberFor<T>"),
+	}
+
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesMultiBlockMigrationsEvent, "pallet_migrations pallet Event", sc.Sequence[sc.Str]{"pallet_migrations", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"UpgradeStarted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "migrations", "u32"),
+					},
+					EventUpgradeStarted,
+					"Events.UpgradeStarted"),
+				primitives.NewMetadataDefinitionVariant(
+					"UpgradeCompleted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventUpgradeCompleted,
+					"Events.UpgradeCompleted"),
+				primitives.NewMetadataDefinitionVariant(
+					"UpgradeFailed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventUpgradeFailed,
+					"Events.UpgradeFailed"),
+				primitives.NewMetadataDefinitionVariant(
+					"MigrationAdvanced",
+					indexAndTookFields,
+					EventMigrationAdvanced,
+					"Events.MigrationAdvanced"),
+				primitives.NewMetadataDefinitionVariant(
+					"MigrationCompleted",
+					indexAndTookFields,
+					EventMigrationCompleted,
+					"Events.MigrationCompleted"),
+				primitives.NewMetadataDefinitionVariant(
+					"MigrationFailed",
+					indexAndTookFields,
+					EventMigrationFailed,
+					"Events.MigrationFailed"),
+			},
+		)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesMultiBlockMigrationsActiveCursor, "ActiveCursor", sc.Sequence[sc.Str]{"pallet_migrations", "ActiveCursor"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSequenceU8, "inner_cursor", "Option<Cursor>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "started_at", "BlockNumber"),
+			},
+		)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesMultiBlockMigrationsCursor, "MigrationCursor", sc.Sequence[sc.Str]{"pallet_migrations", "MigrationCursor"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Active",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiBlockMigrationsActiveCursor),
+					},
+					MigrationCursorActive,
+					"MigrationCursor.Active"),
+				primitives.NewMetadataDefinitionVariant(
+					"Stuck",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					MigrationCursorStuck,
+					"MigrationCursor.Stuck"),
+			},
+		)),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Cursor",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesMultiBlockMigrationsCursor)),
+				"The currently active migration to run and its cursor."),
+		},
+	})
+}
@@ -0,0 +1,400 @@
+package multi_block_migrations
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId = 8
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	blockWeights = primitives.BlockWeights{
+		BaseBlock: primitives.WeightFromParts(1, 1),
+		MaxBlock:  primitives.WeightFromParts(100, 100),
+	}
+	blockNumber   = sc.U64(7)
+	startedAt     = sc.U64(5)
+	noCursor      = sc.NewOption[sc.Sequence[sc.U8]](nil)
+	innerCursor   = sc.NewOption[sc.Sequence[sc.U8]](sc.Sequence[sc.U8]{1})
+	otherCursor   = sc.NewOption[sc.Sequence[sc.U8]](sc.Sequence[sc.U8]{2})
+	stepWeight    = primitives.WeightFromParts(10, 10)
+	migrationId   = sc.BytesToSequenceU8([]byte("migration"))
+	expectedErr   = errors.New("error")
+	initialWeight = dbWeight.ReadsWrites(1, 1)
+	mdGenerator   = primitives.NewMetadataTypeGenerator()
+	logger        = log.NewLogger()
+)
+
+var (
+	mockEventDepositor     *mocks.EventDepositor
+	mockStorageCursor      *mocks.StorageValue[MigrationCursor]
+	mockMigration          *mocks.SteppedMigration
+	mockMigrationOther     *mocks.SteppedMigration
+	mockStorageBlockNumber func() (sc.U64, error)
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 0, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(new(mocks.Call))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), new(mocks.Call))
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_OnRuntimeUpgrade(t *testing.T) {
+	target := setupModule()
+
+	expectedCursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: noCursor, StartedAt: blockNumber + 1})
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](nil), nil)
+	mockStorageCursor.On("Put", expectedCursor).Return()
+	mockEventDepositor.On("DepositEvent", newEventUpgradeStarted(moduleId, 2)).Return()
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.ReadsWrites(2, 1), result)
+	mockStorageCursor.AssertCalled(t, "Put", expectedCursor)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventUpgradeStarted(moduleId, 2))
+}
+
+func Test_Module_OnRuntimeUpgrade_Stuck(t *testing.T) {
+	target := setupModule()
+
+	expectedCursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: noCursor, StartedAt: blockNumber + 1})
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](NewMigrationCursorStuck()), nil)
+	mockStorageCursor.On("Put", expectedCursor).Return()
+	mockEventDepositor.On("DepositEvent", newEventUpgradeStarted(moduleId, 2)).Return()
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.ReadsWrites(2, 1), result)
+	mockStorageCursor.AssertCalled(t, "Put", expectedCursor)
+}
+
+func Test_Module_OnRuntimeUpgrade_NoMigrations(t *testing.T) {
+	target := setupModule()
+	target.Config.Migrations = []SteppedMigration{}
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, primitives.WeightZero(), result)
+	mockStorageCursor.AssertNotCalled(t, "TryGet")
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_OnRuntimeUpgrade_Ongoing(t *testing.T) {
+	target := setupModule()
+
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 1, InnerCursor: innerCursor, StartedAt: startedAt})
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](cursor), nil)
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.Reads(1), result)
+	mockStorageCursor.AssertNotCalled(t, "Put", mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_OnRuntimeUpgrade_TryGet_Error(t *testing.T) {
+	target := setupModule()
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](nil), expectedErr)
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.Reads(1), result)
+	mockStorageCursor.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnRuntimeUpgrade_StorageBlockNumber_Error(t *testing.T) {
+	target := setupModule()
+	target.Config.StorageBlockNumber = func() (sc.U64, error) {
+		return 0, expectedErr
+	}
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](nil), nil)
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.Reads(2), result)
+	mockStorageCursor.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_NoCursor(t *testing.T) {
+	target := setupModule()
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](nil), nil)
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dbWeight.Reads(1), result)
+	mockMigration.AssertNotCalled(t, "Step", mock.Anything, mock.Anything)
+}
+
+func Test_Module_OnInitialize_Stuck(t *testing.T) {
+	target := setupModule()
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](NewMigrationCursorStuck()), nil)
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dbWeight.Reads(1), result)
+	mockMigration.AssertNotCalled(t, "Step", mock.Anything, mock.Anything)
+}
+
+func Test_Module_OnInitialize_TryGet_Error(t *testing.T) {
+	target := setupModule()
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](nil), expectedErr)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Module_OnInitialize_MigrationAdvanced(t *testing.T) {
+	target := setupModule()
+
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: noCursor, StartedAt: startedAt})
+	expectedCursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: innerCursor, StartedAt: startedAt})
+	remainingWeight := target.maxStepsWeight().SaturatingSub(initialWeight)
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](cursor), nil)
+	mockMigration.On("Step", noCursor, remainingWeight).Return(innerCursor, stepWeight, nil)
+	mockEventDepositor.On("DepositEvent", newEventMigrationAdvanced(moduleId, 0, 2)).Return()
+	mockStorageCursor.On("Put", expectedCursor).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, initialWeight.SaturatingAdd(stepWeight), result)
+	mockMigrationOther.AssertNotCalled(t, "Step", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMigrationAdvanced(moduleId, 0, 2))
+	mockStorageCursor.AssertCalled(t, "Put", expectedCursor)
+}
+
+func Test_Module_OnInitialize_MigrationCompleted_NextAdvanced(t *testing.T) {
+	target := setupModule()
+
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: innerCursor, StartedAt: startedAt})
+	expectedCursor := NewMigrationCursorActive(ActiveCursor{Index: 1, InnerCursor: otherCursor, StartedAt: blockNumber})
+	remainingWeight := target.maxStepsWeight().SaturatingSub(initialWeight)
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](cursor), nil)
+	mockMigration.On("Step", innerCursor, remainingWeight).Return(noCursor, stepWeight, nil)
+	mockMigrationOther.On("Step", noCursor, remainingWeight.SaturatingSub(stepWeight)).Return(otherCursor, stepWeight, nil)
+	mockEventDepositor.On("DepositEvent", newEventMigrationCompleted(moduleId, 0, 2)).Return()
+	mockEventDepositor.On("DepositEvent", newEventMigrationAdvanced(moduleId, 1, 0)).Return()
+	mockStorageCursor.On("Put", expectedCursor).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, initialWeight.SaturatingAdd(stepWeight).SaturatingAdd(stepWeight), result)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMigrationCompleted(moduleId, 0, 2))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMigrationAdvanced(moduleId, 1, 0))
+	mockStorageCursor.AssertCalled(t, "Put", expectedCursor)
+}
+
+func Test_Module_OnInitialize_WeightExhausted(t *testing.T) {
+	target := setupModule()
+
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: innerCursor, StartedAt: startedAt})
+	expectedCursor := NewMigrationCursorActive(ActiveCursor{Index: 1, InnerCursor: noCursor, StartedAt: blockNumber})
+	remainingWeight := target.maxStepsWeight().SaturatingSub(initialWeight)
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](cursor), nil)
+	mockMigration.On("Step", innerCursor, remainingWeight).Return(noCursor, remainingWeight, nil)
+	mockEventDepositor.On("DepositEvent", newEventMigrationCompleted(moduleId, 0, 2)).Return()
+	mockStorageCursor.On("Put", expectedCursor).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, target.maxStepsWeight(), result)
+	mockMigrationOther.AssertNotCalled(t, "Step", mock.Anything, mock.Anything)
+	mockStorageCursor.AssertCalled(t, "Put", expectedCursor)
+}
+
+func Test_Module_OnInitialize_UpgradeCompleted(t *testing.T) {
+	target := setupModule()
+
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 1, InnerCursor: otherCursor, StartedAt: startedAt})
+	remainingWeight := target.maxStepsWeight().SaturatingSub(initialWeight)
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](cursor), nil)
+	mockMigrationOther.On("Step", otherCursor, remainingWeight).Return(noCursor, stepWeight, nil)
+	mockEventDepositor.On("DepositEvent", newEventMigrationCompleted(moduleId, 1, 2)).Return()
+	mockEventDepositor.On("DepositEvent", newEventUpgradeCompleted(moduleId)).Return()
+	mockStorageCursor.On("Clear").Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, initialWeight.SaturatingAdd(stepWeight), result)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMigrationCompleted(moduleId, 1, 2))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventUpgradeCompleted(moduleId))
+	mockStorageCursor.AssertCalled(t, "Clear")
+	mockStorageCursor.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_MigrationFailed(t *testing.T) {
+	target := setupModule()
+
+	cursor := NewMigrationCursorActive(ActiveCursor{Index: 0, InnerCursor: innerCursor, StartedAt: startedAt})
+	remainingWeight := target.maxStepsWeight().SaturatingSub(initialWeight)
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](cursor), nil)
+	mockMigration.On("Step", innerCursor, remainingWeight).Return(noCursor, stepWeight, expectedErr)
+	mockMigration.On("Id").Return(migrationId)
+	mockStorageCursor.On("Put", NewMigrationCursorStuck()).Return()
+	mockEventDepositor.On("DepositEvent", newEventMigrationFailed(moduleId, 0, 2)).Return()
+	mockEventDepositor.On("DepositEvent", newEventUpgradeFailed(moduleId)).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, initialWeight.SaturatingAdd(stepWeight), result)
+	mockStorageCursor.AssertCalled(t, "Put", NewMigrationCursorStuck())
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMigrationFailed(moduleId, 0, 2))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventUpgradeFailed(moduleId))
+	mockMigrationOther.AssertNotCalled(t, "Step", mock.Anything, mock.Anything)
+}
+
+func Test_Module_Ongoing(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		cursor   sc.Option[MigrationCursor]
+		expected bool
+	}{
+		{name: "none", cursor: sc.NewOption[MigrationCursor](nil), expected: false},
+		{name: "stuck", cursor: sc.NewOption[MigrationCursor](NewMigrationCursorStuck()), expected: false},
+		{name: "active", cursor: sc.NewOption[MigrationCursor](NewMigrationCursorActive(ActiveCursor{})), expected: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+			mockStorageCursor.On("TryGet").Return(tt.cursor, nil)
+
+			result, err := target.Ongoing()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Module_Ongoing_Error(t *testing.T) {
+	target := setupModule()
+
+	mockStorageCursor.On("TryGet").Return(sc.NewOption[MigrationCursor](nil), expectedErr)
+
+	_, err := target.Ongoing()
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version: primitives.ModuleVersion14,
+		ModuleV14: primitives.MetadataModuleV14{
+			Name: name,
+			Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+				Prefix: name,
+				Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+					primitives.NewMetadataModuleStorageEntry(
+						"Cursor",
+						primitives.MetadataModuleStorageEntryModifierOptional,
+						primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesMultiBlockMigrationsCursor)),
+						"The currently active migration to run and its cursor."),
+				},
+			}),
+			Call:    sc.NewOption[sc.Compact](nil),
+			CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+			Event:   sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultiBlockMigrationsEvent)),
+			EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+				primitives.NewMetadataDefinitionVariantStr(
+					name,
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMultiBlockMigrationsEvent, "pallet_migrations::Event<Runtime>"),
+					},
+					moduleId,
+					"Events.MultiBlockMigrations"),
+			),
+			Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+			Error:     sc.NewOption[sc.Compact](nil),
+			ErrorDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+			Index:     moduleId,
+		},
+	}
+
+	result := target.Metadata()
+
+	assert.Equal(t, expectMetadataModule, result)
+	assert.Equal(t, target.metadataTypes(), mdGenerator.GetMetadataTypes())
+}
+
+func setupModule() Module {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockStorageCursor = new(mocks.StorageValue[MigrationCursor])
+	mockMigration = new(mocks.SteppedMigration)
+	mockMigrationOther = new(mocks.SteppedMigration)
+	mockStorageBlockNumber = func() (sc.U64, error) {
+		return blockNumber, nil
+	}
+
+	mdGenerator.ClearMetadata()
+
+	config := NewConfig(dbWeight, mockEventDepositor, blockWeights, mockStorageBlockNumber, []SteppedMigration{mockMigration, mockMigrationOther})
+
+	target := New(moduleId, config, mdGenerator, logger)
+	target.storage.Cursor = mockStorageCursor
+
+	return target
+}
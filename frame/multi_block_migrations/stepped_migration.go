@@ -0,0 +1,18 @@
+package multi_block_migrations
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SteppedMigration is a migration, which is too large to fit in a single block and is
+// therefore executed in steps over multiple blocks.
+type SteppedMigration interface {
+	// Id identifies the migration in the logs.
+	Id() sc.Sequence[sc.U8]
+
+	// Step executes the next step of the migration, starting from cursor, which is None for the
+	// first step. A step must not consume more than weightLimit. Returns the cursor to continue from
+	// in the next block, or None if the migration is complete, together with the consumed weight.
+	Step(cursor sc.Option[sc.Sequence[sc.U8]], weightLimit primitives.Weight) (sc.Option[sc.Sequence[sc.U8]], primitives.Weight, error)
+}
@@ -0,0 +1,20 @@
+package multi_block_migrations
+
+import (
+	"github.com/LimeChain/gosemble/frame/support"
+)
+
+var (
+	keyMultiBlockMigrations = []byte("MultiBlockMigrations")
+	keyCursor               = []byte("Cursor")
+)
+
+type storage struct {
+	Cursor support.StorageValue[MigrationCursor]
+}
+
+func newStorage() *storage {
+	return &storage{
+		Cursor: support.NewHashStorageValue(keyMultiBlockMigrations, keyCursor, DecodeMigrationCursor),
+	}
+}
@@ -0,0 +1,133 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Register approval for a dispatch to be made from a deterministic composite account if
+// approved by a total of `threshold` of the signatories.
+// The dispatch origin for this call must be `Signed`.
+type callApproveAsMulti struct {
+	primitives.Callable
+	operation
+}
+
+func newCallApproveAsMulti(moduleId sc.U8, functionId sc.U8, operation operation) primitives.Call {
+	call := callApproveAsMulti{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments: sc.NewVaryingData(
+				sc.U16(0),
+				sc.Sequence[primitives.AccountId]{},
+				sc.NewOption[Timepoint](nil),
+				primitives.H256{},
+				primitives.WeightZero(),
+			),
+		},
+		operation: operation,
+	}
+
+	return call
+}
+
+func (c callApproveAsMulti) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	threshold, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	otherSignatories, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	if err != nil {
+		return nil, err
+	}
+	maybeTimepoint, err := sc.DecodeOptionWith(buffer, DecodeTimepoint)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maxWeight, err := primitives.DecodeWeight(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		threshold,
+		otherSignatories,
+		maybeTimepoint,
+		callHash,
+		maxWeight,
+	)
+	return c, nil
+}
+
+func (c callApproveAsMulti) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callApproveAsMulti) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callApproveAsMulti) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callApproveAsMulti) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callApproveAsMulti) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callApproveAsMulti) BaseWeight() primitives.Weight {
+	signatories := sc.U64(len(c.Arguments[1].(sc.Sequence[primitives.AccountId])))
+
+	return callApproveAsMultiCreateWeight(c.constants.DbWeight, signatories).
+		Max(callApproveAsMultiApproveWeight(c.constants.DbWeight, signatories))
+}
+
+func (_ callApproveAsMulti) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callApproveAsMulti) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callApproveAsMulti) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callApproveAsMulti) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return c.operate(
+		who,
+		args[0].(sc.U16),
+		args[1].(sc.Sequence[primitives.AccountId]),
+		args[2].(sc.Option[Timepoint]),
+		sc.NewOption[primitives.RuntimeCall](nil),
+		args[3].(primitives.H256),
+		args[4].(primitives.Weight),
+	)
+}
+
+func (_ callApproveAsMulti) Docs() string {
+	return "Register approval for a dispatch to be made from a deterministic composite account if " +
+		"approved by a total of `threshold` of the signatories. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"The call is not dispatched, the final approval must be given with `as_multi`."
+}
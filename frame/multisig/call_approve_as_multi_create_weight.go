@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkMultisigApproveAsMultiCreate benchmark.
+
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callApproveAsMultiCreateWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(32600000, 0).
+		SaturatingAdd(primitives.WeightFromParts(120000, 0).SaturatingMul(signatories)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
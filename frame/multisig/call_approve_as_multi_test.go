@@ -0,0 +1,129 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ApproveAsMulti_New(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionApproveAsMultiIndex,
+		Arguments: sc.NewVaryingData(
+			sc.U16(0),
+			sc.Sequence[primitives.AccountId]{},
+			sc.NewOption[Timepoint](nil),
+			primitives.H256{},
+			primitives.WeightZero(),
+		),
+	}
+
+	assert.Equal(t, expected, target.(callApproveAsMulti).Callable)
+}
+
+func Test_Call_ApproveAsMulti_DecodeArgs(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatory.Bytes())
+	buffer.Write(someTimepoint.Bytes())
+	buffer.Write(callHash.Bytes())
+	buffer.Write(maxWeight.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(threshold, otherSignatory, someTimepoint, callHash, maxWeight), call.Args())
+}
+
+func Test_Call_ApproveAsMulti_Encode(t *testing.T) {
+	target := setupDecodedCallApproveAsMulti(noTimepoint)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionApproveAsMultiIndex})
+	expectedBuffer.Write(threshold.Bytes())
+	expectedBuffer.Write(otherSignatory.Bytes())
+	expectedBuffer.Write(noTimepoint.Bytes())
+	expectedBuffer.Write(callHash.Bytes())
+	expectedBuffer.Write(maxWeight.Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_ApproveAsMulti_ModuleIndex(t *testing.T) {
+	target := setupCallApproveAsMulti()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ApproveAsMulti_FunctionIndex(t *testing.T) {
+	target := setupCallApproveAsMulti()
+
+	assert.Equal(t, sc.U8(functionApproveAsMultiIndex), target.FunctionIndex())
+}
+
+func Test_Call_ApproveAsMulti_BaseWeight(t *testing.T) {
+	target := setupDecodedCallApproveAsMulti(noTimepoint)
+
+	expected := callApproveAsMultiCreateWeight(dbWeight, signatoriesLen).
+		Max(callApproveAsMultiApproveWeight(dbWeight, signatoriesLen))
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_ApproveAsMulti_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallApproveAsMulti(noTimepoint)
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ApproveAsMulti_PaysFee(t *testing.T) {
+	target := setupCallApproveAsMulti()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ApproveAsMulti_Dispatch(t *testing.T) {
+	target := setupDecodedCallApproveAsMulti(noTimepoint)
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageMultisigs.On("Put", multiAccountId, callHash, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", newEventNewMultisig(moduleId, whoAccountId, multiAccountId, callHash)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(callApproveAsMultiCreateWeight(dbWeight, signatoriesLen)), result)
+	mockHashing.AssertNotCalled(t, "Blake256", callBytes)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventNewMultisig(moduleId, whoAccountId, multiAccountId, callHash))
+}
+
+func Test_Call_ApproveAsMulti_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallApproveAsMulti(noTimepoint)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Exists", mock.Anything, mock.Anything)
+}
+
+func setupCallApproveAsMulti() primitives.Call {
+	return newCallApproveAsMulti(moduleId, functionApproveAsMultiIndex, setupOperation())
+}
+
+func setupDecodedCallApproveAsMulti(maybeTimepoint sc.Option[Timepoint]) primitives.Call {
+	target := setupCallApproveAsMulti().(callApproveAsMulti)
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatory, maybeTimepoint, callHash, maxWeight)
+
+	return target
+}
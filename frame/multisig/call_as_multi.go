@@ -0,0 +1,148 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Register approval for a dispatch to be made from a deterministic composite account if
+// approved by a total of `threshold` of the signatories. If the approval is the final one,
+// the call is dispatched.
+// The dispatch origin for this call must be `Signed`.
+type callAsMulti struct {
+	primitives.Callable
+	operation
+}
+
+func newCallAsMulti(moduleId sc.U8, functionId sc.U8, operation operation) primitives.Call {
+	call := callAsMulti{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments: sc.NewVaryingData(
+				sc.U16(0),
+				sc.Sequence[primitives.AccountId]{},
+				sc.NewOption[Timepoint](nil),
+				primitives.RuntimeCall{},
+				primitives.WeightZero(),
+			),
+		},
+		operation: operation,
+	}
+
+	return call
+}
+
+func (c callAsMulti) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callAsMulti) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	threshold, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	otherSignatories, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	if err != nil {
+		return nil, err
+	}
+	maybeTimepoint, err := sc.DecodeOptionWith(buffer, DecodeTimepoint)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maxWeight, err := primitives.DecodeWeight(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		threshold,
+		otherSignatories,
+		maybeTimepoint,
+		primitives.NewRuntimeCall(call),
+		maxWeight,
+	)
+	return c, nil
+}
+
+func (c callAsMulti) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAsMulti) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAsMulti) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callAsMulti) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callAsMulti) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callAsMulti) BaseWeight() primitives.Weight {
+	signatories := sc.U64(len(c.Arguments[1].(sc.Sequence[primitives.AccountId])))
+	call := c.Arguments[3].(primitives.RuntimeCall)
+	size := sc.U64(len(call.Bytes()))
+
+	return callAsMultiCreateWeight(c.constants.DbWeight, signatories, size).
+		Max(callAsMultiApproveWeight(c.constants.DbWeight, signatories, size)).
+		Max(callAsMultiCompleteWeight(c.constants.DbWeight, signatories, size)).
+		SaturatingAdd(c.Arguments[4].(primitives.Weight))
+}
+
+func (_ callAsMulti) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callAsMulti) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callAsMulti) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callAsMulti) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	call := args[3].(primitives.RuntimeCall)
+	callHash, err := c.callHash(call)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return c.operate(
+		who,
+		args[0].(sc.U16),
+		args[1].(sc.Sequence[primitives.AccountId]),
+		args[2].(sc.Option[Timepoint]),
+		sc.NewOption[primitives.RuntimeCall](call),
+		callHash,
+		args[4].(primitives.Weight),
+	)
+}
+
+func (_ callAsMulti) Docs() string {
+	return "Register approval for a dispatch to be made from a deterministic composite account if " +
+		"approved by a total of `threshold` of the signatories. If the approval is the final one, the call is dispatched. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"The first approval reserves a deposit of `DepositBase + threshold * DepositFactor`, which is returned once the call is dispatched or cancelled."
+}
@@ -0,0 +1,16 @@
+// Reference weight, to be replaced by the output of the BenchmarkMultisigAsMultiComplete benchmark.
+
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiCompleteWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64, size sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(41400000, 0).
+		SaturatingAdd(primitives.WeightFromParts(140000, 0).SaturatingMul(signatories)).
+		SaturatingAdd(primitives.WeightFromParts(1600, 0).SaturatingMul(size)).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,16 @@
+// Reference weight, to be replaced by the output of the BenchmarkMultisigAsMultiCreate benchmark.
+
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiCreateWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64, size sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(38200000, 0).
+		SaturatingAdd(primitives.WeightFromParts(110000, 0).SaturatingMul(signatories)).
+		SaturatingAdd(primitives.WeightFromParts(1500, 0).SaturatingMul(size)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,149 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_AsMulti_New(t *testing.T) {
+	target := setupCallAsMulti()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionAsMultiIndex,
+		Arguments: sc.NewVaryingData(
+			sc.U16(0),
+			sc.Sequence[primitives.AccountId]{},
+			sc.NewOption[Timepoint](nil),
+			primitives.RuntimeCall{},
+			primitives.WeightZero(),
+		),
+	}
+
+	assert.Equal(t, expected, target.(callAsMulti).Callable)
+}
+
+func Test_Call_AsMulti_DecodeArgs(t *testing.T) {
+	target := setupCallAsMulti()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(threshold.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_AsMulti_DecodeNestedArgs(t *testing.T) {
+	target := setupCallAsMulti()
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatory.Bytes())
+	buffer.Write(someTimepoint.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).
+		Run(func(args mock.Arguments) {
+			args.Get(0).(*bytes.Buffer).Write(maxWeight.Bytes())
+		}).
+		Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		sc.NewVaryingData(threshold, otherSignatory, someTimepoint, primitives.NewRuntimeCall(mockCall), maxWeight),
+		call.Args(),
+	)
+}
+
+func Test_Call_AsMulti_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallAsMulti()
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatory.Bytes())
+	buffer.Write(noTimepoint.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_AsMulti_ModuleIndex(t *testing.T) {
+	target := setupCallAsMulti()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_AsMulti_FunctionIndex(t *testing.T) {
+	target := setupCallAsMulti()
+
+	assert.Equal(t, sc.U8(functionAsMultiIndex), target.FunctionIndex())
+}
+
+func Test_Call_AsMulti_BaseWeight(t *testing.T) {
+	target := setupDecodedCallAsMulti(noTimepoint)
+	setupCallBytes(mockCall)
+
+	expected := callAsMultiCreateWeight(dbWeight, signatoriesLen, callLen).
+		Max(callAsMultiApproveWeight(dbWeight, signatoriesLen, callLen)).
+		Max(callAsMultiCompleteWeight(dbWeight, signatoriesLen, callLen)).
+		SaturatingAdd(maxWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_AsMulti_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallAsMulti(noTimepoint)
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AsMulti_PaysFee(t *testing.T) {
+	target := setupCallAsMulti()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AsMulti_Dispatch(t *testing.T) {
+	target := setupDecodedCallAsMulti(noTimepoint)
+	setupMultiAccountId(threshold)
+	setupCallBytes(mockCall)
+
+	mockHashing.On("Blake256", callBytes).Return(callHash.Bytes())
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageMultisigs.On("Put", multiAccountId, callHash, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", newEventNewMultisig(moduleId, whoAccountId, multiAccountId, callHash)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(callAsMultiCreateWeight(dbWeight, signatoriesLen, callLen)), result)
+	mockHashing.AssertCalled(t, "Blake256", callBytes)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventNewMultisig(moduleId, whoAccountId, multiAccountId, callHash))
+}
+
+func Test_Call_AsMulti_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallAsMulti(noTimepoint)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Exists", mock.Anything, mock.Anything)
+}
+
+func setupCallAsMulti() primitives.Call {
+	return newCallAsMulti(moduleId, functionAsMultiIndex, setupOperation())
+}
+
+func setupDecodedCallAsMulti(maybeTimepoint sc.Option[Timepoint]) primitives.Call {
+	target := setupCallAsMulti().(callAsMulti)
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatory, maybeTimepoint, primitives.NewRuntimeCall(mockCall), maxWeight)
+
+	return target
+}
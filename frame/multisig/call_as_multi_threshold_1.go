@@ -0,0 +1,125 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Immediately dispatch a multi-signature call using a single approval from the caller.
+// The dispatch origin for this call must be `Signed`.
+type callAsMultiThreshold1 struct {
+	primitives.Callable
+	operation
+}
+
+func newCallAsMultiThreshold1(moduleId sc.U8, functionId sc.U8, operation operation) primitives.Call {
+	call := callAsMultiThreshold1{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.AccountId]{}, primitives.RuntimeCall{}),
+		},
+		operation: operation,
+	}
+
+	return call
+}
+
+func (c callAsMultiThreshold1) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callAsMultiThreshold1) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	otherSignatories, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(otherSignatories, primitives.NewRuntimeCall(call))
+	return c, nil
+}
+
+func (c callAsMultiThreshold1) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAsMultiThreshold1) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAsMultiThreshold1) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callAsMultiThreshold1) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callAsMultiThreshold1) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callAsMultiThreshold1) BaseWeight() primitives.Weight {
+	call := c.Arguments[1].(primitives.RuntimeCall)
+	dispatchInfo := primitives.GetDispatchInfo(call)
+
+	return callAsMultiThreshold1Weight(c.constants.DbWeight, sc.U64(len(call.Bytes()))).
+		SaturatingAdd(dispatchInfo.Weight)
+}
+
+func (_ callAsMultiThreshold1) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callAsMultiThreshold1) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[1].(primitives.RuntimeCall)).Class
+}
+
+func (_ callAsMultiThreshold1) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callAsMultiThreshold1) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	otherSignatories := args[0].(sc.Sequence[primitives.AccountId])
+	call := args[1].(primitives.RuntimeCall)
+
+	signatories, err := c.ensureSignatories(who, otherSignatories)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id, err := c.multiAccountId(signatories, 1)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	dispatchInfo := primitives.GetDispatchInfo(call)
+	postInfo, dispatchErr := call.Dispatch(primitives.NewRawOriginSigned(id), call.Args())
+
+	// Always take into account the base weight of this call.
+	weight := callAsMultiThreshold1Weight(c.constants.DbWeight, sc.U64(len(call.Bytes()))).
+		// Add the real weight of the dispatch.
+		SaturatingAdd(postInfo.CalcActualWeight(&dispatchInfo))
+
+	return actualWeight(weight), dispatchErr
+}
+
+func (_ callAsMultiThreshold1) Docs() string {
+	return "Immediately dispatch a multi-signature call using a single approval from the caller. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"The multi-signature account is derived from the sorted signatories and a threshold of 1."
+}
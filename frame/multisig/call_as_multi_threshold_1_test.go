@@ -0,0 +1,150 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_AsMultiThreshold1_New(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionAsMultiThreshold1Index,
+		Arguments:  sc.NewVaryingData(sc.Sequence[primitives.AccountId]{}, primitives.RuntimeCall{}),
+	}
+
+	assert.Equal(t, expected, target.(callAsMultiThreshold1).Callable)
+}
+
+func Test_Call_AsMultiThreshold1_DecodeArgs(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(otherSignatory.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_AsMultiThreshold1_DecodeNestedArgs(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	buffer := bytes.NewBuffer(otherSignatory.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(otherSignatory, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_AsMultiThreshold1_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	buffer := bytes.NewBuffer(otherSignatory.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_AsMultiThreshold1_ModuleIndex(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_AsMultiThreshold1_FunctionIndex(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+
+	assert.Equal(t, sc.U8(functionAsMultiThreshold1Index), target.FunctionIndex())
+}
+
+func Test_Call_AsMultiThreshold1_BaseWeight(t *testing.T) {
+	target := setupDecodedCallAsMultiThreshold1()
+	setupCallBytes(mockCall)
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+
+	expected := callAsMultiThreshold1Weight(dbWeight, callLen).SaturatingAdd(callWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_AsMultiThreshold1_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallAsMultiThreshold1()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AsMultiThreshold1_PaysFee(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch(t *testing.T) {
+	target := setupDecodedCallAsMultiThreshold1()
+	setupMultiAccountId(1)
+	setupCallBytes(mockCall)
+	setupCallDispatch(mockCall, multiOrigin, nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callAsMultiThreshold1Weight(dbWeight, callLen).SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(expectedWeight), result)
+	mockHashing.AssertCalled(t, "Blake256", multiAccountEntropy(1))
+	mockCall.AssertCalled(t, "Dispatch", multiOrigin, callArgs)
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch_CallFails(t *testing.T) {
+	target := setupDecodedCallAsMultiThreshold1()
+	setupMultiAccountId(1)
+	setupCallBytes(mockCall)
+	setupCallDispatch(mockCall, multiOrigin, callErr)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callAsMultiThreshold1Weight(dbWeight, callLen).SaturatingAdd(callWeight)
+
+	assert.Equal(t, callErr, err)
+	assert.Equal(t, actualWeight(expectedWeight), result)
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch_TooFewSignatories(t *testing.T) {
+	target := setupCallAsMultiThreshold1().(callAsMultiThreshold1)
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.AccountId]{}, primitives.NewRuntimeCall(mockCall))
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorTooFewSignatories(moduleId), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallAsMultiThreshold1()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func setupCallAsMultiThreshold1() primitives.Call {
+	return newCallAsMultiThreshold1(moduleId, functionAsMultiThreshold1Index, setupOperation())
+}
+
+func setupDecodedCallAsMultiThreshold1() primitives.Call {
+	target := setupCallAsMultiThreshold1().(callAsMultiThreshold1)
+	target.Arguments = sc.NewVaryingData(otherSignatory, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkMultisigAsMultiThreshold1 benchmark.
+
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiThreshold1Weight(dbWeight primitives.RuntimeDbWeight, size sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(12300000, 0).
+		SaturatingAdd(primitives.WeightFromParts(500, 0).SaturatingMul(size)).
+		SaturatingAdd(dbWeight.Reads(0)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
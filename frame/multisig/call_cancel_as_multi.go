@@ -0,0 +1,159 @@
+package multisig
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Cancel a pre-existing, on-going multisig transaction. Any deposit reserved previously
+// for this operation will be unreserved on success.
+// The dispatch origin for this call must be `Signed`.
+type callCancelAsMulti struct {
+	primitives.Callable
+	operation
+}
+
+func newCallCancelAsMulti(moduleId sc.U8, functionId sc.U8, operation operation) primitives.Call {
+	call := callCancelAsMulti{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments: sc.NewVaryingData(
+				sc.U16(0),
+				sc.Sequence[primitives.AccountId]{},
+				Timepoint{},
+				primitives.H256{},
+			),
+		},
+		operation: operation,
+	}
+
+	return call
+}
+
+func (c callCancelAsMulti) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	threshold, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	otherSignatories, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	if err != nil {
+		return nil, err
+	}
+	timepoint, err := DecodeTimepoint(buffer)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		threshold,
+		otherSignatories,
+		timepoint,
+		callHash,
+	)
+	return c, nil
+}
+
+func (c callCancelAsMulti) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCancelAsMulti) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCancelAsMulti) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callCancelAsMulti) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callCancelAsMulti) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callCancelAsMulti) BaseWeight() primitives.Weight {
+	signatories := sc.U64(len(c.Arguments[1].(sc.Sequence[primitives.AccountId])))
+
+	return callCancelAsMultiWeight(c.constants.DbWeight, signatories)
+}
+
+func (_ callCancelAsMulti) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCancelAsMulti) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCancelAsMulti) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callCancelAsMulti) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	threshold := args[0].(sc.U16)
+	otherSignatories := args[1].(sc.Sequence[primitives.AccountId])
+	timepoint := args[2].(Timepoint)
+	callHash := args[3].(primitives.H256)
+
+	if threshold < 2 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorMinimumThreshold(c.ModuleId)
+	}
+
+	signatories, err := c.ensureSignatories(who, otherSignatories)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id, err := c.multiAccountId(signatories, threshold)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	if !c.storage.Multisigs.Exists(id, callHash) {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNotFound(c.ModuleId)
+	}
+
+	multisig, err := c.storage.Multisigs.Get(id, callHash)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if multisig.When != timepoint {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorWrongTimepoint(c.ModuleId)
+	}
+	if !reflect.DeepEqual(multisig.Depositor, who) {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNotOwner(c.ModuleId)
+	}
+
+	if _, err := c.currency.Unreserve(multisig.Depositor, multisig.Deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	c.storage.Multisigs.Remove(id, callHash)
+
+	c.eventDepositor.DepositEvent(newEventMultisigCancelled(c.ModuleId, who, timepoint, id, callHash))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callCancelAsMulti) Docs() string {
+	return "Cancel a pre-existing, on-going multisig transaction. Any deposit reserved previously " +
+		"for this operation will be unreserved on success. " +
+		"The dispatch origin for this call must be `Signed` by the account, which opened the operation."
+}
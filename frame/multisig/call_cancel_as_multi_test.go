@@ -0,0 +1,184 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	ownedPending = Multisig{
+		When:      timepoint,
+		Deposit:   deposit,
+		Depositor: whoAccountId,
+		Approvals: sc.Sequence[primitives.AccountId]{whoAccountId},
+	}
+)
+
+func Test_Call_CancelAsMulti_New(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionCancelAsMultiIndex,
+		Arguments: sc.NewVaryingData(
+			sc.U16(0),
+			sc.Sequence[primitives.AccountId]{},
+			Timepoint{},
+			primitives.H256{},
+		),
+	}
+
+	assert.Equal(t, expected, target.(callCancelAsMulti).Callable)
+}
+
+func Test_Call_CancelAsMulti_DecodeArgs(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatory.Bytes())
+	buffer.Write(timepoint.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(threshold, otherSignatory, timepoint, callHash), call.Args())
+}
+
+func Test_Call_CancelAsMulti_Encode(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(threshold)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionCancelAsMultiIndex})
+	expectedBuffer.Write(threshold.Bytes())
+	expectedBuffer.Write(otherSignatory.Bytes())
+	expectedBuffer.Write(timepoint.Bytes())
+	expectedBuffer.Write(callHash.Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_CancelAsMulti_ModuleIndex(t *testing.T) {
+	target := setupCallCancelAsMulti()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_CancelAsMulti_FunctionIndex(t *testing.T) {
+	target := setupCallCancelAsMulti()
+
+	assert.Equal(t, sc.U8(functionCancelAsMultiIndex), target.FunctionIndex())
+}
+
+func Test_Call_CancelAsMulti_BaseWeight(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(threshold)
+
+	assert.Equal(t, callCancelAsMultiWeight(dbWeight, signatoriesLen), target.BaseWeight())
+}
+
+func Test_Call_CancelAsMulti_ClassifyDispatch(t *testing.T) {
+	target := setupCallCancelAsMulti()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_CancelAsMulti_PaysFee(t *testing.T) {
+	target := setupCallCancelAsMulti()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_CancelAsMulti_Dispatch(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(threshold)
+	setupMultiAccountId(threshold)
+	expectedEvent := newEventMultisigCancelled(moduleId, whoAccountId, timepoint, multiAccountId, callHash)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(ownedPending, nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageMultisigs.On("Remove", multiAccountId, callHash).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", whoAccountId, deposit)
+	mockStorageMultisigs.AssertCalled(t, "Remove", multiAccountId, callHash)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_MinimumThreshold(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(1)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorMinimumThreshold(moduleId), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Exists", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_NotFound(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(threshold)
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_WrongTimepoint(t *testing.T) {
+	target := setupCallCancelAsMulti().(callCancelAsMulti)
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatory, Timepoint{Height: 1, Index: 1}, callHash)
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(ownedPending, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorWrongTimepoint(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_NotOwner(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(threshold)
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotOwner(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+	mockStorageMultisigs.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallCancelAsMulti(threshold)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Exists", mock.Anything, mock.Anything)
+}
+
+func setupCallCancelAsMulti() primitives.Call {
+	return newCallCancelAsMulti(moduleId, functionCancelAsMultiIndex, setupOperation())
+}
+
+func setupDecodedCallCancelAsMulti(threshold sc.U16) primitives.Call {
+	target := setupCallCancelAsMulti().(callCancelAsMulti)
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatory, timepoint, callHash)
+
+	return target
+}
@@ -0,0 +1,30 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight              primitives.RuntimeDbWeight
+	EventDepositor        primitives.EventDepositor
+	Currency              primitives.ReservableCurrency
+	DepositBase           sc.U128
+	DepositFactor         sc.U128
+	MaxSignatories        sc.U32
+	StorageBlockNumber    func() (sc.U64, error)
+	StorageExtrinsicIndex func() (sc.U32, error)
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, currency primitives.ReservableCurrency, depositBase sc.U128, depositFactor sc.U128, maxSignatories sc.U32, storageBlockNumber func() (sc.U64, error), storageExtrinsicIndex func() (sc.U32, error)) *Config {
+	return &Config{
+		DbWeight:              dbWeight,
+		EventDepositor:        eventDepositor,
+		Currency:              currency,
+		DepositBase:           depositBase,
+		DepositFactor:         depositFactor,
+		MaxSignatories:        maxSignatories,
+		StorageBlockNumber:    storageBlockNumber,
+		StorageExtrinsicIndex: storageExtrinsicIndex,
+	}
+}
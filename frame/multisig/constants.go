@@ -0,0 +1,28 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight       primitives.RuntimeDbWeight
+	DepositBase    sc.U128
+	DepositFactor  sc.U128
+	MaxSignatories sc.U32
+}
+
+type metadataConstants struct {
+	DepositBase    primitives.DepositBase
+	DepositFactor  primitives.DepositFactor
+	MaxSignatories primitives.MaxSignatories
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, depositBase sc.U128, depositFactor sc.U128, maxSignatories sc.U32) *consts {
+	return &consts{
+		DbWeight:       dbWeight,
+		DepositBase:    depositBase,
+		DepositFactor:  depositFactor,
+		MaxSignatories: maxSignatories,
+	}
+}
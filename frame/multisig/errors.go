@@ -0,0 +1,136 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Multisig module errors.
+const (
+	ErrorMinimumThreshold sc.U8 = iota
+	ErrorAlreadyApproved
+	ErrorNoApprovalsNeeded
+	ErrorTooFewSignatories
+	ErrorTooManySignatories
+	ErrorSignatoriesOutOfOrder
+	ErrorSenderInSignatories
+	ErrorNotFound
+	ErrorNotOwner
+	ErrorNoTimepoint
+	ErrorWrongTimepoint
+	ErrorUnexpectedTimepoint
+	ErrorMaxWeightTooLow
+	ErrorAlreadyStored
+)
+
+func NewDispatchErrorMinimumThreshold(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorMinimumThreshold),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorAlreadyApproved(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorAlreadyApproved),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNoApprovalsNeeded(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNoApprovalsNeeded),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorTooFewSignatories(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooFewSignatories),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorTooManySignatories(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManySignatories),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorSignatoriesOutOfOrder(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorSignatoriesOutOfOrder),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorSenderInSignatories(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorSenderInSignatories),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotFound(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotFound),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotOwner(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotOwner),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNoTimepoint(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNoTimepoint),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorWrongTimepoint(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorWrongTimepoint),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorUnexpectedTimepoint(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorUnexpectedTimepoint),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorMaxWeightTooLow(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorMaxWeightTooLow),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorAlreadyStored(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorAlreadyStored),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,104 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Multisig_DecodeEvent_NewMultisig(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventNewMultisig.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(multiAccountId.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventNewMultisig, whoAccountId, multiAccountId, callHash)},
+		result,
+	)
+}
+
+func Test_Multisig_DecodeEvent_MultisigApproval(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventMultisigApproval.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(timepoint.Bytes())
+	buffer.Write(multiAccountId.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventMultisigApproval, whoAccountId, timepoint, multiAccountId, callHash)},
+		result,
+	)
+}
+
+func Test_Multisig_DecodeEvent_MultisigExecuted(t *testing.T) {
+	outcome, err := primitives.NewDispatchOutcome(nil)
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventMultisigExecuted.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(timepoint.Bytes())
+	buffer.Write(multiAccountId.Bytes())
+	buffer.Write(callHash.Bytes())
+	buffer.Write(outcome.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventMultisigExecuted, whoAccountId, timepoint, multiAccountId, callHash, outcome)},
+		result,
+	)
+}
+
+func Test_Multisig_DecodeEvent_MultisigCancelled(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventMultisigCancelled.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(timepoint.Bytes())
+	buffer.Write(multiAccountId.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventMultisigCancelled, whoAccountId, timepoint, multiAccountId, callHash)},
+		result,
+	)
+}
+
+func Test_Multisig_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Multisig_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,251 @@
+package multisig
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_multisig`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionAsMultiThreshold1Index = 0
+	functionAsMultiIndex           = 1
+	functionApproveAsMultiIndex    = 2
+	functionCancelAsMultiIndex     = 3
+)
+
+const (
+	name           = sc.Str("Multisig")
+	storageVersion = sc.U16(0)
+)
+
+// Module enables multi-signature dispatches from deterministic composite accounts.
+//
+// The composite account is derived from the sorted signatories and the threshold, in the same
+// way as in `pallet_multisig`. A call is dispatched from it, once `threshold` of the signatories
+// have approved it. The first approval reserves a deposit from the signatory, which opens the
+// operation, and it is returned once the call is dispatched or the operation is cancelled.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.DepositBase, config.DepositFactor, config.MaxSignatories)
+	storage := newStorage()
+	operation := newOperation(index, config, constants, storage, support.NewTransactional[primitives.PostDispatchInfo](logger), io.NewHashing())
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionAsMultiThreshold1Index] = newCallAsMultiThreshold1(index, functionAsMultiThreshold1Index, operation)
+	functions[functionAsMultiIndex] = newCallAsMulti(index, functionAsMultiIndex, operation)
+	functions[functionApproveAsMultiIndex] = newCallApproveAsMulti(index, functionApproveAsMultiIndex, operation)
+	functions[functionCancelAsMultiIndex] = newCallCancelAsMulti(index, functionCancelAsMultiIndex, operation)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyMultisig, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdMultisigCalls := m.mdGenerator.BuildCallsMetadata("Multisig", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	mdConstants := metadataConstants{
+		DepositBase:    primitives.DepositBase{U128: m.constants.DepositBase},
+		DepositFactor:  primitives.DepositFactor{U128: m.constants.DepositFactor},
+		MaxSignatories: primitives.MaxSignatories{U32: m.constants.MaxSignatories},
+	}
+
+	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdMultisigCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdMultisigCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Multisig, Runtime>"),
+				},
+				m.Index,
+				"Call.Multisig"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMultisigEvent, "pallet_multisig::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Multisig"),
+		),
+		Constants: moduleMdConstants,
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesMultisigErrors),
+				},
+				m.Index,
+				"Errors.Multisig"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesMultisigTimepoint, "Timepoint", sc.Sequence[sc.Str]{"pallet_multisig", "Timepoint"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "height", "BlockNumber"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+			},
+		)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesMultisig, "Multisig", sc.Sequence[sc.Str]{"pallet_multisig", "Multisig"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "when", "Timepoint<BlockNumber>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "deposit", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "depositor", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "approvals", "BoundedVec<AccountId, MaxApprovals>"),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesTupleAddress32H256, "(AccountId, H256)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesAddress32), sc.ToCompact(metadata.TypesH256)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesMultisigEvent, "pallet_multisig pallet Event", sc.Sequence[sc.Str]{"pallet_multisig", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"NewMultisig",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "approving", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHash"),
+					},
+					EventNewMultisig,
+					"Events.NewMultisig"),
+				primitives.NewMetadataDefinitionVariant(
+					"MultisigApproval",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "approving", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHash"),
+					},
+					EventMultisigApproval,
+					"Events.MultisigApproval"),
+				primitives.NewMetadataDefinitionVariant(
+					"MultisigExecuted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "approving", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHash"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTuple, "result", "DispatchResult"),
+					},
+					EventMultisigExecuted,
+					"Events.MultisigExecuted"),
+				primitives.NewMetadataDefinitionVariant(
+					"MultisigCancelled",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "cancelling", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHash"),
+					},
+					EventMultisigCancelled,
+					"Events.MultisigCancelled"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesMultisigErrors,
+			"pallet_multisig pallet Error",
+			sc.Sequence[sc.Str]{"pallet_multisig", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("MinimumThreshold", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorMinimumThreshold, "Threshold must be 2 or greater."),
+					primitives.NewMetadataDefinitionVariant("AlreadyApproved", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorAlreadyApproved, "Call is already approved by this signatory."),
+					primitives.NewMetadataDefinitionVariant("NoApprovalsNeeded", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoApprovalsNeeded, "Call doesn't need any (more) approvals."),
+					primitives.NewMetadataDefinitionVariant("TooFewSignatories", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorTooFewSignatories, "There are too few signatories in the list."),
+					primitives.NewMetadataDefinitionVariant("TooManySignatories", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorTooManySignatories, "There are too many signatories in the list."),
+					primitives.NewMetadataDefinitionVariant("SignatoriesOutOfOrder", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorSignatoriesOutOfOrder, "The signatories were provided out of order; they should be ordered."),
+					primitives.NewMetadataDefinitionVariant("SenderInSignatories", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorSenderInSignatories, "The sender was contained in the other signatories; it shouldn't be."),
+					primitives.NewMetadataDefinitionVariant("NotFound", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotFound, "Multisig operation not found when attempting to cancel."),
+					primitives.NewMetadataDefinitionVariant("NotOwner", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotOwner, "Only the account that originally created the multisig is able to cancel it."),
+					primitives.NewMetadataDefinitionVariant("NoTimepoint", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoTimepoint, "No timepoint was given, yet the multisig operation is already underway."),
+					primitives.NewMetadataDefinitionVariant("WrongTimepoint", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorWrongTimepoint, "A different timepoint was given to the multisig operation that is underway."),
+					primitives.NewMetadataDefinitionVariant("UnexpectedTimepoint", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorUnexpectedTimepoint, "A timepoint was given, yet no multisig operation is underway."),
+					primitives.NewMetadataDefinitionVariant("MaxWeightTooLow", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorMaxWeightTooLow, "The maximum weight information provided was too low."),
+					primitives.NewMetadataDefinitionVariant("AlreadyStored", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorAlreadyStored, "The data to be stored is already stored."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Multisigs",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesTupleAddress32H256,
+					metadata.TypesMultisig,
+					support.NewHasherTwox64Concat(),
+					support.NewHasherBlake128Concat(),
+				),
+				"The set of open multisig operations."),
+		},
+	})
+}
@@ -0,0 +1,349 @@
+package multisig
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId       = 9
+	maxSignatories = 3
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	depositBase    = sc.NewU128(100)
+	depositFactor  = sc.NewU128(10)
+	blockNumber    = sc.U64(5)
+	extrinsicIndex = sc.U32(2)
+
+	whoAccountId   = constants.OneAccountId
+	otherAccountId = constants.TwoAccountId
+	multiAccountId = newTestAccountId(9)
+	callHash       = newTestH256(7)
+	timepoint      = Timepoint{Height: blockNumber, Index: extrinsicIndex}
+
+	callWeight      = primitives.WeightFromParts(1_000, 10)
+	maxWeight       = primitives.WeightFromParts(5_000, 50)
+	callArgs        = sc.NewVaryingData(sc.U8(1))
+	callBytes       = []byte{1, 2, 3}
+	callErr         = primitives.NewDispatchErrorCannotLookup()
+	expectedErr     = errors.New("error")
+	mdGenerator     = primitives.NewMetadataTypeGenerator()
+	logger          = log.NewLogger()
+	signedOrigin    = primitives.NewRawOriginSigned(whoAccountId)
+	multiOrigin     = primitives.NewRawOriginSigned(multiAccountId)
+	successPostInfo = primitives.PostDispatchInfo{}
+)
+
+var (
+	mockEventDepositor        *mocks.EventDepositor
+	mockCurrency              *mocks.ReservableCurrency
+	mockStorageMultisigs      *mocks.StorageDoubleMap[primitives.AccountId, primitives.H256, Multisig]
+	mockTransactional         *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockRuntimeDecoder        *mocks.RuntimeDecoder
+	mockHashing               *mocks.IoHashing
+	mockCall                  *mocks.Call
+	mockStorageBlockNumber    func() (sc.U64, error)
+	mockStorageExtrinsicIndex func() (sc.U32, error)
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 4, len(functions))
+	assert.Equal(t, sc.U8(functionAsMultiThreshold1Index), functions[functionAsMultiThreshold1Index].FunctionIndex())
+	assert.Equal(t, sc.U8(functionAsMultiIndex), functions[functionAsMultiIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionApproveAsMultiIndex), functions[functionApproveAsMultiIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionCancelAsMultiIndex), functions[functionCancelAsMultiIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedMultisigCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+	expectedOptionTimepointId := expectedMultisigCallsMetadataId + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedOptionTimepointId, "Option<Timepoint>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<Timepoint>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultisigTimepoint),
+					},
+					1,
+					"Option<Timepoint>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesMultisigTimepoint, "T"),
+		),
+		primitives.NewMetadataTypeWithParam(expectedMultisigCallsMetadataId, "Multisig calls", sc.Sequence[sc.Str]{"pallet_multisig", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"as_multi_threshold_1",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceAddress32),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionAsMultiThreshold1Index,
+					target.functions[functionAsMultiThreshold1Index].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"as_multi",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU16),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceAddress32),
+						primitives.NewMetadataTypeDefinitionField(expectedOptionTimepointId),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesWeight),
+					},
+					functionAsMultiIndex,
+					target.functions[functionAsMultiIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"approve_as_multi",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU16),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceAddress32),
+						primitives.NewMetadataTypeDefinitionField(expectedOptionTimepointId),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesH256),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesWeight),
+					},
+					functionApproveAsMultiIndex,
+					target.functions[functionApproveAsMultiIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"cancel_as_multi",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU16),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceAddress32),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultisigTimepoint),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesH256),
+					},
+					functionCancelAsMultiIndex,
+					target.functions[functionCancelAsMultiIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedMultisigCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedMultisigCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Multisig, Runtime>"),
+				},
+				moduleId,
+				"Call.Multisig"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMultisigEvent, "pallet_multisig::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Multisig"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"DepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(depositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating a multisig execution or to store a dispatch call for later.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"DepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(depositFactor.Bytes()),
+				"The amount of currency needed per unit threshold when creating a multisig execution.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxSignatories",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(maxSignatories).Bytes()),
+				"The maximum amount of signatories allowed in the multisig.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesMultisigErrors),
+				},
+				moduleId,
+				"Errors.Multisig"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Multisigs",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+						primitives.MetadataModuleStorageHashFuncMultiBlake128Concat,
+					},
+					sc.ToCompact(metadata.TypesTupleAddress32H256),
+					sc.ToCompact(metadata.TypesMultisig),
+				),
+				"The set of open multisig operations."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	config := NewConfig(dbWeight, mockEventDepositor, mockCurrency, depositBase, depositFactor, maxSignatories, mockStorageBlockNumber, mockStorageExtrinsicIndex)
+
+	return New(moduleId, config, mdGenerator, logger)
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockCurrency = new(mocks.ReservableCurrency)
+	mockStorageMultisigs = new(mocks.StorageDoubleMap[primitives.AccountId, primitives.H256, Multisig])
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockHashing = new(mocks.IoHashing)
+	mockCall = new(mocks.Call)
+	mockStorageBlockNumber = func() (sc.U64, error) { return blockNumber, nil }
+	mockStorageExtrinsicIndex = func() (sc.U32, error) { return extrinsicIndex, nil }
+}
+
+// setupOperation returns an operation, which uses the mocked storage, transactional and hashing.
+func setupOperation() operation {
+	setupMocks()
+
+	config := NewConfig(dbWeight, mockEventDepositor, mockCurrency, depositBase, depositFactor, maxSignatories, mockStorageBlockNumber, mockStorageExtrinsicIndex)
+	storage := &storage{Multisigs: mockStorageMultisigs}
+
+	return newOperation(moduleId, config, newConstants(dbWeight, depositBase, depositFactor, maxSignatories), storage, mockTransactional, mockHashing)
+}
+
+func setupCallDispatchInfo(call *mocks.Call, class primitives.DispatchClass) {
+	call.On("BaseWeight").Return(callWeight)
+	call.On("WeighData", callWeight).Return(callWeight)
+	call.On("ClassifyDispatch", callWeight).Return(class)
+	call.On("PaysFee", callWeight).Return(primitives.PaysYes)
+}
+
+// setupCallDispatch sets up a call, which is dispatched with the given origin and result.
+func setupCallDispatch(call *mocks.Call, origin primitives.RuntimeOrigin, err error) {
+	setupCallDispatchInfo(call, primitives.NewDispatchClassNormal())
+	call.On("Args").Return(callArgs)
+	call.On("Dispatch", origin, callArgs).Return(successPostInfo, err)
+}
+
+// setupCallBytes sets up the encoding of a call.
+func setupCallBytes(call *mocks.Call) {
+	call.On("Bytes").Return(callBytes)
+}
+
+// setupMultiAccountId sets up the derivation of the multi-account id of `who` and `otherAccountId`.
+func setupMultiAccountId(threshold sc.U16) {
+	mockHashing.On("Blake256", multiAccountEntropy(threshold)).Return(multiAccountId.Bytes())
+}
+
+// runInStorageLayer executes the function passed to the storage layer and returns the given error.
+func runInStorageLayer(err error) {
+	mockTransactional.On("WithStorageLayer", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+			fn()
+		}).
+		Return(primitives.PostDispatchInfo{}, err).
+		Once()
+}
+
+func multiAccountEntropy(threshold sc.U16) []byte {
+	entropy := append([]byte{}, multiAccountIdPrefix...)
+	entropy = append(entropy, sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId}.Bytes()...)
+	return append(entropy, threshold.Bytes()...)
+}
+
+func newTestAccountId(b byte) primitives.AccountId {
+	accountId, _ := primitives.NewAccountId(sc.BytesToSequenceU8(bytes.Repeat([]byte{b}, 32))...)
+	return accountId
+}
+
+func newTestH256(b byte) primitives.H256 {
+	h, _ := primitives.NewH256(sc.BytesToSequenceU8(bytes.Repeat([]byte{b}, 32))...)
+	return h
+}
@@ -0,0 +1,258 @@
+package multisig
+
+import (
+	"bytes"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// multiAccountIdPrefix is the prefix of the entropy of multi-account ids. It is the
+	// same as in `pallet_multisig`, so that the ids match the ones derived by polkadot.js.
+	multiAccountIdPrefix = []byte("modlpy/utilisuba")
+)
+
+// operation holds the dependencies and logic, shared by the calls of the module.
+type operation struct {
+	moduleId              sc.U8
+	constants             *consts
+	storage               *storage
+	eventDepositor        primitives.EventDepositor
+	currency              primitives.ReservableCurrency
+	storageBlockNumber    func() (sc.U64, error)
+	storageExtrinsicIndex func() (sc.U32, error)
+	transactional         support.Transactional[primitives.PostDispatchInfo]
+	hashing               io.Hashing
+}
+
+func newOperation(moduleId sc.U8, config *Config, constants *consts, storage *storage, transactional support.Transactional[primitives.PostDispatchInfo], hashing io.Hashing) operation {
+	return operation{
+		moduleId:              moduleId,
+		constants:             constants,
+		storage:               storage,
+		eventDepositor:        config.EventDepositor,
+		currency:              config.Currency,
+		storageBlockNumber:    config.StorageBlockNumber,
+		storageExtrinsicIndex: config.StorageExtrinsicIndex,
+		transactional:         transactional,
+		hashing:               hashing,
+	}
+}
+
+// operate registers the approval of `who` for the operation identified by `callHash`.
+//
+// If the operation does not exist, it is created and the deposit is reserved from `who`.
+// If the call is provided and the operation has enough approvals, including the one of `who`,
+// the call is dispatched from the multi-account and the operation is removed.
+func (o operation) operate(who primitives.AccountId, threshold sc.U16, otherSignatories sc.Sequence[primitives.AccountId], maybeTimepoint sc.Option[Timepoint], maybeCall sc.Option[primitives.RuntimeCall], callHash primitives.H256, maxWeight primitives.Weight) (primitives.PostDispatchInfo, error) {
+	if threshold < 2 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorMinimumThreshold(o.moduleId)
+	}
+
+	signatories, err := o.ensureSignatories(who, otherSignatories)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id, err := o.multiAccountId(signatories, threshold)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	signatoriesLen := sc.U64(len(otherSignatories))
+	callLen := sc.U64(0)
+	if maybeCall.HasValue {
+		callLen = sc.U64(len(maybeCall.Value.Bytes()))
+	}
+
+	if !o.storage.Multisigs.Exists(id, callHash) {
+		if maybeTimepoint.HasValue {
+			return primitives.PostDispatchInfo{}, NewDispatchErrorUnexpectedTimepoint(o.moduleId)
+		}
+
+		deposit := sc.SaturatingAddU128(o.constants.DepositBase, o.constants.DepositFactor.Mul(sc.NewU128(uint64(threshold))))
+		if err := o.currency.Reserve(who, deposit); err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+
+		when, err := o.timepoint()
+		if err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+
+		o.storage.Multisigs.Put(id, callHash, Multisig{
+			When:      when,
+			Deposit:   deposit,
+			Depositor: who,
+			Approvals: sc.Sequence[primitives.AccountId]{who},
+		})
+		o.eventDepositor.DepositEvent(newEventNewMultisig(o.moduleId, who, id, callHash))
+
+		weight := callApproveAsMultiCreateWeight(o.constants.DbWeight, signatoriesLen)
+		if maybeCall.HasValue {
+			weight = callAsMultiCreateWeight(o.constants.DbWeight, signatoriesLen, callLen)
+		}
+		return actualWeight(weight), nil
+	}
+
+	multisig, err := o.storage.Multisigs.Get(id, callHash)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	if !maybeTimepoint.HasValue {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNoTimepoint(o.moduleId)
+	}
+	timepoint := maybeTimepoint.Value
+	if multisig.When != timepoint {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorWrongTimepoint(o.moduleId)
+	}
+
+	position, approved := searchApproval(multisig.Approvals, who)
+	approvals := len(multisig.Approvals)
+	if !approved {
+		approvals++
+	}
+
+	if maybeCall.HasValue && approvals >= int(threshold) {
+		call := maybeCall.Value
+
+		dispatchInfo := primitives.GetDispatchInfo(call)
+		if dispatchInfo.Weight.AnyGt(maxWeight) {
+			return primitives.PostDispatchInfo{}, NewDispatchErrorMaxWeightTooLow(o.moduleId)
+		}
+
+		o.storage.Multisigs.Remove(id, callHash)
+		if _, err := o.currency.Unreserve(multisig.Depositor, multisig.Deposit); err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+
+		postInfo, dispatchErr := o.dispatchWithStorageLayer(call, primitives.NewRawOriginSigned(id))
+		result, err := primitives.NewDispatchOutcomeFromError(dispatchErr)
+		if err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+		o.eventDepositor.DepositEvent(newEventMultisigExecuted(o.moduleId, who, timepoint, id, callHash, result))
+
+		weight := callAsMultiCompleteWeight(o.constants.DbWeight, signatoriesLen, callLen).
+			SaturatingAdd(postInfo.CalcActualWeight(&dispatchInfo))
+		return actualWeight(weight), nil
+	}
+
+	if approved {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorAlreadyApproved(o.moduleId)
+	}
+
+	multisig.Approvals = append(multisig.Approvals[:position], append(sc.Sequence[primitives.AccountId]{who}, multisig.Approvals[position:]...)...)
+	o.storage.Multisigs.Put(id, callHash, multisig)
+	o.eventDepositor.DepositEvent(newEventMultisigApproval(o.moduleId, who, timepoint, id, callHash))
+
+	weight := callApproveAsMultiApproveWeight(o.constants.DbWeight, signatoriesLen)
+	if maybeCall.HasValue {
+		weight = callAsMultiApproveWeight(o.constants.DbWeight, signatoriesLen, callLen)
+	}
+	return actualWeight(weight), nil
+}
+
+// ensureSignatories checks the number of the other signatories and returns all signatories,
+// including `who`, in sorted order.
+func (o operation) ensureSignatories(who primitives.AccountId, otherSignatories sc.Sequence[primitives.AccountId]) (sc.Sequence[primitives.AccountId], error) {
+	if len(otherSignatories) == 0 {
+		return nil, NewDispatchErrorTooFewSignatories(o.moduleId)
+	}
+	if sc.U32(len(otherSignatories)) >= o.constants.MaxSignatories {
+		return nil, NewDispatchErrorTooManySignatories(o.moduleId)
+	}
+
+	return o.ensureSortedAndInsert(otherSignatories, who)
+}
+
+// ensureSortedAndInsert checks that the signatories are sorted and do not contain `who`,
+// and inserts `who` at its sorted position.
+func (o operation) ensureSortedAndInsert(otherSignatories sc.Sequence[primitives.AccountId], who primitives.AccountId) (sc.Sequence[primitives.AccountId], error) {
+	index := 0
+	for i, signatory := range otherSignatories {
+		if i > 0 && bytes.Compare(otherSignatories[i-1].Bytes(), signatory.Bytes()) >= 0 {
+			return nil, NewDispatchErrorSignatoriesOutOfOrder(o.moduleId)
+		}
+
+		cmp := bytes.Compare(signatory.Bytes(), who.Bytes())
+		if cmp == 0 {
+			return nil, NewDispatchErrorSenderInSignatories(o.moduleId)
+		}
+		if cmp < 0 {
+			index++
+		}
+	}
+
+	signatories := make(sc.Sequence[primitives.AccountId], 0, len(otherSignatories)+1)
+	signatories = append(signatories, otherSignatories[:index]...)
+	signatories = append(signatories, who)
+	signatories = append(signatories, otherSignatories[index:]...)
+
+	return signatories, nil
+}
+
+// multiAccountId derives the multi-account id of the sorted signatories and the threshold.
+func (o operation) multiAccountId(signatories sc.Sequence[primitives.AccountId], threshold sc.U16) (primitives.AccountId, error) {
+	entropy := append([]byte{}, multiAccountIdPrefix...)
+	entropy = append(entropy, signatories.Bytes()...)
+	entropy = append(entropy, threshold.Bytes()...)
+
+	return primitives.NewAccountId(sc.BytesToSequenceU8(o.hashing.Blake256(entropy))...)
+}
+
+// callHash returns the hash, under which an operation of the call is stored.
+func (o operation) callHash(call primitives.Call) (primitives.H256, error) {
+	return primitives.NewH256(sc.BytesToSequenceU8(o.hashing.Blake256(call.Bytes()))...)
+}
+
+// timepoint returns the timepoint of the currently executed extrinsic.
+func (o operation) timepoint() (Timepoint, error) {
+	height, err := o.storageBlockNumber()
+	if err != nil {
+		return Timepoint{}, err
+	}
+	index, err := o.storageExtrinsicIndex()
+	if err != nil {
+		return Timepoint{}, err
+	}
+	return Timepoint{
+		Height: height,
+		Index:  index,
+	}, nil
+}
+
+// dispatchWithStorageLayer dispatches the call in a new storage layer. The post dispatch
+// info of the call is returned even if the call fails and the storage layer is rolled back.
+func (o operation) dispatchWithStorageLayer(call primitives.Call, origin primitives.RuntimeOrigin) (primitives.PostDispatchInfo, error) {
+	postInfo := primitives.PostDispatchInfo{}
+
+	_, err := o.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		var dispatchErr error
+		postInfo, dispatchErr = call.Dispatch(origin, call.Args())
+		return postInfo, dispatchErr
+	})
+
+	return postInfo, err
+}
+
+// searchApproval returns the position of `who` in the sorted approvals and whether it is found.
+func searchApproval(approvals sc.Sequence[primitives.AccountId], who primitives.AccountId) (int, bool) {
+	position := sort.Search(len(approvals), func(i int) bool {
+		return bytes.Compare(approvals[i].Bytes(), who.Bytes()) >= 0
+	})
+
+	return position, position < len(approvals) && bytes.Equal(approvals[position].Bytes(), who.Bytes())
+}
+
+func actualWeight(weight primitives.Weight) primitives.PostDispatchInfo {
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](weight),
+		PaysFee:      primitives.PaysYes,
+	}
+}
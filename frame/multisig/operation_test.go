@@ -0,0 +1,344 @@
+package multisig
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	threshold       = sc.U16(2)
+	signatoriesLen  = sc.U64(1)
+	callLen         = sc.U64(len(callBytes))
+	deposit         = sc.NewU128(120)
+	thirdAccountId  = newTestAccountId(3)
+	otherSignatory  = sc.Sequence[primitives.AccountId]{otherAccountId}
+	noTimepoint     = sc.NewOption[Timepoint](nil)
+	someTimepoint   = sc.NewOption[Timepoint](timepoint)
+	noCall          = sc.NewOption[primitives.RuntimeCall](nil)
+	existingPending = Multisig{
+		When:      timepoint,
+		Deposit:   deposit,
+		Depositor: otherAccountId,
+		Approvals: sc.Sequence[primitives.AccountId]{otherAccountId},
+	}
+)
+
+func Test_Operation_operate_Create(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageMultisigs.On("Put", multiAccountId, callHash, Multisig{
+		When:      timepoint,
+		Deposit:   deposit,
+		Depositor: whoAccountId,
+		Approvals: sc.Sequence[primitives.AccountId]{whoAccountId},
+	}).Return()
+	mockEventDepositor.On("DepositEvent", newEventNewMultisig(moduleId, whoAccountId, multiAccountId, callHash)).Return()
+
+	result, err := target.operate(whoAccountId, threshold, otherSignatory, noTimepoint, noCall, callHash, maxWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(callApproveAsMultiCreateWeight(dbWeight, signatoriesLen)), result)
+	mockCurrency.AssertCalled(t, "Reserve", whoAccountId, deposit)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventNewMultisig(moduleId, whoAccountId, multiAccountId, callHash))
+}
+
+func Test_Operation_operate_Create_WithCall(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+	setupCallBytes(mockCall)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageMultisigs.On("Put", multiAccountId, callHash, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	result, err := target.operate(whoAccountId, threshold, otherSignatory, noTimepoint, sc.NewOption[primitives.RuntimeCall](primitives.NewRuntimeCall(mockCall)), callHash, maxWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(callAsMultiCreateWeight(dbWeight, signatoriesLen, callLen)), result)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Operation_operate_Create_UnexpectedTimepoint(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, someTimepoint, noCall, callHash, maxWeight)
+
+	assert.Equal(t, NewDispatchErrorUnexpectedTimepoint(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Operation_operate_Create_ReserveFails(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(expectedErr)
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, noTimepoint, noCall, callHash, maxWeight)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageMultisigs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Operation_operate_MinimumThreshold(t *testing.T) {
+	target := setupOperation()
+
+	_, err := target.operate(whoAccountId, 1, otherSignatory, noTimepoint, noCall, callHash, maxWeight)
+
+	assert.Equal(t, NewDispatchErrorMinimumThreshold(moduleId), err)
+}
+
+func Test_Operation_operate_Approve(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(3)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+	mockStorageMultisigs.On("Put", multiAccountId, callHash, Multisig{
+		When:      timepoint,
+		Deposit:   deposit,
+		Depositor: otherAccountId,
+		Approvals: sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId},
+	}).Return()
+	mockEventDepositor.On("DepositEvent", newEventMultisigApproval(moduleId, whoAccountId, timepoint, multiAccountId, callHash)).Return()
+
+	result, err := target.operate(whoAccountId, 3, otherSignatory, someTimepoint, noCall, callHash, maxWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(callApproveAsMultiApproveWeight(dbWeight, signatoriesLen)), result)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMultisigApproval(moduleId, whoAccountId, timepoint, multiAccountId, callHash))
+}
+
+func Test_Operation_operate_Approve_WithoutCall_AtThreshold(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+	mockStorageMultisigs.On("Put", multiAccountId, callHash, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, someTimepoint, noCall, callHash, maxWeight)
+
+	assert.Nil(t, err)
+	mockStorageMultisigs.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMultisigApproval(moduleId, whoAccountId, timepoint, multiAccountId, callHash))
+}
+
+func Test_Operation_operate_AlreadyApproved(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	approved := existingPending
+	approved.Approvals = sc.Sequence[primitives.AccountId]{whoAccountId}
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(approved, nil)
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, someTimepoint, noCall, callHash, maxWeight)
+
+	assert.Equal(t, NewDispatchErrorAlreadyApproved(moduleId), err)
+}
+
+func Test_Operation_operate_NoTimepoint(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, noTimepoint, noCall, callHash, maxWeight)
+
+	assert.Equal(t, NewDispatchErrorNoTimepoint(moduleId), err)
+}
+
+func Test_Operation_operate_WrongTimepoint(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, sc.NewOption[Timepoint](Timepoint{Height: 1, Index: 1}), noCall, callHash, maxWeight)
+
+	assert.Equal(t, NewDispatchErrorWrongTimepoint(moduleId), err)
+}
+
+func Test_Operation_operate_Execute(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+	setupCallBytes(mockCall)
+	setupCallDispatch(mockCall, multiOrigin, nil)
+	runInStorageLayer(nil)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(nil)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+	mockStorageMultisigs.On("Remove", multiAccountId, callHash).Return()
+	mockCurrency.On("Unreserve", otherAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockEventDepositor.On("DepositEvent", newEventMultisigExecuted(moduleId, whoAccountId, timepoint, multiAccountId, callHash, expectedResult)).Return()
+
+	result, err := target.operate(whoAccountId, threshold, otherSignatory, someTimepoint, sc.NewOption[primitives.RuntimeCall](primitives.NewRuntimeCall(mockCall)), callHash, maxWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, actualWeight(callAsMultiCompleteWeight(dbWeight, signatoriesLen, callLen).SaturatingAdd(callWeight)), result)
+	mockStorageMultisigs.AssertCalled(t, "Remove", multiAccountId, callHash)
+	mockCurrency.AssertCalled(t, "Unreserve", otherAccountId, deposit)
+	mockCall.AssertCalled(t, "Dispatch", multiOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMultisigExecuted(moduleId, whoAccountId, timepoint, multiAccountId, callHash, expectedResult))
+}
+
+func Test_Operation_operate_Execute_CallFails(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+	setupCallBytes(mockCall)
+	setupCallDispatch(mockCall, multiOrigin, callErr)
+	runInStorageLayer(callErr)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(callErr)
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+	mockStorageMultisigs.On("Remove", multiAccountId, callHash).Return()
+	mockCurrency.On("Unreserve", otherAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockEventDepositor.On("DepositEvent", newEventMultisigExecuted(moduleId, whoAccountId, timepoint, multiAccountId, callHash, expectedResult)).Return()
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, someTimepoint, sc.NewOption[primitives.RuntimeCall](primitives.NewRuntimeCall(mockCall)), callHash, maxWeight)
+
+	assert.Nil(t, err)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventMultisigExecuted(moduleId, whoAccountId, timepoint, multiAccountId, callHash, expectedResult))
+}
+
+func Test_Operation_operate_Execute_MaxWeightTooLow(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+	setupCallBytes(mockCall)
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+
+	mockStorageMultisigs.On("Exists", multiAccountId, callHash).Return(true)
+	mockStorageMultisigs.On("Get", multiAccountId, callHash).Return(existingPending, nil)
+
+	_, err := target.operate(whoAccountId, threshold, otherSignatory, someTimepoint, sc.NewOption[primitives.RuntimeCall](primitives.NewRuntimeCall(mockCall)), callHash, primitives.WeightFromParts(1, 1))
+
+	assert.Equal(t, NewDispatchErrorMaxWeightTooLow(moduleId), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+}
+
+func Test_Operation_ensureSignatories_TooFewSignatories(t *testing.T) {
+	target := setupOperation()
+
+	_, err := target.ensureSignatories(whoAccountId, sc.Sequence[primitives.AccountId]{})
+
+	assert.Equal(t, NewDispatchErrorTooFewSignatories(moduleId), err)
+}
+
+func Test_Operation_ensureSignatories_TooManySignatories(t *testing.T) {
+	target := setupOperation()
+
+	_, err := target.ensureSignatories(whoAccountId, sc.Sequence[primitives.AccountId]{otherAccountId, thirdAccountId, multiAccountId})
+
+	assert.Equal(t, NewDispatchErrorTooManySignatories(moduleId), err)
+}
+
+func Test_Operation_ensureSortedAndInsert(t *testing.T) {
+	target := setupOperation()
+
+	result, err := target.ensureSortedAndInsert(sc.Sequence[primitives.AccountId]{whoAccountId, multiAccountId}, thirdAccountId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Sequence[primitives.AccountId]{whoAccountId, thirdAccountId, multiAccountId}, result)
+}
+
+func Test_Operation_ensureSortedAndInsert_First(t *testing.T) {
+	target := setupOperation()
+
+	result, err := target.ensureSortedAndInsert(sc.Sequence[primitives.AccountId]{otherAccountId, thirdAccountId}, whoAccountId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId, thirdAccountId}, result)
+}
+
+func Test_Operation_ensureSortedAndInsert_OutOfOrder(t *testing.T) {
+	target := setupOperation()
+
+	_, err := target.ensureSortedAndInsert(sc.Sequence[primitives.AccountId]{thirdAccountId, otherAccountId}, whoAccountId)
+
+	assert.Equal(t, NewDispatchErrorSignatoriesOutOfOrder(moduleId), err)
+}
+
+func Test_Operation_ensureSortedAndInsert_SenderInSignatories(t *testing.T) {
+	target := setupOperation()
+
+	_, err := target.ensureSortedAndInsert(sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId}, whoAccountId)
+
+	assert.Equal(t, NewDispatchErrorSenderInSignatories(moduleId), err)
+}
+
+func Test_Operation_multiAccountId(t *testing.T) {
+	target := setupOperation()
+	setupMultiAccountId(threshold)
+
+	result, err := target.multiAccountId(sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId}, threshold)
+
+	assert.Nil(t, err)
+	assert.Equal(t, multiAccountId, result)
+	mockHashing.AssertCalled(t, "Blake256", multiAccountEntropy(threshold))
+}
+
+func Test_Operation_callHash(t *testing.T) {
+	target := setupOperation()
+	setupCallBytes(mockCall)
+
+	mockHashing.On("Blake256", callBytes).Return(callHash.Bytes())
+
+	result, err := target.callHash(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, callHash, result)
+}
+
+func Test_Operation_timepoint(t *testing.T) {
+	target := setupOperation()
+
+	result, err := target.timepoint()
+
+	assert.Nil(t, err)
+	assert.Equal(t, timepoint, result)
+}
+
+func Test_Operation_timepoint_Error(t *testing.T) {
+	target := setupOperation()
+	target.storageExtrinsicIndex = func() (sc.U32, error) { return 0, expectedErr }
+
+	_, err := target.timepoint()
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_searchApproval(t *testing.T) {
+	approvals := sc.Sequence[primitives.AccountId]{whoAccountId, thirdAccountId}
+
+	position, found := searchApproval(approvals, thirdAccountId)
+	assert.Equal(t, 1, position)
+	assert.True(t, found)
+
+	position, found = searchApproval(approvals, otherAccountId)
+	assert.Equal(t, 1, position)
+	assert.False(t, found)
+
+	position, found = searchApproval(approvals, multiAccountId)
+	assert.Equal(t, 2, position)
+	assert.False(t, found)
+}
@@ -0,0 +1,21 @@
+package multisig
+
+import (
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyMultisig  = []byte("Multisig")
+	keyMultisigs = []byte("Multisigs")
+)
+
+type storage struct {
+	Multisigs support.StorageDoubleMap[primitives.AccountId, primitives.H256, Multisig]
+}
+
+func newStorage() *storage {
+	return &storage{
+		Multisigs: support.NewHashStorageDoubleMap[primitives.AccountId, primitives.H256, Multisig](keyMultisig, keyMultisigs, support.NewHasherTwox64Concat(), support.NewHasherBlake128Concat(), primitives.DecodeH256, DecodeMultisig),
+	}
+}
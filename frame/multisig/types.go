@@ -0,0 +1,93 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Timepoint is a global extrinsic index, formed as the extrinsic index within a block, together with
+// the block number. It is used to uniquely identify the extrinsic, which created a multisig operation.
+type Timepoint struct {
+	// Height is the block number, in which the extrinsic was included.
+	Height sc.U64
+	// Index is the index of the extrinsic within the block.
+	Index sc.U32
+}
+
+func (tp Timepoint) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		tp.Height,
+		tp.Index,
+	)
+}
+
+func DecodeTimepoint(buffer *bytes.Buffer) (Timepoint, error) {
+	height, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Timepoint{}, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Timepoint{}, err
+	}
+	return Timepoint{
+		Height: height,
+		Index:  index,
+	}, nil
+}
+
+func (tp Timepoint) Bytes() []byte {
+	return sc.EncodedBytes(tp)
+}
+
+// Multisig is an open multisig operation.
+type Multisig struct {
+	// When is the timepoint of the extrinsic, which opened the operation.
+	When Timepoint
+	// Deposit is the amount held in reserve of the depositor, to be returned once the operation ends.
+	Deposit primitives.Balance
+	// Depositor is the account, which opened the operation.
+	Depositor primitives.AccountId
+	// Approvals are the signatories, which have approved the operation, in sorted order.
+	Approvals sc.Sequence[primitives.AccountId]
+}
+
+func (m Multisig) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		m.When,
+		m.Deposit,
+		m.Depositor,
+		m.Approvals,
+	)
+}
+
+func DecodeMultisig(buffer *bytes.Buffer) (Multisig, error) {
+	when, err := DecodeTimepoint(buffer)
+	if err != nil {
+		return Multisig{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Multisig{}, err
+	}
+	depositor, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return Multisig{}, err
+	}
+	approvals, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	if err != nil {
+		return Multisig{}, err
+	}
+	return Multisig{
+		When:      when,
+		Deposit:   deposit,
+		Depositor: depositor,
+		Approvals: approvals,
+	}, nil
+}
+
+func (m Multisig) Bytes() []byte {
+	return sc.EncodedBytes(m)
+}
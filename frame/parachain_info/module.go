@@ -3,25 +3,29 @@ package parachain_info
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 const (
-	name = "ParachainInfo"
+	name           = "ParachainInfo"
+	storageVersion = sc.U16(0)
 )
 
 type Module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	index   sc.U8
 	storage *storage
 }
 
 func New(index sc.U8) Module {
 	return Module{
-		index:   index,
-		storage: newStorage(),
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyAura, storageVersion),
+		index:                index,
+		storage:              newStorage(),
 	}
 }
 
@@ -0,0 +1,98 @@
+package preimage
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// BoundedLegacy is a reference to a preimage by its hash only. The length is looked up in the request status.
+	BoundedLegacy sc.U8 = iota
+	// BoundedInline holds the encoded call itself.
+	BoundedInline
+	// BoundedLookup is a reference to a preimage by its hash and length.
+	BoundedLookup
+)
+
+const (
+	// MaxInlineLen is the maximum length of an encoded call, which is kept inline in a Bounded.
+	// Longer calls are noted as preimages and referenced by their hash.
+	MaxInlineLen = 128
+)
+
+var (
+	errInvalidBoundedType = errors.New("invalid preimage.Bounded type")
+)
+
+// Bounded is an encoded call of bounded size. Short calls are kept inline, while longer ones are stored
+// as preimages and referenced by their hash, so that modules can store calls of any size in their storage.
+type Bounded struct {
+	sc.VaryingData
+}
+
+func NewBoundedLegacy(hash primitives.H256) Bounded {
+	return Bounded{sc.NewVaryingData(BoundedLegacy, hash)}
+}
+
+func NewBoundedInline(data sc.Sequence[sc.U8]) Bounded {
+	return Bounded{sc.NewVaryingData(BoundedInline, data)}
+}
+
+func NewBoundedLookup(hash primitives.H256, length sc.U32) Bounded {
+	return Bounded{sc.NewVaryingData(BoundedLookup, hash, length)}
+}
+
+func DecodeBounded(buffer *bytes.Buffer) (Bounded, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Bounded{}, err
+	}
+
+	switch b {
+	case BoundedLegacy:
+		hash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return Bounded{}, err
+		}
+		return NewBoundedLegacy(hash), nil
+	case BoundedInline:
+		data, err := sc.DecodeSequence[sc.U8](buffer)
+		if err != nil {
+			return Bounded{}, err
+		}
+		return NewBoundedInline(data), nil
+	case BoundedLookup:
+		hash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return Bounded{}, err
+		}
+		length, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return Bounded{}, err
+		}
+		return NewBoundedLookup(hash, length), nil
+	default:
+		return Bounded{}, errInvalidBoundedType
+	}
+}
+
+// IsInline returns whether the call is held inline.
+func (b Bounded) IsInline() bool {
+	return b.VaryingData[0] == BoundedInline
+}
+
+// Lookup returns the hash of the referenced preimage and its length, if known.
+// Inline calls do not reference a preimage.
+func (b Bounded) Lookup() (primitives.H256, sc.Option[sc.U32], bool) {
+	switch b.VaryingData[0] {
+	case BoundedLegacy:
+		return b.VaryingData[1].(primitives.H256), sc.NewOption[sc.U32](nil), true
+	case BoundedLookup:
+		return b.VaryingData[1].(primitives.H256), sc.NewOption[sc.U32](b.VaryingData[2].(sc.U32)), true
+	default:
+		return primitives.H256{}, sc.NewOption[sc.U32](nil), false
+	}
+}
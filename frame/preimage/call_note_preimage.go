@@ -0,0 +1,105 @@
+package preimage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Register a preimage on-chain.
+// If the preimage was previously requested, no fees or deposits are taken for providing
+// the preimage. Otherwise, a deposit is taken proportional to the size of the preimage.
+// The dispatch origin for this call must be `Signed` or `Root`. Preimages noted by `Root` take no deposit.
+type callNotePreimage struct {
+	primitives.Callable
+	preimages
+}
+
+func newCallNotePreimage(moduleId sc.U8, functionId sc.U8, preimages preimages) primitives.Call {
+	call := callNotePreimage{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[sc.U8]{}),
+		},
+		preimages: preimages,
+	}
+
+	return call
+}
+
+func (c callNotePreimage) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	preimage, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(preimage)
+	return c, nil
+}
+
+func (c callNotePreimage) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callNotePreimage) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callNotePreimage) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callNotePreimage) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callNotePreimage) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callNotePreimage) BaseWeight() primitives.Weight {
+	preimage := c.Arguments[0].(sc.Sequence[sc.U8])
+	return callNotePreimageWeight(c.constants.DbWeight, sc.U64(len(preimage)))
+}
+
+func (_ callNotePreimage) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callNotePreimage) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callNotePreimage) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callNotePreimage) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	maybeDepositor := sc.NewOption[primitives.AccountId](nil)
+	if origin.IsSignedOrigin() {
+		who, err := origin.AsSigned()
+		if err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+		maybeDepositor = sc.NewOption[primitives.AccountId](who)
+	} else if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	wasRequested, err := c.doNote(args[0].(sc.Sequence[sc.U8]), maybeDepositor)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	if wasRequested {
+		return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+	}
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysYes}, nil
+}
+
+func (_ callNotePreimage) Docs() string {
+	return "Register a preimage on-chain. " +
+		"If the preimage was previously requested, no fees or deposits are taken for providing " +
+		"the preimage. Otherwise, a deposit is taken proportional to the size of the preimage."
+}
@@ -0,0 +1,156 @@
+package preimage
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_NotePreimage_New(t *testing.T) {
+	target := setupCallNotePreimage()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionNotePreimageIndex,
+		Arguments:  sc.NewVaryingData(sc.Sequence[sc.U8]{}),
+	}
+
+	assert.Equal(t, expected, target.(callNotePreimage).Callable)
+}
+
+func Test_Call_NotePreimage_DecodeArgs(t *testing.T) {
+	target := setupCallNotePreimage()
+	buffer := bytes.NewBuffer(preimage.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(preimage), call.Args())
+}
+
+func Test_Call_NotePreimage_Encode(t *testing.T) {
+	target := setupCallNotePreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(preimage.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionNotePreimageIndex}, preimage.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_NotePreimage_Bytes(t *testing.T) {
+	target := setupCallNotePreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(preimage.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionNotePreimageIndex}, preimage.Bytes()...), call.Bytes())
+}
+
+func Test_Call_NotePreimage_ModuleIndex(t *testing.T) {
+	target := setupCallNotePreimage()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_NotePreimage_FunctionIndex(t *testing.T) {
+	target := setupCallNotePreimage()
+
+	assert.Equal(t, sc.U8(functionNotePreimageIndex), target.FunctionIndex())
+}
+
+func Test_Call_NotePreimage_BaseWeight(t *testing.T) {
+	target := setupCallNotePreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(preimage.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, callNotePreimageWeight(dbWeight, sc.U64(preimageLen)), call.BaseWeight())
+}
+
+func Test_Call_NotePreimage_WeighData(t *testing.T) {
+	target := setupCallNotePreimage()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_NotePreimage_ClassifyDispatch(t *testing.T) {
+	target := setupCallNotePreimage()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_NotePreimage_PaysFee(t *testing.T) {
+	target := setupCallNotePreimage()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_NotePreimage_Dispatch_Signed(t *testing.T) {
+	target := setupCallNotePreimage()
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageStatusFor.On("Put", hash, unrequested).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(preimage))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysYes}, result)
+	mockCurrency.AssertCalled(t, "Reserve", whoAccountId, deposit)
+	mockStoragePreimageFor.AssertCalled(t, "Put", preimageKey, preimage)
+}
+
+func Test_Call_NotePreimage_Dispatch_Requested(t *testing.T) {
+	target := setupCallNotePreimage()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](nil),
+	})
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requested), nil)
+	mockStorageStatusFor.On("Put", hash, requestedOne).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(preimage))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_NotePreimage_Dispatch_Root(t *testing.T) {
+	target := setupCallNotePreimage()
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockStorageStatusFor.On("Put", hash, requestedOne).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.Dispatch(rootOrigin, sc.NewVaryingData(preimage))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_NotePreimage_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallNotePreimage()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(preimage))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallNotePreimage() primitives.Call {
+	return newCallNotePreimage(moduleId, functionNotePreimageIndex, setupPreimages())
+}
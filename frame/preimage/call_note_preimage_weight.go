@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkPreimageNotePreimage benchmark.
+
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callNotePreimageWeight(dbWeight primitives.RuntimeDbWeight, size sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(50000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(2000, 0).SaturatingMul(size)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
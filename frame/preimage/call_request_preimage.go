@@ -0,0 +1,87 @@
+package preimage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Request a preimage be uploaded to the chain without paying any fees or deposits.
+// The dispatch origin for this call must be `Root`.
+type callRequestPreimage struct {
+	primitives.Callable
+	preimages
+}
+
+func newCallRequestPreimage(moduleId sc.U8, functionId sc.U8, preimages preimages) primitives.Call {
+	call := callRequestPreimage{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.H256{}),
+		},
+		preimages: preimages,
+	}
+
+	return call
+}
+
+func (c callRequestPreimage) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	hash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(hash)
+	return c, nil
+}
+
+func (c callRequestPreimage) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRequestPreimage) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRequestPreimage) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callRequestPreimage) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callRequestPreimage) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callRequestPreimage) BaseWeight() primitives.Weight {
+	return callRequestPreimageWeight(c.constants.DbWeight)
+}
+
+func (_ callRequestPreimage) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRequestPreimage) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRequestPreimage) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callRequestPreimage) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doRequest(args[0].(primitives.H256))
+}
+
+func (_ callRequestPreimage) Docs() string {
+	return "Request a preimage be uploaded to the chain without paying any fees or deposits. " +
+		"If the preimage requests has already been provided on-chain, we unreserve any deposit " +
+		"a user may have paid, and take the control of the preimage out of their hands."
+}
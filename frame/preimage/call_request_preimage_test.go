@@ -0,0 +1,121 @@
+package preimage
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_RequestPreimage_New(t *testing.T) {
+	target := setupCallRequestPreimage()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionRequestPreimageIndex,
+		Arguments:  sc.NewVaryingData(primitives.H256{}),
+	}
+
+	assert.Equal(t, expected, target.(callRequestPreimage).Callable)
+}
+
+func Test_Call_RequestPreimage_DecodeArgs(t *testing.T) {
+	target := setupCallRequestPreimage()
+	buffer := bytes.NewBuffer(hash.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(hash), call.Args())
+}
+
+func Test_Call_RequestPreimage_Encode(t *testing.T) {
+	target := setupCallRequestPreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(hash.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionRequestPreimageIndex}, hash.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_RequestPreimage_Bytes(t *testing.T) {
+	target := setupCallRequestPreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(hash.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionRequestPreimageIndex}, hash.Bytes()...), call.Bytes())
+}
+
+func Test_Call_RequestPreimage_ModuleIndex(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_RequestPreimage_FunctionIndex(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	assert.Equal(t, sc.U8(functionRequestPreimageIndex), target.FunctionIndex())
+}
+
+func Test_Call_RequestPreimage_BaseWeight(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	assert.Equal(t, callRequestPreimageWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_RequestPreimage_WeighData(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_RequestPreimage_ClassifyDispatch(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_RequestPreimage_PaysFee(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_RequestPreimage_Dispatch(t *testing.T) {
+	target := setupCallRequestPreimage()
+	expected := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](nil),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockStorageStatusFor.On("Put", hash, expected).Return()
+	mockEventDepositor.On("DepositEvent", newEventRequested(moduleId, hash)).Return()
+
+	result, err := target.Dispatch(rootOrigin, sc.NewVaryingData(hash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageStatusFor.AssertCalled(t, "Put", hash, expected)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventRequested(moduleId, hash))
+}
+
+func Test_Call_RequestPreimage_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallRequestPreimage()
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(hash))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallRequestPreimage() primitives.Call {
+	return newCallRequestPreimage(moduleId, functionRequestPreimageIndex, setupPreimages())
+}
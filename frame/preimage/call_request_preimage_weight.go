@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkPreimageRequestPreimage benchmark.
+
+package preimage
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callRequestPreimageWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(20000000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,93 @@
+package preimage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Clear an unrequested preimage from the runtime storage.
+// The dispatch origin for this call must be `Signed` by the account, which noted the preimage, or `Root`.
+type callUnnotePreimage struct {
+	primitives.Callable
+	preimages
+}
+
+func newCallUnnotePreimage(moduleId sc.U8, functionId sc.U8, preimages preimages) primitives.Call {
+	call := callUnnotePreimage{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.H256{}),
+		},
+		preimages: preimages,
+	}
+
+	return call
+}
+
+func (c callUnnotePreimage) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	hash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(hash)
+	return c, nil
+}
+
+func (c callUnnotePreimage) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callUnnotePreimage) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callUnnotePreimage) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callUnnotePreimage) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callUnnotePreimage) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callUnnotePreimage) BaseWeight() primitives.Weight {
+	return callUnnotePreimageWeight(c.constants.DbWeight)
+}
+
+func (_ callUnnotePreimage) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callUnnotePreimage) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callUnnotePreimage) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callUnnotePreimage) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	maybeCheckOwner := sc.NewOption[primitives.AccountId](nil)
+	if origin.IsSignedOrigin() {
+		who, err := origin.AsSigned()
+		if err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+		maybeCheckOwner = sc.NewOption[primitives.AccountId](who)
+	} else if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doUnnote(args[0].(primitives.H256), maybeCheckOwner)
+}
+
+func (_ callUnnotePreimage) Docs() string {
+	return "Clear an unrequested preimage from the runtime storage. " +
+		"- `hash`: The hash of the preimage to be removed from the store."
+}
@@ -0,0 +1,142 @@
+package preimage
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_UnnotePreimage_New(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionUnnotePreimageIndex,
+		Arguments:  sc.NewVaryingData(primitives.H256{}),
+	}
+
+	assert.Equal(t, expected, target.(callUnnotePreimage).Callable)
+}
+
+func Test_Call_UnnotePreimage_DecodeArgs(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	buffer := bytes.NewBuffer(hash.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(hash), call.Args())
+}
+
+func Test_Call_UnnotePreimage_Encode(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(hash.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionUnnotePreimageIndex}, hash.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_UnnotePreimage_Bytes(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(hash.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionUnnotePreimageIndex}, hash.Bytes()...), call.Bytes())
+}
+
+func Test_Call_UnnotePreimage_ModuleIndex(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_UnnotePreimage_FunctionIndex(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	assert.Equal(t, sc.U8(functionUnnotePreimageIndex), target.FunctionIndex())
+}
+
+func Test_Call_UnnotePreimage_BaseWeight(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	assert.Equal(t, callUnnotePreimageWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_UnnotePreimage_WeighData(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_UnnotePreimage_ClassifyDispatch(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_UnnotePreimage_PaysFee(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_UnnotePreimage_Dispatch(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(hash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", whoAccountId, deposit)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventCleared(moduleId, hash))
+}
+
+func Test_Call_UnnotePreimage_Dispatch_Root(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	_, err := target.Dispatch(rootOrigin, sc.NewVaryingData(hash))
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "Unreserve", whoAccountId, deposit)
+}
+
+func Test_Call_UnnotePreimage_Dispatch_NotAuthorized(t *testing.T) {
+	target := setupCallUnnotePreimage()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(otherAccountId), sc.NewVaryingData(hash))
+
+	assert.Equal(t, NewDispatchErrorNotAuthorized(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_UnnotePreimage_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallUnnotePreimage()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(hash))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallUnnotePreimage() primitives.Call {
+	return newCallUnnotePreimage(moduleId, functionUnnotePreimageIndex, setupPreimages())
+}
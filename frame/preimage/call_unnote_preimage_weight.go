@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkPreimageUnnotePreimage benchmark.
+
+package preimage
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callUnnotePreimageWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(60000000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
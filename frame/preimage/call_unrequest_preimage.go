@@ -0,0 +1,86 @@
+package preimage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Clear a previously made request for a preimage.
+// The dispatch origin for this call must be `Root`.
+type callUnrequestPreimage struct {
+	primitives.Callable
+	preimages
+}
+
+func newCallUnrequestPreimage(moduleId sc.U8, functionId sc.U8, preimages preimages) primitives.Call {
+	call := callUnrequestPreimage{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.H256{}),
+		},
+		preimages: preimages,
+	}
+
+	return call
+}
+
+func (c callUnrequestPreimage) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	hash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(hash)
+	return c, nil
+}
+
+func (c callUnrequestPreimage) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callUnrequestPreimage) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callUnrequestPreimage) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callUnrequestPreimage) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callUnrequestPreimage) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callUnrequestPreimage) BaseWeight() primitives.Weight {
+	return callUnrequestPreimageWeight(c.constants.DbWeight)
+}
+
+func (_ callUnrequestPreimage) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callUnrequestPreimage) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callUnrequestPreimage) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callUnrequestPreimage) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doUnrequest(args[0].(primitives.H256))
+}
+
+func (_ callUnrequestPreimage) Docs() string {
+	return "Clear a previously made request for a preimage. " +
+		"NOTE: THIS MUST NOT BE CALLED ON `hash` MORE TIMES THAN `request_preimage`."
+}
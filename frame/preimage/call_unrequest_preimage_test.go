@@ -0,0 +1,126 @@
+package preimage
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_UnrequestPreimage_New(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionUnrequestPreimageIndex,
+		Arguments:  sc.NewVaryingData(primitives.H256{}),
+	}
+
+	assert.Equal(t, expected, target.(callUnrequestPreimage).Callable)
+}
+
+func Test_Call_UnrequestPreimage_DecodeArgs(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+	buffer := bytes.NewBuffer(hash.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(hash), call.Args())
+}
+
+func Test_Call_UnrequestPreimage_Encode(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(hash.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionUnrequestPreimageIndex}, hash.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_UnrequestPreimage_Bytes(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+	call, err := target.DecodeArgs(bytes.NewBuffer(hash.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionUnrequestPreimageIndex}, hash.Bytes()...), call.Bytes())
+}
+
+func Test_Call_UnrequestPreimage_ModuleIndex(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_UnrequestPreimage_FunctionIndex(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	assert.Equal(t, sc.U8(functionUnrequestPreimageIndex), target.FunctionIndex())
+}
+
+func Test_Call_UnrequestPreimage_BaseWeight(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	assert.Equal(t, callUnrequestPreimageWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_UnrequestPreimage_WeighData(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_UnrequestPreimage_ClassifyDispatch(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_UnrequestPreimage_PaysFee(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_UnrequestPreimage_Dispatch(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	result, err := target.Dispatch(rootOrigin, sc.NewVaryingData(hash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStoragePreimageFor.AssertCalled(t, "Remove", preimageKey)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventCleared(moduleId, hash))
+}
+
+func Test_Call_UnrequestPreimage_Dispatch_NotRequested(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+
+	_, err := target.Dispatch(rootOrigin, sc.NewVaryingData(hash))
+
+	assert.Equal(t, NewDispatchErrorNotRequested(moduleId), err)
+}
+
+func Test_Call_UnrequestPreimage_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallUnrequestPreimage()
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(hash))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallUnrequestPreimage() primitives.Call {
+	return newCallUnrequestPreimage(moduleId, functionUnrequestPreimageIndex, setupPreimages())
+}
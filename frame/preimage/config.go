@@ -0,0 +1,27 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight       primitives.RuntimeDbWeight
+	EventDepositor primitives.EventDepositor
+	Currency       primitives.ReservableCurrency
+	BaseDeposit    sc.U128
+	ByteDeposit    sc.U128
+	// CallDecoder decodes the calls, referenced by a Bounded.
+	CallDecoder primitives.CallDecoder
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, currency primitives.ReservableCurrency, baseDeposit sc.U128, byteDeposit sc.U128, callDecoder primitives.CallDecoder) *Config {
+	return &Config{
+		DbWeight:       dbWeight,
+		EventDepositor: eventDepositor,
+		Currency:       currency,
+		BaseDeposit:    baseDeposit,
+		ByteDeposit:    byteDeposit,
+		CallDecoder:    callDecoder,
+	}
+}
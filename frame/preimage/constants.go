@@ -0,0 +1,25 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// MaxSize is the maximum length of a preimage.
+	MaxSize = 4 * 1024 * 1024
+)
+
+type consts struct {
+	DbWeight    primitives.RuntimeDbWeight
+	BaseDeposit sc.U128
+	ByteDeposit sc.U128
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, baseDeposit sc.U128, byteDeposit sc.U128) *consts {
+	return &consts{
+		DbWeight:    dbWeight,
+		BaseDeposit: baseDeposit,
+		ByteDeposit: byteDeposit,
+	}
+}
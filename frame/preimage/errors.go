@@ -0,0 +1,64 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Preimage module errors.
+const (
+	ErrorTooBig sc.U8 = iota
+	ErrorAlreadyNoted
+	ErrorNotAuthorized
+	ErrorNotNoted
+	ErrorRequested
+	ErrorNotRequested
+)
+
+func NewDispatchErrorTooBig(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooBig),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorAlreadyNoted(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorAlreadyNoted),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotAuthorized(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotAuthorized),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotNoted(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotNoted),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorRequested(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorRequested),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+func NewDispatchErrorNotRequested(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorNotRequested),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,71 @@
+package preimage
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Preimage module events.
+const (
+	EventNoted sc.U8 = iota
+	EventRequested
+	EventCleared
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid preimage.Event module")
+	errInvalidEventType   = errors.New("invalid preimage.Event type")
+)
+
+func newEventNoted(moduleIndex sc.U8, hash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventNoted, hash)
+}
+
+func newEventRequested(moduleIndex sc.U8, hash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventRequested, hash)
+}
+
+func newEventCleared(moduleIndex sc.U8, hash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventCleared, hash)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventNoted:
+		hash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventNoted(moduleIndex, hash), nil
+	case EventRequested:
+		hash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventRequested(moduleIndex, hash), nil
+	case EventCleared:
+		hash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventCleared(moduleIndex, hash), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
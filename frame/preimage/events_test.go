@@ -0,0 +1,74 @@
+package preimage
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Preimage_DecodeEvent_Noted(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventNoted.Bytes())
+	buffer.Write(hash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventNoted, hash)},
+		result,
+	)
+}
+
+func Test_Preimage_DecodeEvent_Requested(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventRequested.Bytes())
+	buffer.Write(hash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventRequested, hash)},
+		result,
+	)
+}
+
+func Test_Preimage_DecodeEvent_Cleared(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventCleared.Bytes())
+	buffer.Write(hash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventCleared, hash)},
+		result,
+	)
+}
+
+func Test_Preimage_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Preimage_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
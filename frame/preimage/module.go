@@ -0,0 +1,319 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_preimage`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionNotePreimageIndex      = 0
+	functionUnnotePreimageIndex    = 1
+	functionRequestPreimageIndex   = 2
+	functionUnrequestPreimageIndex = 3
+)
+
+const (
+	name           = sc.Str("Preimage")
+	storageVersion = sc.U16(0)
+)
+
+// Module stores preimages of hashes on-chain, so that large data, such as runtime upgrade blobs
+// or governance proposals, can be submitted once and referenced by its hash afterwards.
+//
+// Preimages are keyed by their blake2-256 hash, the same hash that is used to authorize runtime
+// upgrades in the system module. Anyone can note a preimage for a deposit, proportional to its size.
+// Root can request a preimage, after which it can be noted by anyone free of charge and is kept
+// until all of its requests are removed. Other modules store calls as a Bounded, which keeps
+// short calls inline and notes the longer ones as requested preimages, with Bound, Peek, Realize and Drop.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	preimages   preimages
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.BaseDeposit, config.ByteDeposit)
+	storage := newStorage()
+	preimages := newPreimages(index, config, constants, storage, io.NewHashing())
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionNotePreimageIndex] = newCallNotePreimage(index, functionNotePreimageIndex, preimages)
+	functions[functionUnnotePreimageIndex] = newCallUnnotePreimage(index, functionUnnotePreimageIndex, preimages)
+	functions[functionRequestPreimageIndex] = newCallRequestPreimage(index, functionRequestPreimageIndex, preimages)
+	functions[functionUnrequestPreimageIndex] = newCallUnrequestPreimage(index, functionUnrequestPreimageIndex, preimages)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyPreimage, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		preimages:            preimages,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// NotePreimage stores `preimage` without a deposit, as a requested preimage.
+func (m Module) NotePreimage(preimage sc.Sequence[sc.U8]) error {
+	_, err := m.preimages.doNote(preimage, sc.NewOption[primitives.AccountId](nil))
+	return err
+}
+
+// UnnotePreimage removes the request for `hash`, made by NotePreimage.
+func (m Module) UnnotePreimage(hash primitives.H256) error {
+	return m.preimages.doUnnote(hash, sc.NewOption[primitives.AccountId](nil))
+}
+
+// RequestPreimage adds a request for the preimage of `hash`, so that it can be noted without a deposit.
+func (m Module) RequestPreimage(hash primitives.H256) error {
+	return m.preimages.doRequest(hash)
+}
+
+// UnrequestPreimage removes a request for the preimage of `hash`.
+func (m Module) UnrequestPreimage(hash primitives.H256) error {
+	return m.preimages.doUnrequest(hash)
+}
+
+// Fetch returns the preimage of `hash`. If `maybeLen` is not set, it is looked up in the request status.
+func (m Module) Fetch(hash primitives.H256, maybeLen sc.Option[sc.U32]) (sc.Sequence[sc.U8], error) {
+	return m.preimages.fetch(hash, maybeLen)
+}
+
+// Len returns the length of the preimage of `hash`, if it is noted.
+func (m Module) Len(hash primitives.H256) (sc.Option[sc.U32], error) {
+	return m.preimages.length(hash)
+}
+
+// IsRequested returns whether the preimage of `hash` is requested.
+func (m Module) IsRequested(hash primitives.H256) (bool, error) {
+	status, err := m.storage.StatusFor.TryGet(hash)
+	if err != nil {
+		return false, err
+	}
+	return status.HasValue && status.Value.IsRequested(), nil
+}
+
+// Bound returns `call` as a Bounded. Calls longer than MaxInlineLen are noted as requested preimages.
+func (m Module) Bound(call primitives.Call) (Bounded, error) {
+	return m.preimages.bound(call)
+}
+
+// Peek returns the call, referenced by `bounded`.
+func (m Module) Peek(bounded Bounded) (primitives.Call, error) {
+	return m.preimages.peek(bounded)
+}
+
+// Realize returns the call, referenced by `bounded`, and drops its preimage request.
+func (m Module) Realize(bounded Bounded) (primitives.Call, error) {
+	call, err := m.preimages.peek(bounded)
+	if err != nil {
+		return nil, err
+	}
+	return call, m.preimages.drop(bounded)
+}
+
+// Drop removes the preimage request of `bounded`, made by Bound.
+func (m Module) Drop(bounded Bounded) error {
+	return m.preimages.drop(bounded)
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdPreimageCalls := m.mdGenerator.BuildCallsMetadata("Preimage", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdPreimageCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdPreimageCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Preimage, Runtime>"),
+				},
+				m.Index,
+				"Call.Preimage"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesPreimageEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesPreimageEvent, "pallet_preimage::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Preimage"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesPreimageErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesPreimageErrors),
+				},
+				m.Index,
+				"Errors.Preimage"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	hashFields := sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "T::Hash"),
+	}
+
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesTupleAddress32U128, "(AccountId, Balance)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesAddress32), sc.ToCompact(metadata.PrimitiveTypesU128)})),
+		metadataTypeOption(metadata.TypesOptionTupleAddress32U128, "Option<(AccountId, Balance)>", metadata.TypesTupleAddress32U128),
+		metadataTypeOption(metadata.TypesOptionU32, "Option<u32>", metadata.PrimitiveTypesU32),
+		primitives.NewMetadataTypeWithPath(metadata.TypesPreimageRequestStatus, "RequestStatus", sc.Sequence[sc.Str]{"pallet_preimage", "RequestStatus"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Unrequested",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesTupleAddress32U128, "deposit", "(AccountId, Balance)"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "len", "u32"),
+					},
+					RequestStatusUnrequested,
+					""),
+				primitives.NewMetadataDefinitionVariant(
+					"Requested",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionTupleAddress32U128, "deposit", "Option<(AccountId, Balance)>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "count", "u32"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU32, "len", "Option<u32>"),
+					},
+					RequestStatusRequested,
+					""),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesTupleH256U32, "(H256, u32)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesH256), sc.ToCompact(metadata.PrimitiveTypesU32)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesPreimageEvent, "pallet_preimage pallet Event", sc.Sequence[sc.Str]{"pallet_preimage", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Noted",
+					hashFields,
+					EventNoted,
+					"Events.Noted"),
+				primitives.NewMetadataDefinitionVariant(
+					"Requested",
+					hashFields,
+					EventRequested,
+					"Events.Requested"),
+				primitives.NewMetadataDefinitionVariant(
+					"Cleared",
+					hashFields,
+					EventCleared,
+					"Events.Cleared"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesPreimageErrors,
+			"pallet_preimage pallet Error",
+			sc.Sequence[sc.Str]{"pallet_preimage", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("TooBig", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorTooBig, "Preimage is too large to store on-chain."),
+					primitives.NewMetadataDefinitionVariant("AlreadyNoted", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorAlreadyNoted, "Preimage has already been noted on-chain."),
+					primitives.NewMetadataDefinitionVariant("NotAuthorized", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotAuthorized, "The user is not authorized to perform this action."),
+					primitives.NewMetadataDefinitionVariant("NotNoted", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotNoted, "The preimage cannot be removed since it has not yet been noted."),
+					primitives.NewMetadataDefinitionVariant("Requested", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorRequested, "A preimage may not be removed when there are outstanding requests."),
+					primitives.NewMetadataDefinitionVariant("NotRequested", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotRequested, "The preimage request cannot be removed since no outstanding requests exist."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"StatusFor",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesH256,
+					metadata.TypesPreimageRequestStatus,
+					support.NewHasherIdentity(),
+				),
+				"The request status of a given hash."),
+			primitives.NewMetadataModuleStorageEntry(
+				"PreimageFor",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesTupleH256U32,
+					metadata.TypesSequenceU8,
+					support.NewHasherIdentity(),
+				),
+				""),
+		},
+	})
+}
+
+// metadataTypeOption returns the metadata type of an option of the type `typeId`.
+func metadataTypeOption(id int, docs string, typeId int) primitives.MetadataType {
+	return primitives.NewMetadataTypeWithParam(id, docs, sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+		sc.Sequence[primitives.MetadataDefinitionVariant]{
+			primitives.NewMetadataDefinitionVariant(
+				"None",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+				0,
+				""),
+			primitives.NewMetadataDefinitionVariant(
+				"Some",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(typeId),
+				},
+				1,
+				""),
+		}),
+		primitives.NewMetadataTypeParameter(typeId, "T"))
+}
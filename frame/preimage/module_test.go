@@ -0,0 +1,491 @@
+package preimage
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId = 15
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	baseDeposit = sc.NewU128(100)
+	byteDeposit = sc.NewU128(2)
+
+	whoAccountId   = constants.OneAccountId
+	otherAccountId = constants.TwoAccountId
+	signedOrigin   = primitives.NewRawOriginSigned(whoAccountId)
+	rootOrigin     = primitives.NewRawOriginRoot()
+
+	preimage     = sc.Sequence[sc.U8]{1, 2, 3, 4}
+	preimageLen  = sc.U32(len(preimage))
+	hashBytes    = bytes.Repeat([]byte{7}, 32)
+	hash, _      = primitives.NewH256(sc.BytesToSequenceU8(hashBytes)...)
+	preimageKey  = PreimageKey{Hash: hash, Len: preimageLen}
+	deposit      = sc.SaturatingAddU128(baseDeposit, byteDeposit.Mul(sc.NewU128(uint64(preimageLen))))
+	ticket       = Ticket{Who: whoAccountId, Amount: deposit}
+	expectedErr  = errors.New("error")
+	mdGenerator  = primitives.NewMetadataTypeGenerator()
+	logger       = log.NewLogger()
+	unrequested  = NewRequestStatusUnrequested(UnrequestedStatus{Deposit: ticket, Len: preimageLen})
+	requestedOne = NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+)
+
+var (
+	mockEventDepositor     *mocks.EventDepositor
+	mockCurrency           *mocks.ReservableCurrency
+	mockRuntimeDecoder     *mocks.RuntimeDecoder
+	mockHashing            *mocks.IoHashing
+	mockStorageStatusFor   *mocks.StorageMap[primitives.H256, RequestStatus]
+	mockStoragePreimageFor *mocks.StorageMap[PreimageKey, sc.Sequence[sc.U8]]
+	mockCall               *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 4, len(functions))
+	assert.Equal(t, sc.U8(functionNotePreimageIndex), functions[functionNotePreimageIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionUnnotePreimageIndex), functions[functionUnnotePreimageIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionRequestPreimageIndex), functions[functionRequestPreimageIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionUnrequestPreimageIndex), functions[functionUnrequestPreimageIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_NotePreimage(t *testing.T) {
+	target := setupModule()
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockStorageStatusFor.On("Put", hash, requestedOne).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	err := target.NotePreimage(preimage)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Module_UnnotePreimage(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	err := target.UnnotePreimage(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Module_RequestPreimage(t *testing.T) {
+	target := setupModule()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](nil),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockStorageStatusFor.On("Put", hash, requested).Return()
+	mockEventDepositor.On("DepositEvent", newEventRequested(moduleId, hash)).Return()
+
+	err := target.RequestPreimage(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Module_UnrequestPreimage(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	err := target.UnrequestPreimage(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertExpectations(t)
+}
+
+func Test_Module_Fetch(t *testing.T) {
+	target := setupModule()
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](preimage), nil)
+
+	result, err := target.Fetch(hash, sc.NewOption[sc.U32](preimageLen))
+
+	assert.Nil(t, err)
+	assert.Equal(t, preimage, result)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Module_Len(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+
+	result, err := target.Len(hash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[sc.U32](preimageLen), result)
+}
+
+func Test_Module_IsRequested(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+
+	result, err := target.IsRequested(hash)
+
+	assert.Nil(t, err)
+	assert.True(t, result)
+}
+
+func Test_Module_IsRequested_Unrequested(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+
+	result, err := target.IsRequested(hash)
+
+	assert.Nil(t, err)
+	assert.False(t, result)
+}
+
+func Test_Module_IsRequested_Error(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), expectedErr)
+
+	_, err := target.IsRequested(hash)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Module_Bound_Inline(t *testing.T) {
+	target := setupModule()
+	mockCall.On("Bytes").Return([]byte{1, 2, 3})
+
+	result, err := target.Bound(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, NewBoundedInline(sc.Sequence[sc.U8]{1, 2, 3}), result)
+	mockStoragePreimageFor.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_Bound_Lookup(t *testing.T) {
+	target := setupModule()
+	callBytes := bytes.Repeat([]byte{1}, MaxInlineLen+1)
+	callLen := sc.U32(len(callBytes))
+	mockCall.On("Bytes").Return(callBytes)
+	mockHashing.On("Blake256", callBytes).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockStorageStatusFor.On("Put", hash, NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](callLen),
+	})).Return()
+	mockStoragePreimageFor.On("Put", PreimageKey{Hash: hash, Len: callLen}, sc.BytesToSequenceU8(callBytes)).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.Bound(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, NewBoundedLookup(hash, callLen), result)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertExpectations(t)
+}
+
+func Test_Module_Peek_Inline(t *testing.T) {
+	target := setupModule()
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(sc.SequenceU8ToBytes(preimage))).Return(mockCall, nil)
+
+	result, err := target.Peek(NewBoundedInline(preimage))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockCall, result)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Module_Peek_Lookup(t *testing.T) {
+	target := setupModule()
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](preimage), nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(sc.SequenceU8ToBytes(preimage))).Return(mockCall, nil)
+
+	result, err := target.Peek(NewBoundedLookup(hash, preimageLen))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockCall, result)
+}
+
+func Test_Module_Peek_Legacy(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](preimage), nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(sc.SequenceU8ToBytes(preimage))).Return(mockCall, nil)
+
+	result, err := target.Peek(NewBoundedLegacy(hash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockCall, result)
+}
+
+func Test_Module_Peek_Unavailable(t *testing.T) {
+	target := setupModule()
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	_, err := target.Peek(NewBoundedLookup(hash, preimageLen))
+
+	assert.Equal(t, primitives.NewDispatchErrorUnavailable(), err)
+	mockRuntimeDecoder.AssertNotCalled(t, "DecodeCall", mock.Anything)
+}
+
+func Test_Module_Realize(t *testing.T) {
+	target := setupModule()
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](preimage), nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(sc.SequenceU8ToBytes(preimage))).Return(mockCall, nil)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	result, err := target.Realize(NewBoundedLookup(hash, preimageLen))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockCall, result)
+	mockStorageStatusFor.AssertCalled(t, "Remove", hash)
+	mockStoragePreimageFor.AssertCalled(t, "Remove", preimageKey)
+}
+
+func Test_Module_Realize_DecodeError(t *testing.T) {
+	target := setupModule()
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](preimage), nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(sc.SequenceU8ToBytes(preimage))).Return(mockCall, expectedErr)
+
+	_, err := target.Realize(NewBoundedLookup(hash, preimageLen))
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Module_Drop_Inline(t *testing.T) {
+	target := setupModule()
+
+	err := target.Drop(NewBoundedInline(preimage))
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Module_Drop_Lookup(t *testing.T) {
+	target := setupModule()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+
+	err := target.Drop(NewBoundedLookup(hash, preimageLen))
+
+	assert.Equal(t, NewDispatchErrorNotRequested(moduleId), err)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedPreimageCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedPreimageCallsMetadataId, "Preimage calls", sc.Sequence[sc.Str]{"pallet_preimage", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"note_preimage",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
+					},
+					functionNotePreimageIndex,
+					target.functions[functionNotePreimageIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"unnote_preimage",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesH256),
+					},
+					functionUnnotePreimageIndex,
+					target.functions[functionUnnotePreimageIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"request_preimage",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesH256),
+					},
+					functionRequestPreimageIndex,
+					target.functions[functionRequestPreimageIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"unrequest_preimage",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesH256),
+					},
+					functionUnrequestPreimageIndex,
+					target.functions[functionUnrequestPreimageIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedPreimageCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedPreimageCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Preimage, Runtime>"),
+				},
+				moduleId,
+				"Call.Preimage"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesPreimageEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesPreimageEvent, "pallet_preimage::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Preimage"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesPreimageErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesPreimageErrors),
+				},
+				moduleId,
+				"Errors.Preimage"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"StatusFor",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncIdentity,
+					},
+					sc.ToCompact(metadata.TypesH256),
+					sc.ToCompact(metadata.TypesPreimageRequestStatus),
+				),
+				"The request status of a given hash."),
+			primitives.NewMetadataModuleStorageEntry(
+				"PreimageFor",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncIdentity,
+					},
+					sc.ToCompact(metadata.TypesTupleH256U32),
+					sc.ToCompact(metadata.TypesSequenceU8),
+				),
+				""),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	target := New(moduleId, newTestConfig(), mdGenerator, logger)
+	target.storage.StatusFor = mockStorageStatusFor
+	target.storage.PreimageFor = mockStoragePreimageFor
+	target.preimages.hashing = mockHashing
+
+	return target
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockCurrency = new(mocks.ReservableCurrency)
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockHashing = new(mocks.IoHashing)
+	mockStorageStatusFor = new(mocks.StorageMap[primitives.H256, RequestStatus])
+	mockStoragePreimageFor = new(mocks.StorageMap[PreimageKey, sc.Sequence[sc.U8]])
+	mockCall = new(mocks.Call)
+}
+
+func newTestConfig() *Config {
+	return NewConfig(dbWeight, mockEventDepositor, mockCurrency, baseDeposit, byteDeposit, mockRuntimeDecoder)
+}
@@ -0,0 +1,333 @@
+package preimage
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// preimages holds the dependencies and logic, shared by the calls of the module and the Bounded call API.
+type preimages struct {
+	moduleId       sc.U8
+	constants      *consts
+	storage        *storage
+	eventDepositor primitives.EventDepositor
+	currency       primitives.ReservableCurrency
+	callDecoder    primitives.CallDecoder
+	hashing        io.Hashing
+}
+
+func newPreimages(moduleId sc.U8, config *Config, constants *consts, storage *storage, hashing io.Hashing) preimages {
+	return preimages{
+		moduleId:       moduleId,
+		constants:      constants,
+		storage:        storage,
+		eventDepositor: config.EventDepositor,
+		currency:       config.Currency,
+		callDecoder:    config.CallDecoder,
+		hashing:        hashing,
+	}
+}
+
+// doNote stores `preimage` and returns whether it was requested. A deposit is reserved from
+// `maybeDepositor`, unless the preimage is already requested. If `maybeDepositor` is None, the
+// preimage is noted by the manager and is requested.
+func (p preimages) doNote(preimage sc.Sequence[sc.U8], maybeDepositor sc.Option[primitives.AccountId]) (bool, error) {
+	if len(preimage) > MaxSize {
+		return false, NewDispatchErrorTooBig(p.moduleId)
+	}
+
+	hash, err := p.hash(preimage)
+	if err != nil {
+		return false, err
+	}
+	length := sc.U32(len(preimage))
+
+	maybeStatus, err := p.storage.StatusFor.TryGet(hash)
+	if err != nil {
+		return false, err
+	}
+
+	var status RequestStatus
+	switch {
+	case maybeStatus.HasValue && maybeStatus.Value.IsRequested():
+		requested, err := maybeStatus.Value.AsRequested()
+		if err != nil {
+			return false, err
+		}
+		requested.MaybeLen = sc.NewOption[sc.U32](length)
+		status = NewRequestStatusRequested(requested)
+	case maybeStatus.HasValue && maybeDepositor.HasValue:
+		return false, NewDispatchErrorAlreadyNoted(p.moduleId)
+	case maybeStatus.HasValue:
+		unrequested, err := maybeStatus.Value.AsUnrequested()
+		if err != nil {
+			return false, err
+		}
+		status = NewRequestStatusRequested(RequestedStatus{
+			MaybeDeposit: sc.NewOption[Ticket](unrequested.Deposit),
+			Count:        1,
+			MaybeLen:     sc.NewOption[sc.U32](unrequested.Len),
+		})
+	case !maybeDepositor.HasValue:
+		status = NewRequestStatusRequested(RequestedStatus{
+			MaybeDeposit: sc.NewOption[Ticket](nil),
+			Count:        1,
+			MaybeLen:     sc.NewOption[sc.U32](length),
+		})
+	default:
+		deposit := sc.SaturatingAddU128(p.constants.BaseDeposit, p.constants.ByteDeposit.Mul(sc.NewU128(uint64(length))))
+		if err := p.currency.Reserve(maybeDepositor.Value, deposit); err != nil {
+			return false, err
+		}
+		status = NewRequestStatusUnrequested(UnrequestedStatus{
+			Deposit: Ticket{Who: maybeDepositor.Value, Amount: deposit},
+			Len:     length,
+		})
+	}
+
+	p.storage.StatusFor.Put(hash, status)
+	p.storage.PreimageFor.Put(PreimageKey{Hash: hash, Len: length}, preimage)
+
+	p.eventDepositor.DepositEvent(newEventNoted(p.moduleId, hash))
+
+	return status.IsRequested(), nil
+}
+
+// doUnnote removes the deposit of the preimage with `hash` and clears the preimage, unless it is requested.
+// If `maybeCheckOwner` is set, the preimage must have been noted by it.
+func (p preimages) doUnnote(hash primitives.H256, maybeCheckOwner sc.Option[primitives.AccountId]) error {
+	maybeStatus, err := p.storage.StatusFor.TryGet(hash)
+	if err != nil {
+		return err
+	}
+	if !maybeStatus.HasValue {
+		return NewDispatchErrorNotNoted(p.moduleId)
+	}
+
+	if maybeStatus.Value.IsUnrequested() {
+		unrequested, err := maybeStatus.Value.AsUnrequested()
+		if err != nil {
+			return err
+		}
+		if !isOwner(maybeCheckOwner, unrequested.Deposit.Who) {
+			return NewDispatchErrorNotAuthorized(p.moduleId)
+		}
+		if _, err := p.currency.Unreserve(unrequested.Deposit.Who, unrequested.Deposit.Amount); err != nil {
+			return err
+		}
+		p.clear(hash, unrequested.Len)
+		return nil
+	}
+
+	requested, err := maybeStatus.Value.AsRequested()
+	if err != nil {
+		return err
+	}
+	if !requested.MaybeDeposit.HasValue {
+		if maybeCheckOwner.HasValue {
+			return NewDispatchErrorNotAuthorized(p.moduleId)
+		}
+		return p.doUnrequest(hash)
+	}
+
+	deposit := requested.MaybeDeposit.Value
+	if !isOwner(maybeCheckOwner, deposit.Who) {
+		return NewDispatchErrorNotAuthorized(p.moduleId)
+	}
+	if _, err := p.currency.Unreserve(deposit.Who, deposit.Amount); err != nil {
+		return err
+	}
+	requested.MaybeDeposit = sc.NewOption[Ticket](nil)
+	p.storage.StatusFor.Put(hash, NewRequestStatusRequested(requested))
+
+	return nil
+}
+
+// doRequest adds a request for the preimage with `hash`. A requested preimage can be noted without a deposit
+// and is kept until all of its requests are removed.
+func (p preimages) doRequest(hash primitives.H256) error {
+	maybeStatus, err := p.storage.StatusFor.TryGet(hash)
+	if err != nil {
+		return err
+	}
+
+	requested := RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](nil),
+	}
+	if maybeStatus.HasValue && maybeStatus.Value.IsRequested() {
+		requested, err = maybeStatus.Value.AsRequested()
+		if err != nil {
+			return err
+		}
+		requested.Count = sc.SaturatingAddU32(requested.Count, 1)
+	} else if maybeStatus.HasValue {
+		unrequested, err := maybeStatus.Value.AsUnrequested()
+		if err != nil {
+			return err
+		}
+		requested.MaybeDeposit = sc.NewOption[Ticket](unrequested.Deposit)
+		requested.MaybeLen = sc.NewOption[sc.U32](unrequested.Len)
+	}
+
+	p.storage.StatusFor.Put(hash, NewRequestStatusRequested(requested))
+
+	if requested.Count == 1 {
+		p.eventDepositor.DepositEvent(newEventRequested(p.moduleId, hash))
+	}
+
+	return nil
+}
+
+// doUnrequest removes a request for the preimage with `hash`. When the last request is removed, the preimage
+// is cleared, unless it was noted with a deposit, in which case it becomes unrequested.
+func (p preimages) doUnrequest(hash primitives.H256) error {
+	maybeStatus, err := p.storage.StatusFor.TryGet(hash)
+	if err != nil {
+		return err
+	}
+	if !maybeStatus.HasValue || !maybeStatus.Value.IsRequested() {
+		return NewDispatchErrorNotRequested(p.moduleId)
+	}
+
+	requested, err := maybeStatus.Value.AsRequested()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case requested.Count > 1:
+		requested.Count--
+		p.storage.StatusFor.Put(hash, NewRequestStatusRequested(requested))
+	case !requested.MaybeLen.HasValue:
+		p.storage.StatusFor.Remove(hash)
+	case !requested.MaybeDeposit.HasValue:
+		p.clear(hash, requested.MaybeLen.Value)
+	default:
+		p.storage.StatusFor.Put(hash, NewRequestStatusUnrequested(UnrequestedStatus{
+			Deposit: requested.MaybeDeposit.Value,
+			Len:     requested.MaybeLen.Value,
+		}))
+	}
+
+	return nil
+}
+
+// clear removes the preimage with `hash` and its status.
+func (p preimages) clear(hash primitives.H256, length sc.U32) {
+	p.storage.StatusFor.Remove(hash)
+	p.storage.PreimageFor.Remove(PreimageKey{Hash: hash, Len: length})
+
+	p.eventDepositor.DepositEvent(newEventCleared(p.moduleId, hash))
+}
+
+// length returns the length of the preimage with `hash`, if it is noted.
+func (p preimages) length(hash primitives.H256) (sc.Option[sc.U32], error) {
+	maybeStatus, err := p.storage.StatusFor.TryGet(hash)
+	if err != nil {
+		return sc.Option[sc.U32]{}, err
+	}
+	if !maybeStatus.HasValue {
+		return sc.NewOption[sc.U32](nil), nil
+	}
+
+	if maybeStatus.Value.IsUnrequested() {
+		unrequested, err := maybeStatus.Value.AsUnrequested()
+		if err != nil {
+			return sc.Option[sc.U32]{}, err
+		}
+		return sc.NewOption[sc.U32](unrequested.Len), nil
+	}
+
+	requested, err := maybeStatus.Value.AsRequested()
+	if err != nil {
+		return sc.Option[sc.U32]{}, err
+	}
+	return requested.MaybeLen, nil
+}
+
+// fetch returns the preimage with `hash`. If `maybeLen` is not set, the length is looked up in the status of the preimage.
+func (p preimages) fetch(hash primitives.H256, maybeLen sc.Option[sc.U32]) (sc.Sequence[sc.U8], error) {
+	if !maybeLen.HasValue {
+		var err error
+		maybeLen, err = p.length(hash)
+		if err != nil {
+			return nil, err
+		}
+		if !maybeLen.HasValue {
+			return nil, primitives.NewDispatchErrorUnavailable()
+		}
+	}
+
+	preimage, err := p.storage.PreimageFor.TryGet(PreimageKey{Hash: hash, Len: maybeLen.Value})
+	if err != nil {
+		return nil, err
+	}
+	if !preimage.HasValue {
+		return nil, primitives.NewDispatchErrorUnavailable()
+	}
+
+	return preimage.Value, nil
+}
+
+// bound returns `call` inline, if it is short enough, or notes it as a requested preimage and returns a lookup to it.
+func (p preimages) bound(call primitives.Call) (Bounded, error) {
+	data := sc.BytesToSequenceU8(call.Bytes())
+	if len(data) <= MaxInlineLen {
+		return NewBoundedInline(data), nil
+	}
+
+	hash, err := p.hash(data)
+	if err != nil {
+		return Bounded{}, err
+	}
+	if _, err := p.doNote(data, sc.NewOption[primitives.AccountId](nil)); err != nil {
+		return Bounded{}, err
+	}
+
+	return NewBoundedLookup(hash, sc.U32(len(data))), nil
+}
+
+// peek decodes the call, referenced by `bounded`, without removing its preimage.
+func (p preimages) peek(bounded Bounded) (primitives.Call, error) {
+	data, err := p.boundedData(bounded)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.callDecoder.DecodeCall(bytes.NewBuffer(sc.SequenceU8ToBytes(data)))
+}
+
+// drop removes the request for the preimage, referenced by `bounded`, if any.
+func (p preimages) drop(bounded Bounded) error {
+	hash, _, ok := bounded.Lookup()
+	if !ok {
+		return nil
+	}
+
+	return p.doUnrequest(hash)
+}
+
+func (p preimages) boundedData(bounded Bounded) (sc.Sequence[sc.U8], error) {
+	hash, maybeLen, ok := bounded.Lookup()
+	if !ok {
+		return bounded.VaryingData[1].(sc.Sequence[sc.U8]), nil
+	}
+
+	return p.fetch(hash, maybeLen)
+}
+
+// hash returns the blake2-256 hash of `preimage`, the same hash that is used to authorize runtime upgrades.
+func (p preimages) hash(preimage sc.Sequence[sc.U8]) (primitives.H256, error) {
+	return primitives.NewH256(sc.BytesToSequenceU8(p.hashing.Blake256(sc.SequenceU8ToBytes(preimage)))...)
+}
+
+// isOwner returns whether `owner` is `maybeCheckOwner`, or the check is not required.
+func isOwner(maybeCheckOwner sc.Option[primitives.AccountId], owner primitives.AccountId) bool {
+	return !maybeCheckOwner.HasValue || reflect.DeepEqual(maybeCheckOwner.Value, owner)
+}
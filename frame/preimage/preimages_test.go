@@ -0,0 +1,298 @@
+package preimage
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	maybeWho  = sc.NewOption[primitives.AccountId](whoAccountId)
+	maybeNone = sc.NewOption[primitives.AccountId](nil)
+)
+
+func Test_Preimages_doNote_Deposit(t *testing.T) {
+	target := setupPreimages()
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(nil)
+	mockStorageStatusFor.On("Put", hash, unrequested).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.doNote(preimage, maybeWho)
+
+	assert.Nil(t, err)
+	assert.False(t, result)
+	mockCurrency.AssertExpectations(t)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Preimages_doNote_Requested(t *testing.T) {
+	target := setupPreimages()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        2,
+		MaybeLen:     sc.NewOption[sc.U32](nil),
+	})
+	expected := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        2,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requested), nil)
+	mockStorageStatusFor.On("Put", hash, expected).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.doNote(preimage, maybeWho)
+
+	assert.Nil(t, err)
+	assert.True(t, result)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+	mockStorageStatusFor.AssertExpectations(t)
+}
+
+func Test_Preimages_doNote_AlreadyNoted(t *testing.T) {
+	target := setupPreimages()
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+
+	_, err := target.doNote(preimage, sc.NewOption[primitives.AccountId](otherAccountId))
+
+	assert.Equal(t, NewDispatchErrorAlreadyNoted(moduleId), err)
+	mockStorageStatusFor.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Preimages_doNote_Unrequested_Manager(t *testing.T) {
+	target := setupPreimages()
+	expected := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](ticket),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+	mockStorageStatusFor.On("Put", hash, expected).Return()
+	mockStoragePreimageFor.On("Put", preimageKey, preimage).Return()
+	mockEventDepositor.On("DepositEvent", newEventNoted(moduleId, hash)).Return()
+
+	result, err := target.doNote(preimage, maybeNone)
+
+	assert.Nil(t, err)
+	assert.True(t, result)
+	mockStorageStatusFor.AssertExpectations(t)
+}
+
+func Test_Preimages_doNote_TooBig(t *testing.T) {
+	target := setupPreimages()
+
+	_, err := target.doNote(make(sc.Sequence[sc.U8], MaxSize+1), maybeWho)
+
+	assert.Equal(t, NewDispatchErrorTooBig(moduleId), err)
+	mockHashing.AssertNotCalled(t, "Blake256", mock.Anything)
+}
+
+func Test_Preimages_doNote_ReserveError(t *testing.T) {
+	target := setupPreimages()
+	mockHashing.On("Blake256", sc.SequenceU8ToBytes(preimage)).Return(hashBytes)
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+	mockCurrency.On("Reserve", whoAccountId, deposit).Return(expectedErr)
+
+	_, err := target.doNote(preimage, maybeWho)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageStatusFor.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStoragePreimageFor.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Preimages_doUnnote_Unrequested(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+	mockStoragePreimageFor.On("Remove", preimageKey).Return()
+	mockEventDepositor.On("DepositEvent", newEventCleared(moduleId, hash)).Return()
+
+	err := target.doUnnote(hash, maybeWho)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertExpectations(t)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Preimages_doUnnote_NotAuthorized(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+
+	err := target.doUnnote(hash, sc.NewOption[primitives.AccountId](otherAccountId))
+
+	assert.Equal(t, NewDispatchErrorNotAuthorized(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func Test_Preimages_doUnnote_NotNoted(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+
+	err := target.doUnnote(hash, maybeWho)
+
+	assert.Equal(t, NewDispatchErrorNotNoted(moduleId), err)
+}
+
+func Test_Preimages_doUnnote_RequestedWithDeposit(t *testing.T) {
+	target := setupPreimages()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](ticket),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requested), nil)
+	mockCurrency.On("Unreserve", whoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockStorageStatusFor.On("Put", hash, requestedOne).Return()
+
+	err := target.doUnnote(hash, maybeWho)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertExpectations(t)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Preimages_doUnnote_RequestedWithoutDeposit_Signed(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+
+	err := target.doUnnote(hash, maybeWho)
+
+	assert.Equal(t, NewDispatchErrorNotAuthorized(moduleId), err)
+	mockStorageStatusFor.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Preimages_doRequest_Unrequested(t *testing.T) {
+	target := setupPreimages()
+	expected := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](ticket),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+	mockStorageStatusFor.On("Put", hash, expected).Return()
+	mockEventDepositor.On("DepositEvent", newEventRequested(moduleId, hash)).Return()
+
+	err := target.doRequest(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Preimages_doRequest_Requested(t *testing.T) {
+	target := setupPreimages()
+	expected := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        2,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requestedOne), nil)
+	mockStorageStatusFor.On("Put", hash, expected).Return()
+
+	err := target.doRequest(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Preimages_doUnrequest_Decrement(t *testing.T) {
+	target := setupPreimages()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        2,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requested), nil)
+	mockStorageStatusFor.On("Put", hash, requestedOne).Return()
+
+	err := target.doUnrequest(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Preimages_doUnrequest_NotNoted(t *testing.T) {
+	target := setupPreimages()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](nil),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](nil),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requested), nil)
+	mockStorageStatusFor.On("Remove", hash).Return()
+
+	err := target.doUnrequest(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Preimages_doUnrequest_WithDeposit(t *testing.T) {
+	target := setupPreimages()
+	requested := NewRequestStatusRequested(RequestedStatus{
+		MaybeDeposit: sc.NewOption[Ticket](ticket),
+		Count:        1,
+		MaybeLen:     sc.NewOption[sc.U32](preimageLen),
+	})
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](requested), nil)
+	mockStorageStatusFor.On("Put", hash, unrequested).Return()
+
+	err := target.doUnrequest(hash)
+
+	assert.Nil(t, err)
+	mockStorageStatusFor.AssertExpectations(t)
+	mockStoragePreimageFor.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Preimages_doUnrequest_NotRequested(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+
+	err := target.doUnrequest(hash)
+
+	assert.Equal(t, NewDispatchErrorNotRequested(moduleId), err)
+}
+
+func Test_Preimages_fetch_LenFromStatus(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](unrequested), nil)
+	mockStoragePreimageFor.On("TryGet", preimageKey).Return(sc.NewOption[sc.Sequence[sc.U8]](preimage), nil)
+
+	result, err := target.fetch(hash, sc.NewOption[sc.U32](nil))
+
+	assert.Nil(t, err)
+	assert.Equal(t, preimage, result)
+}
+
+func Test_Preimages_fetch_Unavailable(t *testing.T) {
+	target := setupPreimages()
+	mockStorageStatusFor.On("TryGet", hash).Return(sc.NewOption[RequestStatus](nil), nil)
+
+	_, err := target.fetch(hash, sc.NewOption[sc.U32](nil))
+
+	assert.Equal(t, primitives.NewDispatchErrorUnavailable(), err)
+	mockStoragePreimageFor.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupPreimages() preimages {
+	return setupModule().preimages
+}
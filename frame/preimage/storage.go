@@ -0,0 +1,25 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyPreimage    = []byte("Preimage")
+	keyStatusFor   = []byte("StatusFor")
+	keyPreimageFor = []byte("PreimageFor")
+)
+
+type storage struct {
+	StatusFor   support.StorageMap[primitives.H256, RequestStatus]
+	PreimageFor support.StorageMap[PreimageKey, sc.Sequence[sc.U8]]
+}
+
+func newStorage() *storage {
+	return &storage{
+		StatusFor:   support.NewHashStorageMap[primitives.H256, RequestStatus](keyPreimage, keyStatusFor, support.NewHasherIdentity(), primitives.DecodeH256, DecodeRequestStatus),
+		PreimageFor: support.NewHashStorageMap[PreimageKey, sc.Sequence[sc.U8]](keyPreimage, keyPreimageFor, support.NewHasherIdentity(), DecodePreimageKey, sc.DecodeSequence[sc.U8]),
+	}
+}
@@ -0,0 +1,225 @@
+package preimage
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// RequestStatusUnrequested is the status of a preimage, which is noted by an account, but not requested.
+	RequestStatusUnrequested sc.U8 = iota
+	// RequestStatusRequested is the status of a preimage, which is requested and may or may not be noted.
+	RequestStatusRequested
+)
+
+var (
+	errInvalidRequestStatusType = errors.New("invalid preimage.RequestStatus type")
+	errNotUnrequestedStatus     = errors.New("not an unrequested preimage.RequestStatus")
+	errNotRequestedStatus       = errors.New("not a requested preimage.RequestStatus")
+)
+
+// Ticket is the deposit, reserved for noting a preimage, encoded as the `(AccountId, Balance)` tuple.
+type Ticket struct {
+	// Who is the account, from which the deposit is reserved.
+	Who primitives.AccountId
+	// Amount is the reserved amount.
+	Amount primitives.Balance
+}
+
+func (t Ticket) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		t.Who,
+		t.Amount,
+	)
+}
+
+func DecodeTicket(buffer *bytes.Buffer) (Ticket, error) {
+	who, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return Ticket{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return Ticket{
+		Who:    who,
+		Amount: amount,
+	}, nil
+}
+
+func (t Ticket) Bytes() []byte {
+	return sc.EncodedBytes(t)
+}
+
+// UnrequestedStatus is a preimage, which is noted by an account and can be unnoted to release the deposit.
+type UnrequestedStatus struct {
+	// Deposit is the deposit, reserved for noting the preimage.
+	Deposit Ticket
+	// Len is the length of the preimage.
+	Len sc.U32
+}
+
+func (us UnrequestedStatus) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		us.Deposit,
+		us.Len,
+	)
+}
+
+func DecodeUnrequestedStatus(buffer *bytes.Buffer) (UnrequestedStatus, error) {
+	deposit, err := DecodeTicket(buffer)
+	if err != nil {
+		return UnrequestedStatus{}, err
+	}
+	length, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return UnrequestedStatus{}, err
+	}
+	return UnrequestedStatus{
+		Deposit: deposit,
+		Len:     length,
+	}, nil
+}
+
+func (us UnrequestedStatus) Bytes() []byte {
+	return sc.EncodedBytes(us)
+}
+
+// RequestedStatus is a preimage, which is requested Count times. It is kept until all requests are dropped.
+type RequestedStatus struct {
+	// MaybeDeposit is the deposit, reserved for noting the preimage, if it was noted by an account.
+	MaybeDeposit sc.Option[Ticket]
+	// Count is the number of requests.
+	Count sc.U32
+	// MaybeLen is the length of the preimage, if it is noted.
+	MaybeLen sc.Option[sc.U32]
+}
+
+func (rs RequestedStatus) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		rs.MaybeDeposit,
+		rs.Count,
+		rs.MaybeLen,
+	)
+}
+
+func DecodeRequestedStatus(buffer *bytes.Buffer) (RequestedStatus, error) {
+	maybeDeposit, err := sc.DecodeOptionWith(buffer, DecodeTicket)
+	if err != nil {
+		return RequestedStatus{}, err
+	}
+	count, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return RequestedStatus{}, err
+	}
+	maybeLen, err := sc.DecodeOptionWith(buffer, sc.DecodeU32)
+	if err != nil {
+		return RequestedStatus{}, err
+	}
+	return RequestedStatus{
+		MaybeDeposit: maybeDeposit,
+		Count:        count,
+		MaybeLen:     maybeLen,
+	}, nil
+}
+
+func (rs RequestedStatus) Bytes() []byte {
+	return sc.EncodedBytes(rs)
+}
+
+// RequestStatus is the status of a preimage. It is either unrequested or requested.
+type RequestStatus struct {
+	sc.VaryingData
+}
+
+func NewRequestStatusUnrequested(status UnrequestedStatus) RequestStatus {
+	return RequestStatus{sc.NewVaryingData(RequestStatusUnrequested, status)}
+}
+
+func NewRequestStatusRequested(status RequestedStatus) RequestStatus {
+	return RequestStatus{sc.NewVaryingData(RequestStatusRequested, status)}
+}
+
+func DecodeRequestStatus(buffer *bytes.Buffer) (RequestStatus, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return RequestStatus{}, err
+	}
+
+	switch b {
+	case RequestStatusUnrequested:
+		status, err := DecodeUnrequestedStatus(buffer)
+		if err != nil {
+			return RequestStatus{}, err
+		}
+		return NewRequestStatusUnrequested(status), nil
+	case RequestStatusRequested:
+		status, err := DecodeRequestedStatus(buffer)
+		if err != nil {
+			return RequestStatus{}, err
+		}
+		return NewRequestStatusRequested(status), nil
+	default:
+		return RequestStatus{}, errInvalidRequestStatusType
+	}
+}
+
+func (rs RequestStatus) IsUnrequested() bool {
+	return rs.VaryingData[0] == RequestStatusUnrequested
+}
+
+func (rs RequestStatus) IsRequested() bool {
+	return rs.VaryingData[0] == RequestStatusRequested
+}
+
+func (rs RequestStatus) AsUnrequested() (UnrequestedStatus, error) {
+	if !rs.IsUnrequested() {
+		return UnrequestedStatus{}, errNotUnrequestedStatus
+	}
+	return rs.VaryingData[1].(UnrequestedStatus), nil
+}
+
+func (rs RequestStatus) AsRequested() (RequestedStatus, error) {
+	if !rs.IsRequested() {
+		return RequestedStatus{}, errNotRequestedStatus
+	}
+	return rs.VaryingData[1].(RequestedStatus), nil
+}
+
+// PreimageKey is the key of a stored preimage, encoded as the `(H256, u32)` tuple.
+type PreimageKey struct {
+	// Hash is the blake2-256 hash of the preimage.
+	Hash primitives.H256
+	// Len is the length of the preimage.
+	Len sc.U32
+}
+
+func (pk PreimageKey) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		pk.Hash,
+		pk.Len,
+	)
+}
+
+func DecodePreimageKey(buffer *bytes.Buffer) (PreimageKey, error) {
+	hash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return PreimageKey{}, err
+	}
+	length, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return PreimageKey{}, err
+	}
+	return PreimageKey{
+		Hash: hash,
+		Len:  length,
+	}, nil
+}
+
+func (pk PreimageKey) Bytes() []byte {
+	return sc.EncodedBytes(pk)
+}
@@ -0,0 +1,82 @@
+package preimage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RequestStatus_Unrequested_Decode(t *testing.T) {
+	result, err := DecodeRequestStatus(bytes.NewBuffer(unrequested.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, unrequested, result)
+	assert.True(t, result.IsUnrequested())
+	assert.False(t, result.IsRequested())
+}
+
+func Test_RequestStatus_Requested_Decode(t *testing.T) {
+	result, err := DecodeRequestStatus(bytes.NewBuffer(requestedOne.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, requestedOne, result)
+	assert.True(t, result.IsRequested())
+	assert.False(t, result.IsUnrequested())
+}
+
+func Test_RequestStatus_Decode_InvalidType(t *testing.T) {
+	_, err := DecodeRequestStatus(bytes.NewBuffer([]byte{2}))
+
+	assert.Equal(t, errInvalidRequestStatusType, err)
+}
+
+func Test_RequestStatus_AsUnrequested_Invalid(t *testing.T) {
+	_, err := requestedOne.AsUnrequested()
+
+	assert.Equal(t, errNotUnrequestedStatus, err)
+}
+
+func Test_RequestStatus_AsRequested_Invalid(t *testing.T) {
+	_, err := unrequested.AsRequested()
+
+	assert.Equal(t, errNotRequestedStatus, err)
+}
+
+func Test_PreimageKey_Decode(t *testing.T) {
+	result, err := DecodePreimageKey(bytes.NewBuffer(preimageKey.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, preimageKey, result)
+}
+
+func Test_Bounded_Decode(t *testing.T) {
+	for _, bounded := range []Bounded{
+		NewBoundedLegacy(hash),
+		NewBoundedInline(preimage),
+		NewBoundedLookup(hash, preimageLen),
+	} {
+		result, err := DecodeBounded(bytes.NewBuffer(bounded.Bytes()))
+
+		assert.Nil(t, err)
+		assert.Equal(t, bounded, result)
+	}
+}
+
+func Test_Bounded_Decode_InvalidType(t *testing.T) {
+	_, err := DecodeBounded(bytes.NewBuffer([]byte{3}))
+
+	assert.Equal(t, errInvalidBoundedType, err)
+}
+
+func Test_Bounded_Lookup(t *testing.T) {
+	_, _, ok := NewBoundedInline(preimage).Lookup()
+	assert.False(t, ok)
+
+	lookupHash, maybeLen, ok := NewBoundedLookup(hash, preimageLen).Lookup()
+	assert.True(t, ok)
+	assert.Equal(t, hash, lookupHash)
+	assert.Equal(t, preimageLen, maybeLen.Value)
+	assert.True(t, maybeLen.HasValue)
+	assert.True(t, NewBoundedInline(preimage).IsInline())
+}
@@ -0,0 +1,161 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_AddProxy_New(t *testing.T) {
+	target := setupCallAddProxy()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionAddProxyIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, ProxyType(0), sc.U64(0)),
+	}
+
+	assert.Equal(t, expected, target.(callAddProxy).Callable)
+}
+
+func Test_Call_AddProxy_DecodeArgs(t *testing.T) {
+	target := setupCallAddProxy()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(ProxyTypeNonTransfer.Bytes())
+	buffer.Write(sc.U64(5).Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, ProxyTypeNonTransfer, sc.U64(5)), call.Args())
+}
+
+func Test_Call_AddProxy_DecodeArgs_InvalidProxyType(t *testing.T) {
+	target := setupCallAddProxy()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.WriteByte(3)
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, errInvalidProxyType, err)
+}
+
+func Test_Call_AddProxy_Encode(t *testing.T) {
+	target := setupDecodedCallAddProxy(whoAddress)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionAddProxyIndex})
+	expectedBuffer.Write(whoAddress.Bytes())
+	expectedBuffer.Write(ProxyTypeAny.Bytes())
+	expectedBuffer.Write(sc.U64(0).Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_AddProxy_ModuleIndex(t *testing.T) {
+	target := setupCallAddProxy()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_AddProxy_FunctionIndex(t *testing.T) {
+	target := setupCallAddProxy()
+
+	assert.Equal(t, sc.U8(functionAddProxyIndex), target.FunctionIndex())
+}
+
+func Test_Call_AddProxy_BaseWeight(t *testing.T) {
+	target := setupCallAddProxy()
+
+	assert.Equal(t, callAddProxyWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_AddProxy_ClassifyDispatch(t *testing.T) {
+	target := setupCallAddProxy()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AddProxy_PaysFee(t *testing.T) {
+	target := setupCallAddProxy()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AddProxy_Dispatch(t *testing.T) {
+	target := setupDecodedCallAddProxy(whoAddress)
+	expectedEvent := newEventProxyAdded(moduleId, realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{}, nil)
+	mockCurrency.On("Reserve", realAccountId, singleProxyDeposit).Return(nil)
+	mockStorageProxies.On("Put", realAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(realOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageProxies.AssertCalled(t, "Put", realAccountId, ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	})
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_AddProxy_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallAddProxy(whoAddress)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_AddProxy_Dispatch_AccountIndex(t *testing.T) {
+	target := setupDecodedCallAddProxy(indexAddress).(callAddProxy)
+	mockLookup := new(mocks.StaticLookup)
+	target.lookup = mockLookup
+	expectedEvent := newEventProxyAdded(moduleId, realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	mockLookup.On("Lookup", indexAddress).Return(whoAccountId, nil)
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{}, nil)
+	mockCurrency.On("Reserve", realAccountId, singleProxyDeposit).Return(nil)
+	mockStorageProxies.On("Put", realAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(realOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockLookup.AssertCalled(t, "Lookup", indexAddress)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_AddProxy_Dispatch_CannotLookup(t *testing.T) {
+	target := setupDecodedCallAddProxy(indexAddress)
+
+	_, err := target.Dispatch(realOrigin, target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func setupCallAddProxy() primitives.Call {
+	return newCallAddProxy(moduleId, functionAddProxyIndex, setupDelegation())
+}
+
+func setupDecodedCallAddProxy(delegate primitives.MultiAddress) primitives.Call {
+	target := setupCallAddProxy().(callAddProxy)
+	target.Arguments = sc.NewVaryingData(delegate, ProxyTypeAny, sc.U64(0))
+
+	return target
+}
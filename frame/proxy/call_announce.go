@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Publish the hash of a proxy-call that will be made in the future.
+// The dispatch origin for this call must be `Signed` by a proxy of `real`.
+type callAnnounce struct {
+	primitives.Callable
+	delegation
+}
+
+func newCallAnnounce(moduleId sc.U8, functionId sc.U8, delegation delegation) primitives.Call {
+	call := callAnnounce{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.H256{}),
+		},
+		delegation: delegation,
+	}
+
+	return call
+}
+
+func (c callAnnounce) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	realAddress, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		realAddress,
+		callHash,
+	)
+	return c, nil
+}
+
+func (c callAnnounce) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAnnounce) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAnnounce) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callAnnounce) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callAnnounce) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callAnnounce) BaseWeight() primitives.Weight {
+	return callAnnounceWeight(c.constants.DbWeight, sc.U64(c.constants.MaxPending), sc.U64(c.constants.MaxProxies))
+}
+
+func (_ callAnnounce) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callAnnounce) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callAnnounce) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callAnnounce) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	realAccount, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	callHash := args[1].(primitives.H256)
+
+	if _, err := c.findProxy(realAccount, who, sc.NewOption[ProxyType](nil)); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	height, err := c.storageBlockNumber()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	pending, err := c.storage.Announcements.Get(who)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if sc.U32(len(pending.Announcements)) >= c.constants.MaxPending {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorTooMany(c.ModuleId)
+	}
+
+	announcements := append(pending.Announcements, Announcement{
+		Real:     realAccount,
+		CallHash: callHash,
+		Height:   height,
+	})
+	deposit := c.deposit(c.constants.AnnouncementDepositBase, c.constants.AnnouncementDepositFactor, len(announcements))
+	if err := c.rejigDeposit(who, pending.Deposit, deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.storage.Announcements.Put(who, PendingAnnouncements{
+		Announcements: announcements,
+		Deposit:       deposit,
+	})
+	c.eventDepositor.DepositEvent(newEventAnnounced(c.ModuleId, realAccount, who, callHash))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callAnnounce) Docs() string {
+	return "Publish the hash of a proxy-call that will be made in the future. " +
+		"This must be called some number of blocks before the corresponding `proxy` is attempted " +
+		"if the delay associated with the proxy relationship is greater than zero. " +
+		"No more than `MaxPending` announcements may be made at any one time. " +
+		"This will take a deposit of `AnnouncementDepositFactor` as well as " +
+		"`AnnouncementDepositBase` if there are no other pending announcements. " +
+		"The dispatch origin for this call must be `Signed` and a proxy of `real`. " +
+		"Parameters: `real`: The account that the proxy will make a call on behalf of. " +
+		"`call_hash`: The hash of the call to be made by the `real` account."
+}
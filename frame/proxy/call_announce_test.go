@@ -0,0 +1,145 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	announcement = Announcement{
+		Real:     realAccountId,
+		CallHash: callHash,
+		Height:   blockNumber,
+	}
+	singleAnnouncementDeposit = sc.NewU128(55)
+)
+
+func Test_Call_Announce_New(t *testing.T) {
+	target := setupCallAnnounce()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionAnnounceIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.H256{}),
+	}
+
+	assert.Equal(t, expected, target.(callAnnounce).Callable)
+}
+
+func Test_Call_Announce_DecodeArgs(t *testing.T) {
+	target := setupCallAnnounce()
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(realAddress, callHash), call.Args())
+}
+
+func Test_Call_Announce_ModuleIndex(t *testing.T) {
+	target := setupCallAnnounce()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Announce_FunctionIndex(t *testing.T) {
+	target := setupCallAnnounce()
+
+	assert.Equal(t, sc.U8(functionAnnounceIndex), target.FunctionIndex())
+}
+
+func Test_Call_Announce_BaseWeight(t *testing.T) {
+	target := setupCallAnnounce()
+
+	assert.Equal(t, callAnnounceWeight(dbWeight, maxPending, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_Announce_ClassifyDispatch(t *testing.T) {
+	target := setupCallAnnounce()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Announce_PaysFee(t *testing.T) {
+	target := setupCallAnnounce()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Announce_Dispatch(t *testing.T) {
+	target := setupDecodedCallAnnounce()
+	expectedEvent := newEventAnnounced(moduleId, realAccountId, whoAccountId, callHash)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+	mockStorageAnnouncements.On("Get", whoAccountId).Return(PendingAnnouncements{}, nil)
+	mockCurrency.On("Reserve", whoAccountId, singleAnnouncementDeposit).Return(nil)
+	mockStorageAnnouncements.On("Put", whoAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Reserve", whoAccountId, singleAnnouncementDeposit)
+	mockStorageAnnouncements.AssertCalled(t, "Put", whoAccountId, PendingAnnouncements{
+		Announcements: sc.Sequence[Announcement]{announcement},
+		Deposit:       singleAnnouncementDeposit,
+	})
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Announce_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallAnnounce()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_Announce_Dispatch_NotProxy(t *testing.T) {
+	target := setupDecodedCallAnnounce()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{}, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+	mockStorageAnnouncements.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_Announce_Dispatch_TooMany(t *testing.T) {
+	target := setupDecodedCallAnnounce()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+	mockStorageAnnouncements.On("Get", whoAccountId).Return(PendingAnnouncements{
+		Announcements: sc.Sequence[Announcement]{announcement, announcement},
+		Deposit:       sc.NewU128(60),
+	}, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorTooMany(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func setupCallAnnounce() primitives.Call {
+	return newCallAnnounce(moduleId, functionAnnounceIndex, setupDelegation())
+}
+
+func setupDecodedCallAnnounce() primitives.Call {
+	target := setupCallAnnounce().(callAnnounce)
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+
+	return target
+}
@@ -0,0 +1,151 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Spawn a fresh new account that is guaranteed to be otherwise inaccessible, and
+// initialize it with a proxy of `proxy_type` for the sender.
+// The dispatch origin for this call must be `Signed`.
+type callCreatePure struct {
+	primitives.Callable
+	delegation
+}
+
+func newCallCreatePure(moduleId sc.U8, functionId sc.U8, delegation delegation) primitives.Call {
+	call := callCreatePure{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(ProxyType(0), sc.U64(0), sc.U16(0)),
+		},
+		delegation: delegation,
+	}
+
+	return call
+}
+
+func (c callCreatePure) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	proxyType, err := DecodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		proxyType,
+		delay,
+		index,
+	)
+	return c, nil
+}
+
+func (c callCreatePure) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCreatePure) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCreatePure) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callCreatePure) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callCreatePure) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callCreatePure) BaseWeight() primitives.Weight {
+	return callCreatePureWeight(c.constants.DbWeight, sc.U64(c.constants.MaxProxies))
+}
+
+func (_ callCreatePure) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCreatePure) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCreatePure) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callCreatePure) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	proxyType := args[0].(ProxyType)
+	delay := args[1].(sc.U64)
+	index := args[2].(sc.U16)
+
+	height, err := c.storageBlockNumber()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	extrinsicIndex, err := c.storageExtrinsicIndex()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	pure, err := c.pureAccountId(who, proxyType, index, height, extrinsicIndex)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if c.storage.Proxies.Exists(pure) {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorDuplicate(c.ModuleId)
+	}
+
+	deposit := sc.SaturatingAddU128(c.constants.ProxyDepositBase, c.constants.ProxyDepositFactor)
+	if err := c.currency.Reserve(who, deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.storage.Proxies.Put(pure, ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{
+			{
+				Delegate:  who,
+				ProxyType: proxyType,
+				Delay:     delay,
+			},
+		},
+		Deposit: deposit,
+	})
+	c.eventDepositor.DepositEvent(newEventPureCreated(c.ModuleId, pure, who, proxyType, index))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callCreatePure) Docs() string {
+	return "Spawn a fresh new account that is guaranteed to be otherwise inaccessible, and " +
+		"initialize it with a proxy of `proxy_type` for `origin` sender. " +
+		"Requires a `Signed` origin. " +
+		"`proxy_type`: The type of the proxy that the sender will be registered as over the new account. " +
+		"`delay`: The announcement period required of the initial proxy. Will generally be zero. " +
+		"`index`: A disambiguation index, in case this is called multiple times in the same " +
+		"transaction (e.g. with `utility::batch`). Unless you're using `batch` you probably just " +
+		"want to use `0`. " +
+		"Fails if there are insufficient funds to pay for deposit. " +
+		"Fails with `Duplicate` if this has already been called in this transaction, from the " +
+		"same sender, with the same parameters."
+}
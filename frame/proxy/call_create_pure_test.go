@@ -0,0 +1,136 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	pureIndex = sc.U16(1)
+)
+
+func Test_Call_CreatePure_New(t *testing.T) {
+	target := setupCallCreatePure()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionCreatePureIndex,
+		Arguments:  sc.NewVaryingData(ProxyType(0), sc.U64(0), sc.U16(0)),
+	}
+
+	assert.Equal(t, expected, target.(callCreatePure).Callable)
+}
+
+func Test_Call_CreatePure_DecodeArgs(t *testing.T) {
+	target := setupCallCreatePure()
+	buffer := &bytes.Buffer{}
+	buffer.Write(ProxyTypeGovernance.Bytes())
+	buffer.Write(sc.U64(5).Bytes())
+	buffer.Write(pureIndex.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(ProxyTypeGovernance, sc.U64(5), pureIndex), call.Args())
+}
+
+func Test_Call_CreatePure_ModuleIndex(t *testing.T) {
+	target := setupCallCreatePure()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_CreatePure_FunctionIndex(t *testing.T) {
+	target := setupCallCreatePure()
+
+	assert.Equal(t, sc.U8(functionCreatePureIndex), target.FunctionIndex())
+}
+
+func Test_Call_CreatePure_BaseWeight(t *testing.T) {
+	target := setupCallCreatePure()
+
+	assert.Equal(t, callCreatePureWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_CreatePure_ClassifyDispatch(t *testing.T) {
+	target := setupCallCreatePure()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_CreatePure_PaysFee(t *testing.T) {
+	target := setupCallCreatePure()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_CreatePure_Dispatch(t *testing.T) {
+	target := setupDecodedCallCreatePure()
+	setupPureAccountId(ProxyTypeAny, pureIndex)
+	expectedEvent := newEventPureCreated(moduleId, pureAccountId, whoAccountId, ProxyTypeAny, pureIndex)
+
+	mockStorageProxies.On("Exists", pureAccountId).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, singleProxyDeposit).Return(nil)
+	mockStorageProxies.On("Put", pureAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Reserve", whoAccountId, singleProxyDeposit)
+	mockStorageProxies.AssertCalled(t, "Put", pureAccountId, ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	})
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_CreatePure_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallCreatePure()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockHashing.AssertNotCalled(t, "Blake256", mock.Anything)
+}
+
+func Test_Call_CreatePure_Dispatch_Duplicate(t *testing.T) {
+	target := setupDecodedCallCreatePure()
+	setupPureAccountId(ProxyTypeAny, pureIndex)
+
+	mockStorageProxies.On("Exists", pureAccountId).Return(true)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorDuplicate(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CreatePure_Dispatch_ReserveFails(t *testing.T) {
+	target := setupDecodedCallCreatePure()
+	setupPureAccountId(ProxyTypeAny, pureIndex)
+
+	mockStorageProxies.On("Exists", pureAccountId).Return(false)
+	mockCurrency.On("Reserve", whoAccountId, singleProxyDeposit).Return(expectedErr)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func setupCallCreatePure() primitives.Call {
+	return newCallCreatePure(moduleId, functionCreatePureIndex, setupDelegation())
+}
+
+func setupDecodedCallCreatePure() primitives.Call {
+	target := setupCallCreatePure().(callCreatePure)
+	target.Arguments = sc.NewVaryingData(ProxyTypeAny, sc.U64(0), pureIndex)
+
+	return target
+}
@@ -0,0 +1,157 @@
+package proxy
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Remove a previously spawned pure proxy.
+// The dispatch origin for this call must be `Signed` by the pure account.
+type callKillPure struct {
+	primitives.Callable
+	delegation
+}
+
+func newCallKillPure(moduleId sc.U8, functionId sc.U8, delegation delegation) primitives.Call {
+	call := callKillPure{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments: sc.NewVaryingData(
+				primitives.MultiAddress{},
+				ProxyType(0),
+				sc.U16(0),
+				sc.Compact{Number: sc.U64(0)},
+				sc.Compact{Number: sc.U32(0)},
+			),
+		},
+		delegation: delegation,
+	}
+
+	return call
+}
+
+func (c callKillPure) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	spawner, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	proxyType, err := DecodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	height, err := sc.DecodeCompact[sc.U64](buffer)
+	if err != nil {
+		return nil, err
+	}
+	extrinsicIndex, err := sc.DecodeCompact[sc.U32](buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		spawner,
+		proxyType,
+		index,
+		height,
+		extrinsicIndex,
+	)
+	return c, nil
+}
+
+func (c callKillPure) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callKillPure) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callKillPure) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callKillPure) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callKillPure) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callKillPure) BaseWeight() primitives.Weight {
+	return callKillPureWeight(c.constants.DbWeight, sc.U64(c.constants.MaxProxies))
+}
+
+func (_ callKillPure) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callKillPure) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callKillPure) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callKillPure) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	spawner, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	proxyType := args[1].(ProxyType)
+	index := args[2].(sc.U16)
+	height := sc.U64(args[3].(sc.Compact).ToBigInt().Uint64())
+	extrinsicIndex := sc.U32(args[4].(sc.Compact).ToBigInt().Uint64())
+
+	pure, err := c.pureAccountId(spawner, proxyType, index, height, extrinsicIndex)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if !reflect.DeepEqual(pure, who) {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNoPermission(c.ModuleId)
+	}
+
+	proxies, err := c.storage.Proxies.Get(who)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	c.storage.Proxies.Remove(who)
+
+	if _, err := c.currency.Unreserve(spawner, proxies.Deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callKillPure) Docs() string {
+	return "Removes a previously spawned pure proxy. " +
+		"WARNING: **All access to this account will be lost.** Any funds held in it will be " +
+		"inaccessible. " +
+		"Requires a `Signed` origin, and the sender account must have been created by a call to " +
+		"`pure` with corresponding parameters. " +
+		"`spawner`: The account that originally called `pure` to create this account. " +
+		"`index`: The disambiguation index originally passed to `pure`. Probably `0`. " +
+		"`proxy_type`: The proxy type originally passed to `pure`. " +
+		"`height`: The height of the chain when the call to `pure` was processed. " +
+		"`ext_index`: The extrinsic index in which the call to `pure` was processed. " +
+		"Fails with `NoPermission` in case the caller is not a previously created pure " +
+		"account whose `pure` call has corresponding parameters."
+}
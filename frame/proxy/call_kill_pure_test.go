@@ -0,0 +1,148 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	pureOrigin            = primitives.NewRawOriginSigned(pureAccountId)
+	compactBlockNumber    = sc.Compact{Number: blockNumber}
+	compactExtrinsicIndex = sc.Compact{Number: extrinsicIndex}
+)
+
+func Test_Call_KillPure_New(t *testing.T) {
+	target := setupCallKillPure()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionKillPureIndex,
+		Arguments: sc.NewVaryingData(
+			primitives.MultiAddress{},
+			ProxyType(0),
+			sc.U16(0),
+			sc.Compact{Number: sc.U64(0)},
+			sc.Compact{Number: sc.U32(0)},
+		),
+	}
+
+	assert.Equal(t, expected, target.(callKillPure).Callable)
+}
+
+func Test_Call_KillPure_DecodeArgs(t *testing.T) {
+	target := setupCallKillPure()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(ProxyTypeAny.Bytes())
+	buffer.Write(pureIndex.Bytes())
+	buffer.Write(compactBlockNumber.Bytes())
+	buffer.Write(compactExtrinsicIndex.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		sc.NewVaryingData(whoAddress, ProxyTypeAny, pureIndex, compactBlockNumber, compactExtrinsicIndex),
+		call.Args(),
+	)
+}
+
+func Test_Call_KillPure_Encode(t *testing.T) {
+	target := setupDecodedCallKillPure()
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionKillPureIndex})
+	expectedBuffer.Write(whoAddress.Bytes())
+	expectedBuffer.Write(ProxyTypeAny.Bytes())
+	expectedBuffer.Write(pureIndex.Bytes())
+	expectedBuffer.Write(compactBlockNumber.Bytes())
+	expectedBuffer.Write(compactExtrinsicIndex.Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_KillPure_ModuleIndex(t *testing.T) {
+	target := setupCallKillPure()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_KillPure_FunctionIndex(t *testing.T) {
+	target := setupCallKillPure()
+
+	assert.Equal(t, sc.U8(functionKillPureIndex), target.FunctionIndex())
+}
+
+func Test_Call_KillPure_BaseWeight(t *testing.T) {
+	target := setupCallKillPure()
+
+	assert.Equal(t, callKillPureWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_KillPure_ClassifyDispatch(t *testing.T) {
+	target := setupCallKillPure()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_KillPure_PaysFee(t *testing.T) {
+	target := setupCallKillPure()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_KillPure_Dispatch(t *testing.T) {
+	target := setupDecodedCallKillPure()
+	setupPureAccountId(ProxyTypeAny, pureIndex)
+
+	mockStorageProxies.On("Get", pureAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+	mockStorageProxies.On("Remove", pureAccountId).Return()
+	mockCurrency.On("Unreserve", whoAccountId, singleProxyDeposit).Return(sc.NewU128(0), nil)
+
+	result, err := target.Dispatch(pureOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageProxies.AssertCalled(t, "Remove", pureAccountId)
+	mockCurrency.AssertCalled(t, "Unreserve", whoAccountId, singleProxyDeposit)
+}
+
+func Test_Call_KillPure_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallKillPure()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_KillPure_Dispatch_NoPermission(t *testing.T) {
+	target := setupDecodedCallKillPure()
+	setupPureAccountId(ProxyTypeAny, pureIndex)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNoPermission(moduleId), err)
+	mockStorageProxies.AssertNotCalled(t, "Remove", mock.Anything)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func setupCallKillPure() primitives.Call {
+	return newCallKillPure(moduleId, functionKillPureIndex, setupDelegation())
+}
+
+func setupDecodedCallKillPure() primitives.Call {
+	target := setupCallKillPure().(callKillPure)
+	target.Arguments = sc.NewVaryingData(whoAddress, ProxyTypeAny, pureIndex, compactBlockNumber, compactExtrinsicIndex)
+
+	return target
+}
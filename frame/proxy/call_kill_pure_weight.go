@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkProxyKillPure benchmark.
+
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callKillPureWeight(dbWeight primitives.RuntimeDbWeight, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(24000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(40000, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,132 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Dispatch the given call from an account that the sender is authorised for through `add_proxy`.
+// The dispatch origin for this call must be `Signed`.
+type callProxy struct {
+	primitives.Callable
+	delegation
+}
+
+func newCallProxy(moduleId sc.U8, functionId sc.U8, delegation delegation) primitives.Call {
+	call := callProxy{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments: sc.NewVaryingData(
+				primitives.MultiAddress{},
+				sc.NewOption[ProxyType](nil),
+				primitives.RuntimeCall{},
+			),
+		},
+		delegation: delegation,
+	}
+
+	return call
+}
+
+func (c callProxy) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callProxy) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	realAddress, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	forceProxyType, err := sc.DecodeOptionWith(buffer, DecodeProxyType)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		realAddress,
+		forceProxyType,
+		primitives.NewRuntimeCall(call),
+	)
+	return c, nil
+}
+
+func (c callProxy) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callProxy) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callProxy) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callProxy) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callProxy) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callProxy) BaseWeight() primitives.Weight {
+	dispatchInfo := primitives.GetDispatchInfo(c.Arguments[2].(primitives.RuntimeCall))
+
+	return callProxyWeight(c.constants.DbWeight, sc.U64(c.constants.MaxProxies)).
+		SaturatingAdd(dispatchInfo.Weight)
+}
+
+func (_ callProxy) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callProxy) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[2].(primitives.RuntimeCall)).Class
+}
+
+func (_ callProxy) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callProxy) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	realAccount, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	forceProxyType := args[1].(sc.Option[ProxyType])
+	call := args[2].(primitives.RuntimeCall)
+
+	definition, err := c.findProxy(realAccount, who, forceProxyType)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if definition.Delay != 0 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorUnannounced(c.ModuleId)
+	}
+
+	return primitives.PostDispatchInfo{}, c.doProxy(definition, realAccount, call)
+}
+
+func (_ callProxy) Docs() string {
+	return "Dispatch the given `call` from an account that the sender is authorised for through `add_proxy`. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `real`: The account that the proxy will make a call on behalf of. " +
+		"`force_proxy_type`: Specify the exact proxy type to be used and checked for this call. " +
+		"`call`: The call to be made by the `real` account."
+}
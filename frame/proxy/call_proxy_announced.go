@@ -0,0 +1,189 @@
+package proxy
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Dispatch the given call from an account that the sender is authorized for through `add_proxy`,
+// once the call has been announced by the delegate and the delay of the proxy has passed.
+// The dispatch origin for this call must be `Signed`.
+type callProxyAnnounced struct {
+	primitives.Callable
+	delegation
+}
+
+func newCallProxyAnnounced(moduleId sc.U8, functionId sc.U8, delegation delegation) primitives.Call {
+	call := callProxyAnnounced{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments: sc.NewVaryingData(
+				primitives.MultiAddress{},
+				primitives.MultiAddress{},
+				sc.NewOption[ProxyType](nil),
+				primitives.RuntimeCall{},
+			),
+		},
+		delegation: delegation,
+	}
+
+	return call
+}
+
+func (c callProxyAnnounced) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callProxyAnnounced) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	delegateAddress, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	realAddress, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	forceProxyType, err := sc.DecodeOptionWith(buffer, DecodeProxyType)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		delegateAddress,
+		realAddress,
+		forceProxyType,
+		primitives.NewRuntimeCall(call),
+	)
+	return c, nil
+}
+
+func (c callProxyAnnounced) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callProxyAnnounced) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callProxyAnnounced) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callProxyAnnounced) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callProxyAnnounced) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callProxyAnnounced) BaseWeight() primitives.Weight {
+	dispatchInfo := primitives.GetDispatchInfo(c.Arguments[3].(primitives.RuntimeCall))
+
+	return callProxyAnnouncedWeight(c.constants.DbWeight, sc.U64(c.constants.MaxPending), sc.U64(c.constants.MaxProxies)).
+		SaturatingAdd(dispatchInfo.Weight)
+}
+
+func (_ callProxyAnnounced) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callProxyAnnounced) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[3].(primitives.RuntimeCall)).Class
+}
+
+func (_ callProxyAnnounced) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callProxyAnnounced) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	delegate, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	realAccount, err := c.lookup.Lookup(args[1].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	forceProxyType := args[2].(sc.Option[ProxyType])
+	call := args[3].(primitives.RuntimeCall)
+
+	definition, err := c.findProxy(realAccount, delegate, forceProxyType)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	callHash, err := c.callHash(call)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	now, err := c.storageBlockNumber()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	if err := c.removeAnnouncements(delegate, realAccount, callHash, now, definition.Delay); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.doProxy(definition, realAccount, call)
+}
+
+// removeAnnouncements removes the announcements of `callHash` by `delegate` on behalf of `realAccount`,
+// whose delay has passed, and updates the deposit of `delegate`. Fails if there is no such announcement.
+func (c callProxyAnnounced) removeAnnouncements(delegate primitives.AccountId, realAccount primitives.AccountId, callHash primitives.H256, now sc.U64, delay sc.U64) error {
+	pending, err := c.storage.Announcements.Get(delegate)
+	if err != nil {
+		return err
+	}
+
+	announcements := sc.Sequence[Announcement]{}
+	for _, announcement := range pending.Announcements {
+		matches := reflect.DeepEqual(announcement.Real, realAccount) &&
+			reflect.DeepEqual(announcement.CallHash, callHash) &&
+			announcement.Height <= now && now-announcement.Height >= delay
+		if !matches {
+			announcements = append(announcements, announcement)
+		}
+	}
+	if len(announcements) == len(pending.Announcements) {
+		return NewDispatchErrorUnannounced(c.ModuleId)
+	}
+
+	deposit := c.deposit(c.constants.AnnouncementDepositBase, c.constants.AnnouncementDepositFactor, len(announcements))
+	if err := c.rejigDeposit(delegate, pending.Deposit, deposit); err != nil {
+		return err
+	}
+
+	if len(announcements) == 0 {
+		c.storage.Announcements.Remove(delegate)
+	} else {
+		c.storage.Announcements.Put(delegate, PendingAnnouncements{
+			Announcements: announcements,
+			Deposit:       deposit,
+		})
+	}
+
+	return nil
+}
+
+func (_ callProxyAnnounced) Docs() string {
+	return "Dispatch the given `call` from an account that the sender is authorized for through " +
+		"`add_proxy`. " +
+		"Removes any corresponding announcement(s). " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `real`: The account that the proxy will make a call on behalf of. " +
+		"`force_proxy_type`: Specify the exact proxy type to be used and checked for this call. " +
+		"`call`: The call to be made by the `real` account."
+}
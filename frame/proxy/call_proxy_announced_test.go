@@ -0,0 +1,201 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	// matureAnnouncement is announced `delayedDefinition.Delay` blocks before `blockNumber`.
+	matureAnnouncement = Announcement{
+		Real:     realAccountId,
+		CallHash: callHash,
+		Height:   blockNumber - delayedDefinition.Delay,
+	}
+	otherAnnouncement = Announcement{
+		Real:     pureAccountId,
+		CallHash: callHash,
+		Height:   blockNumber,
+	}
+)
+
+func Test_Call_ProxyAnnounced_New(t *testing.T) {
+	target := setupCallProxyAnnounced()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionProxyAnnouncedIndex,
+		Arguments: sc.NewVaryingData(
+			primitives.MultiAddress{},
+			primitives.MultiAddress{},
+			sc.NewOption[ProxyType](nil),
+			primitives.RuntimeCall{},
+		),
+	}
+
+	assert.Equal(t, expected, target.(callProxyAnnounced).Callable)
+}
+
+func Test_Call_ProxyAnnounced_DecodeArgs(t *testing.T) {
+	target := setupCallProxyAnnounced()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(whoAddress.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_ProxyAnnounced_DecodeNestedArgs(t *testing.T) {
+	target := setupCallProxyAnnounced()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(forceProxyAny.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		sc.NewVaryingData(whoAddress, realAddress, forceProxyAny, primitives.NewRuntimeCall(mockCall)),
+		call.Args(),
+	)
+}
+
+func Test_Call_ProxyAnnounced_ModuleIndex(t *testing.T) {
+	target := setupCallProxyAnnounced()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ProxyAnnounced_FunctionIndex(t *testing.T) {
+	target := setupCallProxyAnnounced()
+
+	assert.Equal(t, sc.U8(functionProxyAnnouncedIndex), target.FunctionIndex())
+}
+
+func Test_Call_ProxyAnnounced_BaseWeight(t *testing.T) {
+	target := setupDecodedCallProxyAnnounced()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+
+	expected := callProxyAnnouncedWeight(dbWeight, maxPending, maxProxies).SaturatingAdd(callWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_ProxyAnnounced_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallProxyAnnounced()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ProxyAnnounced_PaysFee(t *testing.T) {
+	target := setupCallProxyAnnounced()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ProxyAnnounced_Dispatch(t *testing.T) {
+	target := setupDecodedCallProxyAnnounced()
+	setupCallBytes(mockCall)
+	setupCallIndices(mockCall, utilityIndex, 0)
+	setupCallDispatch(mockCall, realOrigin, nil)
+	runInStorageLayer(nil)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(nil)
+	expectedEvent := newEventProxyExecuted(moduleId, expectedResult)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+	mockHashing.On("Blake256", callBytes).Return(callHash.Bytes())
+	mockStorageAnnouncements.On("Get", whoAccountId).Return(PendingAnnouncements{
+		Announcements: sc.Sequence[Announcement]{matureAnnouncement},
+		Deposit:       singleAnnouncementDeposit,
+	}, nil)
+	mockCurrency.On("Unreserve", whoAccountId, singleAnnouncementDeposit).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Remove", whoAccountId).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAnnouncements.AssertCalled(t, "Remove", whoAccountId)
+	mockCall.AssertCalled(t, "Dispatch", realOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_ProxyAnnounced_Dispatch_KeepsOtherAnnouncements(t *testing.T) {
+	target := setupDecodedCallProxyAnnounced()
+	setupCallBytes(mockCall)
+	setupCallIndices(mockCall, utilityIndex, 0)
+	setupCallDispatch(mockCall, realOrigin, nil)
+	runInStorageLayer(nil)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+	mockHashing.On("Blake256", callBytes).Return(callHash.Bytes())
+	mockStorageAnnouncements.On("Get", whoAccountId).Return(PendingAnnouncements{
+		Announcements: sc.Sequence[Announcement]{matureAnnouncement, otherAnnouncement},
+		Deposit:       sc.NewU128(60),
+	}, nil)
+	mockCurrency.On("Unreserve", whoAccountId, sc.NewU128(5)).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Put", whoAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	mockStorageAnnouncements.AssertCalled(t, "Put", whoAccountId, PendingAnnouncements{
+		Announcements: sc.Sequence[Announcement]{otherAnnouncement},
+		Deposit:       singleAnnouncementDeposit,
+	})
+}
+
+func Test_Call_ProxyAnnounced_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallProxyAnnounced()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_ProxyAnnounced_Dispatch_Unannounced(t *testing.T) {
+	target := setupDecodedCallProxyAnnounced()
+	setupCallBytes(mockCall)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+	mockHashing.On("Blake256", callBytes).Return(callHash.Bytes())
+	mockStorageAnnouncements.On("Get", whoAccountId).Return(PendingAnnouncements{
+		Announcements: sc.Sequence[Announcement]{announcement},
+		Deposit:       singleAnnouncementDeposit,
+	}, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorUnannounced(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func setupCallProxyAnnounced() primitives.Call {
+	return newCallProxyAnnounced(moduleId, functionProxyAnnouncedIndex, setupDelegation())
+}
+
+func setupDecodedCallProxyAnnounced() primitives.Call {
+	target := setupCallProxyAnnounced().(callProxyAnnounced)
+	target.Arguments = sc.NewVaryingData(whoAddress, realAddress, noForceProxyType, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
@@ -0,0 +1,16 @@
+// Reference weight, to be replaced by the output of the BenchmarkProxyProxyAnnounced benchmark.
+
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callProxyAnnouncedWeight(dbWeight primitives.RuntimeDbWeight, announcements sc.U64, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(40000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(150000, 0).SaturatingMul(announcements)).
+		SaturatingAdd(primitives.WeightFromParts(40000, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
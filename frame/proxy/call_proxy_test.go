@@ -0,0 +1,174 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	noForceProxyType = sc.NewOption[ProxyType](nil)
+	forceProxyAny    = sc.NewOption[ProxyType](ProxyTypeAny)
+)
+
+func Test_Call_Proxy_New(t *testing.T) {
+	target := setupCallProxy()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionProxyIndex,
+		Arguments: sc.NewVaryingData(
+			primitives.MultiAddress{},
+			sc.NewOption[ProxyType](nil),
+			primitives.RuntimeCall{},
+		),
+	}
+
+	assert.Equal(t, expected, target.(callProxy).Callable)
+}
+
+func Test_Call_Proxy_DecodeArgs(t *testing.T) {
+	target := setupCallProxy()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(realAddress.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_Proxy_DecodeNestedArgs(t *testing.T) {
+	target := setupCallProxy()
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(forceProxyAny.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		sc.NewVaryingData(realAddress, forceProxyAny, primitives.NewRuntimeCall(mockCall)),
+		call.Args(),
+	)
+}
+
+func Test_Call_Proxy_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallProxy()
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(noForceProxyType.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_Proxy_ModuleIndex(t *testing.T) {
+	target := setupCallProxy()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Proxy_FunctionIndex(t *testing.T) {
+	target := setupCallProxy()
+
+	assert.Equal(t, sc.U8(functionProxyIndex), target.FunctionIndex())
+}
+
+func Test_Call_Proxy_BaseWeight(t *testing.T) {
+	target := setupDecodedCallProxy(noForceProxyType)
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+
+	expected := callProxyWeight(dbWeight, maxProxies).SaturatingAdd(callWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_Proxy_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallProxy(noForceProxyType)
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Proxy_PaysFee(t *testing.T) {
+	target := setupCallProxy()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Proxy_Dispatch(t *testing.T) {
+	target := setupDecodedCallProxy(noForceProxyType)
+	setupCallIndices(mockCall, utilityIndex, 0)
+	setupCallDispatch(mockCall, realOrigin, nil)
+	runInStorageLayer(nil)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(nil)
+	expectedEvent := newEventProxyExecuted(moduleId, expectedResult)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCall.AssertCalled(t, "Dispatch", realOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Proxy_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallProxy(noForceProxyType)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_Proxy_Dispatch_NotProxy(t *testing.T) {
+	target := setupDecodedCallProxy(forceProxyAny)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Proxy_Dispatch_Unannounced(t *testing.T) {
+	target := setupDecodedCallProxy(noForceProxyType)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{delayedDefinition},
+	}, nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorUnannounced(moduleId), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func setupCallProxy() primitives.Call {
+	return newCallProxy(moduleId, functionProxyIndex, setupDelegation())
+}
+
+func setupDecodedCallProxy(forceProxyType sc.Option[ProxyType]) primitives.Call {
+	target := setupCallProxy().(callProxy)
+	target.Arguments = sc.NewVaryingData(realAddress, forceProxyType, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
@@ -0,0 +1,14 @@
+// Reference weight, to be replaced by the output of the BenchmarkProxyProxy benchmark.
+
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callProxyWeight(dbWeight primitives.RuntimeDbWeight, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(15500000, 0).
+		SaturatingAdd(primitives.WeightFromParts(40000, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(1))
+}
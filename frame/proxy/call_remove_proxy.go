@@ -0,0 +1,113 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Unregister a proxy account for the sender.
+// The dispatch origin for this call must be `Signed`.
+type callRemoveProxy struct {
+	primitives.Callable
+	delegation
+}
+
+func newCallRemoveProxy(moduleId sc.U8, functionId sc.U8, delegation delegation) primitives.Call {
+	call := callRemoveProxy{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, ProxyType(0), sc.U64(0)),
+		},
+		delegation: delegation,
+	}
+
+	return call
+}
+
+func (c callRemoveProxy) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	delegate, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	proxyType, err := DecodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		delegate,
+		proxyType,
+		delay,
+	)
+	return c, nil
+}
+
+func (c callRemoveProxy) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRemoveProxy) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRemoveProxy) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callRemoveProxy) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callRemoveProxy) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callRemoveProxy) BaseWeight() primitives.Weight {
+	return callRemoveProxyWeight(c.constants.DbWeight, sc.U64(c.constants.MaxProxies))
+}
+
+func (_ callRemoveProxy) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRemoveProxy) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRemoveProxy) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callRemoveProxy) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	delegate, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	proxyType := args[1].(ProxyType)
+	delay := args[2].(sc.U64)
+
+	return primitives.PostDispatchInfo{}, c.removeProxyDelegate(who, delegate, proxyType, delay)
+}
+
+func (_ callRemoveProxy) Docs() string {
+	return "Unregister a proxy account for the sender. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `proxy`: The account that the `caller` would like to remove as a proxy. " +
+		"`proxy_type`: The permissions currently enabled for the removed proxy account. " +
+		"`delay`: The announcement period of the removed proxy."
+}
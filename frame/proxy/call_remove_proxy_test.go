@@ -0,0 +1,152 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_RemoveProxy_New(t *testing.T) {
+	target := setupCallRemoveProxy()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionRemoveProxyIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, ProxyType(0), sc.U64(0)),
+	}
+
+	assert.Equal(t, expected, target.(callRemoveProxy).Callable)
+}
+
+func Test_Call_RemoveProxy_DecodeArgs(t *testing.T) {
+	target := setupCallRemoveProxy()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(ProxyTypeNonTransfer.Bytes())
+	buffer.Write(sc.U64(5).Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, ProxyTypeNonTransfer, sc.U64(5)), call.Args())
+}
+
+func Test_Call_RemoveProxy_DecodeArgs_InvalidProxyType(t *testing.T) {
+	target := setupCallRemoveProxy()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.WriteByte(3)
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, errInvalidProxyType, err)
+}
+
+func Test_Call_RemoveProxy_Encode(t *testing.T) {
+	target := setupDecodedCallRemoveProxy(whoAddress)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionRemoveProxyIndex})
+	expectedBuffer.Write(whoAddress.Bytes())
+	expectedBuffer.Write(ProxyTypeAny.Bytes())
+	expectedBuffer.Write(sc.U64(0).Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_RemoveProxy_ModuleIndex(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_RemoveProxy_FunctionIndex(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	assert.Equal(t, sc.U8(functionRemoveProxyIndex), target.FunctionIndex())
+}
+
+func Test_Call_RemoveProxy_BaseWeight(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	assert.Equal(t, callRemoveProxyWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_RemoveProxy_ClassifyDispatch(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_RemoveProxy_PaysFee(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_RemoveProxy_Dispatch(t *testing.T) {
+	target := setupDecodedCallRemoveProxy(whoAddress)
+	expectedEvent := newEventProxyRemoved(moduleId, realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+	mockCurrency.On("Unreserve", realAccountId, singleProxyDeposit).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Remove", realAccountId).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(realOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", realAccountId, singleProxyDeposit)
+	mockStorageProxies.AssertCalled(t, "Remove", realAccountId)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_RemoveProxy_Dispatch_NotFound(t *testing.T) {
+	target := setupDecodedCallRemoveProxy(whoAddress)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{}, nil)
+
+	_, err := target.Dispatch(realOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockStorageProxies.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_RemoveProxy_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallRemoveProxy(whoAddress)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Call_RemoveProxy_Dispatch_CannotLookup(t *testing.T) {
+	target := setupDecodedCallRemoveProxy(primitives.NewMultiAddressIndex(1))
+
+	_, err := target.Dispatch(realOrigin, target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func setupCallRemoveProxy() primitives.Call {
+	return newCallRemoveProxy(moduleId, functionRemoveProxyIndex, setupDelegation())
+}
+
+func setupDecodedCallRemoveProxy(delegate primitives.MultiAddress) primitives.Call {
+	target := setupCallRemoveProxy().(callRemoveProxy)
+	target.Arguments = sc.NewVaryingData(delegate, ProxyTypeAny, sc.U64(0))
+
+	return target
+}
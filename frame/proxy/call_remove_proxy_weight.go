@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkProxyRemoveProxy benchmark.
+
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callRemoveProxyWeight(dbWeight primitives.RuntimeDbWeight, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(25000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(60000, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
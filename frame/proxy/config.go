@@ -0,0 +1,40 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight                  primitives.RuntimeDbWeight
+	EventDepositor            primitives.EventDepositor
+	Currency                  primitives.ReservableCurrency
+	InstanceFilter            InstanceFilter
+	ProxyDepositBase          sc.U128
+	ProxyDepositFactor        sc.U128
+	MaxProxies                sc.U32
+	MaxPending                sc.U32
+	AnnouncementDepositBase   sc.U128
+	AnnouncementDepositFactor sc.U128
+	StorageBlockNumber        func() (sc.U64, error)
+	StorageExtrinsicIndex     func() (sc.U32, error)
+	Lookup                    primitives.StaticLookup
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, currency primitives.ReservableCurrency, instanceFilter InstanceFilter, proxyDepositBase sc.U128, proxyDepositFactor sc.U128, maxProxies sc.U32, maxPending sc.U32, announcementDepositBase sc.U128, announcementDepositFactor sc.U128, storageBlockNumber func() (sc.U64, error), storageExtrinsicIndex func() (sc.U32, error), lookup primitives.StaticLookup) *Config {
+	return &Config{
+		DbWeight:                  dbWeight,
+		EventDepositor:            eventDepositor,
+		Currency:                  currency,
+		InstanceFilter:            instanceFilter,
+		ProxyDepositBase:          proxyDepositBase,
+		ProxyDepositFactor:        proxyDepositFactor,
+		MaxProxies:                maxProxies,
+		MaxPending:                maxPending,
+		AnnouncementDepositBase:   announcementDepositBase,
+		AnnouncementDepositFactor: announcementDepositFactor,
+		StorageBlockNumber:        storageBlockNumber,
+		StorageExtrinsicIndex:     storageExtrinsicIndex,
+		Lookup:                    lookup,
+	}
+}
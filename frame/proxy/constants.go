@@ -0,0 +1,37 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight                  primitives.RuntimeDbWeight
+	ProxyDepositBase          sc.U128
+	ProxyDepositFactor        sc.U128
+	MaxProxies                sc.U32
+	MaxPending                sc.U32
+	AnnouncementDepositBase   sc.U128
+	AnnouncementDepositFactor sc.U128
+}
+
+type metadataConstants struct {
+	ProxyDepositBase          primitives.ProxyDepositBase
+	ProxyDepositFactor        primitives.ProxyDepositFactor
+	MaxProxies                primitives.MaxProxies
+	MaxPending                primitives.MaxPending
+	AnnouncementDepositBase   primitives.AnnouncementDepositBase
+	AnnouncementDepositFactor primitives.AnnouncementDepositFactor
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, proxyDepositBase sc.U128, proxyDepositFactor sc.U128, maxProxies sc.U32, maxPending sc.U32, announcementDepositBase sc.U128, announcementDepositFactor sc.U128) *consts {
+	return &consts{
+		DbWeight:                  dbWeight,
+		ProxyDepositBase:          proxyDepositBase,
+		ProxyDepositFactor:        proxyDepositFactor,
+		MaxProxies:                maxProxies,
+		MaxPending:                maxPending,
+		AnnouncementDepositBase:   announcementDepositBase,
+		AnnouncementDepositFactor: announcementDepositFactor,
+	}
+}
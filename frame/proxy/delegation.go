@@ -0,0 +1,252 @@
+package proxy
+
+import (
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// pureAccountIdPrefix is the prefix of the entropy of pure account ids. It is the
+	// same as in `pallet_proxy`, so that the ids match the ones derived by polkadot.js.
+	pureAccountIdPrefix = []byte("modlpy/proxy____")
+)
+
+// delegation holds the dependencies and logic, shared by the calls of the module.
+type delegation struct {
+	moduleId              sc.U8
+	constants             *consts
+	storage               *storage
+	eventDepositor        primitives.EventDepositor
+	currency              primitives.ReservableCurrency
+	instanceFilter        InstanceFilter
+	storageBlockNumber    func() (sc.U64, error)
+	storageExtrinsicIndex func() (sc.U32, error)
+	lookup                primitives.StaticLookup
+	transactional         support.Transactional[primitives.PostDispatchInfo]
+	hashing               io.Hashing
+}
+
+func newDelegation(moduleId sc.U8, config *Config, constants *consts, storage *storage, transactional support.Transactional[primitives.PostDispatchInfo], hashing io.Hashing) delegation {
+	return delegation{
+		moduleId:              moduleId,
+		constants:             constants,
+		storage:               storage,
+		eventDepositor:        config.EventDepositor,
+		currency:              config.Currency,
+		instanceFilter:        config.InstanceFilter,
+		storageBlockNumber:    config.StorageBlockNumber,
+		storageExtrinsicIndex: config.StorageExtrinsicIndex,
+		lookup:                config.Lookup,
+		transactional:         transactional,
+		hashing:               hashing,
+	}
+}
+
+// addProxyDelegate registers `delegatee` as a proxy of `delegator` and updates the deposit of `delegator`.
+func (d delegation) addProxyDelegate(delegator primitives.AccountId, delegatee primitives.AccountId, proxyType ProxyType, delay sc.U64) error {
+	if reflect.DeepEqual(delegator, delegatee) {
+		return NewDispatchErrorNoSelfProxy(d.moduleId)
+	}
+
+	proxies, err := d.storage.Proxies.Get(delegator)
+	if err != nil {
+		return err
+	}
+
+	definition := ProxyDefinition{
+		Delegate:  delegatee,
+		ProxyType: proxyType,
+		Delay:     delay,
+	}
+	position, found := searchDefinition(proxies.Definitions, definition)
+	if found {
+		return NewDispatchErrorDuplicate(d.moduleId)
+	}
+	if sc.U32(len(proxies.Definitions)) >= d.constants.MaxProxies {
+		return NewDispatchErrorTooMany(d.moduleId)
+	}
+
+	definitions := make(sc.Sequence[ProxyDefinition], 0, len(proxies.Definitions)+1)
+	definitions = append(definitions, proxies.Definitions[:position]...)
+	definitions = append(definitions, definition)
+	definitions = append(definitions, proxies.Definitions[position:]...)
+
+	deposit := d.deposit(d.constants.ProxyDepositBase, d.constants.ProxyDepositFactor, len(definitions))
+	if err := d.rejigDeposit(delegator, proxies.Deposit, deposit); err != nil {
+		return err
+	}
+
+	d.storage.Proxies.Put(delegator, ProxyDefinitions{
+		Definitions: definitions,
+		Deposit:     deposit,
+	})
+	d.eventDepositor.DepositEvent(newEventProxyAdded(d.moduleId, delegator, delegatee, proxyType, delay))
+
+	return nil
+}
+
+// removeProxyDelegate unregisters `delegatee` as a proxy of `delegator` and updates the deposit of `delegator`.
+func (d delegation) removeProxyDelegate(delegator primitives.AccountId, delegatee primitives.AccountId, proxyType ProxyType, delay sc.U64) error {
+	proxies, err := d.storage.Proxies.Get(delegator)
+	if err != nil {
+		return err
+	}
+
+	definition := ProxyDefinition{
+		Delegate:  delegatee,
+		ProxyType: proxyType,
+		Delay:     delay,
+	}
+	position, found := searchDefinition(proxies.Definitions, definition)
+	if !found {
+		return NewDispatchErrorNotFound(d.moduleId)
+	}
+
+	definitions := append(sc.Sequence[ProxyDefinition]{}, proxies.Definitions[:position]...)
+	definitions = append(definitions, proxies.Definitions[position+1:]...)
+
+	deposit := d.deposit(d.constants.ProxyDepositBase, d.constants.ProxyDepositFactor, len(definitions))
+	if err := d.rejigDeposit(delegator, proxies.Deposit, deposit); err != nil {
+		return err
+	}
+
+	if len(definitions) == 0 {
+		d.storage.Proxies.Remove(delegator)
+	} else {
+		d.storage.Proxies.Put(delegator, ProxyDefinitions{
+			Definitions: definitions,
+			Deposit:     deposit,
+		})
+	}
+	d.eventDepositor.DepositEvent(newEventProxyRemoved(d.moduleId, delegator, delegatee, proxyType, delay))
+
+	return nil
+}
+
+// findProxy returns the definition, under which `delegate` is a proxy of `realAccount`.
+// If `forceProxyType` is given, only a definition of this type is matched.
+func (d delegation) findProxy(realAccount primitives.AccountId, delegate primitives.AccountId, forceProxyType sc.Option[ProxyType]) (ProxyDefinition, error) {
+	proxies, err := d.storage.Proxies.Get(realAccount)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+
+	for _, definition := range proxies.Definitions {
+		if !reflect.DeepEqual(definition.Delegate, delegate) {
+			continue
+		}
+		if forceProxyType.HasValue && definition.ProxyType != forceProxyType.Value {
+			continue
+		}
+		return definition, nil
+	}
+
+	return ProxyDefinition{}, NewDispatchErrorNotProxy(d.moduleId)
+}
+
+// doProxy dispatches `call` from `realAccount`, if it is allowed by the definition of the proxy.
+// The outcome of the call is deposited as an event, but it does not fail the proxy call.
+func (d delegation) doProxy(definition ProxyDefinition, realAccount primitives.AccountId, call primitives.Call) error {
+	var dispatchErr error
+	if d.filter(definition.ProxyType, call) {
+		dispatchErr = d.dispatchWithStorageLayer(call, primitives.NewRawOriginSigned(realAccount))
+	} else {
+		dispatchErr = NewDispatchErrorUnproxyable(d.moduleId)
+	}
+
+	result, err := primitives.NewDispatchOutcomeFromError(dispatchErr)
+	if err != nil {
+		return err
+	}
+	d.eventDepositor.DepositEvent(newEventProxyExecuted(d.moduleId, result))
+
+	return nil
+}
+
+// filter returns whether a proxy of `proxyType` can dispatch `call`.
+//
+// Besides the instance filter, a proxy cannot add or remove proxies with more permissions than
+// its own, and only a proxy of ProxyTypeAny can kill a pure account. These checks apply to the
+// nested calls as well.
+func (d delegation) filter(proxyType ProxyType, call primitives.Call) bool {
+	if call.ModuleIndex() == d.moduleId {
+		switch call.FunctionIndex() {
+		case functionAddProxyIndex, functionRemoveProxyIndex:
+			if !proxyType.IsSuperset(call.Args()[1].(ProxyType)) {
+				return false
+			}
+		case functionKillPureIndex:
+			if proxyType != ProxyTypeAny {
+				return false
+			}
+		}
+	}
+
+	for _, nested := range nestedCalls(call) {
+		if !d.filter(proxyType, nested) {
+			return false
+		}
+	}
+
+	return d.instanceFilter.Filter(proxyType, call)
+}
+
+// pureAccountId derives the id of a pure account, spawned by `spawner` at the given height
+// and extrinsic index.
+func (d delegation) pureAccountId(spawner primitives.AccountId, proxyType ProxyType, index sc.U16, height sc.U64, extrinsicIndex sc.U32) (primitives.AccountId, error) {
+	entropy := append([]byte{}, pureAccountIdPrefix...)
+	entropy = append(entropy, spawner.Bytes()...)
+	entropy = append(entropy, height.Bytes()...)
+	entropy = append(entropy, extrinsicIndex.Bytes()...)
+	entropy = append(entropy, proxyType.Bytes()...)
+	entropy = append(entropy, index.Bytes()...)
+
+	return primitives.NewAccountId(sc.BytesToSequenceU8(d.hashing.Blake256(entropy))...)
+}
+
+// callHash returns the hash, under which a call is announced.
+func (d delegation) callHash(call primitives.Call) (primitives.H256, error) {
+	return primitives.NewH256(sc.BytesToSequenceU8(d.hashing.Blake256(call.Bytes()))...)
+}
+
+// deposit returns the deposit for `count` items, which is zero if there are no items.
+func (d delegation) deposit(base sc.U128, factor sc.U128, count int) sc.U128 {
+	if count == 0 {
+		return sc.NewU128(0)
+	}
+	return sc.SaturatingAddU128(base, factor.Mul(sc.NewU128(uint64(count))))
+}
+
+// rejigDeposit reserves or unreserves the difference between the old and the new deposit of `who`.
+func (d delegation) rejigDeposit(who primitives.AccountId, oldDeposit sc.U128, newDeposit sc.U128) error {
+	if newDeposit.Gt(oldDeposit) {
+		return d.currency.Reserve(who, newDeposit.Sub(oldDeposit))
+	}
+	if newDeposit.Lt(oldDeposit) {
+		_, err := d.currency.Unreserve(who, oldDeposit.Sub(newDeposit))
+		return err
+	}
+	return nil
+}
+
+// dispatchWithStorageLayer dispatches the call in a new storage layer, which is rolled back if the call fails.
+func (d delegation) dispatchWithStorageLayer(call primitives.Call, origin primitives.RuntimeOrigin) error {
+	_, err := d.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		return call.Dispatch(origin, call.Args())
+	})
+	return err
+}
+
+// searchDefinition returns the position of `definition` in the sorted definitions and whether it is found.
+func searchDefinition(definitions sc.Sequence[ProxyDefinition], definition ProxyDefinition) (int, bool) {
+	position := sort.Search(len(definitions), func(i int) bool {
+		return definitions[i].compare(definition) >= 0
+	})
+
+	return position, position < len(definitions) && definitions[position].compare(definition) == 0
+}
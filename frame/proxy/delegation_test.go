@@ -0,0 +1,347 @@
+package proxy
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	anyDefinition = ProxyDefinition{
+		Delegate:  whoAccountId,
+		ProxyType: ProxyTypeAny,
+	}
+	delayedDefinition = ProxyDefinition{
+		Delegate:  whoAccountId,
+		ProxyType: ProxyTypeNonTransfer,
+		Delay:     5,
+	}
+	otherDefinition = ProxyDefinition{
+		Delegate:  pureAccountId,
+		ProxyType: ProxyTypeAny,
+	}
+	singleProxyDeposit = sc.NewU128(110)
+	doubleProxyDeposit = sc.NewU128(120)
+)
+
+func Test_Delegation_addProxyDelegate(t *testing.T) {
+	target := setupDelegation()
+	expectedEvent := newEventProxyAdded(moduleId, realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{}, nil)
+	mockCurrency.On("Reserve", realAccountId, singleProxyDeposit).Return(nil)
+	mockStorageProxies.On("Put", realAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.addProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "Reserve", realAccountId, singleProxyDeposit)
+	mockStorageProxies.AssertCalled(t, "Put", realAccountId, ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	})
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Delegation_addProxyDelegate_Sorted(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{otherDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+	mockCurrency.On("Reserve", realAccountId, sc.NewU128(10)).Return(nil)
+	mockStorageProxies.On("Put", realAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	err := target.addProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Nil(t, err)
+	mockStorageProxies.AssertCalled(t, "Put", realAccountId, ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition, otherDefinition},
+		Deposit:     doubleProxyDeposit,
+	})
+}
+
+func Test_Delegation_addProxyDelegate_NoSelfProxy(t *testing.T) {
+	target := setupDelegation()
+
+	err := target.addProxyDelegate(whoAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorNoSelfProxy(moduleId), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Delegation_addProxyDelegate_Duplicate(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+
+	err := target.addProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorDuplicate(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Delegation_addProxyDelegate_TooMany(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{
+			{Delegate: newTestAccountId(3)},
+			{Delegate: newTestAccountId(4)},
+			{Delegate: newTestAccountId(5)},
+		},
+	}, nil)
+
+	err := target.addProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorTooMany(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Delegation_addProxyDelegate_ReserveFails(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{}, nil)
+	mockCurrency.On("Reserve", realAccountId, singleProxyDeposit).Return(expectedErr)
+
+	err := target.addProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Delegation_removeProxyDelegate(t *testing.T) {
+	target := setupDelegation()
+	expectedEvent := newEventProxyRemoved(moduleId, realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition, otherDefinition},
+		Deposit:     doubleProxyDeposit,
+	}, nil)
+	mockCurrency.On("Unreserve", realAccountId, sc.NewU128(10)).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Put", realAccountId, mock.Anything).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.removeProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Nil(t, err)
+	mockStorageProxies.AssertCalled(t, "Put", realAccountId, ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{otherDefinition},
+		Deposit:     singleProxyDeposit,
+	})
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Delegation_removeProxyDelegate_Last(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+	mockCurrency.On("Unreserve", realAccountId, singleProxyDeposit).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Remove", realAccountId).Return()
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	err := target.removeProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "Unreserve", realAccountId, singleProxyDeposit)
+	mockStorageProxies.AssertCalled(t, "Remove", realAccountId)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Delegation_removeProxyDelegate_NotFound(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{otherDefinition},
+		Deposit:     singleProxyDeposit,
+	}, nil)
+
+	err := target.removeProxyDelegate(realAccountId, whoAccountId, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func Test_Delegation_findProxy(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition, delayedDefinition, otherDefinition},
+	}, nil)
+
+	result, err := target.findProxy(realAccountId, whoAccountId, sc.NewOption[ProxyType](nil))
+	assert.Nil(t, err)
+	assert.Equal(t, anyDefinition, result)
+
+	result, err = target.findProxy(realAccountId, whoAccountId, sc.NewOption[ProxyType](ProxyTypeNonTransfer))
+	assert.Nil(t, err)
+	assert.Equal(t, delayedDefinition, result)
+}
+
+func Test_Delegation_findProxy_NotProxy(t *testing.T) {
+	target := setupDelegation()
+
+	mockStorageProxies.On("Get", realAccountId).Return(ProxyDefinitions{
+		Definitions: sc.Sequence[ProxyDefinition]{anyDefinition},
+	}, nil)
+
+	_, err := target.findProxy(realAccountId, whoAccountId, sc.NewOption[ProxyType](ProxyTypeGovernance))
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+}
+
+func Test_Delegation_doProxy(t *testing.T) {
+	target := setupDelegation()
+	setupCallIndices(mockCall, balancesIndex, 0)
+	setupCallDispatch(mockCall, realOrigin, nil)
+	runInStorageLayer(nil)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(nil)
+	expectedEvent := newEventProxyExecuted(moduleId, expectedResult)
+
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doProxy(anyDefinition, realAccountId, mockCall)
+
+	assert.Nil(t, err)
+	mockCall.AssertCalled(t, "Dispatch", realOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Delegation_doProxy_CallFails(t *testing.T) {
+	target := setupDelegation()
+	setupCallIndices(mockCall, balancesIndex, 0)
+	setupCallDispatch(mockCall, realOrigin, callErr)
+	runInStorageLayer(callErr)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(callErr)
+	expectedEvent := newEventProxyExecuted(moduleId, expectedResult)
+
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doProxy(anyDefinition, realAccountId, mockCall)
+
+	assert.Nil(t, err)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Delegation_doProxy_Unproxyable(t *testing.T) {
+	target := setupDelegation()
+	setupCallIndices(mockCall, balancesIndex, 0)
+	setupCallDispatch(mockCall, realOrigin, nil)
+
+	expectedResult, _ := primitives.NewDispatchOutcome(NewDispatchErrorUnproxyable(moduleId))
+	expectedEvent := newEventProxyExecuted(moduleId, expectedResult)
+
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doProxy(delayedDefinition, realAccountId, mockCall)
+
+	assert.Nil(t, err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Delegation_filter_AddProxy_Superset(t *testing.T) {
+	target := setupDelegation()
+	call := new(mocks.Call)
+	setupCallIndices(call, moduleId, functionAddProxyIndex)
+	call.On("Args").Return(sc.NewVaryingData(whoAddress, ProxyTypeGovernance, sc.U64(0)))
+
+	assert.True(t, target.filter(ProxyTypeNonTransfer, call))
+}
+
+func Test_Delegation_filter_AddProxy_EscalatesPrivileges(t *testing.T) {
+	target := setupDelegation()
+	call := new(mocks.Call)
+	setupCallIndices(call, moduleId, functionAddProxyIndex)
+	call.On("Args").Return(sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0)))
+
+	assert.False(t, target.filter(ProxyTypeNonTransfer, call))
+}
+
+func Test_Delegation_filter_KillPure(t *testing.T) {
+	target := setupDelegation()
+	call := new(mocks.Call)
+	setupCallIndices(call, moduleId, functionKillPureIndex)
+	call.On("Args").Return(sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U16(0), sc.U64(0), sc.U32(0)))
+
+	assert.True(t, target.filter(ProxyTypeAny, call))
+	assert.False(t, target.filter(ProxyTypeNonTransfer, call))
+}
+
+func Test_Delegation_filter_Nested(t *testing.T) {
+	target := setupDelegation()
+	nested := new(mocks.Call)
+	setupCallIndices(nested, moduleId, functionKillPureIndex)
+	nested.On("Args").Return(sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U16(0), sc.U64(0), sc.U32(0)))
+	setupCallIndices(mockCall, utilityIndex, 0)
+	mockCall.On("Args").Return(sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(nested)}))
+
+	assert.False(t, target.filter(ProxyTypeNonTransfer, mockCall))
+}
+
+func Test_Delegation_pureAccountId(t *testing.T) {
+	target := setupDelegation()
+	setupPureAccountId(ProxyTypeAny, 1)
+
+	result, err := target.pureAccountId(whoAccountId, ProxyTypeAny, 1, blockNumber, extrinsicIndex)
+
+	assert.Nil(t, err)
+	assert.Equal(t, pureAccountId, result)
+	mockHashing.AssertCalled(t, "Blake256", pureAccountEntropy(ProxyTypeAny, 1))
+}
+
+func Test_Delegation_callHash(t *testing.T) {
+	target := setupDelegation()
+	setupCallBytes(mockCall)
+
+	mockHashing.On("Blake256", callBytes).Return(callHash.Bytes())
+
+	result, err := target.callHash(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, callHash, result)
+}
+
+func Test_Delegation_deposit(t *testing.T) {
+	target := setupDelegation()
+
+	assert.Equal(t, sc.NewU128(0), target.deposit(proxyDepositBase, proxyDepositFactor, 0))
+	assert.Equal(t, doubleProxyDeposit, target.deposit(proxyDepositBase, proxyDepositFactor, 2))
+}
+
+func Test_Delegation_rejigDeposit_Unchanged(t *testing.T) {
+	target := setupDelegation()
+
+	err := target.rejigDeposit(whoAccountId, singleProxyDeposit, singleProxyDeposit)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func Test_searchDefinition(t *testing.T) {
+	definitions := sc.Sequence[ProxyDefinition]{anyDefinition, delayedDefinition, otherDefinition}
+
+	position, found := searchDefinition(definitions, delayedDefinition)
+	assert.True(t, found)
+	assert.Equal(t, 1, position)
+
+	position, found = searchDefinition(definitions, ProxyDefinition{Delegate: whoAccountId, ProxyType: ProxyTypeGovernance})
+	assert.False(t, found)
+	assert.Equal(t, 2, position)
+}
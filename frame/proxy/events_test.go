@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Proxy_DecodeEvent_ProxyExecuted(t *testing.T) {
+	outcome, err := primitives.NewDispatchOutcome(nil)
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventProxyExecuted.Bytes())
+	buffer.Write(outcome.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventProxyExecuted, outcome)},
+		result,
+	)
+}
+
+func Test_Proxy_DecodeEvent_PureCreated(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventPureCreated.Bytes())
+	buffer.Write(pureAccountId.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(ProxyTypeNonTransfer.Bytes())
+	buffer.Write(sc.U16(1).Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventPureCreated, pureAccountId, whoAccountId, ProxyTypeNonTransfer, sc.U16(1))},
+		result,
+	)
+}
+
+func Test_Proxy_DecodeEvent_Announced(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventAnnounced.Bytes())
+	buffer.Write(realAccountId.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventAnnounced, realAccountId, whoAccountId, callHash)},
+		result,
+	)
+}
+
+func Test_Proxy_DecodeEvent_ProxyAdded(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventProxyAdded.Bytes())
+	buffer.Write(realAccountId.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(ProxyTypeGovernance.Bytes())
+	buffer.Write(sc.U64(5).Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventProxyAdded, realAccountId, whoAccountId, ProxyTypeGovernance, sc.U64(5))},
+		result,
+	)
+}
+
+func Test_Proxy_DecodeEvent_ProxyRemoved(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventProxyRemoved.Bytes())
+	buffer.Write(realAccountId.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.Write(ProxyTypeAny.Bytes())
+	buffer.Write(sc.U64(0).Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventProxyRemoved, realAccountId, whoAccountId, ProxyTypeAny, sc.U64(0))},
+		result,
+	)
+}
+
+func Test_Proxy_DecodeEvent_InvalidProxyType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventProxyAdded.Bytes())
+	buffer.Write(realAccountId.Bytes())
+	buffer.Write(whoAccountId.Bytes())
+	buffer.WriteByte(3)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidProxyType, err)
+}
+
+func Test_Proxy_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Proxy_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
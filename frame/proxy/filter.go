@@ -0,0 +1,103 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// InstanceFilter decides whether a call can be dispatched by a proxy of a given type.
+type InstanceFilter interface {
+	Filter(proxyType ProxyType, call primitives.Call) bool
+}
+
+// CallGroup matches calls by the index of their module and their function index.
+// If no function indices are given, all calls of the module are matched.
+type CallGroup struct {
+	ModuleIndex     sc.U8
+	FunctionIndices sc.Sequence[sc.U8]
+}
+
+func (cg CallGroup) contains(call primitives.Call) bool {
+	if call.ModuleIndex() != cg.ModuleIndex {
+		return false
+	}
+	if len(cg.FunctionIndices) == 0 {
+		return true
+	}
+	for _, functionIndex := range cg.FunctionIndices {
+		if call.FunctionIndex() == functionIndex {
+			return true
+		}
+	}
+	return false
+}
+
+type callFilter struct {
+	transfers  sc.Sequence[CallGroup]
+	governance sc.Sequence[CallGroup]
+}
+
+// NewInstanceFilter returns a filter, which forbids the `transfers` calls for NonTransfer proxies
+// and allows only the `governance` calls for Governance proxies.
+//
+// Calls, nested in the arguments of a call (e.g. the calls in a batch), are filtered as well,
+// so that a restricted proxy cannot wrap a forbidden call in an allowed one.
+func NewInstanceFilter(transfers sc.Sequence[CallGroup], governance sc.Sequence[CallGroup]) InstanceFilter {
+	return callFilter{
+		transfers:  transfers,
+		governance: governance,
+	}
+}
+
+func (f callFilter) Filter(proxyType ProxyType, call primitives.Call) bool {
+	switch proxyType {
+	case ProxyTypeAny:
+		return true
+	case ProxyTypeNonTransfer:
+		if matchesAny(f.transfers, call) {
+			return false
+		}
+	case ProxyTypeGovernance:
+		if !matchesAny(f.governance, call) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	for _, nested := range nestedCalls(call) {
+		if !f.Filter(proxyType, nested) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesAny(groups sc.Sequence[CallGroup], call primitives.Call) bool {
+	for _, group := range groups {
+		if group.contains(call) {
+			return true
+		}
+	}
+	return false
+}
+
+// nestedCalls returns the calls, which are passed as arguments to `call`.
+func nestedCalls(call primitives.Call) []primitives.Call {
+	var calls []primitives.Call
+	for _, arg := range call.Args() {
+		switch value := arg.(type) {
+		case primitives.RuntimeCall:
+			if value.Call != nil {
+				calls = append(calls, value.Call)
+			}
+		case sc.Sequence[primitives.RuntimeCall]:
+			for _, runtimeCall := range value {
+				if runtimeCall.Call != nil {
+					calls = append(calls, runtimeCall.Call)
+				}
+			}
+		}
+	}
+	return calls
+}
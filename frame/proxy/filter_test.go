@@ -0,0 +1,77 @@
+package proxy
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InstanceFilter_Any(t *testing.T) {
+	target := NewInstanceFilter(transferGroups, governanceGroups)
+	call := newFilterTestCall(balancesIndex, 0)
+
+	assert.True(t, target.Filter(ProxyTypeAny, call))
+}
+
+func Test_InstanceFilter_NonTransfer(t *testing.T) {
+	target := NewInstanceFilter(transferGroups, governanceGroups)
+
+	assert.False(t, target.Filter(ProxyTypeNonTransfer, newFilterTestCall(balancesIndex, 0)))
+	assert.True(t, target.Filter(ProxyTypeNonTransfer, newFilterTestCall(utilityIndex, 0)))
+}
+
+func Test_InstanceFilter_Governance(t *testing.T) {
+	target := NewInstanceFilter(transferGroups, governanceGroups)
+
+	assert.True(t, target.Filter(ProxyTypeGovernance, newFilterTestCall(utilityIndex, 0)))
+	assert.False(t, target.Filter(ProxyTypeGovernance, newFilterTestCall(balancesIndex, 0)))
+}
+
+func Test_InstanceFilter_FunctionIndices(t *testing.T) {
+	transfers := sc.Sequence[CallGroup]{
+		{ModuleIndex: balancesIndex, FunctionIndices: sc.Sequence[sc.U8]{0, 3}},
+	}
+	target := NewInstanceFilter(transfers, governanceGroups)
+
+	assert.False(t, target.Filter(ProxyTypeNonTransfer, newFilterTestCall(balancesIndex, 3)))
+	assert.True(t, target.Filter(ProxyTypeNonTransfer, newFilterTestCall(balancesIndex, 2)))
+}
+
+func Test_InstanceFilter_UnknownProxyType(t *testing.T) {
+	target := NewInstanceFilter(transferGroups, governanceGroups)
+
+	assert.False(t, target.Filter(ProxyType(3), newFilterTestCall(utilityIndex, 0)))
+}
+
+func Test_InstanceFilter_Nested(t *testing.T) {
+	target := NewInstanceFilter(transferGroups, governanceGroups)
+	transfer := newFilterTestCall(balancesIndex, 0)
+	remark := newFilterTestCall(utilityIndex, 0)
+
+	batch := new(mocks.Call)
+	setupCallIndices(batch, utilityIndex, 0)
+	batch.On("Args").Return(sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{
+		primitives.NewRuntimeCall(remark),
+		primitives.NewRuntimeCall(transfer),
+	}))
+
+	wrapped := new(mocks.Call)
+	setupCallIndices(wrapped, utilityIndex, 1)
+	wrapped.On("Args").Return(sc.NewVaryingData(primitives.NewRuntimeCall(transfer)))
+
+	assert.False(t, target.Filter(ProxyTypeNonTransfer, batch))
+	assert.False(t, target.Filter(ProxyTypeNonTransfer, wrapped))
+	assert.False(t, target.Filter(ProxyTypeGovernance, batch))
+	assert.True(t, target.Filter(ProxyTypeAny, batch))
+}
+
+func newFilterTestCall(moduleIndex sc.U8, functionIndex sc.U8) *mocks.Call {
+	call := new(mocks.Call)
+	setupCallIndices(call, moduleIndex, functionIndex)
+	call.On("Args").Return(sc.NewVaryingData())
+
+	return call
+}
@@ -0,0 +1,282 @@
+package proxy
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_proxy`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionProxyIndex          = 0
+	functionAddProxyIndex       = 1
+	functionRemoveProxyIndex    = 2
+	functionCreatePureIndex     = 4
+	functionKillPureIndex       = 5
+	functionAnnounceIndex       = 6
+	functionProxyAnnouncedIndex = 9
+)
+
+const (
+	name           = sc.Str("Proxy")
+	storageVersion = sc.U16(0)
+)
+
+// Module allows accounts to delegate the dispatch of calls to other accounts, called proxies.
+//
+// The calls, which a proxy can dispatch, are restricted by its ProxyType, through the InstanceFilter
+// of the module. A proxy may be registered with a delay, in which case it has to announce the
+// hash of a call and wait for the delay to pass, before the call can be dispatched. Registered
+// proxies and pending announcements are backed by deposits, which are returned on removal.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.ProxyDepositBase, config.ProxyDepositFactor, config.MaxProxies, config.MaxPending, config.AnnouncementDepositBase, config.AnnouncementDepositFactor)
+	storage := newStorage()
+	delegation := newDelegation(index, config, constants, storage, support.NewTransactional[primitives.PostDispatchInfo](logger), io.NewHashing())
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionProxyIndex] = newCallProxy(index, functionProxyIndex, delegation)
+	functions[functionAddProxyIndex] = newCallAddProxy(index, functionAddProxyIndex, delegation)
+	functions[functionRemoveProxyIndex] = newCallRemoveProxy(index, functionRemoveProxyIndex, delegation)
+	functions[functionCreatePureIndex] = newCallCreatePure(index, functionCreatePureIndex, delegation)
+	functions[functionKillPureIndex] = newCallKillPure(index, functionKillPureIndex, delegation)
+	functions[functionAnnounceIndex] = newCallAnnounce(index, functionAnnounceIndex, delegation)
+	functions[functionProxyAnnouncedIndex] = newCallProxyAnnounced(index, functionProxyAnnouncedIndex, delegation)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyProxy, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdProxyCalls := m.mdGenerator.BuildCallsMetadata("Proxy", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	mdConstants := metadataConstants{
+		ProxyDepositBase:          primitives.ProxyDepositBase{U128: m.constants.ProxyDepositBase},
+		ProxyDepositFactor:        primitives.ProxyDepositFactor{U128: m.constants.ProxyDepositFactor},
+		MaxProxies:                primitives.MaxProxies{U32: m.constants.MaxProxies},
+		MaxPending:                primitives.MaxPending{U32: m.constants.MaxPending},
+		AnnouncementDepositBase:   primitives.AnnouncementDepositBase{U128: m.constants.AnnouncementDepositBase},
+		AnnouncementDepositFactor: primitives.AnnouncementDepositFactor{U128: m.constants.AnnouncementDepositFactor},
+	}
+
+	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdProxyCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdProxyCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Proxy, Runtime>"),
+				},
+				m.Index,
+				"Call.Proxy"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesProxyEvent, "pallet_proxy::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Proxy"),
+		),
+		Constants: moduleMdConstants,
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyErrors),
+				},
+				m.Index,
+				"Errors.Proxy"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesProxyType, "ProxyType", sc.Sequence[sc.Str]{"pallet_proxy", "ProxyType"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("Any", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, sc.U8(ProxyTypeAny), "ProxyType.Any"),
+				primitives.NewMetadataDefinitionVariant("NonTransfer", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, sc.U8(ProxyTypeNonTransfer), "ProxyType.NonTransfer"),
+				primitives.NewMetadataDefinitionVariant("Governance", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, sc.U8(ProxyTypeGovernance), "ProxyType.Governance"),
+			},
+		)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesProxyDefinition, "ProxyDefinition", sc.Sequence[sc.Str]{"pallet_proxy", "ProxyDefinition"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegate", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "ProxyType"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumber"),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesSequenceProxyDefinition, "[]ProxyDefinition",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesProxyDefinition))),
+		primitives.NewMetadataType(metadata.TypesTupleSequenceProxyDefinitionU128, "([]ProxyDefinition, Balance)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesSequenceProxyDefinition), sc.ToCompact(metadata.PrimitiveTypesU128)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesProxyAnnouncement, "Announcement", sc.Sequence[sc.Str]{"pallet_proxy", "Announcement"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "real", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "Hash"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "height", "BlockNumber"),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesSequenceProxyAnnouncement, "[]Announcement",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesProxyAnnouncement))),
+		primitives.NewMetadataType(metadata.TypesTupleSequenceProxyAnnouncementU128, "([]Announcement, Balance)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesSequenceProxyAnnouncement), sc.ToCompact(metadata.PrimitiveTypesU128)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesProxyEvent, "pallet_proxy pallet Event", sc.Sequence[sc.Str]{"pallet_proxy", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"ProxyExecuted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTuple, "result", "DispatchResult"),
+					},
+					EventProxyExecuted,
+					"Events.ProxyExecuted"),
+				primitives.NewMetadataDefinitionVariant(
+					"PureCreated",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "pure", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "disambiguation_index", "u16"),
+					},
+					EventPureCreated,
+					"Events.PureCreated"),
+				primitives.NewMetadataDefinitionVariant(
+					"Announced",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "real", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "proxy", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHashOf<T>"),
+					},
+					EventAnnounced,
+					"Events.Announced"),
+				primitives.NewMetadataDefinitionVariant(
+					"ProxyAdded",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegator", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegatee", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+					},
+					EventProxyAdded,
+					"Events.ProxyAdded"),
+				primitives.NewMetadataDefinitionVariant(
+					"ProxyRemoved",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegator", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegatee", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+					},
+					EventProxyRemoved,
+					"Events.ProxyRemoved"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesProxyErrors,
+			"pallet_proxy pallet Error",
+			sc.Sequence[sc.Str]{"pallet_proxy", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("TooMany", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorTooMany, "There are too many proxies registered or too many announcements pending."),
+					primitives.NewMetadataDefinitionVariant("NotFound", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotFound, "Proxy registration not found."),
+					primitives.NewMetadataDefinitionVariant("NotProxy", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotProxy, "Sender is not a proxy of the account to be proxied."),
+					primitives.NewMetadataDefinitionVariant("Unproxyable", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorUnproxyable, "A call which is incompatible with the proxy type's filter was attempted."),
+					primitives.NewMetadataDefinitionVariant("Duplicate", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorDuplicate, "Account is already a proxy."),
+					primitives.NewMetadataDefinitionVariant("NoPermission", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoPermission, "Call may not be made by proxy because it may escalate its privileges."),
+					primitives.NewMetadataDefinitionVariant("Unannounced", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorUnannounced, "Announcement, if made at all, was made too recently."),
+					primitives.NewMetadataDefinitionVariant("NoSelfProxy", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoSelfProxy, "Cannot add self as proxy."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Proxies",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesAddress32,
+					metadata.TypesTupleSequenceProxyDefinitionU128,
+					support.NewHasherTwox64Concat(),
+				),
+				"The set of account proxies. Maps the account which has delegated to the accounts "+
+					"which are being delegated to, together with the amount held on deposit."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Announcements",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesAddress32,
+					metadata.TypesTupleSequenceProxyAnnouncementU128,
+					support.NewHasherTwox64Concat(),
+				),
+				"The announcements made by the proxy (key)."),
+		},
+	})
+}
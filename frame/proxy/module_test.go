@@ -0,0 +1,448 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId      = 11
+	balancesIndex = 5
+	utilityIndex  = 8
+	maxProxies    = 3
+	maxPending    = 2
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	proxyDepositBase          = sc.NewU128(100)
+	proxyDepositFactor        = sc.NewU128(10)
+	announcementDepositBase   = sc.NewU128(50)
+	announcementDepositFactor = sc.NewU128(5)
+	blockNumber               = sc.U64(10)
+	extrinsicIndex            = sc.U32(2)
+
+	whoAccountId     = constants.OneAccountId
+	realAccountId    = constants.TwoAccountId
+	pureAccountId    = newTestAccountId(9)
+	whoAddress       = primitives.NewMultiAddressId(whoAccountId)
+	realAddress      = primitives.NewMultiAddressId(realAccountId)
+	indexAddress     = primitives.NewMultiAddressIndex(1)
+	testLookup       = primitives.NewIdentityLookup()
+	callHash         = newTestH256(7)
+	transferGroups   = sc.Sequence[CallGroup]{{ModuleIndex: balancesIndex}}
+	governanceGroups = sc.Sequence[CallGroup]{{ModuleIndex: utilityIndex}}
+
+	callWeight      = primitives.WeightFromParts(1_000, 10)
+	callArgs        = sc.NewVaryingData(sc.U8(1))
+	callBytes       = []byte{1, 2, 3}
+	callErr         = primitives.NewDispatchErrorCannotLookup()
+	expectedErr     = errors.New("error")
+	mdGenerator     = primitives.NewMetadataTypeGenerator()
+	logger          = log.NewLogger()
+	signedOrigin    = primitives.NewRawOriginSigned(whoAccountId)
+	realOrigin      = primitives.NewRawOriginSigned(realAccountId)
+	successPostInfo = primitives.PostDispatchInfo{}
+)
+
+var (
+	mockEventDepositor        *mocks.EventDepositor
+	mockCurrency              *mocks.ReservableCurrency
+	mockStorageProxies        *mocks.StorageMap[primitives.AccountId, ProxyDefinitions]
+	mockStorageAnnouncements  *mocks.StorageMap[primitives.AccountId, PendingAnnouncements]
+	mockTransactional         *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockRuntimeDecoder        *mocks.RuntimeDecoder
+	mockHashing               *mocks.IoHashing
+	mockCall                  *mocks.Call
+	mockStorageBlockNumber    func() (sc.U64, error)
+	mockStorageExtrinsicIndex func() (sc.U32, error)
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 7, len(functions))
+	assert.Equal(t, sc.U8(functionProxyIndex), functions[functionProxyIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionAddProxyIndex), functions[functionAddProxyIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionRemoveProxyIndex), functions[functionRemoveProxyIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionCreatePureIndex), functions[functionCreatePureIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionKillPureIndex), functions[functionKillPureIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionAnnounceIndex), functions[functionAnnounceIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionProxyAnnouncedIndex), functions[functionProxyAnnouncedIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedProxyCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+	expectedOptionProxyTypeId := expectedProxyCallsMetadataId + 1
+	expectedCompactU64Id := expectedProxyCallsMetadataId + 2
+	expectedCompactU32Id := expectedProxyCallsMetadataId + 3
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedOptionProxyTypeId, "Option<ProxyType>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<ProxyType>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyType),
+					},
+					1,
+					"Option<ProxyType>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesProxyType, "T"),
+		),
+		primitives.NewMetadataType(expectedCompactU64Id, "CompactU64", primitives.NewMetadataTypeDefinitionCompact(sc.ToCompact(metadata.PrimitiveTypesU64))),
+		primitives.NewMetadataType(expectedCompactU32Id, "CompactU32", primitives.NewMetadataTypeDefinitionCompact(sc.ToCompact(metadata.PrimitiveTypesU32))),
+		primitives.NewMetadataTypeWithParam(expectedProxyCallsMetadataId, "Proxy calls", sc.Sequence[sc.Str]{"pallet_proxy", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"proxy",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(expectedOptionProxyTypeId),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionProxyIndex,
+					target.functions[functionProxyIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"add_proxy",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyType),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+					},
+					functionAddProxyIndex,
+					target.functions[functionAddProxyIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"remove_proxy",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyType),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+					},
+					functionRemoveProxyIndex,
+					target.functions[functionRemoveProxyIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"create_pure",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyType),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU16),
+					},
+					functionCreatePureIndex,
+					target.functions[functionCreatePureIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"kill_pure",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyType),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU16),
+						primitives.NewMetadataTypeDefinitionField(expectedCompactU64Id),
+						primitives.NewMetadataTypeDefinitionField(expectedCompactU32Id),
+					},
+					functionKillPureIndex,
+					target.functions[functionKillPureIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"announce",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesH256),
+					},
+					functionAnnounceIndex,
+					target.functions[functionAnnounceIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"proxy_announced",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(expectedOptionProxyTypeId),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionProxyAnnouncedIndex,
+					target.functions[functionProxyAnnouncedIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedProxyCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedProxyCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Proxy, Runtime>"),
+				},
+				moduleId,
+				"Call.Proxy"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesProxyEvent, "pallet_proxy::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Proxy"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"ProxyDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(proxyDepositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating a proxy.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ProxyDepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(proxyDepositFactor.Bytes()),
+				"The amount of currency needed per proxy added.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxProxies",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(maxProxies).Bytes()),
+				"The maximum amount of proxies allowed for a single account.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxPending",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(maxPending).Bytes()),
+				"The maximum amount of time-delayed announcements that are allowed to be pending.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"AnnouncementDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(announcementDepositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating an announcement.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"AnnouncementDepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(announcementDepositFactor.Bytes()),
+				"The amount of currency needed per announcement made.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyErrors),
+				},
+				moduleId,
+				"Errors.Proxy"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Proxies",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+					},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesTupleSequenceProxyDefinitionU128),
+				),
+				"The set of account proxies. Maps the account which has delegated to the accounts "+
+					"which are being delegated to, together with the amount held on deposit."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Announcements",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+					},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesTupleSequenceProxyAnnouncementU128),
+				),
+				"The announcements made by the proxy (key)."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	return New(moduleId, newTestConfig(), mdGenerator, logger)
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockCurrency = new(mocks.ReservableCurrency)
+	mockStorageProxies = new(mocks.StorageMap[primitives.AccountId, ProxyDefinitions])
+	mockStorageAnnouncements = new(mocks.StorageMap[primitives.AccountId, PendingAnnouncements])
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockHashing = new(mocks.IoHashing)
+	mockCall = new(mocks.Call)
+	mockStorageBlockNumber = func() (sc.U64, error) { return blockNumber, nil }
+	mockStorageExtrinsicIndex = func() (sc.U32, error) { return extrinsicIndex, nil }
+}
+
+func newTestConfig() *Config {
+	return NewConfig(
+		dbWeight,
+		mockEventDepositor,
+		mockCurrency,
+		NewInstanceFilter(transferGroups, governanceGroups),
+		proxyDepositBase,
+		proxyDepositFactor,
+		maxProxies,
+		maxPending,
+		announcementDepositBase,
+		announcementDepositFactor,
+		mockStorageBlockNumber,
+		mockStorageExtrinsicIndex,
+		testLookup,
+	)
+}
+
+// setupDelegation returns a delegation, which uses the mocked storage, transactional and hashing.
+func setupDelegation() delegation {
+	setupMocks()
+
+	storage := &storage{
+		Proxies:       mockStorageProxies,
+		Announcements: mockStorageAnnouncements,
+	}
+	constants := newConstants(dbWeight, proxyDepositBase, proxyDepositFactor, maxProxies, maxPending, announcementDepositBase, announcementDepositFactor)
+
+	return newDelegation(moduleId, newTestConfig(), constants, storage, mockTransactional, mockHashing)
+}
+
+func setupCallDispatchInfo(call *mocks.Call, class primitives.DispatchClass) {
+	call.On("BaseWeight").Return(callWeight)
+	call.On("WeighData", callWeight).Return(callWeight)
+	call.On("ClassifyDispatch", callWeight).Return(class)
+	call.On("PaysFee", callWeight).Return(primitives.PaysYes)
+}
+
+// setupCallIndices sets up the module and function indices of a call.
+func setupCallIndices(call *mocks.Call, moduleIndex sc.U8, functionIndex sc.U8) {
+	call.On("ModuleIndex").Return(moduleIndex)
+	call.On("FunctionIndex").Return(functionIndex)
+}
+
+// setupCallDispatch sets up a call, which is dispatched with the given origin and result.
+func setupCallDispatch(call *mocks.Call, origin primitives.RuntimeOrigin, err error) {
+	call.On("Args").Return(callArgs)
+	call.On("Dispatch", origin, callArgs).Return(successPostInfo, err)
+}
+
+// setupCallBytes sets up the encoding of a call.
+func setupCallBytes(call *mocks.Call) {
+	call.On("Bytes").Return(callBytes)
+}
+
+// setupPureAccountId sets up the derivation of the pure account id, spawned by `whoAccountId`.
+func setupPureAccountId(proxyType ProxyType, index sc.U16) {
+	mockHashing.On("Blake256", pureAccountEntropy(proxyType, index)).Return(pureAccountId.Bytes())
+}
+
+// runInStorageLayer executes the function passed to the storage layer and returns the given error.
+func runInStorageLayer(err error) {
+	mockTransactional.On("WithStorageLayer", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+			fn()
+		}).
+		Return(primitives.PostDispatchInfo{}, err).
+		Once()
+}
+
+func pureAccountEntropy(proxyType ProxyType, index sc.U16) []byte {
+	entropy := append([]byte{}, pureAccountIdPrefix...)
+	entropy = append(entropy, whoAccountId.Bytes()...)
+	entropy = append(entropy, blockNumber.Bytes()...)
+	entropy = append(entropy, extrinsicIndex.Bytes()...)
+	entropy = append(entropy, proxyType.Bytes()...)
+	return append(entropy, index.Bytes()...)
+}
+
+func newTestAccountId(b byte) primitives.AccountId {
+	accountId, _ := primitives.NewAccountId(sc.BytesToSequenceU8(bytes.Repeat([]byte{b}, 32))...)
+	return accountId
+}
+
+func newTestH256(b byte) primitives.H256 {
+	h, _ := primitives.NewH256(sc.BytesToSequenceU8(bytes.Repeat([]byte{b}, 32))...)
+	return h
+}
@@ -0,0 +1,24 @@
+package proxy
+
+import (
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyProxy         = []byte("Proxy")
+	keyProxies       = []byte("Proxies")
+	keyAnnouncements = []byte("Announcements")
+)
+
+type storage struct {
+	Proxies       support.StorageMap[primitives.AccountId, ProxyDefinitions]
+	Announcements support.StorageMap[primitives.AccountId, PendingAnnouncements]
+}
+
+func newStorage() *storage {
+	return &storage{
+		Proxies:       support.NewHashStorageMap[primitives.AccountId, ProxyDefinitions](keyProxy, keyProxies, support.NewHasherTwox64Concat(), primitives.DecodeAccountId, DecodeProxyDefinitions),
+		Announcements: support.NewHashStorageMap[primitives.AccountId, PendingAnnouncements](keyProxy, keyAnnouncements, support.NewHasherTwox64Concat(), primitives.DecodeAccountId, DecodePendingAnnouncements),
+	}
+}
@@ -0,0 +1,237 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	errInvalidProxyType = errors.New("invalid proxy.ProxyType type")
+)
+
+// ProxyType is the kind of permissions, which a proxy has over the calls it dispatches on behalf of an account.
+type ProxyType sc.U8
+
+const (
+	// ProxyTypeAny allows all calls.
+	ProxyTypeAny ProxyType = iota
+	// ProxyTypeNonTransfer allows all calls, except the ones which transfer funds.
+	ProxyTypeNonTransfer
+	// ProxyTypeGovernance allows only governance calls.
+	ProxyTypeGovernance
+)
+
+func (pt ProxyType) Encode(buffer *bytes.Buffer) error {
+	return sc.U8(pt).Encode(buffer)
+}
+
+func DecodeProxyType(buffer *bytes.Buffer) (ProxyType, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return 0, err
+	}
+
+	switch ProxyType(b) {
+	case ProxyTypeAny:
+		return ProxyTypeAny, nil
+	case ProxyTypeNonTransfer:
+		return ProxyTypeNonTransfer, nil
+	case ProxyTypeGovernance:
+		return ProxyTypeGovernance, nil
+	default:
+		return 0, errInvalidProxyType
+	}
+}
+
+func (pt ProxyType) Bytes() []byte {
+	return sc.EncodedBytes(pt)
+}
+
+// IsSuperset returns whether all calls, allowed for `other`, are also allowed for `pt`.
+func (pt ProxyType) IsSuperset(other ProxyType) bool {
+	switch {
+	case pt == other:
+		return true
+	case pt == ProxyTypeAny:
+		return true
+	case other == ProxyTypeAny:
+		return false
+	case pt == ProxyTypeNonTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProxyDefinition is a delegation of permissions from an account to a proxy.
+type ProxyDefinition struct {
+	// Delegate is the account, which can dispatch calls on behalf of the delegator.
+	Delegate primitives.AccountId
+	// ProxyType restricts the calls, which the delegate can dispatch.
+	ProxyType ProxyType
+	// Delay is the number of blocks, which must pass after a call is announced, before it can be dispatched.
+	Delay sc.U64
+}
+
+func (pd ProxyDefinition) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		pd.Delegate,
+		pd.ProxyType,
+		pd.Delay,
+	)
+}
+
+func DecodeProxyDefinition(buffer *bytes.Buffer) (ProxyDefinition, error) {
+	delegate, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+	proxyType, err := DecodeProxyType(buffer)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+	return ProxyDefinition{
+		Delegate:  delegate,
+		ProxyType: proxyType,
+		Delay:     delay,
+	}, nil
+}
+
+func (pd ProxyDefinition) Bytes() []byte {
+	return sc.EncodedBytes(pd)
+}
+
+// compare orders the definitions by delegate, proxy type and delay, in the same way
+// as the derived ordering in `pallet_proxy`.
+func (pd ProxyDefinition) compare(other ProxyDefinition) int {
+	if cmp := bytes.Compare(pd.Delegate.Bytes(), other.Delegate.Bytes()); cmp != 0 {
+		return cmp
+	}
+	switch {
+	case pd.ProxyType < other.ProxyType:
+		return -1
+	case pd.ProxyType > other.ProxyType:
+		return 1
+	case pd.Delay < other.Delay:
+		return -1
+	case pd.Delay > other.Delay:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ProxyDefinitions are the proxies of an account, sorted by ProxyDefinition.compare,
+// together with the deposit reserved for them.
+type ProxyDefinitions struct {
+	Definitions sc.Sequence[ProxyDefinition]
+	Deposit     primitives.Balance
+}
+
+func (pd ProxyDefinitions) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		pd.Definitions,
+		pd.Deposit,
+	)
+}
+
+func DecodeProxyDefinitions(buffer *bytes.Buffer) (ProxyDefinitions, error) {
+	definitions, err := sc.DecodeSequenceWith(buffer, DecodeProxyDefinition)
+	if err != nil {
+		return ProxyDefinitions{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return ProxyDefinitions{}, err
+	}
+	return ProxyDefinitions{
+		Definitions: definitions,
+		Deposit:     deposit,
+	}, nil
+}
+
+func (pd ProxyDefinitions) Bytes() []byte {
+	return sc.EncodedBytes(pd)
+}
+
+// Announcement is a call, announced by a proxy to be dispatched on behalf of `Real`.
+type Announcement struct {
+	// Real is the account, on behalf of which the call is to be dispatched.
+	Real primitives.AccountId
+	// CallHash is the hash of the announced call.
+	CallHash primitives.H256
+	// Height is the block number, in which the call was announced.
+	Height sc.U64
+}
+
+func (a Announcement) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		a.Real,
+		a.CallHash,
+		a.Height,
+	)
+}
+
+func DecodeAnnouncement(buffer *bytes.Buffer) (Announcement, error) {
+	realAccount, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return Announcement{}, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return Announcement{}, err
+	}
+	height, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Announcement{}, err
+	}
+	return Announcement{
+		Real:     realAccount,
+		CallHash: callHash,
+		Height:   height,
+	}, nil
+}
+
+func (a Announcement) Bytes() []byte {
+	return sc.EncodedBytes(a)
+}
+
+// PendingAnnouncements are the announcements of a proxy, in the order they were made,
+// together with the deposit reserved for them.
+type PendingAnnouncements struct {
+	Announcements sc.Sequence[Announcement]
+	Deposit       primitives.Balance
+}
+
+func (pa PendingAnnouncements) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		pa.Announcements,
+		pa.Deposit,
+	)
+}
+
+func DecodePendingAnnouncements(buffer *bytes.Buffer) (PendingAnnouncements, error) {
+	announcements, err := sc.DecodeSequenceWith(buffer, DecodeAnnouncement)
+	if err != nil {
+		return PendingAnnouncements{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return PendingAnnouncements{}, err
+	}
+	return PendingAnnouncements{
+		Announcements: announcements,
+		Deposit:       deposit,
+	}, nil
+}
+
+func (pa PendingAnnouncements) Bytes() []byte {
+	return sc.EncodedBytes(pa)
+}
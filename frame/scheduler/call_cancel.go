@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Cancel an anonymously scheduled task.
+// The dispatch origin for this call must be `Root`.
+type callCancel struct {
+	primitives.Callable
+	scheduling
+}
+
+func newCallCancel(moduleId sc.U8, functionId sc.U8, scheduling scheduling) primitives.Call {
+	call := callCancel{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.U32(0)),
+		},
+		scheduling: scheduling,
+	}
+
+	return call
+}
+
+func (c callCancel) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		when,
+		index,
+	)
+	return c, nil
+}
+
+func (c callCancel) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCancel) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCancel) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callCancel) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callCancel) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callCancel) BaseWeight() primitives.Weight {
+	return callCancelWeight(c.constants.DbWeight, sc.U64(c.constants.MaxScheduledPerBlock))
+}
+
+func (_ callCancel) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCancel) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCancel) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callCancel) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doCancel(TaskAddress{When: args[0].(sc.U64), Index: args[1].(sc.U32)})
+}
+
+func (_ callCancel) Docs() string {
+	return "Cancel an anonymously scheduled task."
+}
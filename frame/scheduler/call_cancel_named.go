@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Cancel a named scheduled task.
+// The dispatch origin for this call must be `Root`.
+type callCancelNamed struct {
+	primitives.Callable
+	scheduling
+}
+
+func newCallCancelNamed(moduleId sc.U8, functionId sc.U8, scheduling scheduling) primitives.Call {
+	call := callCancelNamed{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(TaskName{}),
+		},
+		scheduling: scheduling,
+	}
+
+	return call
+}
+
+func (c callCancelNamed) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	id, err := DecodeTaskName(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		id,
+	)
+	return c, nil
+}
+
+func (c callCancelNamed) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCancelNamed) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCancelNamed) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callCancelNamed) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callCancelNamed) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callCancelNamed) BaseWeight() primitives.Weight {
+	return callCancelNamedWeight(c.constants.DbWeight, sc.U64(c.constants.MaxScheduledPerBlock))
+}
+
+func (_ callCancelNamed) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCancelNamed) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCancelNamed) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callCancelNamed) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doCancelNamed(args[0].(TaskName))
+}
+
+func (_ callCancelNamed) Docs() string {
+	return "Cancel a named scheduled task."
+}
@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_CancelNamed_New(t *testing.T) {
+	target := setupCallCancelNamed()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionCancelNamedIndex,
+		Arguments:  sc.NewVaryingData(TaskName{}),
+	}
+
+	assert.Equal(t, expected, target.(callCancelNamed).Callable)
+}
+
+func Test_Call_CancelNamed_DecodeArgs(t *testing.T) {
+	target := setupCallCancelNamed()
+	buffer := bytes.NewBuffer(taskName.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(taskName), call.Args())
+}
+
+func Test_Call_CancelNamed_Encode(t *testing.T) {
+	target := setupCallCancelNamed()
+	call, err := target.DecodeArgs(bytes.NewBuffer(taskName.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionCancelNamedIndex}, taskName.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_CancelNamed_Bytes(t *testing.T) {
+	target := setupCallCancelNamed()
+	call, err := target.DecodeArgs(bytes.NewBuffer(taskName.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionCancelNamedIndex}, taskName.Bytes()...), call.Bytes())
+}
+
+func Test_Call_CancelNamed_ModuleIndex(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_CancelNamed_FunctionIndex(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, sc.U8(functionCancelNamedIndex), target.FunctionIndex())
+}
+
+func Test_Call_CancelNamed_BaseWeight(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, callCancelNamedWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_CancelNamed_WeighData(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_CancelNamed_ClassifyDispatch(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_CancelNamed_PaysFee(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_CancelNamed_Dispatch(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	mockStorageLookup.On("TryGet", taskName).Return(sc.NewOption[TaskAddress](address), nil)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.Dispatch(rootOrigin, sc.NewVaryingData(taskName))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, when, taskIndex))
+}
+
+func Test_Call_CancelNamed_Dispatch_NotFound(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	mockStorageLookup.On("TryGet", taskName).Return(sc.NewOption[TaskAddress](nil), nil)
+
+	_, err := target.Dispatch(rootOrigin, sc.NewVaryingData(taskName))
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Call_CancelNamed_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(taskName))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageLookup.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallCancelNamed() primitives.Call {
+	return newCallCancelNamed(moduleId, functionCancelNamedIndex, setupScheduling())
+}
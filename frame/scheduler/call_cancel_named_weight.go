@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkSchedulerCancelNamed benchmark.
+
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callCancelNamedWeight(dbWeight primitives.RuntimeDbWeight, scheduled sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(30000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(70000, 0).SaturatingMul(scheduled)).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
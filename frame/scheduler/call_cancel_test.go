@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Cancel_New(t *testing.T) {
+	target := setupCallCancel()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionCancelIndex,
+		Arguments:  sc.NewVaryingData(sc.U64(0), sc.U32(0)),
+	}
+
+	assert.Equal(t, expected, target.(callCancel).Callable)
+}
+
+func Test_Call_Cancel_DecodeArgs(t *testing.T) {
+	target := setupCallCancel()
+	buffer := bytes.NewBuffer(address.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(when, taskIndex), call.Args())
+}
+
+func Test_Call_Cancel_Encode(t *testing.T) {
+	target := setupCallCancel()
+	call, err := target.DecodeArgs(bytes.NewBuffer(address.Bytes()))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append([]byte{moduleId, functionCancelIndex}, address.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_Cancel_Bytes(t *testing.T) {
+	target := setupCallCancel()
+	call, err := target.DecodeArgs(bytes.NewBuffer(address.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionCancelIndex}, address.Bytes()...), call.Bytes())
+}
+
+func Test_Call_Cancel_ModuleIndex(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Cancel_FunctionIndex(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, sc.U8(functionCancelIndex), target.FunctionIndex())
+}
+
+func Test_Call_Cancel_BaseWeight(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, callCancelWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_Cancel_WeighData(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Cancel_ClassifyDispatch(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Cancel_PaysFee(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Cancel_Dispatch(t *testing.T) {
+	target := setupCallCancel()
+
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.Dispatch(rootOrigin, sc.NewVaryingData(when, taskIndex))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Remove", when)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, when, taskIndex))
+}
+
+func Test_Call_Cancel_Dispatch_NotFound(t *testing.T) {
+	target := setupCallCancel()
+
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+
+	_, err := target.Dispatch(rootOrigin, sc.NewVaryingData(when, taskIndex))
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Call_Cancel_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallCancel()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(when, taskIndex))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAgenda.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func setupCallCancel() primitives.Call {
+	return newCallCancel(moduleId, functionCancelIndex, setupScheduling())
+}
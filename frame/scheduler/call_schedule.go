@@ -0,0 +1,115 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Anonymously schedule a task.
+// The dispatch origin for this call must be `Root`.
+type callSchedule struct {
+	primitives.Callable
+	scheduling
+}
+
+func newCallSchedule(moduleId sc.U8, functionId sc.U8, scheduling scheduling) primitives.Call {
+	call := callSchedule{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+		},
+		scheduling: scheduling,
+	}
+
+	return call
+}
+
+func (c callSchedule) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callSchedule) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		when,
+		maybePeriodic,
+		priority,
+		primitives.NewRuntimeCall(call),
+	)
+	return c, nil
+}
+
+func (c callSchedule) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSchedule) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSchedule) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callSchedule) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callSchedule) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callSchedule) BaseWeight() primitives.Weight {
+	return callScheduleWeight(c.constants.DbWeight, sc.U64(c.constants.MaxScheduledPerBlock))
+}
+
+func (_ callSchedule) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callSchedule) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callSchedule) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callSchedule) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := c.doSchedule(
+		sc.NewOption[TaskName](nil),
+		args[0].(sc.U64),
+		args[1].(sc.Option[Period]),
+		args[2].(sc.U8),
+		origin,
+		args[3].(primitives.RuntimeCall),
+	)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callSchedule) Docs() string {
+	return "Anonymously schedule a task."
+}
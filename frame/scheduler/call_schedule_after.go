@@ -0,0 +1,115 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Anonymously schedule a task after a delay.
+// The dispatch origin for this call must be `Root`.
+type callScheduleAfter struct {
+	primitives.Callable
+	scheduling
+}
+
+func newCallScheduleAfter(moduleId sc.U8, functionId sc.U8, scheduling scheduling) primitives.Call {
+	call := callScheduleAfter{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+		},
+		scheduling: scheduling,
+	}
+
+	return call
+}
+
+func (c callScheduleAfter) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callScheduleAfter) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	after, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		after,
+		maybePeriodic,
+		priority,
+		primitives.NewRuntimeCall(call),
+	)
+	return c, nil
+}
+
+func (c callScheduleAfter) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callScheduleAfter) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callScheduleAfter) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callScheduleAfter) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callScheduleAfter) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callScheduleAfter) BaseWeight() primitives.Weight {
+	return callScheduleAfterWeight(c.constants.DbWeight, sc.U64(c.constants.MaxScheduledPerBlock))
+}
+
+func (_ callScheduleAfter) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callScheduleAfter) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callScheduleAfter) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callScheduleAfter) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := c.doScheduleAfter(
+		sc.NewOption[TaskName](nil),
+		args[0].(sc.U64),
+		args[1].(sc.Option[Period]),
+		args[2].(sc.U8),
+		origin,
+		args[3].(primitives.RuntimeCall),
+	)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callScheduleAfter) Docs() string {
+	return "Anonymously schedule a task after a delay."
+}
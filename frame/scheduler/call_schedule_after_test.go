@@ -0,0 +1,140 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	after = sc.U64(3)
+)
+
+func Test_Call_ScheduleAfter_New(t *testing.T) {
+	target := setupCallScheduleAfter()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionScheduleAfterIndex,
+		Arguments:  sc.NewVaryingData(sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+	}
+
+	assert.Equal(t, expected, target.(callScheduleAfter).Callable)
+}
+
+func Test_Call_ScheduleAfter_DecodeArgs(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(after.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_ScheduleAfter_DecodeNestedArgs(t *testing.T) {
+	target := setupCallScheduleAfter()
+	maybePeriodic := sc.NewOption[Period](period)
+	buffer := &bytes.Buffer{}
+	buffer.Write(after.Bytes())
+	buffer.Write(maybePeriodic.Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(after, maybePeriodic, priority, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_ScheduleAfter_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallScheduleAfter()
+	buffer := &bytes.Buffer{}
+	buffer.Write(after.Bytes())
+	buffer.Write(sc.NewOption[Period](nil).Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_ScheduleAfter_ModuleIndex(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ScheduleAfter_FunctionIndex(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, sc.U8(functionScheduleAfterIndex), target.FunctionIndex())
+}
+
+func Test_Call_ScheduleAfter_BaseWeight(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, callScheduleAfterWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_ScheduleAfter_WeighData(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_ScheduleAfter_ClassifyDispatch(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ScheduleAfter_PaysFee(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ScheduleAfter_Dispatch(t *testing.T) {
+	target := setupDecodedCallScheduleAfter()
+	expectedWhen := blockNumber + after + 1
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", expectedWhen).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Put", expectedWhen, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, expectedWhen, taskIndex)).Return()
+
+	result, err := target.Dispatch(rootOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", expectedWhen, expectedAgenda)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, expectedWhen, taskIndex))
+}
+
+func Test_Call_ScheduleAfter_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallScheduleAfter()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func setupCallScheduleAfter() primitives.Call {
+	return newCallScheduleAfter(moduleId, functionScheduleAfterIndex, setupScheduling())
+}
+
+func setupDecodedCallScheduleAfter() primitives.Call {
+	target := setupCallScheduleAfter().(callScheduleAfter)
+	target.Arguments = sc.NewVaryingData(after, sc.NewOption[Period](nil), priority, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
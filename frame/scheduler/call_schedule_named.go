@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Schedule a named task.
+// The dispatch origin for this call must be `Root`.
+type callScheduleNamed struct {
+	primitives.Callable
+	scheduling
+}
+
+func newCallScheduleNamed(moduleId sc.U8, functionId sc.U8, scheduling scheduling) primitives.Call {
+	call := callScheduleNamed{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(TaskName{}, sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+		},
+		scheduling: scheduling,
+	}
+
+	return call
+}
+
+func (c callScheduleNamed) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callScheduleNamed) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	id, err := DecodeTaskName(buffer)
+	if err != nil {
+		return nil, err
+	}
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		id,
+		when,
+		maybePeriodic,
+		priority,
+		primitives.NewRuntimeCall(call),
+	)
+	return c, nil
+}
+
+func (c callScheduleNamed) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callScheduleNamed) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callScheduleNamed) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callScheduleNamed) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callScheduleNamed) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callScheduleNamed) BaseWeight() primitives.Weight {
+	return callScheduleNamedWeight(c.constants.DbWeight, sc.U64(c.constants.MaxScheduledPerBlock))
+}
+
+func (_ callScheduleNamed) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callScheduleNamed) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callScheduleNamed) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callScheduleNamed) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := c.doSchedule(
+		sc.NewOption[TaskName](args[0].(TaskName)),
+		args[1].(sc.U64),
+		args[2].(sc.Option[Period]),
+		args[3].(sc.U8),
+		origin,
+		args[4].(primitives.RuntimeCall),
+	)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callScheduleNamed) Docs() string {
+	return "Schedule a named task."
+}
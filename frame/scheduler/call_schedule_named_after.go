@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Schedule a named task after a delay.
+// The dispatch origin for this call must be `Root`.
+type callScheduleNamedAfter struct {
+	primitives.Callable
+	scheduling
+}
+
+func newCallScheduleNamedAfter(moduleId sc.U8, functionId sc.U8, scheduling scheduling) primitives.Call {
+	call := callScheduleNamedAfter{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(TaskName{}, sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+		},
+		scheduling: scheduling,
+	}
+
+	return call
+}
+
+func (c callScheduleNamedAfter) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callScheduleNamedAfter) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	id, err := DecodeTaskName(buffer)
+	if err != nil {
+		return nil, err
+	}
+	after, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		id,
+		after,
+		maybePeriodic,
+		priority,
+		primitives.NewRuntimeCall(call),
+	)
+	return c, nil
+}
+
+func (c callScheduleNamedAfter) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callScheduleNamedAfter) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callScheduleNamedAfter) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callScheduleNamedAfter) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callScheduleNamedAfter) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callScheduleNamedAfter) BaseWeight() primitives.Weight {
+	return callScheduleNamedAfterWeight(c.constants.DbWeight, sc.U64(c.constants.MaxScheduledPerBlock))
+}
+
+func (_ callScheduleNamedAfter) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callScheduleNamedAfter) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callScheduleNamedAfter) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callScheduleNamedAfter) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := c.doScheduleAfter(
+		sc.NewOption[TaskName](args[0].(TaskName)),
+		args[1].(sc.U64),
+		args[2].(sc.Option[Period]),
+		args[3].(sc.U8),
+		origin,
+		args[4].(primitives.RuntimeCall),
+	)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callScheduleNamedAfter) Docs() string {
+	return "Schedule a named task after a delay."
+}
@@ -0,0 +1,141 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ScheduleNamedAfter_New(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionScheduleNamedAfterIndex,
+		Arguments:  sc.NewVaryingData(TaskName{}, sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+	}
+
+	assert.Equal(t, expected, target.(callScheduleNamedAfter).Callable)
+}
+
+func Test_Call_ScheduleNamedAfter_DecodeArgs(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(taskName.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_ScheduleNamedAfter_DecodeNestedArgs(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+	maybePeriodic := sc.NewOption[Period](period)
+	buffer := &bytes.Buffer{}
+	buffer.Write(taskName.Bytes())
+	buffer.Write(after.Bytes())
+	buffer.Write(maybePeriodic.Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(taskName, after, maybePeriodic, priority, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_ScheduleNamedAfter_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+	buffer := &bytes.Buffer{}
+	buffer.Write(taskName.Bytes())
+	buffer.Write(after.Bytes())
+	buffer.Write(sc.NewOption[Period](nil).Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_ScheduleNamedAfter_ModuleIndex(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ScheduleNamedAfter_FunctionIndex(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, sc.U8(functionScheduleNamedAfterIndex), target.FunctionIndex())
+}
+
+func Test_Call_ScheduleNamedAfter_BaseWeight(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, callScheduleNamedAfterWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_ScheduleNamedAfter_WeighData(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_ScheduleNamedAfter_ClassifyDispatch(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ScheduleNamedAfter_PaysFee(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ScheduleNamedAfter_Dispatch(t *testing.T) {
+	target := setupDecodedCallScheduleNamedAfter()
+	expectedWhen := blockNumber + after + 1
+	expectedAddress := TaskAddress{When: expectedWhen, Index: taskIndex}
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockStorageAgenda.On("Get", expectedWhen).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Put", expectedWhen, expectedAgenda).Return()
+	mockStorageLookup.On("Put", taskName, expectedAddress).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, expectedWhen, taskIndex)).Return()
+
+	result, err := target.Dispatch(rootOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", expectedWhen, expectedAgenda)
+	mockStorageLookup.AssertCalled(t, "Put", taskName, expectedAddress)
+}
+
+func Test_Call_ScheduleNamedAfter_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallScheduleNamedAfter()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageLookup.AssertNotCalled(t, "Exists", mock.Anything)
+}
+
+func setupCallScheduleNamedAfter() primitives.Call {
+	return newCallScheduleNamedAfter(moduleId, functionScheduleNamedAfterIndex, setupScheduling())
+}
+
+func setupDecodedCallScheduleNamedAfter() primitives.Call {
+	target := setupCallScheduleNamedAfter().(callScheduleNamedAfter)
+	target.Arguments = sc.NewVaryingData(taskName, after, sc.NewOption[Period](nil), priority, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ScheduleNamed_New(t *testing.T) {
+	target := setupCallScheduleNamed()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionScheduleNamedIndex,
+		Arguments:  sc.NewVaryingData(TaskName{}, sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+	}
+
+	assert.Equal(t, expected, target.(callScheduleNamed).Callable)
+}
+
+func Test_Call_ScheduleNamed_DecodeArgs(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(taskName.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_ScheduleNamed_DecodeNestedArgs(t *testing.T) {
+	target := setupCallScheduleNamed()
+	maybePeriodic := sc.NewOption[Period](period)
+	buffer := &bytes.Buffer{}
+	buffer.Write(taskName.Bytes())
+	buffer.Write(when.Bytes())
+	buffer.Write(maybePeriodic.Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(taskName, when, maybePeriodic, priority, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_ScheduleNamed_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallScheduleNamed()
+	buffer := &bytes.Buffer{}
+	buffer.Write(taskName.Bytes())
+	buffer.Write(when.Bytes())
+	buffer.Write(sc.NewOption[Period](nil).Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_ScheduleNamed_ModuleIndex(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ScheduleNamed_FunctionIndex(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, sc.U8(functionScheduleNamedIndex), target.FunctionIndex())
+}
+
+func Test_Call_ScheduleNamed_BaseWeight(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, callScheduleNamedWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_ScheduleNamed_WeighData(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_ScheduleNamed_ClassifyDispatch(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ScheduleNamed_PaysFee(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ScheduleNamed_Dispatch(t *testing.T) {
+	target := setupDecodedCallScheduleNamed()
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockStorageLookup.On("Put", taskName, address).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.Dispatch(rootOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+	mockStorageLookup.AssertCalled(t, "Put", taskName, address)
+}
+
+func Test_Call_ScheduleNamed_Dispatch_NameInUse(t *testing.T) {
+	target := setupDecodedCallScheduleNamed()
+
+	mockStorageLookup.On("Exists", taskName).Return(true)
+
+	_, err := target.Dispatch(rootOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_ScheduleNamed_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallScheduleNamed()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageLookup.AssertNotCalled(t, "Exists", mock.Anything)
+}
+
+func setupCallScheduleNamed() primitives.Call {
+	return newCallScheduleNamed(moduleId, functionScheduleNamedIndex, setupScheduling())
+}
+
+func setupDecodedCallScheduleNamed() primitives.Call {
+	target := setupCallScheduleNamed().(callScheduleNamed)
+	target.Arguments = sc.NewVaryingData(taskName, when, sc.NewOption[Period](nil), priority, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
@@ -0,0 +1,141 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Schedule_New(t *testing.T) {
+	target := setupCallSchedule()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionScheduleIndex,
+		Arguments:  sc.NewVaryingData(sc.U64(0), sc.NewOption[Period](nil), sc.U8(0), primitives.RuntimeCall{}),
+	}
+
+	assert.Equal(t, expected, target.(callSchedule).Callable)
+}
+
+func Test_Call_Schedule_DecodeArgs(t *testing.T) {
+	target := setupCallSchedule()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(when.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_Schedule_DecodeNestedArgs(t *testing.T) {
+	target := setupCallSchedule()
+	maybePeriodic := sc.NewOption[Period](period)
+	buffer := &bytes.Buffer{}
+	buffer.Write(when.Bytes())
+	buffer.Write(maybePeriodic.Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(when, maybePeriodic, priority, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_Schedule_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallSchedule()
+	buffer := &bytes.Buffer{}
+	buffer.Write(when.Bytes())
+	buffer.Write(sc.NewOption[Period](nil).Bytes())
+	buffer.Write(priority.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_Schedule_ModuleIndex(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Schedule_FunctionIndex(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, sc.U8(functionScheduleIndex), target.FunctionIndex())
+}
+
+func Test_Call_Schedule_BaseWeight(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, callScheduleWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_Schedule_WeighData(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Schedule_ClassifyDispatch(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Schedule_PaysFee(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Schedule_Dispatch(t *testing.T) {
+	target := setupDecodedCallSchedule()
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.Dispatch(rootOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, when, taskIndex))
+}
+
+func Test_Call_Schedule_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallSchedule()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Schedule_Docs(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, "Anonymously schedule a task.", target.Docs())
+}
+
+func setupCallSchedule() primitives.Call {
+	return newCallSchedule(moduleId, functionScheduleIndex, setupScheduling())
+}
+
+func setupDecodedCallSchedule() primitives.Call {
+	target := setupCallSchedule().(callSchedule)
+	target.Arguments = sc.NewVaryingData(when, sc.NewOption[Period](nil), priority, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
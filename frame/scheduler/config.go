@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight             primitives.RuntimeDbWeight
+	EventDepositor       primitives.EventDepositor
+	MaximumWeight        primitives.Weight
+	MaxScheduledPerBlock sc.U32
+	// Preimages bounds the scheduled calls and resolves them, when they are dispatched.
+	Preimages          Preimages
+	StorageBlockNumber func() (sc.U64, error)
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, maximumWeight primitives.Weight, maxScheduledPerBlock sc.U32, preimages Preimages, storageBlockNumber func() (sc.U64, error)) *Config {
+	return &Config{
+		DbWeight:             dbWeight,
+		EventDepositor:       eventDepositor,
+		MaximumWeight:        maximumWeight,
+		MaxScheduledPerBlock: maxScheduledPerBlock,
+		Preimages:            preimages,
+		StorageBlockNumber:   storageBlockNumber,
+	}
+}
@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight             primitives.RuntimeDbWeight
+	MaximumWeight        primitives.Weight
+	MaxScheduledPerBlock sc.U32
+}
+
+type metadataConstants struct {
+	MaximumWeight        primitives.SchedulerMaximumWeight
+	MaxScheduledPerBlock primitives.MaxScheduledPerBlock
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, maximumWeight primitives.Weight, maxScheduledPerBlock sc.U32) *consts {
+	return &consts{
+		DbWeight:             dbWeight,
+		MaximumWeight:        maximumWeight,
+		MaxScheduledPerBlock: maxScheduledPerBlock,
+	}
+}
@@ -0,0 +1,135 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	namedId = sc.NewOption[TaskName](taskName)
+)
+
+func Test_Scheduler_DecodeEvent_Scheduled(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventScheduled.Bytes())
+	buffer.Write(when.Bytes())
+	buffer.Write(taskIndex.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventScheduled, when, taskIndex)},
+		result,
+	)
+}
+
+func Test_Scheduler_DecodeEvent_Canceled(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventCanceled.Bytes())
+	buffer.Write(when.Bytes())
+	buffer.Write(taskIndex.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventCanceled, when, taskIndex)},
+		result,
+	)
+}
+
+func Test_Scheduler_DecodeEvent_Dispatched(t *testing.T) {
+	outcome, err := primitives.NewDispatchOutcome(callErr)
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventDispatched.Bytes())
+	buffer.Write(address.Bytes())
+	buffer.Write(namedId.Bytes())
+	buffer.Write(outcome.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventDispatched, address, namedId, outcome)},
+		result,
+	)
+}
+
+func Test_Scheduler_DecodeEvent_CallUnavailable(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventCallUnavailable.Bytes())
+	buffer.Write(address.Bytes())
+	buffer.Write(namedId.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventCallUnavailable, address, namedId)},
+		result,
+	)
+}
+
+func Test_Scheduler_DecodeEvent_PeriodicFailed(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventPeriodicFailed.Bytes())
+	buffer.Write(address.Bytes())
+	buffer.Write(namedId.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventPeriodicFailed, address, namedId)},
+		result,
+	)
+}
+
+func Test_Scheduler_DecodeEvent_PermanentlyOverweight(t *testing.T) {
+	anonymousId := sc.NewOption[TaskName](nil)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventPermanentlyOverweight.Bytes())
+	buffer.Write(address.Bytes())
+	buffer.Write(anonymousId.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventPermanentlyOverweight, address, anonymousId)},
+		result,
+	)
+}
+
+func Test_Scheduler_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Scheduler_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,156 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// scheduledV0 is a task in the agenda before storage version 1, in which the call is stored encoded.
+type scheduledV0 struct {
+	MaybeId       sc.Option[TaskName]
+	Priority      sc.U8
+	Call          sc.Sequence[sc.U8]
+	MaybePeriodic sc.Option[Period]
+	Origin        primitives.RawOrigin
+}
+
+func (s scheduledV0) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		s.MaybeId,
+		s.Priority,
+		s.Call,
+		s.MaybePeriodic,
+		s.Origin,
+	)
+}
+
+func decodeScheduledV0(buffer *bytes.Buffer) (scheduledV0, error) {
+	maybeId, err := sc.DecodeOptionWith(buffer, DecodeTaskName)
+	if err != nil {
+		return scheduledV0{}, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return scheduledV0{}, err
+	}
+	call, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return scheduledV0{}, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return scheduledV0{}, err
+	}
+	origin, err := primitives.DecodeRawOrigin(buffer)
+	if err != nil {
+		return scheduledV0{}, err
+	}
+	return scheduledV0{
+		MaybeId:       maybeId,
+		Priority:      priority,
+		Call:          call,
+		MaybePeriodic: maybePeriodic,
+		Origin:        origin,
+	}, nil
+}
+
+func (s scheduledV0) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
+
+func decodeAgendaV0(buffer *bytes.Buffer) (sc.Sequence[sc.Option[scheduledV0]], error) {
+	return sc.DecodeSequenceWith(buffer, func(buffer *bytes.Buffer) (sc.Option[scheduledV0], error) {
+		return sc.DecodeOptionWith(buffer, decodeScheduledV0)
+	})
+}
+
+// migrationToV1 converts the calls in the agendas from their encoding to a preimage.Bounded.
+// Each call is decoded and bound with the Preimages, which notes the calls longer than
+// preimage.MaxInlineLen as requested preimages. Tasks, whose calls cannot be decoded or bound, are removed.
+type migrationToV1 struct {
+	agendaV0    support.StorageMap[sc.U64, sc.Sequence[sc.Option[scheduledV0]]]
+	storage     *storage
+	preimages   Preimages
+	callDecoder primitives.CallDecoder
+	dbWeight    primitives.RuntimeDbWeight
+	logger      log.WarnLogger
+}
+
+// NewMigrationToV1 returns the migration of the scheduler storage from version 0 to version 1,
+// in which the agendas store the scheduled calls as a preimage.Bounded.
+func NewMigrationToV1(preimages Preimages, callDecoder primitives.CallDecoder, dbWeight primitives.RuntimeDbWeight, logger log.WarnLogger) support.VersionedMigration {
+	return support.NewVersionedMigration(0, 1, keyScheduler, newMigrationToV1(preimages, callDecoder, dbWeight, logger), dbWeight, logger)
+}
+
+func newMigrationToV1(preimages Preimages, callDecoder primitives.CallDecoder, dbWeight primitives.RuntimeDbWeight, logger log.WarnLogger) migrationToV1 {
+	return migrationToV1{
+		agendaV0:    support.NewHashStorageMap[sc.U64, sc.Sequence[sc.Option[scheduledV0]]](keyScheduler, keyAgenda, support.NewHasherTwox64Concat(), sc.DecodeU64, decodeAgendaV0),
+		storage:     newStorage(),
+		preimages:   preimages,
+		callDecoder: callDecoder,
+		dbWeight:    dbWeight,
+		logger:      logger,
+	}
+}
+
+func (m migrationToV1) OnRuntimeUpgrade() primitives.Weight {
+	agendas := sc.U64(0)
+	tasks := sc.U64(0)
+
+	err := m.agendaV0.Iter(func(when sc.U64, agendaV0 sc.Sequence[sc.Option[scheduledV0]]) error {
+		agendas++
+
+		agenda := make(sc.Sequence[sc.Option[Scheduled]], 0, len(agendaV0))
+		for _, maybeTask := range agendaV0 {
+			if !maybeTask.HasValue {
+				agenda = append(agenda, sc.NewOption[Scheduled](nil))
+				continue
+			}
+			tasks++
+
+			task, err := m.migrateTask(maybeTask.Value)
+			if err != nil {
+				m.logger.Warnf("failed to migrate task of agenda [%d], removing it: %v", when, err)
+				if maybeTask.Value.MaybeId.HasValue {
+					m.storage.Lookup.Remove(maybeTask.Value.MaybeId.Value)
+				}
+				agenda = append(agenda, sc.NewOption[Scheduled](nil))
+				continue
+			}
+			agenda = append(agenda, sc.NewOption[Scheduled](task))
+		}
+
+		m.storage.Agenda.Put(when, agenda)
+		return nil
+	})
+	if err != nil {
+		m.logger.Warnf("failed to migrate agendas: %v", err)
+	}
+
+	// Each agenda is read and written once. Bounding a call may note its preimage.
+	return m.dbWeight.ReadsWrites(agendas+tasks, agendas+2*tasks)
+}
+
+func (m migrationToV1) migrateTask(task scheduledV0) (Scheduled, error) {
+	call, err := m.callDecoder.DecodeCall(bytes.NewBuffer(sc.SequenceU8ToBytes(task.Call)))
+	if err != nil {
+		return Scheduled{}, err
+	}
+
+	bounded, err := m.preimages.Bound(call)
+	if err != nil {
+		return Scheduled{}, err
+	}
+
+	return Scheduled{
+		MaybeId:       task.MaybeId,
+		Priority:      task.Priority,
+		Call:          bounded,
+		MaybePeriodic: task.MaybePeriodic,
+		Origin:        task.Origin,
+	}, nil
+}
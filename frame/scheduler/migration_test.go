@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	anonymousTaskV0 = scheduledV0{
+		MaybeId:       sc.NewOption[TaskName](nil),
+		Priority:      priority,
+		Call:          sc.BytesToSequenceU8(callBytes),
+		MaybePeriodic: sc.NewOption[Period](nil),
+		Origin:        rootOrigin,
+	}
+	namedTaskV0 = scheduledV0{
+		MaybeId:       sc.NewOption[TaskName](taskName),
+		Priority:      priority,
+		Call:          sc.BytesToSequenceU8(callBytes),
+		MaybePeriodic: sc.NewOption[Period](nil),
+		Origin:        rootOrigin,
+	}
+)
+
+var (
+	mockStorageAgendaV0 *mocks.StorageMap[sc.U64, sc.Sequence[sc.Option[scheduledV0]]]
+)
+
+func Test_scheduledV0_Encode_Decode(t *testing.T) {
+	buffer := bytes.NewBuffer(namedTaskV0.Bytes())
+
+	result, err := decodeScheduledV0(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, namedTaskV0, result)
+}
+
+func Test_migrationToV1_OnRuntimeUpgrade(t *testing.T) {
+	target := setupMigrationToV1()
+	agendaV0 := sc.Sequence[sc.Option[scheduledV0]]{
+		sc.NewOption[scheduledV0](anonymousTaskV0),
+		sc.NewOption[scheduledV0](nil),
+		sc.NewOption[scheduledV0](namedTaskV0),
+	}
+	expectAgenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](nil),
+		sc.NewOption[Scheduled](namedTask),
+	}
+
+	iterAgendasV0(when, agendaV0)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(mockCall, nil)
+	mockPreimage.On("Bound", mockCall).Return(inlineCall, nil)
+	mockStorageAgenda.On("Put", when, expectAgenda).Return()
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.ReadsWrites(3, 5), result)
+	mockPreimage.AssertNumberOfCalls(t, "Bound", 2)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectAgenda)
+	mockStorageLookup.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_migrationToV1_OnRuntimeUpgrade_BoundError(t *testing.T) {
+	target := setupMigrationToV1()
+	agendaV0 := sc.Sequence[sc.Option[scheduledV0]]{
+		sc.NewOption[scheduledV0](namedTaskV0),
+	}
+	expectAgenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](nil),
+	}
+
+	iterAgendasV0(when, agendaV0)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(mockCall, nil)
+	mockPreimage.On("Bound", mockCall).Return(inlineCall, expectedErr)
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockStorageAgenda.On("Put", when, expectAgenda).Return()
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, dbWeight.ReadsWrites(2, 3), result)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectAgenda)
+}
+
+func Test_migrationToV1_OnRuntimeUpgrade_DecodeCallError(t *testing.T) {
+	target := setupMigrationToV1()
+	agendaV0 := sc.Sequence[sc.Option[scheduledV0]]{
+		sc.NewOption[scheduledV0](anonymousTaskV0),
+	}
+	expectAgenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](nil),
+	}
+
+	iterAgendasV0(when, agendaV0)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(mockCall, expectedErr)
+	mockStorageAgenda.On("Put", when, expectAgenda).Return()
+
+	target.OnRuntimeUpgrade()
+
+	mockPreimage.AssertNotCalled(t, "Bound", mock.Anything)
+	mockStorageLookup.AssertNotCalled(t, "Remove", mock.Anything)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectAgenda)
+}
+
+func setupMigrationToV1() migrationToV1 {
+	setupMocks()
+	mockStorageAgendaV0 = new(mocks.StorageMap[sc.U64, sc.Sequence[sc.Option[scheduledV0]]])
+
+	target := newMigrationToV1(mockPreimage, mockRuntimeDecoder, dbWeight, logger)
+	target.agendaV0 = mockStorageAgendaV0
+	target.storage = &storage{
+		IncompleteSince: mockStorageIncomplete,
+		Agenda:          mockStorageAgenda,
+		Lookup:          mockStorageLookup,
+	}
+
+	return target
+}
+
+// iterAgendasV0 sets up the iteration of the agendas in the format before version 1 to visit a single agenda.
+func iterAgendasV0(block sc.U64, agenda sc.Sequence[sc.Option[scheduledV0]]) {
+	mockStorageAgendaV0.On("Iter", mock.Anything).
+		Run(func(args mock.Arguments) {
+			f := args.Get(0).(func(k sc.U64, v sc.Sequence[sc.Option[scheduledV0]]) error)
+			f(block, agenda)
+		}).
+		Return(nil)
+}
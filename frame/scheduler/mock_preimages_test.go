@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPreimages struct {
+	mock.Mock
+}
+
+func (m *mockPreimages) Bound(call types.Call) (preimage.Bounded, error) {
+	args := m.Called(call)
+
+	if args[1] != nil {
+		return args[0].(preimage.Bounded), args[1].(error)
+	}
+
+	return args[0].(preimage.Bounded), nil
+}
+
+func (m *mockPreimages) Peek(bounded preimage.Bounded) (types.Call, error) {
+	args := m.Called(bounded)
+
+	if args[1] != nil {
+		return nil, args[1].(error)
+	}
+
+	return args[0].(types.Call), nil
+}
+
+func (m *mockPreimages) Drop(bounded preimage.Bounded) error {
+	args := m.Called(bounded)
+
+	if args[0] != nil {
+		return args[0].(error)
+	}
+
+	return nil
+}
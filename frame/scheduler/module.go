@@ -0,0 +1,357 @@
+package scheduler
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_scheduler`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionScheduleIndex           = 0
+	functionCancelIndex             = 1
+	functionScheduleNamedIndex      = 2
+	functionCancelNamedIndex        = 3
+	functionScheduleAfterIndex      = 4
+	functionScheduleNamedAfterIndex = 5
+)
+
+const (
+	name           = sc.Str("Scheduler")
+	storageVersion = sc.U16(1)
+)
+
+// Module dispatches calls at a given block number, either once or periodically.
+//
+// The calls are stored as a preimage.Bounded in the agenda of the block, together with the origin,
+// with which they are dispatched. Calls longer than preimage.MaxInlineLen are noted as preimages
+// until they are dispatched or cancelled. In OnInitialize, the tasks of the agenda are dispatched in order
+// of priority, within MaximumWeight. The tasks, which do not fit in the block, are retried in the
+// following blocks. Tasks can be scheduled by Root with the module calls, or by other modules
+// with Schedule and ScheduleNamed.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	scheduling  scheduling
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.MaximumWeight, config.MaxScheduledPerBlock)
+	storage := newStorage()
+	scheduling := newScheduling(index, config, constants, storage, support.NewTransactional[primitives.PostDispatchInfo](logger))
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionScheduleIndex] = newCallSchedule(index, functionScheduleIndex, scheduling)
+	functions[functionCancelIndex] = newCallCancel(index, functionCancelIndex, scheduling)
+	functions[functionScheduleNamedIndex] = newCallScheduleNamed(index, functionScheduleNamedIndex, scheduling)
+	functions[functionCancelNamedIndex] = newCallCancelNamed(index, functionCancelNamedIndex, scheduling)
+	functions[functionScheduleAfterIndex] = newCallScheduleAfter(index, functionScheduleAfterIndex, scheduling)
+	functions[functionScheduleNamedAfterIndex] = newCallScheduleNamedAfter(index, functionScheduleNamedAfterIndex, scheduling)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyScheduler, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		scheduling:           scheduling,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize dispatches the tasks, which are due up to block `n`.
+func (m Module) OnInitialize(n sc.U64) (primitives.Weight, error) {
+	return m.scheduling.serviceAgendas(n)
+}
+
+// Schedule adds `call` to the agenda of block `when`, to be dispatched with `origin`.
+func (m Module) Schedule(when sc.U64, maybePeriodic sc.Option[Period], priority sc.U8, origin primitives.RawOrigin, call primitives.Call) (TaskAddress, error) {
+	return m.scheduling.doSchedule(sc.NewOption[TaskName](nil), when, maybePeriodic, priority, origin, call)
+}
+
+// ScheduleNamed adds `call` to the agenda of block `when` under the name `id`, to be dispatched with `origin`.
+func (m Module) ScheduleNamed(id TaskName, when sc.U64, maybePeriodic sc.Option[Period], priority sc.U8, origin primitives.RawOrigin, call primitives.Call) (TaskAddress, error) {
+	return m.scheduling.doSchedule(sc.NewOption[TaskName](id), when, maybePeriodic, priority, origin, call)
+}
+
+// Cancel removes the task at `address` from the agenda.
+func (m Module) Cancel(address TaskAddress) error {
+	return m.scheduling.doCancel(address)
+}
+
+// CancelNamed removes the task named `id` from the agenda.
+func (m Module) CancelNamed(id TaskName) error {
+	return m.scheduling.doCancelNamed(id)
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdSchedulerCalls := m.mdGenerator.BuildCallsMetadata("Scheduler", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	mdConstants := metadataConstants{
+		MaximumWeight:        primitives.SchedulerMaximumWeight{Weight: m.constants.MaximumWeight},
+		MaxScheduledPerBlock: primitives.MaxScheduledPerBlock{U32: m.constants.MaxScheduledPerBlock},
+	}
+
+	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdSchedulerCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdSchedulerCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Scheduler, Runtime>"),
+				},
+				m.Index,
+				"Call.Scheduler"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSchedulerEvent, "pallet_scheduler::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Scheduler"),
+		),
+		Constants: moduleMdConstants,
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSchedulerErrors),
+				},
+				m.Index,
+				"Errors.Scheduler"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	taskFields := sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesTupleU64U32, "task", "TaskAddress<BlockNumberFor<T>>"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionFixedSequence32U8, "id", "Option<TaskName>"),
+	}
+
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesTupleU64U32, "(BlockNumber, u32)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.PrimitiveTypesU64), sc.ToCompact(metadata.PrimitiveTypesU32)})),
+		metadataTypeOption(metadata.TypesOptionTupleU64U32, "Option<(BlockNumber, u32)>", metadata.TypesTupleU64U32),
+		metadataTypeOption(metadata.TypesOptionFixedSequence32U8, "Option<TaskName>", metadata.TypesFixedSequence32U8),
+		primitives.NewMetadataTypeWithPath(metadata.TypesRawOrigin, "RawOrigin", sc.Sequence[sc.Str]{"frame_support", "dispatch", "RawOrigin"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Root",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					primitives.RawOriginRoot,
+					""),
+				primitives.NewMetadataDefinitionVariant(
+					"Signed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesAddress32),
+					},
+					primitives.RawOriginSigned,
+					""),
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					primitives.RawOriginNone,
+					""),
+			},
+		)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesPreimageBounded, "Bounded", sc.Sequence[sc.Str]{"frame_support", "traits", "preimages", "Bounded"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Legacy",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "H::Output"),
+					},
+					preimage.BoundedLegacy,
+					""),
+				primitives.NewMetadataDefinitionVariant(
+					"Inline",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSequenceU8, "BoundedInline"),
+					},
+					preimage.BoundedInline,
+					""),
+				primitives.NewMetadataDefinitionVariant(
+					"Lookup",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "H::Output"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "len", "u32"),
+					},
+					preimage.BoundedLookup,
+					""),
+			},
+		)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesSchedulerScheduled, "Scheduled", sc.Sequence[sc.Str]{"pallet_scheduler", "Scheduled"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionFixedSequence32U8, "maybe_id", "Option<TaskName>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "priority", "schedule::Priority"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesPreimageBounded, "call", "Call"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionTupleU64U32, "maybe_periodic", "Option<schedule::Period<BlockNumber>>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesRawOrigin, "origin", "PalletsOrigin"),
+			},
+		)),
+		metadataTypeOption(metadata.TypesOptionSchedulerScheduled, "Option<Scheduled>", metadata.TypesSchedulerScheduled),
+		primitives.NewMetadataType(metadata.TypesSequenceOptionSchedulerScheduled, "[]Option<Scheduled>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesOptionSchedulerScheduled))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesSchedulerEvent, "pallet_scheduler pallet Event", sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Scheduled",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+					},
+					EventScheduled,
+					"Events.Scheduled"),
+				primitives.NewMetadataDefinitionVariant(
+					"Canceled",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+					},
+					EventCanceled,
+					"Events.Canceled"),
+				primitives.NewMetadataDefinitionVariant(
+					"Dispatched",
+					append(taskFields,
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTuple, "result", "DispatchResult"),
+					),
+					EventDispatched,
+					"Events.Dispatched"),
+				primitives.NewMetadataDefinitionVariant(
+					"CallUnavailable",
+					taskFields,
+					EventCallUnavailable,
+					"Events.CallUnavailable"),
+				primitives.NewMetadataDefinitionVariant(
+					"PeriodicFailed",
+					taskFields,
+					EventPeriodicFailed,
+					"Events.PeriodicFailed"),
+				primitives.NewMetadataDefinitionVariant(
+					"PermanentlyOverweight",
+					taskFields,
+					EventPermanentlyOverweight,
+					"Events.PermanentlyOverweight"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesSchedulerErrors,
+			"pallet_scheduler pallet Error",
+			sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("FailedToSchedule", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorFailedToSchedule, "Failed to schedule a call"),
+					primitives.NewMetadataDefinitionVariant("NotFound", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotFound, "Cannot find the scheduled call."),
+					primitives.NewMetadataDefinitionVariant("TargetBlockNumberInPast", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorTargetBlockNumberInPast, "Given target block number is in the past."),
+					primitives.NewMetadataDefinitionVariant("RescheduleNoChange", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorRescheduleNoChange, "Reschedule failed because it does not change scheduled time."),
+					primitives.NewMetadataDefinitionVariant("Named", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNamed, "Attempt to use a non-named function on a named task."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"IncompleteSince",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				""),
+			primitives.NewMetadataModuleStorageEntry(
+				"Agenda",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.PrimitiveTypesU64,
+					metadata.TypesSequenceOptionSchedulerScheduled,
+					support.NewHasherTwox64Concat(),
+				),
+				"Items to be executed, indexed by the block number that they should be executed on."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Lookup",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesFixedSequence32U8,
+					metadata.TypesTupleU64U32,
+					support.NewHasherTwox64Concat(),
+				),
+				"Lookup from a name to the block number and index of the task."),
+		},
+	})
+}
+
+// metadataTypeOption returns the metadata type of an option of the type `typeId`.
+func metadataTypeOption(id int, docs string, typeId int) primitives.MetadataType {
+	return primitives.NewMetadataTypeWithParam(id, docs, sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+		sc.Sequence[primitives.MetadataDefinitionVariant]{
+			primitives.NewMetadataDefinitionVariant(
+				"None",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+				0,
+				""),
+			primitives.NewMetadataDefinitionVariant(
+				"Some",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(typeId),
+				},
+				1,
+				""),
+		}),
+		primitives.NewMetadataTypeParameter(typeId, "T"))
+}
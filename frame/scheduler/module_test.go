@@ -0,0 +1,463 @@
+package scheduler
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId             = 14
+	preimageModuleId     = 15
+	maxScheduledPerBlock = 3
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	maximumWeight = primitives.WeightFromParts(100_000_000, 1_000)
+	blockNumber   = sc.U64(5)
+	when          = sc.U64(10)
+	taskIndex     = sc.U32(0)
+	priority      = sc.U8(63)
+	taskName      = newTestTaskName(7)
+	address       = TaskAddress{When: when, Index: taskIndex}
+	period        = Period{Interval: 4, Count: 3}
+
+	callWeight      = primitives.WeightFromParts(1_000, 10)
+	callArgs        = sc.NewVaryingData(sc.U8(1))
+	callBytes       = []byte{1, 2, 3}
+	inlineCall      = preimage.NewBoundedInline(sc.BytesToSequenceU8(callBytes))
+	callHash, _     = primitives.NewH256(sc.BytesToSequenceU8(bytes.Repeat([]byte{7}, 32))...)
+	lookupCall      = preimage.NewBoundedLookup(callHash, preimage.MaxInlineLen+1)
+	callErr         = primitives.NewDispatchErrorCannotLookup()
+	expectedErr     = errors.New("error")
+	mdGenerator     = primitives.NewMetadataTypeGenerator()
+	logger          = log.NewLogger()
+	rootOrigin      = primitives.NewRawOriginRoot()
+	successPostInfo = primitives.PostDispatchInfo{}
+
+	anonymousTask = Scheduled{
+		MaybeId:       sc.NewOption[TaskName](nil),
+		Priority:      priority,
+		Call:          inlineCall,
+		MaybePeriodic: sc.NewOption[Period](nil),
+		Origin:        rootOrigin,
+	}
+	namedTask = Scheduled{
+		MaybeId:       sc.NewOption[TaskName](taskName),
+		Priority:      priority,
+		Call:          inlineCall,
+		MaybePeriodic: sc.NewOption[Period](nil),
+		Origin:        rootOrigin,
+	}
+)
+
+var (
+	mockEventDepositor     *mocks.EventDepositor
+	mockStorageIncomplete  *mocks.StorageValue[sc.U64]
+	mockStorageAgenda      *mocks.StorageMap[sc.U64, sc.Sequence[sc.Option[Scheduled]]]
+	mockStorageLookup      *mocks.StorageMap[TaskName, TaskAddress]
+	mockTransactional      *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockRuntimeDecoder     *mocks.RuntimeDecoder
+	mockPreimage           *mockPreimages
+	mockCall               *mocks.Call
+	mockStorageBlockNumber func() (sc.U64, error)
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 6, len(functions))
+	assert.Equal(t, sc.U8(functionScheduleIndex), functions[functionScheduleIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionCancelIndex), functions[functionCancelIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionScheduleNamedIndex), functions[functionScheduleNamedIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionCancelNamedIndex), functions[functionCancelNamedIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionScheduleAfterIndex), functions[functionScheduleAfterIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionScheduleNamedAfterIndex), functions[functionScheduleNamedAfterIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_OnInitialize(t *testing.T) {
+	target := setupModule()
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		serviceAgendasBaseWeight(dbWeight).SaturatingAdd(serviceAgendaBaseWeight(dbWeight, 0)),
+		result,
+	)
+	mockStorageIncomplete.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageIncomplete.AssertNotCalled(t, "Clear")
+}
+
+func Test_Module_Schedule(t *testing.T) {
+	target := setupModule()
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Put", when, sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.Schedule(when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, address, result)
+	mockStorageAgenda.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Module_ScheduleNamed(t *testing.T) {
+	target := setupModule()
+	setupCallBytes(mockCall)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{}, nil)
+	mockStorageAgenda.On("Put", when, sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}).Return()
+	mockStorageLookup.On("Put", taskName, address).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.ScheduleNamed(taskName, when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, address, result)
+	mockStorageLookup.AssertExpectations(t)
+	mockStorageAgenda.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Module_Cancel(t *testing.T) {
+	target := setupModule()
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	err := target.Cancel(address)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Module_CancelNamed(t *testing.T) {
+	target := setupModule()
+	mockStorageLookup.On("TryGet", taskName).Return(sc.NewOption[TaskAddress](address), nil)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	err := target.CancelNamed(taskName)
+
+	assert.Nil(t, err)
+	mockStorageLookup.AssertExpectations(t)
+	mockStorageAgenda.AssertExpectations(t)
+	mockEventDepositor.AssertExpectations(t)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedSchedulerCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedSchedulerCallsMetadataId, "Scheduler calls", sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"schedule",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesOptionTupleU64U32),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU8),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionScheduleIndex,
+					target.functions[functionScheduleIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"cancel",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					functionCancelIndex,
+					target.functions[functionCancelIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"schedule_named",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesOptionTupleU64U32),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU8),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionScheduleNamedIndex,
+					target.functions[functionScheduleNamedIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"cancel_named",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8),
+					},
+					functionCancelNamedIndex,
+					target.functions[functionCancelNamedIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"schedule_after",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesOptionTupleU64U32),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU8),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionScheduleAfterIndex,
+					target.functions[functionScheduleAfterIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"schedule_named_after",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesOptionTupleU64U32),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU8),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionScheduleNamedAfterIndex,
+					target.functions[functionScheduleNamedAfterIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedSchedulerCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedSchedulerCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Scheduler, Runtime>"),
+				},
+				moduleId,
+				"Call.Scheduler"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSchedulerEvent, "pallet_scheduler::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Scheduler"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MaximumWeight",
+				sc.ToCompact(metadata.TypesWeight),
+				sc.BytesToSequenceU8(maximumWeight.Bytes()),
+				"The maximum weight that may be scheduled per block for any dispatchables.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxScheduledPerBlock",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(maxScheduledPerBlock).Bytes()),
+				"The maximum number of scheduled calls in the queue for a single block.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSchedulerErrors),
+				},
+				moduleId,
+				"Errors.Scheduler"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"IncompleteSince",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				""),
+			primitives.NewMetadataModuleStorageEntry(
+				"Agenda",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+					},
+					sc.ToCompact(metadata.PrimitiveTypesU64),
+					sc.ToCompact(metadata.TypesSequenceOptionSchedulerScheduled),
+				),
+				"Items to be executed, indexed by the block number that they should be executed on."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Lookup",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+					},
+					sc.ToCompact(metadata.TypesFixedSequence32U8),
+					sc.ToCompact(metadata.TypesTupleU64U32),
+				),
+				"Lookup from a name to the block number and index of the task."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	target := New(moduleId, newTestConfig(), mdGenerator, logger)
+	target.storage.IncompleteSince = mockStorageIncomplete
+	target.storage.Agenda = mockStorageAgenda
+	target.storage.Lookup = mockStorageLookup
+
+	return target
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockStorageIncomplete = new(mocks.StorageValue[sc.U64])
+	mockStorageAgenda = new(mocks.StorageMap[sc.U64, sc.Sequence[sc.Option[Scheduled]]])
+	mockStorageLookup = new(mocks.StorageMap[TaskName, TaskAddress])
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockPreimage = new(mockPreimages)
+	mockCall = new(mocks.Call)
+	mockStorageBlockNumber = func() (sc.U64, error) { return blockNumber, nil }
+}
+
+func newTestConfig() *Config {
+	return NewConfig(
+		dbWeight,
+		mockEventDepositor,
+		maximumWeight,
+		maxScheduledPerBlock,
+		newTestPreimageModule(),
+		func() (sc.U64, error) { return mockStorageBlockNumber() },
+	)
+}
+
+// newTestPreimageModule returns a preimage module, which bounds the short test calls inline
+// and decodes them with the mocked runtime decoder.
+func newTestPreimageModule() preimage.Module {
+	config := preimage.NewConfig(dbWeight, mockEventDepositor, new(mocks.ReservableCurrency), sc.NewU128(0), sc.NewU128(0), mockRuntimeDecoder)
+	return preimage.New(preimageModuleId, config, primitives.NewMetadataTypeGenerator(), logger)
+}
+
+// setupScheduling returns a scheduling, which uses the mocked storage and transactional.
+func setupScheduling() scheduling {
+	setupMocks()
+
+	storage := &storage{
+		IncompleteSince: mockStorageIncomplete,
+		Agenda:          mockStorageAgenda,
+		Lookup:          mockStorageLookup,
+	}
+	constants := newConstants(dbWeight, maximumWeight, maxScheduledPerBlock)
+
+	return newScheduling(moduleId, newTestConfig(), constants, storage, mockTransactional)
+}
+
+func setupCallDispatchInfo(call *mocks.Call, weight primitives.Weight) {
+	call.On("BaseWeight").Return(weight)
+	call.On("WeighData", weight).Return(weight)
+	call.On("ClassifyDispatch", weight).Return(primitives.NewDispatchClassNormal())
+	call.On("PaysFee", weight).Return(primitives.PaysYes)
+}
+
+// setupCallDispatch sets up a call, which is decoded from its bytes and dispatched with the given result.
+func setupCallDispatch(call *mocks.Call, err error) {
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(call, nil)
+	setupCallDispatchInfo(call, callWeight)
+	call.On("Args").Return(callArgs)
+	call.On("Dispatch", rootOrigin, callArgs).Return(successPostInfo, err)
+}
+
+// setupCallBytes sets up the encoding of a call.
+func setupCallBytes(call *mocks.Call) {
+	call.On("Bytes").Return(callBytes)
+}
+
+// runInStorageLayer executes the function passed to the storage layer and returns the given error.
+func runInStorageLayer(err error) {
+	mockTransactional.On("WithStorageLayer", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+			fn()
+		}).
+		Return(primitives.PostDispatchInfo{}, err).
+		Once()
+}
+
+func newTestTaskName(b byte) TaskName {
+	taskName, _ := NewTaskName(sc.BytesToSequenceU8(bytes.Repeat([]byte{b}, 32))...)
+	return taskName
+}
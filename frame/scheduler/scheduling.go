@@ -0,0 +1,377 @@
+package scheduler
+
+import (
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// taskResult is the outcome of servicing a single task.
+type taskResult int
+
+const (
+	// taskDispatched means the call was dispatched and the task was removed from the agenda.
+	taskDispatched taskResult = iota
+	// taskUnavailable means the call could not be resolved and the task was removed from the agenda.
+	taskUnavailable
+	// taskOverweight means the call does not fit in the remaining weight and is retried in the next block.
+	taskOverweight
+	// taskPermanentlyOverweight means the call does not fit in MaximumWeight and is kept in the agenda
+	// until it is cancelled.
+	taskPermanentlyOverweight
+)
+
+// scheduling holds the dependencies and logic, shared by the calls and the hooks of the module.
+type scheduling struct {
+	moduleId           sc.U8
+	constants          *consts
+	storage            *storage
+	eventDepositor     primitives.EventDepositor
+	preimages          Preimages
+	storageBlockNumber func() (sc.U64, error)
+	transactional      support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newScheduling(moduleId sc.U8, config *Config, constants *consts, storage *storage, transactional support.Transactional[primitives.PostDispatchInfo]) scheduling {
+	return scheduling{
+		moduleId:           moduleId,
+		constants:          constants,
+		storage:            storage,
+		eventDepositor:     config.EventDepositor,
+		preimages:          config.Preimages,
+		storageBlockNumber: config.StorageBlockNumber,
+		transactional:      transactional,
+	}
+}
+
+// doSchedule adds `call` to the agenda of block `when`, to be dispatched with `origin`.
+func (s scheduling) doSchedule(maybeId sc.Option[TaskName], when sc.U64, maybePeriodic sc.Option[Period], priority sc.U8, origin primitives.RawOrigin, call primitives.Call) (TaskAddress, error) {
+	now, err := s.storageBlockNumber()
+	if err != nil {
+		return TaskAddress{}, err
+	}
+	if when <= now {
+		return TaskAddress{}, NewDispatchErrorTargetBlockNumberInPast(s.moduleId)
+	}
+
+	if maybeId.HasValue && s.storage.Lookup.Exists(maybeId.Value) {
+		return TaskAddress{}, NewDispatchErrorFailedToSchedule(s.moduleId)
+	}
+
+	// The first dispatch is not counted in the stored repetitions.
+	// A period with a zero interval or with a single dispatch is not periodic.
+	if maybePeriodic.HasValue {
+		period := maybePeriodic.Value
+		if period.Interval == 0 || period.Count <= 1 {
+			maybePeriodic = sc.NewOption[Period](nil)
+		} else {
+			maybePeriodic = sc.NewOption[Period](Period{Interval: period.Interval, Count: period.Count - 1})
+		}
+	}
+
+	bounded, err := s.preimages.Bound(call)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	address, err := s.placeTask(when, Scheduled{
+		MaybeId:       maybeId,
+		Priority:      priority,
+		Call:          bounded,
+		MaybePeriodic: maybePeriodic,
+		Origin:        origin,
+	})
+	if err != nil {
+		s.dropCall(bounded)
+		return TaskAddress{}, err
+	}
+
+	return address, nil
+}
+
+// doScheduleAfter adds `call` to the agenda of the block, which is `after` blocks after the next one.
+func (s scheduling) doScheduleAfter(maybeId sc.Option[TaskName], after sc.U64, maybePeriodic sc.Option[Period], priority sc.U8, origin primitives.RawOrigin, call primitives.Call) (TaskAddress, error) {
+	now, err := s.storageBlockNumber()
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	when := sc.SaturatingAddU64(sc.SaturatingAddU64(now, after), 1)
+
+	return s.doSchedule(maybeId, when, maybePeriodic, priority, origin, call)
+}
+
+// doCancel removes the task at `address` from the agenda.
+func (s scheduling) doCancel(address TaskAddress) error {
+	agenda, err := s.storage.Agenda.Get(address.When)
+	if err != nil {
+		return err
+	}
+	if int(address.Index) >= len(agenda) || !agenda[address.Index].HasValue {
+		return NewDispatchErrorNotFound(s.moduleId)
+	}
+
+	task := agenda[address.Index].Value
+	agenda[address.Index] = sc.NewOption[Scheduled](nil)
+	s.putAgenda(address.When, agenda)
+
+	if task.MaybeId.HasValue {
+		s.storage.Lookup.Remove(task.MaybeId.Value)
+	}
+	s.dropCall(task.Call)
+
+	s.eventDepositor.DepositEvent(newEventCanceled(s.moduleId, address.When, address.Index))
+
+	return nil
+}
+
+// doCancelNamed removes the task named `id` from the agenda.
+func (s scheduling) doCancelNamed(id TaskName) error {
+	address, err := s.storage.Lookup.TryGet(id)
+	if err != nil {
+		return err
+	}
+	if !address.HasValue {
+		return NewDispatchErrorNotFound(s.moduleId)
+	}
+
+	return s.doCancel(address.Value)
+}
+
+// placeTask adds `task` to the agenda of block `when`, reusing an empty slot if the agenda is full.
+func (s scheduling) placeTask(when sc.U64, task Scheduled) (TaskAddress, error) {
+	agenda, err := s.storage.Agenda.Get(when)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	index := len(agenda)
+	if sc.U32(index) < s.constants.MaxScheduledPerBlock {
+		agenda = append(agenda, sc.NewOption[Scheduled](task))
+	} else {
+		index = emptySlot(agenda)
+		if index < 0 {
+			return TaskAddress{}, NewDispatchErrorFailedToSchedule(s.moduleId)
+		}
+		agenda[index] = sc.NewOption[Scheduled](task)
+	}
+	s.storage.Agenda.Put(when, agenda)
+
+	address := TaskAddress{When: when, Index: sc.U32(index)}
+	if task.MaybeId.HasValue {
+		s.storage.Lookup.Put(task.MaybeId.Value, address)
+	}
+
+	s.eventDepositor.DepositEvent(newEventScheduled(s.moduleId, address.When, address.Index))
+
+	return address, nil
+}
+
+// serviceAgendas dispatches the tasks from the agendas of the blocks, starting from the first
+// incomplete one up to `now`, within MaximumWeight. The agendas, which could not be completed,
+// are serviced in the following blocks.
+func (s scheduling) serviceAgendas(now sc.U64) (primitives.Weight, error) {
+	meter := newWeightMeter(s.constants.MaximumWeight)
+	meter.consume(serviceAgendasBaseWeight(s.constants.DbWeight))
+
+	incompleteSince, err := s.storage.IncompleteSince.TryGet()
+	if err != nil {
+		return meter.consumed, err
+	}
+
+	when := now
+	if incompleteSince.HasValue {
+		when = incompleteSince.Value
+	}
+	nextIncomplete := now + 1
+	executed := sc.U32(0)
+
+	for when <= now && meter.canConsume(serviceAgendaBaseWeight(s.constants.DbWeight, 0)) {
+		complete, err := s.serviceAgenda(meter, &executed, now, when)
+		if err != nil {
+			return meter.consumed, err
+		}
+		if !complete && when < nextIncomplete {
+			nextIncomplete = when
+		}
+		when++
+	}
+	if when < nextIncomplete {
+		nextIncomplete = when
+	}
+
+	if nextIncomplete <= now {
+		s.storage.IncompleteSince.Put(nextIncomplete)
+	} else if incompleteSince.HasValue {
+		s.storage.IncompleteSince.Clear()
+	}
+
+	return meter.consumed, nil
+}
+
+// serviceAgenda dispatches the tasks from the agenda of block `when` in order of priority.
+// Returns whether all tasks, which can be dispatched, were dispatched.
+func (s scheduling) serviceAgenda(meter *weightMeter, executed *sc.U32, now sc.U64, when sc.U64) (bool, error) {
+	agenda, err := s.storage.Agenda.Get(when)
+	if err != nil {
+		return false, err
+	}
+
+	baseWeight := serviceAgendaBaseWeight(s.constants.DbWeight, sc.U64(len(agenda)))
+	if !meter.canConsume(baseWeight) {
+		return false, nil
+	}
+	meter.consume(baseWeight)
+
+	var ordered []int
+	for i, task := range agenda {
+		if task.HasValue {
+			ordered = append(ordered, i)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return agenda[ordered[i]].Value.Priority < agenda[ordered[j]].Value.Priority
+	})
+
+	postponed := 0
+	for _, i := range ordered {
+		if *executed >= s.constants.MaxScheduledPerBlock {
+			postponed++
+			continue
+		}
+
+		address := TaskAddress{When: when, Index: sc.U32(i)}
+		result, err := s.serviceTask(meter, now, address, agenda[i].Value)
+		if err != nil {
+			return false, err
+		}
+
+		switch result {
+		case taskDispatched:
+			agenda[i] = sc.NewOption[Scheduled](nil)
+			*executed++
+		case taskUnavailable:
+			agenda[i] = sc.NewOption[Scheduled](nil)
+		case taskOverweight:
+			postponed++
+		}
+	}
+
+	s.putAgenda(when, agenda)
+
+	return postponed == 0, nil
+}
+
+// serviceTask dispatches the call of `task`, if it fits in the remaining weight, and reschedules it, if it is periodic.
+func (s scheduling) serviceTask(meter *weightMeter, now sc.U64, address TaskAddress, task Scheduled) (taskResult, error) {
+	call, err := s.preimages.Peek(task.Call)
+	if err != nil {
+		if task.MaybeId.HasValue {
+			s.storage.Lookup.Remove(task.MaybeId.Value)
+		}
+		s.dropCall(task.Call)
+		s.eventDepositor.DepositEvent(newEventCallUnavailable(s.moduleId, address, task.MaybeId))
+		return taskUnavailable, nil
+	}
+
+	dispatchInfo := primitives.GetDispatchInfo(call)
+	_, _, fetched := task.Call.Lookup()
+	taskWeight := serviceTaskWeight(s.constants.DbWeight, task.MaybeId.HasValue, task.MaybePeriodic.HasValue, fetched)
+	requiredWeight := taskWeight.SaturatingAdd(dispatchInfo.Weight)
+	if !meter.canConsume(requiredWeight) {
+		if requiredWeight.AnyGt(s.constants.MaximumWeight) {
+			s.eventDepositor.DepositEvent(newEventPermanentlyOverweight(s.moduleId, address, task.MaybeId))
+			return taskPermanentlyOverweight, nil
+		}
+		return taskOverweight, nil
+	}
+	meter.consume(taskWeight)
+
+	postInfo, dispatchErr := s.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		return call.Dispatch(task.Origin, call.Args())
+	})
+	meter.consume(postInfo.CalcActualWeight(&dispatchInfo))
+
+	result, err := primitives.NewDispatchOutcomeFromError(dispatchErr)
+	if err != nil {
+		return taskDispatched, err
+	}
+	s.eventDepositor.DepositEvent(newEventDispatched(s.moduleId, address, task.MaybeId, result))
+
+	if !task.MaybePeriodic.HasValue {
+		if task.MaybeId.HasValue {
+			s.storage.Lookup.Remove(task.MaybeId.Value)
+		}
+		s.dropCall(task.Call)
+		return taskDispatched, nil
+	}
+
+	period := task.MaybePeriodic.Value
+	if period.Count > 1 {
+		task.MaybePeriodic = sc.NewOption[Period](Period{Interval: period.Interval, Count: period.Count - 1})
+	} else {
+		task.MaybePeriodic = sc.NewOption[Period](nil)
+	}
+
+	wake := sc.SaturatingAddU64(now, period.Interval)
+	if _, err := s.placeTask(wake, task); err != nil {
+		if task.MaybeId.HasValue {
+			s.storage.Lookup.Remove(task.MaybeId.Value)
+		}
+		s.dropCall(task.Call)
+		s.eventDepositor.DepositEvent(newEventPeriodicFailed(s.moduleId, address, task.MaybeId))
+	}
+
+	return taskDispatched, nil
+}
+
+// dropCall removes the preimage request of `call`, made when it was scheduled. The request may
+// have already been removed by Root with unrequest_preimage, in which case there is nothing to drop.
+func (s scheduling) dropCall(call preimage.Bounded) {
+	_ = s.preimages.Drop(call)
+}
+
+// putAgenda stores the agenda of block `when`, or removes it, if all of its slots are empty.
+func (s scheduling) putAgenda(when sc.U64, agenda sc.Sequence[sc.Option[Scheduled]]) {
+	for _, task := range agenda {
+		if task.HasValue {
+			s.storage.Agenda.Put(when, agenda)
+			return
+		}
+	}
+	s.storage.Agenda.Remove(when)
+}
+
+// emptySlot returns the index of the first empty slot in the agenda, or -1 if it is full.
+func emptySlot(agenda sc.Sequence[sc.Option[Scheduled]]) int {
+	for i, task := range agenda {
+		if !task.HasValue {
+			return i
+		}
+	}
+	return -1
+}
+
+// weightMeter tracks the weight, consumed from a limit.
+type weightMeter struct {
+	limit    primitives.Weight
+	consumed primitives.Weight
+}
+
+func newWeightMeter(limit primitives.Weight) *weightMeter {
+	return &weightMeter{
+		limit:    limit,
+		consumed: primitives.WeightZero(),
+	}
+}
+
+func (wm *weightMeter) canConsume(weight primitives.Weight) bool {
+	return !wm.consumed.SaturatingAdd(weight).AnyGt(wm.limit)
+}
+
+func (wm *weightMeter) consume(weight primitives.Weight) {
+	wm.consumed = wm.consumed.SaturatingAdd(weight)
+}
@@ -0,0 +1,741 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	emptyAgenda = sc.Sequence[sc.Option[Scheduled]]{}
+)
+
+func Test_Scheduling_doSchedule(t *testing.T) {
+	target := setupScheduling()
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, address, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+	mockStorageLookup.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, when, taskIndex))
+}
+
+func Test_Scheduling_doSchedule_Periodic(t *testing.T) {
+	target := setupScheduling()
+	task := anonymousTask
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: period.Interval, Count: period.Count - 1})
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](period), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+}
+
+func Test_Scheduling_doSchedule_SingleRepetition(t *testing.T) {
+	target := setupScheduling()
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](Period{Interval: 4, Count: 1}), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+}
+
+func Test_Scheduling_doSchedule_Named(t *testing.T) {
+	target := setupScheduling()
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockStorageLookup.On("Put", taskName, address).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	result, err := target.doSchedule(sc.NewOption[TaskName](taskName), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, address, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+	mockStorageLookup.AssertCalled(t, "Put", taskName, address)
+}
+
+func Test_Scheduling_doSchedule_NameInUse(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageLookup.On("Exists", taskName).Return(true)
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](taskName), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Scheduling_doSchedule_TargetBlockNumberInPast(t *testing.T) {
+	target := setupScheduling()
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), blockNumber, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, NewDispatchErrorTargetBlockNumberInPast(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Scheduling_doSchedule_StorageBlockNumberError(t *testing.T) {
+	target := setupScheduling()
+	mockStorageBlockNumber = func() (sc.U64, error) { return 0, expectedErr }
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Scheduling_doSchedule_FullAgenda_ReusesEmptySlot(t *testing.T) {
+	target := setupScheduling()
+	agenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](nil),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(agenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, 1)).Return()
+
+	result, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TaskAddress{When: when, Index: 1}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+}
+
+func Test_Scheduling_doSchedule_FullAgenda(t *testing.T) {
+	target := setupScheduling()
+	agenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(agenda, nil)
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Scheduling_doSchedule_AgendaError(t *testing.T) {
+	target := setupScheduling()
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, expectedErr)
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Scheduling_doSchedule_Lookup(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+	task := anonymousTask
+	task.Call = lookupCall
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}
+
+	mockPreimage.On("Bound", mockCall).Return(lookupCall, nil)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, when, taskIndex)).Return()
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+	mockPreimage.AssertNotCalled(t, "Drop", mock.Anything)
+}
+
+func Test_Scheduling_doSchedule_BoundError(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+
+	mockPreimage.On("Bound", mockCall).Return(preimage.Bounded{}, expectedErr)
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageAgenda.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Scheduling_doSchedule_FullAgenda_DropsCall(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+	agenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+
+	mockPreimage.On("Bound", mockCall).Return(lookupCall, nil)
+	mockStorageAgenda.On("Get", when).Return(agenda, nil)
+	mockPreimage.On("Drop", lookupCall).Return(nil)
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+	mockPreimage.AssertCalled(t, "Drop", lookupCall)
+}
+
+func Test_Scheduling_doScheduleAfter(t *testing.T) {
+	target := setupScheduling()
+	after := sc.U64(2)
+	expectedWhen := blockNumber + after + 1
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", expectedWhen).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", expectedWhen, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventScheduled(moduleId, expectedWhen, taskIndex)).Return()
+
+	result, err := target.doScheduleAfter(sc.NewOption[TaskName](nil), after, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TaskAddress{When: expectedWhen, Index: taskIndex}, result)
+}
+
+func Test_Scheduling_doCancel(t *testing.T) {
+	target := setupScheduling()
+	agenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](nil),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+
+	mockStorageAgenda.On("Get", when).Return(agenda, nil)
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	err := target.doCancel(address)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", when, expectedAgenda)
+	mockStorageLookup.AssertNotCalled(t, "Remove", mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, when, taskIndex))
+}
+
+func Test_Scheduling_doCancel_Named(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	err := target.doCancel(address)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Remove", when)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+}
+
+func Test_Scheduling_doCancel_DropsCall(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+	task := anonymousTask
+	task.Call = lookupCall
+
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockPreimage.On("Drop", lookupCall).Return(nil)
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	err := target.doCancel(address)
+
+	assert.Nil(t, err)
+	mockPreimage.AssertCalled(t, "Drop", lookupCall)
+}
+
+func Test_Scheduling_doCancel_NotFound(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](nil)}, nil)
+
+	err := target.doCancel(address)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Remove", mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Scheduling_doCancel_IndexOutOfRange(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil)
+
+	err := target.doCancel(address)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Scheduling_doCancelNamed(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageLookup.On("TryGet", taskName).Return(sc.NewOption[TaskAddress](address), nil)
+	mockStorageAgenda.On("Get", when).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}, nil)
+	mockStorageAgenda.On("Remove", when).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockEventDepositor.On("DepositEvent", newEventCanceled(moduleId, when, taskIndex)).Return()
+
+	err := target.doCancelNamed(taskName)
+
+	assert.Nil(t, err)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, when, taskIndex))
+}
+
+func Test_Scheduling_doCancelNamed_NotFound(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageLookup.On("TryGet", taskName).Return(sc.NewOption[TaskAddress](nil), nil)
+
+	err := target.doCancelNamed(taskName)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Scheduling_doCancelNamed_LookupError(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageLookup.On("TryGet", taskName).Return(sc.NewOption[TaskAddress](nil), expectedErr)
+
+	err := target.doCancelNamed(taskName)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Scheduling_serviceAgendas_Empty(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	result, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	assert.Equal(t, serviceAgendasBaseWeight(dbWeight).SaturatingAdd(serviceAgendaBaseWeight(dbWeight, 0)), result)
+	mockStorageIncomplete.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageIncomplete.AssertNotCalled(t, "Clear")
+}
+
+func Test_Scheduling_serviceAgendas_Dispatch(t *testing.T) {
+	target := setupScheduling()
+	addr := TaskAddress{When: blockNumber, Index: 0}
+	expectedEvent := newEventDispatched(moduleId, addr, sc.NewOption[TaskName](nil), successOutcome())
+	expectedWeight := serviceAgendasBaseWeight(dbWeight).
+		SaturatingAdd(serviceAgendaBaseWeight(dbWeight, 1)).
+		SaturatingAdd(serviceTaskWeight(dbWeight, false, false, false)).
+		SaturatingAdd(callWeight)
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}, nil)
+	setupCallDispatch(mockCall, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	result, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedWeight, result)
+	mockCall.AssertCalled(t, "Dispatch", rootOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+	mockStorageIncomplete.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Scheduling_serviceAgendas_Lookup(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+	task := anonymousTask
+	task.Call = lookupCall
+	expectedWeight := serviceAgendasBaseWeight(dbWeight).
+		SaturatingAdd(serviceAgendaBaseWeight(dbWeight, 1)).
+		SaturatingAdd(serviceTaskWeight(dbWeight, false, false, true)).
+		SaturatingAdd(callWeight)
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}, nil)
+	mockPreimage.On("Peek", lookupCall).Return(mockCall, nil)
+	setupCallDispatchInfo(mockCall, callWeight)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", rootOrigin, callArgs).Return(successPostInfo, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+	mockPreimage.On("Drop", lookupCall).Return(nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	result, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedWeight, result)
+	mockCall.AssertCalled(t, "Dispatch", rootOrigin, callArgs)
+	mockPreimage.AssertCalled(t, "Drop", lookupCall)
+}
+
+func Test_Scheduling_serviceAgendas_Lookup_Periodic(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+	task := anonymousTask
+	task.Call = lookupCall
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 4, Count: 1})
+	wake := blockNumber + 4
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}, nil)
+	mockPreimage.On("Peek", lookupCall).Return(mockCall, nil)
+	setupCallDispatchInfo(mockCall, callWeight)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", rootOrigin, callArgs).Return(successPostInfo, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+	mockStorageAgenda.On("Get", wake).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", wake, mock.Anything).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", wake, mock.Anything)
+	mockPreimage.AssertNotCalled(t, "Drop", mock.Anything)
+}
+
+func Test_Scheduling_serviceAgendas_DispatchError(t *testing.T) {
+	target := setupScheduling()
+	addr := TaskAddress{When: blockNumber, Index: 0}
+	outcome, _ := primitives.NewDispatchOutcome(callErr)
+	expectedEvent := newEventDispatched(moduleId, addr, sc.NewOption[TaskName](taskName), outcome)
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}, nil)
+	setupCallDispatch(mockCall, callErr)
+	runInStorageLayer(callErr)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+}
+
+func Test_Scheduling_serviceAgendas_Priority(t *testing.T) {
+	target := setupScheduling()
+	lowPriorityTask := anonymousTask
+	lowPriorityTask.Priority = 200
+	highPriorityTask := anonymousTask
+	highPriorityTask.Priority = 10
+	firstEvent := newEventDispatched(moduleId, TaskAddress{When: blockNumber, Index: 1}, sc.NewOption[TaskName](nil), successOutcome())
+	secondEvent := newEventDispatched(moduleId, TaskAddress{When: blockNumber, Index: 0}, sc.NewOption[TaskName](nil), successOutcome())
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](lowPriorityTask),
+		sc.NewOption[Scheduled](highPriorityTask),
+	}, nil)
+	setupCallDispatch(mockCall, nil)
+	runInStorageLayer(nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(mockEventDepositor.Calls))
+	assert.Equal(t, firstEvent, mockEventDepositor.Calls[0].Arguments.Get(0))
+	assert.Equal(t, secondEvent, mockEventDepositor.Calls[1].Arguments.Get(0))
+}
+
+func Test_Scheduling_serviceAgendas_IncompleteSince(t *testing.T) {
+	target := setupScheduling()
+	incompleteSince := blockNumber - 2
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](incompleteSince), nil)
+	for block := incompleteSince; block <= blockNumber; block++ {
+		mockStorageAgenda.On("Get", block).Return(emptyAgenda, nil)
+		mockStorageAgenda.On("Remove", block).Return()
+	}
+	mockStorageIncomplete.On("Clear").Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertNumberOfCalls(t, "Get", 3)
+	mockStorageIncomplete.AssertCalled(t, "Clear")
+}
+
+func Test_Scheduling_serviceAgendas_MaxScheduledPerBlock(t *testing.T) {
+	target := setupScheduling()
+	incompleteSince := blockNumber - 1
+	tasks := func() sc.Sequence[sc.Option[Scheduled]] {
+		return sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask), sc.NewOption[Scheduled](anonymousTask)}
+	}
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](nil), sc.NewOption[Scheduled](anonymousTask)}
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](incompleteSince), nil)
+	mockStorageAgenda.On("Get", incompleteSince).Return(tasks(), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(tasks(), nil)
+	setupCallDispatch(mockCall, nil)
+	runInStorageLayer(nil)
+	runInStorageLayer(nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+	mockStorageAgenda.On("Remove", incompleteSince).Return()
+	mockStorageAgenda.On("Put", blockNumber, expectedAgenda).Return()
+	mockStorageIncomplete.On("Put", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockCall.AssertNumberOfCalls(t, "Dispatch", maxScheduledPerBlock)
+	mockStorageAgenda.AssertCalled(t, "Put", blockNumber, expectedAgenda)
+	mockStorageIncomplete.AssertCalled(t, "Put", blockNumber)
+}
+
+func Test_Scheduling_serviceAgendas_Overweight(t *testing.T) {
+	target := setupScheduling()
+	heavyWeight := maximumWeight.SaturatingSub(serviceTaskWeight(dbWeight, false, false, false))
+	agenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(agenda, nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(mockCall, nil)
+	setupCallDispatchInfo(mockCall, heavyWeight)
+	mockStorageAgenda.On("Put", blockNumber, agenda).Return()
+	mockStorageIncomplete.On("Put", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+	mockStorageAgenda.AssertCalled(t, "Put", blockNumber, agenda)
+	mockStorageIncomplete.AssertCalled(t, "Put", blockNumber)
+}
+
+func Test_Scheduling_serviceAgendas_PermanentlyOverweight(t *testing.T) {
+	target := setupScheduling()
+	addr := TaskAddress{When: blockNumber, Index: 0}
+	agenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+	expectedEvent := newEventPermanentlyOverweight(moduleId, addr, sc.NewOption[TaskName](nil))
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(agenda, nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(mockCall, nil)
+	setupCallDispatchInfo(mockCall, maximumWeight)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageAgenda.On("Put", blockNumber, agenda).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageAgenda.AssertCalled(t, "Put", blockNumber, agenda)
+	mockStorageIncomplete.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Scheduling_serviceAgendas_CallUnavailable(t *testing.T) {
+	target := setupScheduling()
+	addr := TaskAddress{When: blockNumber, Index: 0}
+	expectedEvent := newEventCallUnavailable(moduleId, addr, sc.NewOption[TaskName](taskName))
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](namedTask)}, nil)
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(callBytes)).Return(nil, expectedErr)
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+}
+
+func Test_Scheduling_serviceAgendas_Lookup_CallUnavailable(t *testing.T) {
+	target := setupScheduling()
+	target.preimages = mockPreimage
+	task := anonymousTask
+	task.Call = lookupCall
+	expectedEvent := newEventCallUnavailable(moduleId, TaskAddress{When: blockNumber, Index: 0}, sc.NewOption[TaskName](nil))
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}, nil)
+	mockPreimage.On("Peek", lookupCall).Return(nil, primitives.NewDispatchErrorUnavailable())
+	mockPreimage.On("Drop", lookupCall).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockPreimage.AssertCalled(t, "Drop", lookupCall)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+}
+
+func Test_Scheduling_serviceAgendas_Periodic(t *testing.T) {
+	target := setupScheduling()
+	task := namedTask
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 4, Count: 2})
+	rescheduledTask := namedTask
+	rescheduledTask.MaybePeriodic = sc.NewOption[Period](Period{Interval: 4, Count: 1})
+	wake := blockNumber + 4
+	wakeAddress := TaskAddress{When: wake, Index: 0}
+	rescheduledAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](rescheduledTask)}
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}, nil)
+	setupCallDispatch(mockCall, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+	mockStorageAgenda.On("Get", wake).Return(emptyAgenda, nil)
+	mockStorageAgenda.On("Put", wake, rescheduledAgenda).Return()
+	mockStorageLookup.On("Put", taskName, wakeAddress).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", wake, rescheduledAgenda)
+	mockStorageLookup.AssertCalled(t, "Put", taskName, wakeAddress)
+	mockStorageLookup.AssertNotCalled(t, "Remove", mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, wake, 0))
+}
+
+func Test_Scheduling_serviceAgendas_PeriodicFailed(t *testing.T) {
+	target := setupScheduling()
+	task := namedTask
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 4, Count: 1})
+	wake := blockNumber + 4
+	fullAgenda := sc.Sequence[sc.Option[Scheduled]]{
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+		sc.NewOption[Scheduled](anonymousTask),
+	}
+	expectedEvent := newEventPeriodicFailed(moduleId, TaskAddress{When: blockNumber, Index: 0}, sc.NewOption[TaskName](taskName))
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", blockNumber).Return(sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](task)}, nil)
+	setupCallDispatch(mockCall, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+	mockStorageAgenda.On("Get", wake).Return(fullAgenda, nil)
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Nil(t, err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", wake, mock.Anything)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Scheduling_serviceAgendas_IncompleteSinceError(t *testing.T) {
+	target := setupScheduling()
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), expectedErr)
+
+	_, err := target.serviceAgendas(blockNumber)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageAgenda.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Scheduling_ScheduleAndServiceAgendas(t *testing.T) {
+	target := setupScheduling()
+	expectedAgenda := sc.Sequence[sc.Option[Scheduled]]{sc.NewOption[Scheduled](anonymousTask)}
+	expectedEvent := newEventDispatched(moduleId, address, sc.NewOption[TaskName](nil), successOutcome())
+
+	setupCallBytes(mockCall)
+	mockStorageAgenda.On("Get", when).Return(emptyAgenda, nil).Once()
+	mockStorageAgenda.On("Put", when, expectedAgenda).Return()
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	_, err := target.doSchedule(sc.NewOption[TaskName](nil), when, sc.NewOption[Period](nil), priority, rootOrigin, mockCall)
+	assert.Nil(t, err)
+
+	storedAgenda := mockStorageAgenda.Calls[1].Arguments.Get(1).(sc.Sequence[sc.Option[Scheduled]])
+
+	mockStorageIncomplete.On("TryGet").Return(sc.NewOption[sc.U64](nil), nil)
+	mockStorageAgenda.On("Get", when).Return(storedAgenda, nil)
+	setupCallDispatch(mockCall, nil)
+	runInStorageLayer(nil)
+	mockStorageAgenda.On("Remove", when).Return()
+
+	_, err = target.serviceAgendas(when)
+
+	assert.Nil(t, err)
+	assert.Equal(t, inlineCall, storedAgenda[0].Value.Call)
+	mockCall.AssertCalled(t, "Dispatch", rootOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageAgenda.AssertCalled(t, "Remove", when)
+}
+
+func Test_WeightMeter(t *testing.T) {
+	target := newWeightMeter(primitives.WeightFromParts(10, 10))
+
+	target.consume(primitives.WeightFromParts(6, 1))
+
+	assert.True(t, target.canConsume(primitives.WeightFromParts(4, 9)))
+	assert.False(t, target.canConsume(primitives.WeightFromParts(5, 0)))
+	assert.False(t, target.canConsume(primitives.WeightFromParts(0, 10)))
+}
+
+func successOutcome() primitives.DispatchOutcome {
+	outcome, _ := primitives.NewDispatchOutcome(nil)
+	return outcome
+}
@@ -0,0 +1,37 @@
+// Reference weights, to be replaced by the output of the BenchmarkSchedulerService benchmarks.
+
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func serviceAgendasBaseWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(4000000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
+
+func serviceAgendaBaseWeight(dbWeight primitives.RuntimeDbWeight, tasks sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(5000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(400000, 0).SaturatingMul(tasks)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
+
+func serviceTaskWeight(dbWeight primitives.RuntimeDbWeight, named bool, periodic bool, fetched bool) primitives.Weight {
+	weight := primitives.WeightFromParts(10000000, 0)
+	if fetched {
+		weight = weight.SaturatingAdd(dbWeight.Reads(2)).
+			SaturatingAdd(dbWeight.Writes(1))
+	}
+	if named {
+		weight = weight.SaturatingAdd(dbWeight.Writes(1))
+	}
+	if periodic {
+		weight = weight.SaturatingAdd(dbWeight.Reads(1)).
+			SaturatingAdd(dbWeight.Writes(1))
+	}
+	return weight
+}
@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+)
+
+var (
+	keyScheduler       = []byte("Scheduler")
+	keyIncompleteSince = []byte("IncompleteSince")
+	keyAgenda          = []byte("Agenda")
+	keyLookup          = []byte("Lookup")
+)
+
+type storage struct {
+	IncompleteSince support.StorageValue[sc.U64]
+	Agenda          support.StorageMap[sc.U64, sc.Sequence[sc.Option[Scheduled]]]
+	Lookup          support.StorageMap[TaskName, TaskAddress]
+}
+
+func newStorage() *storage {
+	return &storage{
+		IncompleteSince: support.NewHashStorageValue(keyScheduler, keyIncompleteSince, sc.DecodeU64),
+		Agenda:          support.NewHashStorageMap[sc.U64, sc.Sequence[sc.Option[Scheduled]]](keyScheduler, keyAgenda, support.NewHasherTwox64Concat(), sc.DecodeU64, DecodeAgenda),
+		Lookup:          support.NewHashStorageMap[TaskName, TaskAddress](keyScheduler, keyLookup, support.NewHasherTwox64Concat(), DecodeTaskName, DecodeTaskAddress),
+	}
+}
@@ -0,0 +1,179 @@
+package scheduler
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	errInvalidTaskNameLength = errors.New("scheduler.TaskName should be of size 32")
+)
+
+// Preimages stores the scheduled calls as bounded calls and resolves them, when they are dispatched.
+// It is implemented by the preimage module.
+type Preimages interface {
+	// Bound returns `call` as a Bounded, noting it as a requested preimage, if it is too long to be kept inline.
+	Bound(call primitives.Call) (preimage.Bounded, error)
+	// Peek returns the call, referenced by `bounded`.
+	Peek(bounded preimage.Bounded) (primitives.Call, error)
+	// Drop removes the preimage request of `bounded`, made by Bound.
+	Drop(bounded preimage.Bounded) error
+}
+
+// TaskName is the unique identifier of a named task.
+type TaskName struct {
+	sc.FixedSequence[sc.U8] // size 32
+}
+
+func NewTaskName(values ...sc.U8) (TaskName, error) {
+	if len(values) != 32 {
+		return TaskName{}, errInvalidTaskNameLength
+	}
+	return TaskName{sc.NewFixedSequence(32, values...)}, nil
+}
+
+func DecodeTaskName(buffer *bytes.Buffer) (TaskName, error) {
+	fixedSequence, err := sc.DecodeFixedSequence[sc.U8](32, buffer)
+	if err != nil {
+		return TaskName{}, err
+	}
+	return TaskName{fixedSequence}, nil
+}
+
+// TaskAddress is the location of a task in the agenda.
+type TaskAddress struct {
+	// When is the block number of the agenda.
+	When sc.U64
+	// Index is the position of the task in the agenda.
+	Index sc.U32
+}
+
+func (ta TaskAddress) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		ta.When,
+		ta.Index,
+	)
+}
+
+func DecodeTaskAddress(buffer *bytes.Buffer) (TaskAddress, error) {
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+	return TaskAddress{
+		When:  when,
+		Index: index,
+	}, nil
+}
+
+func (ta TaskAddress) Bytes() []byte {
+	return sc.EncodedBytes(ta)
+}
+
+// Period is the repetition of a periodic task.
+type Period struct {
+	// Interval is the number of blocks between two consecutive dispatches.
+	Interval sc.U64
+	// Count is the number of remaining dispatches.
+	Count sc.U32
+}
+
+func (p Period) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		p.Interval,
+		p.Count,
+	)
+}
+
+func DecodePeriod(buffer *bytes.Buffer) (Period, error) {
+	interval, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Period{}, err
+	}
+	count, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Period{}, err
+	}
+	return Period{
+		Interval: interval,
+		Count:    count,
+	}, nil
+}
+
+func (p Period) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// Scheduled is a task in the agenda.
+type Scheduled struct {
+	// MaybeId is the name of the task, if it is named.
+	MaybeId sc.Option[TaskName]
+	// Priority of the task. Tasks with lower value are dispatched first.
+	Priority sc.U8
+	// Call is the bounded call, which is resolved with the preimage module when dispatched.
+	Call preimage.Bounded
+	// MaybePeriodic is the repetition of the task, if it is periodic.
+	MaybePeriodic sc.Option[Period]
+	// Origin is the origin, with which the call is dispatched.
+	Origin primitives.RawOrigin
+}
+
+func (s Scheduled) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		s.MaybeId,
+		s.Priority,
+		s.Call,
+		s.MaybePeriodic,
+		s.Origin,
+	)
+}
+
+func DecodeScheduled(buffer *bytes.Buffer) (Scheduled, error) {
+	maybeId, err := sc.DecodeOptionWith(buffer, DecodeTaskName)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	call, err := preimage.DecodeBounded(buffer)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	origin, err := primitives.DecodeRawOrigin(buffer)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	return Scheduled{
+		MaybeId:       maybeId,
+		Priority:      priority,
+		Call:          call,
+		MaybePeriodic: maybePeriodic,
+		Origin:        origin,
+	}, nil
+}
+
+func (s Scheduled) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
+
+// DecodeAgenda decodes the tasks, scheduled for a block. Cancelled and dispatched tasks are
+// left as empty slots, so that the addresses of the remaining tasks do not change.
+func DecodeAgenda(buffer *bytes.Buffer) (sc.Sequence[sc.Option[Scheduled]], error) {
+	return sc.DecodeSequenceWith(buffer, func(buffer *bytes.Buffer) (sc.Option[Scheduled], error) {
+		return sc.DecodeOptionWith(buffer, DecodeScheduled)
+	})
+}
@@ -0,0 +1,122 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_PurgeKeys_New(t *testing.T) {
+	target := setupCallPurgeKeys()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionPurgeKeysIndex,
+		Arguments:  sc.NewVaryingData(),
+	}
+
+	assert.Equal(t, expected, target.(callPurgeKeys).Callable)
+}
+
+func Test_Call_PurgeKeys_DecodeArgs(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	call, err := target.DecodeArgs(&bytes.Buffer{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(), call.Args())
+}
+
+func Test_Call_PurgeKeys_Encode(t *testing.T) {
+	target := setupCallPurgeKeys()
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionPurgeKeysIndex})
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_PurgeKeys_Bytes(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, []byte{moduleId, functionPurgeKeysIndex}, target.Bytes())
+}
+
+func Test_Call_PurgeKeys_ModuleIndex(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_PurgeKeys_FunctionIndex(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, sc.U8(functionPurgeKeysIndex), target.FunctionIndex())
+}
+
+func Test_Call_PurgeKeys_BaseWeight(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, callPurgeKeysWeight(dbWeight, 2), target.BaseWeight())
+}
+
+func Test_Call_PurgeKeys_WeighData(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_PurgeKeys_ClassifyDispatch(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_PurgeKeys_PaysFee(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_PurgeKeys_Dispatch(t *testing.T) {
+	target := setupCallPurgeKeys()
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageNextKeys.On("Remove", whoAccountId).Return()
+	mockStorageKeyOwner.On("Remove", auraKeyOwnerKey).Return()
+	mockStorageKeyOwner.On("Remove", grandpaKeyOwnerKey).Return()
+	mockStoredMap.On("DecConsumers", whoAccountId).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStoredMap.AssertCalled(t, "DecConsumers", whoAccountId)
+}
+
+func Test_Call_PurgeKeys_Dispatch_NoKeys(t *testing.T) {
+	target := setupCallPurgeKeys()
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData())
+
+	assert.Equal(t, NewDispatchErrorNoKeys(moduleId), err)
+	mockStorageNextKeys.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_PurgeKeys_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallPurgeKeys()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageNextKeys.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallPurgeKeys() primitives.Call {
+	return newCallPurgeKeys(moduleId, functionPurgeKeysIndex, setupSessions())
+}
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Sets the session keys of the function caller to `keys`. The keys take effect in the session
+// after the next one. The dispatch origin for this call must be `Signed`.
+// The `proof` is not verified, since the session keys api generates an empty proof of ownership.
+type callSetKeys struct {
+	primitives.Callable
+	sessions
+}
+
+func newCallSetKeys(moduleId sc.U8, functionId sc.U8, sessions sessions) primitives.Call {
+	call := callSetKeys{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(SessionKeys{}, sc.Sequence[sc.U8]{}),
+		},
+		sessions: sessions,
+	}
+
+	return call
+}
+
+func (c callSetKeys) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	keys, err := DecodeSessionKeys(buffer, len(c.handlers))
+	if err != nil {
+		return nil, err
+	}
+	proof, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(keys, proof)
+	return c, nil
+}
+
+func (c callSetKeys) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSetKeys) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSetKeys) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callSetKeys) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callSetKeys) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callSetKeys) BaseWeight() primitives.Weight {
+	return callSetKeysWeight(c.constants.DbWeight, sc.U64(len(c.handlers)))
+}
+
+func (_ callSetKeys) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callSetKeys) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callSetKeys) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callSetKeys) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.doSetKeys(who, args[0].(SessionKeys))
+}
+
+func (_ callSetKeys) Docs() string {
+	return "Sets the session key(s) of the function caller to `keys`. " +
+		"Allows an account to set its session key prior to becoming a validator. " +
+		"This doesn't take effect until the next session."
+}
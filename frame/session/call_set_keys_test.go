@@ -0,0 +1,142 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	proof = sc.Sequence[sc.U8]{}
+)
+
+func Test_Call_SetKeys_New(t *testing.T) {
+	target := setupCallSetKeys()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionSetKeysIndex,
+		Arguments:  sc.NewVaryingData(SessionKeys{}, sc.Sequence[sc.U8]{}),
+	}
+
+	assert.Equal(t, expected, target.(callSetKeys).Callable)
+}
+
+func Test_Call_SetKeys_DecodeArgs(t *testing.T) {
+	target := setupCallSetKeys()
+	buffer := bytes.NewBuffer(append(sessionKeys.Bytes(), proof.Bytes()...))
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(sessionKeys, proof), call.Args())
+}
+
+func Test_Call_SetKeys_DecodeArgs_MissingKey(t *testing.T) {
+	target := setupCallSetKeys()
+	buffer := bytes.NewBuffer(auraKey.Bytes())
+
+	_, err := target.DecodeArgs(buffer)
+
+	assert.NotNil(t, err)
+}
+
+func Test_Call_SetKeys_Encode(t *testing.T) {
+	target := setupCallSetKeys()
+	call, err := target.DecodeArgs(bytes.NewBuffer(append(sessionKeys.Bytes(), proof.Bytes()...)))
+	assert.Nil(t, err)
+	expectedBuffer := bytes.NewBuffer(append(append([]byte{moduleId, functionSetKeysIndex}, sessionKeys.Bytes()...), proof.Bytes()...))
+	buffer := &bytes.Buffer{}
+
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_SetKeys_Bytes(t *testing.T) {
+	target := setupCallSetKeys()
+	call, err := target.DecodeArgs(bytes.NewBuffer(append(sessionKeys.Bytes(), proof.Bytes()...)))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append(append([]byte{moduleId, functionSetKeysIndex}, sessionKeys.Bytes()...), proof.Bytes()...), call.Bytes())
+}
+
+func Test_Call_SetKeys_ModuleIndex(t *testing.T) {
+	target := setupCallSetKeys()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_SetKeys_FunctionIndex(t *testing.T) {
+	target := setupCallSetKeys()
+
+	assert.Equal(t, sc.U8(functionSetKeysIndex), target.FunctionIndex())
+}
+
+func Test_Call_SetKeys_BaseWeight(t *testing.T) {
+	target := setupCallSetKeys()
+
+	assert.Equal(t, callSetKeysWeight(dbWeight, 2), target.BaseWeight())
+}
+
+func Test_Call_SetKeys_WeighData(t *testing.T) {
+	target := setupCallSetKeys()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_SetKeys_ClassifyDispatch(t *testing.T) {
+	target := setupCallSetKeys()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_SetKeys_PaysFee(t *testing.T) {
+	target := setupCallSetKeys()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_SetKeys_Dispatch(t *testing.T) {
+	target := setupCallSetKeys()
+	mockStorageKeyOwner.On("TryGet", auraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageKeyOwner.On("TryGet", grandpaKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+	mockStoredMap.On("IncConsumers", whoAccountId).Return(nil)
+	mockStorageKeyOwner.On("Put", auraKeyOwnerKey, whoAccountId).Return()
+	mockStorageKeyOwner.On("Put", grandpaKeyOwnerKey, whoAccountId).Return()
+	mockStorageNextKeys.On("Put", whoAccountId, sessionKeys).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sessionKeys, proof))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageNextKeys.AssertCalled(t, "Put", whoAccountId, sessionKeys)
+}
+
+func Test_Call_SetKeys_Dispatch_DuplicatedKey(t *testing.T) {
+	target := setupCallSetKeys()
+	mockStorageKeyOwner.On("TryGet", auraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](otherAccountId), nil)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sessionKeys, proof))
+
+	assert.Equal(t, NewDispatchErrorDuplicatedKey(moduleId), err)
+	mockStorageNextKeys.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_SetKeys_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallSetKeys()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(sessionKeys, proof))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageKeyOwner.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallSetKeys() primitives.Call {
+	return newCallSetKeys(moduleId, functionSetKeysIndex, setupSessions())
+}
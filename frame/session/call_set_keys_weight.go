@@ -0,0 +1,14 @@
+// Reference weight, to be replaced by the output of the BenchmarkSessionSetKeys benchmark.
+
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callSetKeysWeight(dbWeight primitives.RuntimeDbWeight, keys sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(30000000, 0).
+		SaturatingAdd(dbWeight.Reads(2).SaturatingAdd(dbWeight.Reads(1).SaturatingMul(keys))).
+		SaturatingAdd(dbWeight.Writes(2).SaturatingAdd(dbWeight.Writes(2).SaturatingMul(keys)))
+}
@@ -0,0 +1,30 @@
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight  primitives.RuntimeDbWeight
+	StoredMap primitives.StoredMap
+	// Handlers are notified when the session changes. Each of them has a key in the session keys, in the same order.
+	Handlers []primitives.SessionHandler
+	// Manager selects the validators of the upcoming sessions.
+	Manager SessionManager
+	// Period is the number of blocks in a session.
+	Period sc.U64
+	// Offset is the block number of the first session change.
+	Offset sc.U64
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, storedMap primitives.StoredMap, handlers []primitives.SessionHandler, manager SessionManager, period sc.U64, offset sc.U64) *Config {
+	return &Config{
+		DbWeight:  dbWeight,
+		StoredMap: storedMap,
+		Handlers:  handlers,
+		Manager:   manager,
+		Period:    period,
+		Offset:    offset,
+	}
+}
@@ -0,0 +1,20 @@
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight primitives.RuntimeDbWeight
+	Period   sc.U64
+	Offset   sc.U64
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, period sc.U64, offset sc.U64) *consts {
+	return &consts{
+		DbWeight: dbWeight,
+		Period:   period,
+		Offset:   offset,
+	}
+}
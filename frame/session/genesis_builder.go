@@ -0,0 +1,177 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/vedhavyas/go-subkey"
+)
+
+var (
+	errInvalidAddrValue       = errors.New("invalid address in genesis config json")
+	errInvalidKeysValue       = errors.New("invalid session keys in genesis config json")
+	errEmptyGenesisValidators = errors.New("empty validators in the genesis session")
+)
+
+// genesisConfigKeys are the session keys of validator `Validator`, which is controlled by account `AccountId`.
+type genesisConfigKeys struct {
+	AccountId types.AccountId
+	Validator types.AccountId
+	Keys      SessionKeys
+}
+
+type GenesisConfig struct {
+	Keys []genesisConfigKeys
+}
+
+// genesisConfigJsonStruct lists the keys as `[account, validator, [key, ...]]`, where the
+// ss58 encoded keys are in the order of the session handlers.
+type genesisConfigJsonStruct struct {
+	SessionGenesisConfig struct {
+		Keys [][3]interface{} `json:"keys"`
+	} `json:"session"`
+}
+
+func (gc *GenesisConfig) UnmarshalJSON(data []byte) error {
+	gcJson := genesisConfigJsonStruct{}
+
+	if err := json.Unmarshal(data, &gcJson); err != nil {
+		return err
+	}
+
+	for _, k := range gcJson.SessionGenesisConfig.Keys {
+		accountId, err := decodeAccountId(k[0])
+		if err != nil {
+			return err
+		}
+
+		validator, err := decodeAccountId(k[1])
+		if err != nil {
+			return err
+		}
+
+		keysJson, ok := k[2].([]interface{})
+		if !ok {
+			return errInvalidKeysValue
+		}
+
+		keys := SessionKeys{Keys: sc.Sequence[sc.FixedSequence[sc.U8]]{}}
+		for _, keyJson := range keysJson {
+			keyString, ok := keyJson.(string)
+			if !ok {
+				return errInvalidKeysValue
+			}
+
+			_, publicKey, err := subkey.SS58Decode(keyString)
+			if err != nil {
+				return err
+			}
+			if len(publicKey) != keyLength {
+				return errInvalidKeysValue
+			}
+
+			keys.Keys = append(keys.Keys, sc.BytesToFixedSequenceU8(publicKey))
+		}
+
+		gc.Keys = append(gc.Keys, genesisConfigKeys{
+			AccountId: accountId,
+			Validator: validator,
+			Keys:      keys,
+		})
+	}
+
+	return nil
+}
+
+func (m Module) CreateDefaultConfig() ([]byte, error) {
+	gc := &genesisConfigJsonStruct{}
+	gc.SessionGenesisConfig.Keys = [][3]interface{}{}
+
+	return json.Marshal(gc)
+}
+
+// BuildConfig sets the keys of the genesis validators and notifies the session handlers of the
+// genesis session. The accounts must exist, which is why the module must come after balances in the runtime.
+func (m Module) BuildConfig(config []byte) error {
+	gc := GenesisConfig{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	if len(gc.Keys) == 0 {
+		return nil
+	}
+
+	validators := sc.Sequence[types.AccountId]{}
+	for _, k := range gc.Keys {
+		if len(k.Keys.Keys) != len(m.Config.Handlers) {
+			return errInvalidKeysValue
+		}
+
+		oldKeys, err := m.sessions.checkKeys(k.Validator, k.Keys)
+		if err != nil {
+			return err
+		}
+		m.sessions.putKeys(k.Validator, k.Keys, oldKeys)
+
+		if err := m.Config.StoredMap.IncConsumers(k.AccountId); err != nil {
+			return err
+		}
+
+		validators = append(validators, k.Validator)
+	}
+
+	maybeValidators, err := m.Config.Manager.NewSession(0)
+	if err != nil {
+		return err
+	}
+	if maybeValidators.HasValue {
+		validators = maybeValidators.Value
+	}
+	if len(validators) == 0 {
+		return errEmptyGenesisValidators
+	}
+
+	maybeQueuedValidators, err := m.Config.Manager.NewSession(1)
+	if err != nil {
+		return err
+	}
+	queuedValidators := validators
+	if maybeQueuedValidators.HasValue {
+		queuedValidators = maybeQueuedValidators.Value
+	}
+
+	queuedKeys, err := m.sessions.loadQueuedKeys(queuedValidators)
+	if err != nil {
+		return err
+	}
+
+	for i, handler := range m.Config.Handlers {
+		if err := handler.OnGenesisSession(handlerValidators(queuedKeys, i)); err != nil {
+			return err
+		}
+	}
+
+	m.storage.Validators.Put(validators)
+	m.storage.QueuedKeys.Put(queuedKeys)
+
+	m.Config.Manager.StartSession(0)
+
+	return nil
+}
+
+func decodeAccountId(value interface{}) (types.AccountId, error) {
+	addrString, ok := value.(string)
+	if !ok {
+		return types.AccountId{}, errInvalidAddrValue
+	}
+
+	_, publicKey, err := subkey.SS58Decode(addrString)
+	if err != nil {
+		return types.AccountId{}, err
+	}
+
+	return types.NewAccountId(sc.BytesToSequenceU8(publicKey)...)
+}
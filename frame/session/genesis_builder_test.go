@@ -0,0 +1,162 @@
+package session
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	aliceAddress      = "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	validGcJson       = "{\"session\":{\"keys\":[[\"" + aliceAddress + "\",\"" + aliceAddress + "\",[\"" + aliceAddress + "\",\"" + aliceAddress + "\"]]]}}"
+	aliceAccountId, _ = primitives.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	aliceKey          = sc.BytesToFixedSequenceU8(signature.TestKeyringPairAlice.PublicKey)
+	aliceSessionKeys  = SessionKeys{Keys: sc.Sequence[sc.FixedSequence[sc.U8]]{aliceKey, aliceKey}}
+	aliceQueuedKeys   = sc.Sequence[QueuedKey]{{Validator: aliceAccountId, Keys: aliceSessionKeys}}
+)
+
+func Test_GenesisConfig_BuildConfig(t *testing.T) {
+	target := setupModule()
+	setupGenesisKeys()
+	mockSessionManager.On("NewSession", sc.U32(0)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil), nil)
+	mockSessionManager.On("NewSession", sc.U32(1)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil), nil)
+	mockAuraHandler.On("OnGenesisSession", handlerValidators(aliceQueuedKeys, 0)).Return(nil)
+	mockGrandpaHandler.On("OnGenesisSession", handlerValidators(aliceQueuedKeys, 1)).Return(nil)
+	mockStorageValidators.On("Put", sc.Sequence[primitives.AccountId]{aliceAccountId}).Return()
+	mockStorageQueuedKeys.On("Put", aliceQueuedKeys).Return()
+	mockSessionManager.On("StartSession", sc.U32(0)).Return()
+
+	err := target.BuildConfig([]byte(validGcJson))
+
+	assert.Nil(t, err)
+	mockStoredMap.AssertCalled(t, "IncConsumers", aliceAccountId)
+	mockStorageNextKeys.AssertCalled(t, "Put", aliceAccountId, aliceSessionKeys)
+	mockAuraHandler.AssertExpectations(t)
+	mockGrandpaHandler.AssertExpectations(t)
+	mockStorageValidators.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{aliceAccountId})
+	mockStorageQueuedKeys.AssertCalled(t, "Put", aliceQueuedKeys)
+	mockSessionManager.AssertCalled(t, "StartSession", sc.U32(0))
+}
+
+func Test_GenesisConfig_BuildConfig_ManagerValidators(t *testing.T) {
+	target := setupModule()
+	setupGenesisKeys()
+	validators := sc.Sequence[primitives.AccountId]{aliceAccountId, otherAccountId}
+	mockSessionManager.On("NewSession", sc.U32(0)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](validators), nil)
+	mockSessionManager.On("NewSession", sc.U32(1)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil), nil)
+	mockStorageNextKeys.On("TryGet", otherAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+	mockAuraHandler.On("OnGenesisSession", handlerValidators(aliceQueuedKeys, 0)).Return(nil)
+	mockGrandpaHandler.On("OnGenesisSession", handlerValidators(aliceQueuedKeys, 1)).Return(nil)
+	mockStorageValidators.On("Put", validators).Return()
+	mockStorageQueuedKeys.On("Put", aliceQueuedKeys).Return()
+	mockSessionManager.On("StartSession", sc.U32(0)).Return()
+
+	err := target.BuildConfig([]byte(validGcJson))
+
+	assert.Nil(t, err)
+	mockStorageValidators.AssertCalled(t, "Put", validators)
+	mockStorageQueuedKeys.AssertCalled(t, "Put", aliceQueuedKeys)
+}
+
+func Test_GenesisConfig_BuildConfig_Empty(t *testing.T) {
+	target := setupModule()
+
+	err := target.BuildConfig([]byte("{\"session\":{\"keys\":[]}}"))
+
+	assert.Nil(t, err)
+	mockSessionManager.AssertNotCalled(t, "NewSession", mock.Anything)
+	mockStorageValidators.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_GenesisConfig_BuildConfig_Errors(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		gcJson      string
+		expectedErr error
+	}{
+		{
+			name:        "invalid account",
+			gcJson:      "{\"session\":{\"keys\":[[1,\"" + aliceAddress + "\",[]]]}}",
+			expectedErr: errInvalidAddrValue,
+		},
+		{
+			name:        "invalid ss58 address",
+			gcJson:      "{\"session\":{\"keys\":[[\"" + aliceAddress + "\",\"invalid\",[]]]}}",
+			expectedErr: errors.New("expected at least 2 bytes in base58 decoded address"),
+		},
+		{
+			name:        "invalid keys",
+			gcJson:      "{\"session\":{\"keys\":[[\"" + aliceAddress + "\",\"" + aliceAddress + "\",\"invalid\"]]}}",
+			expectedErr: errInvalidKeysValue,
+		},
+		{
+			name:        "invalid key",
+			gcJson:      "{\"session\":{\"keys\":[[\"" + aliceAddress + "\",\"" + aliceAddress + "\",[1]]]}}",
+			expectedErr: errInvalidKeysValue,
+		},
+		{
+			name:        "missing key",
+			gcJson:      "{\"session\":{\"keys\":[[\"" + aliceAddress + "\",\"" + aliceAddress + "\",[\"" + aliceAddress + "\"]]]}}",
+			expectedErr: errInvalidKeysValue,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+
+			err := target.BuildConfig([]byte(tt.gcJson))
+
+			assert.Equal(t, tt.expectedErr, err)
+			mockStorageNextKeys.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func Test_GenesisConfig_BuildConfig_IncConsumersError(t *testing.T) {
+	target := setupModule()
+	mockStorageKeyOwner.On("TryGet", mock.Anything).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageNextKeys.On("TryGet", aliceAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+	mockStorageKeyOwner.On("Put", mock.Anything, aliceAccountId).Return()
+	mockStorageNextKeys.On("Put", aliceAccountId, aliceSessionKeys).Return()
+	mockStoredMap.On("IncConsumers", aliceAccountId).Return(expectedErr)
+
+	err := target.BuildConfig([]byte(validGcJson))
+
+	assert.Equal(t, expectedErr, err)
+	mockSessionManager.AssertNotCalled(t, "NewSession", mock.Anything)
+}
+
+func Test_GenesisConfig_BuildConfig_NoValidators(t *testing.T) {
+	target := setupModule()
+	setupGenesisKeys()
+	mockSessionManager.On("NewSession", sc.U32(0)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{}), nil)
+
+	err := target.BuildConfig([]byte(validGcJson))
+
+	assert.Equal(t, errEmptyGenesisValidators, err)
+	mockStorageValidators.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_GenesisConfig_CreateDefaultConfig(t *testing.T) {
+	target := setupModule()
+
+	expectedGc := []byte("{\"session\":{\"keys\":[]}}")
+
+	gc, err := target.CreateDefaultConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedGc, gc)
+}
+
+// setupGenesisKeys sets up the mocks for setting the keys of alice, which are then loaded as queued keys.
+func setupGenesisKeys() {
+	mockStorageKeyOwner.On("TryGet", mock.Anything).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageNextKeys.On("TryGet", aliceAccountId).Return(sc.NewOption[SessionKeys](nil), nil).Once()
+	mockStorageNextKeys.On("TryGet", aliceAccountId).Return(sc.NewOption[SessionKeys](aliceSessionKeys), nil)
+	mockStorageKeyOwner.On("Put", mock.Anything, aliceAccountId).Return()
+	mockStorageNextKeys.On("Put", aliceAccountId, aliceSessionKeys).Return()
+	mockStoredMap.On("IncConsumers", aliceAccountId).Return(nil)
+}
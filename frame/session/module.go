@@ -0,0 +1,287 @@
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_session`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionSetKeysIndex   = 0
+	functionPurgeKeysIndex = 1
+)
+
+const (
+	name           = sc.Str("Session")
+	storageVersion = sc.U16(0)
+)
+
+// Module manages the session keys of the validators and rotates the validator set every Period blocks,
+// starting from block Offset, so that the authorities can change without a runtime upgrade.
+//
+// Validators register their session keys with set_keys, one key for each of the session handlers.
+// When a session ends, the queued validators become the current ones and the validators of the
+// session after it are planned by the SessionManager and queued with their next keys. The session
+// handlers, such as Aura and GRANDPA, are notified with the keys of the current and the queued validators.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	sessions    sessions
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.Period, config.Offset)
+	storage := newStorage(len(config.Handlers))
+	sessions := newSessions(index, config, constants, storage)
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionSetKeysIndex] = newCallSetKeys(index, functionSetKeysIndex, sessions)
+	functions[functionPurgeKeysIndex] = newCallPurgeKeys(index, functionPurgeKeysIndex, sessions)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keySession, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		sessions:             sessions,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize rotates the session, if it ends at block `n`.
+func (m Module) OnInitialize(n sc.U64) (primitives.Weight, error) {
+	if !m.sessions.shouldEndSession(n) {
+		return primitives.WeightZero(), nil
+	}
+
+	validators, err := m.sessions.rotateSession()
+	if err != nil {
+		return primitives.Weight{}, err
+	}
+
+	return rotateSessionWeight(m.constants.DbWeight, validators), nil
+}
+
+// Validators returns the validators of the current session.
+func (m Module) Validators() (sc.Sequence[primitives.AccountId], error) {
+	return m.storage.Validators.Get()
+}
+
+// CurrentIndex returns the index of the current session.
+func (m Module) CurrentIndex() (sc.U32, error) {
+	return m.storage.CurrentIndex.Get()
+}
+
+// NextKeys returns the session keys of `validator`, which take effect in the session after the next one.
+func (m Module) NextKeys(validator primitives.AccountId) (sc.Option[SessionKeys], error) {
+	return m.storage.NextKeys.TryGet(validator)
+}
+
+// KeyOwner returns the validator, which owns `key` of the session handler with `typeId`.
+func (m Module) KeyOwner(typeId [4]byte, key sc.Sequence[sc.U8]) (sc.Option[primitives.AccountId], error) {
+	return m.storage.KeyOwner.TryGet(KeyOwnerKey{TypeId: sc.BytesToFixedSequenceU8(typeId[:]), Key: key})
+}
+
+// IsDisabled returns whether the validator at `index` in the current validator set is disabled.
+func (m Module) IsDisabled(index sc.U32) (bool, error) {
+	return m.sessions.isDisabled(index)
+}
+
+// DisableIndex disables the validator at `index` in the current validator set until the next session starts.
+// Returns whether the validator exists and was not already disabled.
+func (m Module) DisableIndex(index sc.U32) (bool, error) {
+	return m.sessions.disableIndex(index)
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdSessionCalls := m.mdGenerator.BuildCallsMetadata("Session", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdSessionCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdSessionCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Session, Runtime>"),
+				},
+				m.Index,
+				"Call.Session"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSessionEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSessionEvent, "pallet_session::Event"),
+				},
+				m.Index,
+				"Events.Session"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSessionErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSessionErrors),
+				},
+				m.Index,
+				"Errors.Session"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesSessionKeys, "SessionKeys", sc.Sequence[sc.Str]{"node_template_runtime", "opaque", "SessionKeys"}, primitives.NewMetadataTypeDefinitionComposite(
+			m.metadataSessionKeysFields())),
+		primitives.NewMetadataType(metadata.TypesTupleAddress32SessionKeys, "(ValidatorId, Keys)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesAddress32), sc.ToCompact(metadata.TypesSessionKeys)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleAddress32SessionKeys, "[]byte (ValidatorId, Keys)",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleAddress32SessionKeys))),
+		primitives.NewMetadataType(metadata.TypesTupleFixedSequence4U8SequenceU8, "(KeyTypeId, Vec<u8>)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesFixedSequence4U8), sc.ToCompact(metadata.TypesSequenceU8)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesSessionEvent, "pallet_session pallet Event", sc.Sequence[sc.Str]{"pallet_session", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"NewSession",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "session_index", "SessionIndex"),
+					},
+					EventNewSession,
+					"Events.NewSession"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesSessionErrors,
+			"pallet_session pallet Error",
+			sc.Sequence[sc.Str]{"pallet_session", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("InvalidProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorInvalidProof, "Invalid ownership proof."),
+					primitives.NewMetadataDefinitionVariant("NoAssociatedValidatorId", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoAssociatedValidatorId, "No associated validator ID for account."),
+					primitives.NewMetadataDefinitionVariant("DuplicatedKey", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorDuplicatedKey, "Registered duplicate key."),
+					primitives.NewMetadataDefinitionVariant("NoKeys", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoKeys, "No keys are associated with this account."),
+					primitives.NewMetadataDefinitionVariant("NoAccount", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoAccount, "Key setting account is not live, so it's impossible to associate keys."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+// metadataSessionKeysFields returns the fields of the session keys, one for each session handler,
+// named by the key type id of the handler.
+func (m Module) metadataSessionKeysFields() sc.Sequence[primitives.MetadataTypeDefinitionField] {
+	fields := sc.Sequence[primitives.MetadataTypeDefinitionField]{}
+	for _, handler := range m.Config.Handlers {
+		keyTypeId := handler.KeyTypeId()
+
+		typeId := metadata.TypesFixedSequence32U8
+		switch handler.KeyType() {
+		case primitives.PublicKeySr25519:
+			typeId = metadata.TypesSr25519PubKey
+		case primitives.PublicKeyEd25519:
+			typeId = metadata.TypesEd25519PubKey
+		}
+
+		fields = append(fields, primitives.NewMetadataTypeDefinitionFieldWithNames(typeId, sc.Str(keyTypeId[:]), "Public"))
+	}
+	return fields
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Validators",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceAddress32)),
+				"The current set of validators."),
+			primitives.NewMetadataModuleStorageEntry(
+				"CurrentIndex",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"Current index of the session."),
+			primitives.NewMetadataModuleStorageEntry(
+				"QueuedChanged",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesBool)),
+				"True if the underlying economic identities or weighting behind the validators has changed in the queued validator set."),
+			primitives.NewMetadataModuleStorageEntry(
+				"QueuedKeys",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceTupleAddress32SessionKeys)),
+				"The queued keys for the next session."),
+			primitives.NewMetadataModuleStorageEntry(
+				"NextKeys",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesAddress32,
+					metadata.TypesSessionKeys,
+					support.NewHasherTwox64Concat(),
+				),
+				"The next session keys for a validator."),
+			primitives.NewMetadataModuleStorageEntry(
+				"KeyOwner",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesTupleFixedSequence4U8SequenceU8,
+					metadata.TypesAddress32,
+					support.NewHasherTwox64Concat(),
+				),
+				"The owner of a key. The key is the `KeyTypeId` + the raw key data."),
+			primitives.NewMetadataModuleStorageEntry(
+				"DisabledValidators",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceU32)),
+				"Indices of disabled validators, kept sorted. They are enabled again when the validator set changes."),
+		},
+	})
+}
@@ -0,0 +1,448 @@
+package session
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId = 16
+	period   = 10
+	offset   = 2
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+
+	whoAccountId   = constants.OneAccountId
+	otherAccountId = constants.TwoAccountId
+	signedOrigin   = primitives.NewRawOriginSigned(whoAccountId)
+
+	auraKeyTypeId    = [4]byte{'a', 'u', 'r', 'a'}
+	grandpaKeyTypeId = [4]byte{'g', 'r', 'a', 'n'}
+
+	auraKey     = sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{1}, 32))
+	grandpaKey  = sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{2}, 32))
+	sessionKeys = SessionKeys{Keys: sc.Sequence[sc.FixedSequence[sc.U8]]{auraKey, grandpaKey}}
+
+	otherAuraKey     = sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{3}, 32))
+	otherGrandpaKey  = sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{4}, 32))
+	otherSessionKeys = SessionKeys{Keys: sc.Sequence[sc.FixedSequence[sc.U8]]{otherAuraKey, otherGrandpaKey}}
+
+	auraKeyOwnerKey    = newKeyOwnerKey(auraKeyTypeId, auraKey)
+	grandpaKeyOwnerKey = newKeyOwnerKey(grandpaKeyTypeId, grandpaKey)
+
+	expectedErr = errors.New("error")
+	mdGenerator = primitives.NewMetadataTypeGenerator()
+	logger      = log.NewLogger()
+)
+
+var (
+	mockStoredMap            *mocks.StoredMap
+	mockSessionManager       *mocks.SessionManager
+	mockAuraHandler          *mocks.SessionHandler
+	mockGrandpaHandler       *mocks.SessionHandler
+	mockStorageValidators    *mocks.StorageValue[sc.Sequence[primitives.AccountId]]
+	mockStorageCurrentIndex  *mocks.StorageValue[sc.U32]
+	mockStorageQueuedChanged *mocks.StorageValue[sc.Bool]
+	mockStorageQueuedKeys    *mocks.StorageValue[sc.Sequence[QueuedKey]]
+	mockStorageNextKeys      *mocks.StorageMap[primitives.AccountId, SessionKeys]
+	mockStorageKeyOwner      *mocks.StorageMap[KeyOwnerKey, primitives.AccountId]
+	mockStorageDisabled      *mocks.StorageValue[sc.Sequence[sc.U32]]
+	mockCall                 *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 2, len(functions))
+	assert.Equal(t, sc.U8(functionSetKeysIndex), functions[functionSetKeysIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionPurgeKeysIndex), functions[functionPurgeKeysIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_OnInitialize_NotSessionEnd(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.OnInitialize(offset + 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.WeightZero(), result)
+	mockStorageCurrentIndex.AssertNotCalled(t, "Get")
+}
+
+func Test_Module_OnInitialize_SessionEnd(t *testing.T) {
+	target := setupModule()
+	queuedKeys := sc.Sequence[QueuedKey]{{Validator: whoAccountId, Keys: sessionKeys}}
+	setupRotateSession(queuedKeys, sc.NewOption[sc.Sequence[primitives.AccountId]](nil))
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageQueuedKeys.On("Put", queuedKeys).Return()
+	mockStorageQueuedChanged.On("Put", sc.Bool(false)).Return()
+
+	result, err := target.OnInitialize(offset + period)
+
+	assert.Nil(t, err)
+	assert.Equal(t, rotateSessionWeight(dbWeight, 1), result)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventNewSession(moduleId, 1))
+}
+
+func Test_Module_IsDisabled(t *testing.T) {
+	target := setupModule()
+	mockStorageDisabled.On("Get").Return(sc.Sequence[sc.U32]{1, 3}, nil)
+
+	result, err := target.IsDisabled(3)
+
+	assert.Nil(t, err)
+	assert.True(t, result)
+}
+
+func Test_Module_DisableIndex(t *testing.T) {
+	target := setupModule()
+	mockStorageValidators.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(4)), nil)
+	mockStorageDisabled.On("Get").Return(sc.Sequence[sc.U32]{1, 3}, nil)
+	mockStorageDisabled.On("Put", sc.Sequence[sc.U32]{1, 2, 3}).Return()
+
+	result, err := target.DisableIndex(2)
+
+	assert.Nil(t, err)
+	assert.True(t, result)
+	mockStorageDisabled.AssertCalled(t, "Put", sc.Sequence[sc.U32]{1, 2, 3})
+}
+
+func Test_Module_OnInitialize_Error(t *testing.T) {
+	target := setupModule()
+	mockStorageCurrentIndex.On("Get").Return(sc.U32(0), expectedErr)
+
+	_, err := target.OnInitialize(offset)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Module_Validators(t *testing.T) {
+	target := setupModule()
+	validators := sc.Sequence[primitives.AccountId]{whoAccountId}
+	mockStorageValidators.On("Get").Return(validators, nil)
+
+	result, err := target.Validators()
+
+	assert.Nil(t, err)
+	assert.Equal(t, validators, result)
+}
+
+func Test_Module_CurrentIndex(t *testing.T) {
+	target := setupModule()
+	mockStorageCurrentIndex.On("Get").Return(sc.U32(3), nil)
+
+	result, err := target.CurrentIndex()
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.U32(3), result)
+}
+
+func Test_Module_NextKeys(t *testing.T) {
+	target := setupModule()
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+
+	result, err := target.NextKeys(whoAccountId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[SessionKeys](sessionKeys), result)
+}
+
+func Test_Module_KeyOwner(t *testing.T) {
+	target := setupModule()
+	mockStorageKeyOwner.On("TryGet", auraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](whoAccountId), nil)
+
+	result, err := target.KeyOwner(auraKeyTypeId, sc.Sequence[sc.U8](auraKey))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[primitives.AccountId](whoAccountId), result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedSessionCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParams(expectedSessionCallsMetadataId, "Session calls", sc.Sequence[sc.Str]{"pallet_session", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"set_keys",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSessionKeys),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
+					},
+					functionSetKeysIndex,
+					"Sets the session key(s) of the function caller to `keys`. "+
+						"Allows an account to set its session key prior to becoming a validator. "+
+						"This doesn't take effect until the next session."),
+				primitives.NewMetadataDefinitionVariant(
+					"purge_keys",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					functionPurgeKeysIndex,
+					"Removes any session key(s) of the function caller. "+
+						"This doesn't take effect until the next session."),
+			}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+		primitives.NewMetadataTypeWithPath(metadata.TypesSessionKeys, "SessionKeys", sc.Sequence[sc.Str]{"node_template_runtime", "opaque", "SessionKeys"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSr25519PubKey, "aura", "Public"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesEd25519PubKey, "gran", "Public"),
+			})),
+		primitives.NewMetadataType(metadata.TypesTupleAddress32SessionKeys, "(ValidatorId, Keys)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesAddress32), sc.ToCompact(metadata.TypesSessionKeys)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleAddress32SessionKeys, "[]byte (ValidatorId, Keys)",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleAddress32SessionKeys))),
+		primitives.NewMetadataType(metadata.TypesTupleFixedSequence4U8SequenceU8, "(KeyTypeId, Vec<u8>)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesFixedSequence4U8), sc.ToCompact(metadata.TypesSequenceU8)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesSessionEvent, "pallet_session pallet Event", sc.Sequence[sc.Str]{"pallet_session", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"NewSession",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "session_index", "SessionIndex"),
+					},
+					EventNewSession,
+					"Events.NewSession"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesSessionErrors,
+			"pallet_session pallet Error",
+			sc.Sequence[sc.Str]{"pallet_session", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("InvalidProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorInvalidProof, "Invalid ownership proof."),
+					primitives.NewMetadataDefinitionVariant("NoAssociatedValidatorId", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoAssociatedValidatorId, "No associated validator ID for account."),
+					primitives.NewMetadataDefinitionVariant("DuplicatedKey", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorDuplicatedKey, "Registered duplicate key."),
+					primitives.NewMetadataDefinitionVariant("NoKeys", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoKeys, "No keys are associated with this account."),
+					primitives.NewMetadataDefinitionVariant("NoAccount", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNoAccount, "Key setting account is not live, so it's impossible to associate keys."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedSessionCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedSessionCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Session, Runtime>"),
+				},
+				moduleId,
+				"Call.Session"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSessionEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSessionEvent, "pallet_session::Event"),
+				},
+				moduleId,
+				"Events.Session"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSessionErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSessionErrors),
+				},
+				moduleId,
+				"Errors.Session"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Validators",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceAddress32)),
+				"The current set of validators."),
+			primitives.NewMetadataModuleStorageEntry(
+				"CurrentIndex",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"Current index of the session."),
+			primitives.NewMetadataModuleStorageEntry(
+				"QueuedChanged",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesBool)),
+				"True if the underlying economic identities or weighting behind the validators has changed in the queued validator set."),
+			primitives.NewMetadataModuleStorageEntry(
+				"QueuedKeys",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceTupleAddress32SessionKeys)),
+				"The queued keys for the next session."),
+			primitives.NewMetadataModuleStorageEntry(
+				"NextKeys",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+					},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSessionKeys),
+				),
+				"The next session keys for a validator."),
+			primitives.NewMetadataModuleStorageEntry(
+				"KeyOwner",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiXX64,
+					},
+					sc.ToCompact(metadata.TypesTupleFixedSequence4U8SequenceU8),
+					sc.ToCompact(metadata.TypesAddress32),
+				),
+				"The owner of a key. The key is the `KeyTypeId` + the raw key data."),
+			primitives.NewMetadataModuleStorageEntry(
+				"DisabledValidators",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceU32)),
+				"Indices of disabled validators, kept sorted. They are enabled again when the next session starts."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	target := New(moduleId, newTestConfig(), mdGenerator, logger)
+	target.storage.Validators = mockStorageValidators
+	target.storage.CurrentIndex = mockStorageCurrentIndex
+	target.storage.QueuedChanged = mockStorageQueuedChanged
+	target.storage.QueuedKeys = mockStorageQueuedKeys
+	target.storage.NextKeys = mockStorageNextKeys
+	target.storage.KeyOwner = mockStorageKeyOwner
+	target.storage.DisabledValidators = mockStorageDisabled
+
+	return target
+}
+
+func setupMocks() {
+	mockStoredMap = new(mocks.StoredMap)
+	mockSessionManager = new(mocks.SessionManager)
+	mockAuraHandler = new(mocks.SessionHandler)
+	mockGrandpaHandler = new(mocks.SessionHandler)
+	mockStorageValidators = new(mocks.StorageValue[sc.Sequence[primitives.AccountId]])
+	mockStorageCurrentIndex = new(mocks.StorageValue[sc.U32])
+	mockStorageQueuedChanged = new(mocks.StorageValue[sc.Bool])
+	mockStorageQueuedKeys = new(mocks.StorageValue[sc.Sequence[QueuedKey]])
+	mockStorageNextKeys = new(mocks.StorageMap[primitives.AccountId, SessionKeys])
+	mockStorageKeyOwner = new(mocks.StorageMap[KeyOwnerKey, primitives.AccountId])
+	mockStorageDisabled = new(mocks.StorageValue[sc.Sequence[sc.U32]])
+	mockCall = new(mocks.Call)
+
+	mockAuraHandler.On("KeyType").Return(primitives.PublicKeySr25519)
+	mockAuraHandler.On("KeyTypeId").Return(auraKeyTypeId)
+	mockGrandpaHandler.On("KeyType").Return(primitives.PublicKeyEd25519)
+	mockGrandpaHandler.On("KeyTypeId").Return(grandpaKeyTypeId)
+}
+
+func newTestConfig() *Config {
+	return NewConfig(
+		dbWeight,
+		mockStoredMap,
+		[]primitives.SessionHandler{mockAuraHandler, mockGrandpaHandler},
+		mockSessionManager,
+		period,
+		offset,
+	)
+}
+
+// setupRotateSession sets up the mocks for the rotation from session 0, with `queuedKeys`,
+// to session 1, where the manager plans `nextValidators` for session 2.
+func setupRotateSession(queuedKeys sc.Sequence[QueuedKey], nextValidators sc.Option[sc.Sequence[primitives.AccountId]]) {
+	validators := sc.Sequence[primitives.AccountId]{}
+	for _, queued := range queuedKeys {
+		validators = append(validators, queued.Validator)
+	}
+
+	mockStorageCurrentIndex.On("Get").Return(sc.U32(0), nil)
+	mockStorageQueuedChanged.On("Get").Return(sc.Bool(false), nil)
+	mockSessionManager.On("EndSession", sc.U32(0)).Return()
+	mockStorageDisabled.On("Clear").Return()
+	mockStorageQueuedKeys.On("Get").Return(queuedKeys, nil)
+	mockStorageValidators.On("Put", validators).Return()
+	mockStorageCurrentIndex.On("Put", sc.U32(1)).Return()
+	mockSessionManager.On("StartSession", sc.U32(1)).Return()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(nextValidators, nil)
+	mockStoredMap.On("DepositEvent", newEventNewSession(moduleId, 1)).Return()
+	mockAuraHandler.On("OnNewSession", false, handlerValidators(queuedKeys, 0), mock.Anything).Return(nil)
+	mockGrandpaHandler.On("OnNewSession", false, handlerValidators(queuedKeys, 1), mock.Anything).Return(nil)
+}
@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkSessionRotateSession benchmark.
+
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func rotateSessionWeight(dbWeight primitives.RuntimeDbWeight, validators sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(30000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(5000000, 0).SaturatingMul(validators)).
+		SaturatingAdd(dbWeight.Reads(3).SaturatingAdd(dbWeight.Reads(1).SaturatingMul(validators))).
+		SaturatingAdd(dbWeight.Writes(6))
+}
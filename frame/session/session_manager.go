@@ -0,0 +1,28 @@
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SessionManager selects the validators of the upcoming sessions and is notified when sessions start and end.
+type SessionManager interface {
+	// NewSession returns the validators of session `newIndex`, which is planned at the start of
+	// the session before it. Returns None to keep the current validators.
+	NewSession(newIndex sc.U32) (sc.Option[sc.Sequence[primitives.AccountId]], error)
+	// EndSession is called when session `endIndex` ends.
+	EndSession(endIndex sc.U32)
+	// StartSession is called when session `startIndex` starts.
+	StartSession(startIndex sc.U32)
+}
+
+// DefaultSessionManager keeps the genesis validators in all sessions.
+type DefaultSessionManager struct{}
+
+func (dsm DefaultSessionManager) NewSession(_ sc.U32) (sc.Option[sc.Sequence[primitives.AccountId]], error) {
+	return sc.NewOption[sc.Sequence[primitives.AccountId]](nil), nil
+}
+
+func (dsm DefaultSessionManager) EndSession(_ sc.U32) {}
+
+func (dsm DefaultSessionManager) StartSession(_ sc.U32) {}
@@ -0,0 +1,259 @@
+package session
+
+import (
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// sessions holds the dependencies and logic, shared by the calls, the hooks and the genesis builder of the module.
+type sessions struct {
+	moduleId  sc.U8
+	constants *consts
+	storage   *storage
+	storedMap primitives.StoredMap
+	handlers  []primitives.SessionHandler
+	manager   SessionManager
+}
+
+func newSessions(moduleId sc.U8, config *Config, constants *consts, storage *storage) sessions {
+	return sessions{
+		moduleId:  moduleId,
+		constants: constants,
+		storage:   storage,
+		storedMap: config.StoredMap,
+		handlers:  config.Handlers,
+		manager:   config.Manager,
+	}
+}
+
+// doSetKeys sets the session keys of `account` for the next session. A consumer reference is added
+// to the account, when it sets its first keys.
+func (s sessions) doSetKeys(account primitives.AccountId, keys SessionKeys) error {
+	oldKeys, err := s.checkKeys(account, keys)
+	if err != nil {
+		return err
+	}
+
+	if !oldKeys.HasValue {
+		if err := s.storedMap.IncConsumers(account); err != nil {
+			return NewDispatchErrorNoAccount(s.moduleId)
+		}
+	}
+
+	s.putKeys(account, keys, oldKeys)
+
+	return nil
+}
+
+// doPurgeKeys removes the session keys of `account` and its consumer reference.
+func (s sessions) doPurgeKeys(account primitives.AccountId) error {
+	oldKeys, err := s.storage.NextKeys.TryGet(account)
+	if err != nil {
+		return err
+	}
+	if !oldKeys.HasValue {
+		return NewDispatchErrorNoKeys(s.moduleId)
+	}
+
+	s.storage.NextKeys.Remove(account)
+	for i, handler := range s.handlers {
+		s.storage.KeyOwner.Remove(newKeyOwnerKey(handler.KeyTypeId(), oldKeys.Value.Keys[i]))
+	}
+
+	s.storedMap.DecConsumers(account)
+
+	return nil
+}
+
+// checkKeys checks that none of `keys` is owned by a validator, other than `who`, and returns
+// the current keys of `who`.
+func (s sessions) checkKeys(who primitives.AccountId, keys SessionKeys) (sc.Option[SessionKeys], error) {
+	for i, handler := range s.handlers {
+		owner, err := s.storage.KeyOwner.TryGet(newKeyOwnerKey(handler.KeyTypeId(), keys.Keys[i]))
+		if err != nil {
+			return sc.Option[SessionKeys]{}, err
+		}
+		if owner.HasValue && !reflect.DeepEqual(owner.Value, who) {
+			return sc.Option[SessionKeys]{}, NewDispatchErrorDuplicatedKey(s.moduleId)
+		}
+	}
+
+	return s.storage.NextKeys.TryGet(who)
+}
+
+// putKeys stores `keys` as the next keys of `who` and replaces the owners of its changed `oldKeys`.
+func (s sessions) putKeys(who primitives.AccountId, keys SessionKeys, oldKeys sc.Option[SessionKeys]) {
+	for i, handler := range s.handlers {
+		key := keys.Keys[i]
+		if oldKeys.HasValue {
+			oldKey := oldKeys.Value.Keys[i]
+			if reflect.DeepEqual(oldKey, key) {
+				continue
+			}
+			s.storage.KeyOwner.Remove(newKeyOwnerKey(handler.KeyTypeId(), oldKey))
+		}
+		s.storage.KeyOwner.Put(newKeyOwnerKey(handler.KeyTypeId(), key), who)
+	}
+
+	s.storage.NextKeys.Put(who, keys)
+}
+
+// shouldEndSession returns whether the session ends at block `n`.
+func (s sessions) shouldEndSession(n sc.U64) bool {
+	if s.constants.Period == 0 {
+		return false
+	}
+	return n >= s.constants.Offset && (n-s.constants.Offset)%s.constants.Period == 0
+}
+
+// rotateSession ends the current session and starts the next one, with the queued validators.
+// The validators of the session after it are planned by the session manager and queued with
+// their next keys. Returns the number of queued validators.
+func (s sessions) rotateSession() (sc.U64, error) {
+	sessionIndex, err := s.storage.CurrentIndex.Get()
+	if err != nil {
+		return 0, err
+	}
+	changed, err := s.storage.QueuedChanged.Get()
+	if err != nil {
+		return 0, err
+	}
+
+	s.manager.EndSession(sessionIndex)
+
+	// The disabled indices refer to the current validator set and stay valid while it is unchanged.
+	if changed {
+		s.storage.DisabledValidators.Clear()
+	}
+
+	sessionKeys, err := s.storage.QueuedKeys.Get()
+	if err != nil {
+		return 0, err
+	}
+	validators := sc.Sequence[primitives.AccountId]{}
+	for _, queued := range sessionKeys {
+		validators = append(validators, queued.Validator)
+	}
+	s.storage.Validators.Put(validators)
+
+	sessionIndex = sessionIndex + 1
+	s.storage.CurrentIndex.Put(sessionIndex)
+
+	s.manager.StartSession(sessionIndex)
+
+	maybeNextValidators, err := s.manager.NewSession(sessionIndex + 1)
+	if err != nil {
+		return 0, err
+	}
+	nextValidators, nextChanged := validators, false
+	if maybeNextValidators.HasValue {
+		nextValidators, nextChanged = maybeNextValidators.Value, true
+	}
+
+	queuedKeys, err := s.loadQueuedKeys(nextValidators)
+	if err != nil {
+		return 0, err
+	}
+	if !nextChanged {
+		nextChanged = keysChanged(sessionKeys, queuedKeys)
+	}
+
+	s.storage.QueuedKeys.Put(queuedKeys)
+	s.storage.QueuedChanged.Put(sc.Bool(nextChanged))
+
+	s.storedMap.DepositEvent(newEventNewSession(s.moduleId, sessionIndex))
+
+	for i, handler := range s.handlers {
+		if err := handler.OnNewSession(bool(changed), handlerValidators(sessionKeys, i), handlerValidators(queuedKeys, i)); err != nil {
+			return 0, err
+		}
+	}
+
+	return sc.U64(len(queuedKeys)), nil
+}
+
+// isDisabled returns whether the validator at `index` is disabled in the current session.
+func (s sessions) isDisabled(index sc.U32) (bool, error) {
+	disabled, err := s.storage.DisabledValidators.Get()
+	if err != nil {
+		return false, err
+	}
+
+	_, found := searchIndex(disabled, index)
+	return found, nil
+}
+
+// disableIndex disables the validator at `index` until the end of the current session.
+// Returns whether the validator exists and was not already disabled.
+func (s sessions) disableIndex(index sc.U32) (bool, error) {
+	validatorsLen, err := s.storage.Validators.DecodeLen()
+	if err != nil {
+		return false, err
+	}
+	if !validatorsLen.HasValue || sc.U64(index) >= validatorsLen.Value {
+		return false, nil
+	}
+
+	disabled, err := s.storage.DisabledValidators.Get()
+	if err != nil {
+		return false, err
+	}
+
+	position, found := searchIndex(disabled, index)
+	if found {
+		return false, nil
+	}
+
+	disabled = append(disabled[:position], append(sc.Sequence[sc.U32]{index}, disabled[position:]...)...)
+	s.storage.DisabledValidators.Put(disabled)
+
+	return true, nil
+}
+
+// loadQueuedKeys returns `validators` with their next keys. Validators without keys are skipped.
+func (s sessions) loadQueuedKeys(validators sc.Sequence[primitives.AccountId]) (sc.Sequence[QueuedKey], error) {
+	queuedKeys := sc.Sequence[QueuedKey]{}
+	for _, validator := range validators {
+		keys, err := s.storage.NextKeys.TryGet(validator)
+		if err != nil {
+			return nil, err
+		}
+		if keys.HasValue {
+			queuedKeys = append(queuedKeys, QueuedKey{Validator: validator, Keys: keys.Value})
+		}
+	}
+	return queuedKeys, nil
+}
+
+// keysChanged returns whether any of the `queuedKeys` differs from the `currentKeys` at the same position.
+func keysChanged(currentKeys sc.Sequence[QueuedKey], queuedKeys sc.Sequence[QueuedKey]) bool {
+	for i, queued := range queuedKeys {
+		if i < len(currentKeys) && !currentKeys[i].Keys.Equal(queued.Keys) {
+			return true
+		}
+	}
+	return false
+}
+
+// handlerValidators returns the validators of `queuedKeys` with the key of the session handler at `index`.
+func handlerValidators(queuedKeys sc.Sequence[QueuedKey], index int) sc.Sequence[primitives.SessionValidator] {
+	validators := sc.Sequence[primitives.SessionValidator]{}
+	for _, queued := range queuedKeys {
+		validators = append(validators, primitives.SessionValidator{
+			AccountId: queued.Validator,
+			Key:       sc.Sequence[sc.U8](queued.Keys.Keys[index]),
+		})
+	}
+	return validators
+}
+
+// searchIndex returns the position of `index` in the sorted `indices`, or the position at which it should be inserted.
+func searchIndex(indices sc.Sequence[sc.U32], index sc.U32) (int, bool) {
+	position := sort.Search(len(indices), func(i int) bool {
+		return indices[i] >= index
+	})
+	return position, position < len(indices) && indices[position] == index
+}
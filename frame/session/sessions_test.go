@@ -0,0 +1,335 @@
+package session
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	queuedKeys = sc.Sequence[QueuedKey]{
+		{Validator: whoAccountId, Keys: sessionKeys},
+	}
+)
+
+func Test_Sessions_doSetKeys_New(t *testing.T) {
+	target := setupSessions()
+	mockStorageKeyOwner.On("TryGet", auraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageKeyOwner.On("TryGet", grandpaKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+	mockStoredMap.On("IncConsumers", whoAccountId).Return(nil)
+	mockStorageKeyOwner.On("Put", auraKeyOwnerKey, whoAccountId).Return()
+	mockStorageKeyOwner.On("Put", grandpaKeyOwnerKey, whoAccountId).Return()
+	mockStorageNextKeys.On("Put", whoAccountId, sessionKeys).Return()
+
+	err := target.doSetKeys(whoAccountId, sessionKeys)
+
+	assert.Nil(t, err)
+	mockStoredMap.AssertCalled(t, "IncConsumers", whoAccountId)
+	mockStorageKeyOwner.AssertExpectations(t)
+	mockStorageNextKeys.AssertExpectations(t)
+}
+
+func Test_Sessions_doSetKeys_Replace(t *testing.T) {
+	target := setupSessions()
+	newSessionKeys := SessionKeys{Keys: sc.Sequence[sc.FixedSequence[sc.U8]]{otherAuraKey, grandpaKey}}
+	otherAuraKeyOwnerKey := newKeyOwnerKey(auraKeyTypeId, otherAuraKey)
+	mockStorageKeyOwner.On("TryGet", otherAuraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageKeyOwner.On("TryGet", grandpaKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](whoAccountId), nil)
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageKeyOwner.On("Remove", auraKeyOwnerKey).Return()
+	mockStorageKeyOwner.On("Put", otherAuraKeyOwnerKey, whoAccountId).Return()
+	mockStorageNextKeys.On("Put", whoAccountId, newSessionKeys).Return()
+
+	err := target.doSetKeys(whoAccountId, newSessionKeys)
+
+	assert.Nil(t, err)
+	mockStoredMap.AssertNotCalled(t, "IncConsumers", mock.Anything)
+	mockStorageKeyOwner.AssertCalled(t, "Remove", auraKeyOwnerKey)
+	mockStorageKeyOwner.AssertNotCalled(t, "Remove", grandpaKeyOwnerKey)
+	mockStorageKeyOwner.AssertNotCalled(t, "Put", grandpaKeyOwnerKey, mock.Anything)
+	mockStorageNextKeys.AssertCalled(t, "Put", whoAccountId, newSessionKeys)
+}
+
+func Test_Sessions_doSetKeys_DuplicatedKey(t *testing.T) {
+	target := setupSessions()
+	mockStorageKeyOwner.On("TryGet", auraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](otherAccountId), nil)
+
+	err := target.doSetKeys(whoAccountId, sessionKeys)
+
+	assert.Equal(t, NewDispatchErrorDuplicatedKey(moduleId), err)
+	mockStoredMap.AssertNotCalled(t, "IncConsumers", mock.Anything)
+	mockStorageNextKeys.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Sessions_doSetKeys_NoAccount(t *testing.T) {
+	target := setupSessions()
+	mockStorageKeyOwner.On("TryGet", auraKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageKeyOwner.On("TryGet", grandpaKeyOwnerKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+	mockStoredMap.On("IncConsumers", whoAccountId).Return(expectedErr)
+
+	err := target.doSetKeys(whoAccountId, sessionKeys)
+
+	assert.Equal(t, NewDispatchErrorNoAccount(moduleId), err)
+	mockStorageKeyOwner.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStorageNextKeys.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Sessions_doPurgeKeys(t *testing.T) {
+	target := setupSessions()
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageNextKeys.On("Remove", whoAccountId).Return()
+	mockStorageKeyOwner.On("Remove", auraKeyOwnerKey).Return()
+	mockStorageKeyOwner.On("Remove", grandpaKeyOwnerKey).Return()
+	mockStoredMap.On("DecConsumers", whoAccountId).Return()
+
+	err := target.doPurgeKeys(whoAccountId)
+
+	assert.Nil(t, err)
+	mockStorageNextKeys.AssertCalled(t, "Remove", whoAccountId)
+	mockStorageKeyOwner.AssertExpectations(t)
+	mockStoredMap.AssertCalled(t, "DecConsumers", whoAccountId)
+}
+
+func Test_Sessions_doPurgeKeys_NoKeys(t *testing.T) {
+	target := setupSessions()
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+
+	err := target.doPurgeKeys(whoAccountId)
+
+	assert.Equal(t, NewDispatchErrorNoKeys(moduleId), err)
+	mockStoredMap.AssertNotCalled(t, "DecConsumers", mock.Anything)
+}
+
+func Test_Sessions_shouldEndSession(t *testing.T) {
+	target := setupSessions()
+
+	assert.False(t, target.shouldEndSession(0))
+	assert.True(t, target.shouldEndSession(offset))
+	assert.False(t, target.shouldEndSession(offset+1))
+	assert.True(t, target.shouldEndSession(offset+period))
+	assert.True(t, target.shouldEndSession(offset+3*period))
+}
+
+func Test_Sessions_shouldEndSession_ZeroPeriod(t *testing.T) {
+	target := setupSessions()
+	target.constants.Period = 0
+
+	assert.False(t, target.shouldEndSession(offset))
+}
+
+func Test_Sessions_rotateSession_SameValidators(t *testing.T) {
+	target := setupSessions()
+	setupRotateSession(queuedKeys, sc.NewOption[sc.Sequence[primitives.AccountId]](nil))
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageQueuedKeys.On("Put", queuedKeys).Return()
+	mockStorageQueuedChanged.On("Put", sc.Bool(false)).Return()
+
+	result, err := target.rotateSession()
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.U64(1), result)
+	mockSessionManager.AssertExpectations(t)
+	mockStorageValidators.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{whoAccountId})
+	mockStorageCurrentIndex.AssertCalled(t, "Put", sc.U32(1))
+	mockStorageDisabled.AssertNotCalled(t, "Clear")
+	mockStorageQueuedChanged.AssertCalled(t, "Put", sc.Bool(false))
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventNewSession(moduleId, 1))
+	mockAuraHandler.AssertCalled(t, "OnNewSession", false, handlerValidators(queuedKeys, 0), handlerValidators(queuedKeys, 0))
+	mockGrandpaHandler.AssertCalled(t, "OnNewSession", false, handlerValidators(queuedKeys, 1), handlerValidators(queuedKeys, 1))
+}
+
+func Test_Sessions_rotateSession_ChangedKeys(t *testing.T) {
+	target := setupSessions()
+	setupRotateSession(queuedKeys, sc.NewOption[sc.Sequence[primitives.AccountId]](nil))
+	nextQueuedKeys := sc.Sequence[QueuedKey]{{Validator: whoAccountId, Keys: otherSessionKeys}}
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](otherSessionKeys), nil)
+	mockStorageQueuedKeys.On("Put", nextQueuedKeys).Return()
+	mockStorageQueuedChanged.On("Put", sc.Bool(true)).Return()
+
+	_, err := target.rotateSession()
+
+	assert.Nil(t, err)
+	mockStorageQueuedKeys.AssertCalled(t, "Put", nextQueuedKeys)
+	mockStorageQueuedChanged.AssertCalled(t, "Put", sc.Bool(true))
+	mockAuraHandler.AssertCalled(t, "OnNewSession", false, handlerValidators(queuedKeys, 0), handlerValidators(nextQueuedKeys, 0))
+}
+
+func Test_Sessions_rotateSession_NewValidators(t *testing.T) {
+	target := setupSessions()
+	setupRotateSession(queuedKeys, sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId}))
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageNextKeys.On("TryGet", otherAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+	mockStorageQueuedKeys.On("Put", queuedKeys).Return()
+	mockStorageQueuedChanged.On("Put", sc.Bool(true)).Return()
+
+	result, err := target.rotateSession()
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.U64(1), result)
+	mockStorageQueuedKeys.AssertCalled(t, "Put", queuedKeys)
+	mockStorageQueuedChanged.AssertCalled(t, "Put", sc.Bool(true))
+}
+
+func Test_Sessions_rotateSession_QueuedChanged(t *testing.T) {
+	target := setupSessions()
+	mockStorageCurrentIndex.On("Get").Return(sc.U32(4), nil)
+	mockStorageQueuedChanged.On("Get").Return(sc.Bool(true), nil)
+	mockSessionManager.On("EndSession", sc.U32(4)).Return()
+	mockStorageDisabled.On("Clear").Return()
+	mockStorageQueuedKeys.On("Get").Return(queuedKeys, nil)
+	mockStorageValidators.On("Put", sc.Sequence[primitives.AccountId]{whoAccountId}).Return()
+	mockStorageCurrentIndex.On("Put", sc.U32(5)).Return()
+	mockSessionManager.On("StartSession", sc.U32(5)).Return()
+	mockSessionManager.On("NewSession", sc.U32(6)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil), nil)
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageQueuedKeys.On("Put", queuedKeys).Return()
+	mockStorageQueuedChanged.On("Put", sc.Bool(false)).Return()
+	mockStoredMap.On("DepositEvent", newEventNewSession(moduleId, 5)).Return()
+	mockAuraHandler.On("OnNewSession", true, handlerValidators(queuedKeys, 0), handlerValidators(queuedKeys, 0)).Return(nil)
+	mockGrandpaHandler.On("OnNewSession", true, handlerValidators(queuedKeys, 1), handlerValidators(queuedKeys, 1)).Return(nil)
+
+	_, err := target.rotateSession()
+
+	assert.Nil(t, err)
+	mockStorageDisabled.AssertCalled(t, "Clear")
+	mockAuraHandler.AssertExpectations(t)
+	mockGrandpaHandler.AssertExpectations(t)
+}
+
+func Test_Sessions_rotateSession_HandlerError(t *testing.T) {
+	target := setupSessions()
+	mockStorageCurrentIndex.On("Get").Return(sc.U32(0), nil)
+	mockStorageQueuedChanged.On("Get").Return(sc.Bool(false), nil)
+	mockSessionManager.On("EndSession", sc.U32(0)).Return()
+	mockStorageDisabled.On("Clear").Return()
+	mockStorageQueuedKeys.On("Get").Return(queuedKeys, nil)
+	mockStorageValidators.On("Put", sc.Sequence[primitives.AccountId]{whoAccountId}).Return()
+	mockStorageCurrentIndex.On("Put", sc.U32(1)).Return()
+	mockSessionManager.On("StartSession", sc.U32(1)).Return()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil), nil)
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageQueuedKeys.On("Put", queuedKeys).Return()
+	mockStorageQueuedChanged.On("Put", sc.Bool(false)).Return()
+	mockStoredMap.On("DepositEvent", newEventNewSession(moduleId, 1)).Return()
+	mockAuraHandler.On("OnNewSession", false, mock.Anything, mock.Anything).Return(expectedErr)
+
+	_, err := target.rotateSession()
+
+	assert.Equal(t, expectedErr, err)
+	mockGrandpaHandler.AssertNotCalled(t, "OnNewSession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Sessions_rotateSession_NewSessionError(t *testing.T) {
+	target := setupSessions()
+	mockStorageCurrentIndex.On("Get").Return(sc.U32(0), nil)
+	mockStorageQueuedChanged.On("Get").Return(sc.Bool(false), nil)
+	mockSessionManager.On("EndSession", sc.U32(0)).Return()
+	mockStorageDisabled.On("Clear").Return()
+	mockStorageQueuedKeys.On("Get").Return(queuedKeys, nil)
+	mockStorageValidators.On("Put", sc.Sequence[primitives.AccountId]{whoAccountId}).Return()
+	mockStorageCurrentIndex.On("Put", sc.U32(1)).Return()
+	mockSessionManager.On("StartSession", sc.U32(1)).Return()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil), expectedErr)
+
+	_, err := target.rotateSession()
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageQueuedKeys.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Sessions_isDisabled(t *testing.T) {
+	target := setupSessions()
+	mockStorageDisabled.On("Get").Return(sc.Sequence[sc.U32]{1, 3}, nil)
+
+	disabled, err := target.isDisabled(1)
+	assert.Nil(t, err)
+	assert.True(t, disabled)
+
+	disabled, err = target.isDisabled(2)
+	assert.Nil(t, err)
+	assert.False(t, disabled)
+}
+
+func Test_Sessions_isDisabled_Error(t *testing.T) {
+	target := setupSessions()
+	mockStorageDisabled.On("Get").Return(sc.Sequence[sc.U32]{}, expectedErr)
+
+	_, err := target.isDisabled(1)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Sessions_disableIndex(t *testing.T) {
+	target := setupSessions()
+	mockStorageValidators.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(4)), nil)
+	mockStorageDisabled.On("Get").Return(sc.Sequence[sc.U32]{1, 2}, nil)
+	mockStorageDisabled.On("Put", sc.Sequence[sc.U32]{0, 1, 2}).Return()
+
+	result, err := target.disableIndex(0)
+
+	assert.Nil(t, err)
+	assert.True(t, result)
+	mockStorageDisabled.AssertCalled(t, "Put", sc.Sequence[sc.U32]{0, 1, 2})
+}
+
+func Test_Sessions_disableIndex_AlreadyDisabled(t *testing.T) {
+	target := setupSessions()
+	mockStorageValidators.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(4)), nil)
+	mockStorageDisabled.On("Get").Return(sc.Sequence[sc.U32]{1, 2}, nil)
+
+	result, err := target.disableIndex(2)
+
+	assert.Nil(t, err)
+	assert.False(t, result)
+	mockStorageDisabled.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Sessions_disableIndex_NotValidator(t *testing.T) {
+	target := setupSessions()
+	mockStorageValidators.On("DecodeLen").Return(sc.NewOption[sc.U64](sc.U64(2)), nil)
+
+	result, err := target.disableIndex(2)
+
+	assert.Nil(t, err)
+	assert.False(t, result)
+	mockStorageDisabled.AssertNotCalled(t, "Get")
+}
+
+func Test_Sessions_loadQueuedKeys(t *testing.T) {
+	target := setupSessions()
+	mockStorageNextKeys.On("TryGet", whoAccountId).Return(sc.NewOption[SessionKeys](sessionKeys), nil)
+	mockStorageNextKeys.On("TryGet", otherAccountId).Return(sc.NewOption[SessionKeys](nil), nil)
+
+	result, err := target.loadQueuedKeys(sc.Sequence[primitives.AccountId]{whoAccountId, otherAccountId})
+
+	assert.Nil(t, err)
+	assert.Equal(t, queuedKeys, result)
+}
+
+func Test_Sessions_keysChanged(t *testing.T) {
+	otherQueuedKeys := sc.Sequence[QueuedKey]{{Validator: whoAccountId, Keys: otherSessionKeys}}
+
+	assert.False(t, keysChanged(queuedKeys, queuedKeys))
+	assert.True(t, keysChanged(queuedKeys, otherQueuedKeys))
+	assert.False(t, keysChanged(sc.Sequence[QueuedKey]{}, otherQueuedKeys))
+}
+
+func Test_Sessions_handlerValidators(t *testing.T) {
+	assert.Equal(t,
+		sc.Sequence[primitives.SessionValidator]{{AccountId: whoAccountId, Key: sc.Sequence[sc.U8](auraKey)}},
+		handlerValidators(queuedKeys, 0),
+	)
+	assert.Equal(t,
+		sc.Sequence[primitives.SessionValidator]{{AccountId: whoAccountId, Key: sc.Sequence[sc.U8](grandpaKey)}},
+		handlerValidators(queuedKeys, 1),
+	)
+}
+
+func setupSessions() sessions {
+	return setupModule().sessions
+}
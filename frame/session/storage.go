@@ -0,0 +1,59 @@
+package session
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keySession       = []byte("Session")
+	keyValidators    = []byte("Validators")
+	keyCurrentIndex  = []byte("CurrentIndex")
+	keyQueuedChanged = []byte("QueuedChanged")
+	keyQueuedKeys    = []byte("QueuedKeys")
+	keyNextKeys      = []byte("NextKeys")
+	keyKeyOwner      = []byte("KeyOwner")
+	keyDisabled      = []byte("DisabledValidators")
+)
+
+type storage struct {
+	Validators    support.StorageValue[sc.Sequence[primitives.AccountId]]
+	CurrentIndex  support.StorageValue[sc.U32]
+	QueuedChanged support.StorageValue[sc.Bool]
+	QueuedKeys    support.StorageValue[sc.Sequence[QueuedKey]]
+	NextKeys      support.StorageMap[primitives.AccountId, SessionKeys]
+	KeyOwner      support.StorageMap[KeyOwnerKey, primitives.AccountId]
+	// DisabledValidators are the sorted indices of the disabled validators in the current session.
+	DisabledValidators support.StorageValue[sc.Sequence[sc.U32]]
+}
+
+// newStorage creates the storage of the module, where each of the session keys consists of `keysCount` keys.
+func newStorage(keysCount int) *storage {
+	decodeValidators := func(buffer *bytes.Buffer) (sc.Sequence[primitives.AccountId], error) {
+		return sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	}
+	decodeSessionKeys := func(buffer *bytes.Buffer) (SessionKeys, error) {
+		return DecodeSessionKeys(buffer, keysCount)
+	}
+	decodeDisabledValidators := func(buffer *bytes.Buffer) (sc.Sequence[sc.U32], error) {
+		return sc.DecodeSequenceWith(buffer, sc.DecodeU32)
+	}
+	decodeQueuedKeys := func(buffer *bytes.Buffer) (sc.Sequence[QueuedKey], error) {
+		return sc.DecodeSequenceWith(buffer, func(buffer *bytes.Buffer) (QueuedKey, error) {
+			return DecodeQueuedKey(buffer, keysCount)
+		})
+	}
+
+	return &storage{
+		Validators:         support.NewHashStorageValue(keySession, keyValidators, decodeValidators),
+		CurrentIndex:       support.NewHashStorageValue(keySession, keyCurrentIndex, sc.DecodeU32),
+		QueuedChanged:      support.NewHashStorageValue(keySession, keyQueuedChanged, sc.DecodeBool),
+		QueuedKeys:         support.NewHashStorageValue(keySession, keyQueuedKeys, decodeQueuedKeys),
+		NextKeys:           support.NewHashStorageMap[primitives.AccountId, SessionKeys](keySession, keyNextKeys, support.NewHasherTwox64Concat(), primitives.DecodeAccountId, decodeSessionKeys),
+		KeyOwner:           support.NewHashStorageMap[KeyOwnerKey, primitives.AccountId](keySession, keyKeyOwner, support.NewHasherTwox64Concat(), DecodeKeyOwnerKey, primitives.DecodeAccountId),
+		DisabledValidators: support.NewHashStorageValue(keySession, keyDisabled, decodeDisabledValidators),
+	}
+}
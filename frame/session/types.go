@@ -0,0 +1,128 @@
+package session
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// keyLength is the length of a session key. Both sr25519 and ed25519 public keys are 32 bytes.
+	keyLength = 32
+	// keyTypeIdLength is the length of a key type id.
+	keyTypeIdLength = 4
+)
+
+// SessionKeys are the public keys of a validator, one for each session handler, in the order of the handlers.
+// They are encoded one after another, without a length prefix, the same way as the keys, generated by the
+// session keys runtime api.
+type SessionKeys struct {
+	Keys sc.Sequence[sc.FixedSequence[sc.U8]]
+}
+
+func (sk SessionKeys) Encode(buffer *bytes.Buffer) error {
+	for _, key := range sk.Keys {
+		if err := key.Encode(buffer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeSessionKeys decodes `count` session keys.
+func DecodeSessionKeys(buffer *bytes.Buffer, count int) (SessionKeys, error) {
+	keys := sc.Sequence[sc.FixedSequence[sc.U8]]{}
+	for i := 0; i < count; i++ {
+		key, err := sc.DecodeFixedSequence[sc.U8](keyLength, buffer)
+		if err != nil {
+			return SessionKeys{}, err
+		}
+		keys = append(keys, key)
+	}
+	return SessionKeys{Keys: keys}, nil
+}
+
+func (sk SessionKeys) Bytes() []byte {
+	return sc.EncodedBytes(sk)
+}
+
+// Equal returns whether `sk` and `other` contain the same keys.
+func (sk SessionKeys) Equal(other SessionKeys) bool {
+	return reflect.DeepEqual(sk.Keys, other.Keys)
+}
+
+// KeyOwnerKey is the key of a key owner, encoded as the `(KeyTypeId, Vec<u8>)` tuple.
+type KeyOwnerKey struct {
+	// TypeId is the key type id of the session handler, which the key belongs to.
+	TypeId sc.FixedSequence[sc.U8]
+	// Key is the raw public key.
+	Key sc.Sequence[sc.U8]
+}
+
+func newKeyOwnerKey(typeId [4]byte, key sc.FixedSequence[sc.U8]) KeyOwnerKey {
+	return KeyOwnerKey{
+		TypeId: sc.BytesToFixedSequenceU8(typeId[:]),
+		Key:    sc.Sequence[sc.U8](key),
+	}
+}
+
+func (k KeyOwnerKey) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		k.TypeId,
+		k.Key,
+	)
+}
+
+func DecodeKeyOwnerKey(buffer *bytes.Buffer) (KeyOwnerKey, error) {
+	typeId, err := sc.DecodeFixedSequence[sc.U8](keyTypeIdLength, buffer)
+	if err != nil {
+		return KeyOwnerKey{}, err
+	}
+	key, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return KeyOwnerKey{}, err
+	}
+	return KeyOwnerKey{
+		TypeId: typeId,
+		Key:    key,
+	}, nil
+}
+
+func (k KeyOwnerKey) Bytes() []byte {
+	return sc.EncodedBytes(k)
+}
+
+// QueuedKey is a validator of the next session and its session keys, encoded as the `(ValidatorId, Keys)` tuple.
+type QueuedKey struct {
+	Validator primitives.AccountId
+	Keys      SessionKeys
+}
+
+func (qk QueuedKey) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		qk.Validator,
+		qk.Keys,
+	)
+}
+
+// DecodeQueuedKey decodes a queued key with `count` session keys.
+func DecodeQueuedKey(buffer *bytes.Buffer, count int) (QueuedKey, error) {
+	validator, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return QueuedKey{}, err
+	}
+	keys, err := DecodeSessionKeys(buffer, count)
+	if err != nil {
+		return QueuedKey{}, err
+	}
+	return QueuedKey{
+		Validator: validator,
+		Keys:      keys,
+	}, nil
+}
+
+func (qk QueuedKey) Bytes() []byte {
+	return sc.EncodedBytes(qk)
+}
@@ -0,0 +1,40 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SessionKeys_Bytes(t *testing.T) {
+	assert.Equal(t, append(auraKey.Bytes(), grandpaKey.Bytes()...), sessionKeys.Bytes())
+}
+
+func Test_SessionKeys_Decode(t *testing.T) {
+	result, err := DecodeSessionKeys(bytes.NewBuffer(sessionKeys.Bytes()), 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sessionKeys, result)
+}
+
+func Test_SessionKeys_Equal(t *testing.T) {
+	assert.True(t, sessionKeys.Equal(sessionKeys))
+	assert.False(t, sessionKeys.Equal(otherSessionKeys))
+}
+
+func Test_KeyOwnerKey_Decode(t *testing.T) {
+	result, err := DecodeKeyOwnerKey(bytes.NewBuffer(auraKeyOwnerKey.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, auraKeyOwnerKey, result)
+}
+
+func Test_QueuedKey_Decode(t *testing.T) {
+	queuedKey := QueuedKey{Validator: whoAccountId, Keys: sessionKeys}
+
+	result, err := DecodeQueuedKey(bytes.NewBuffer(queuedKey.Bytes()), 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, queuedKey, result)
+}
@@ -0,0 +1,89 @@
+package sudo
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Permanently removes the sudo key.
+type callRemoveKey struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	key            support.StorageValue[primitives.AccountId]
+}
+
+func newCallRemoveKey(moduleId sc.U8, functionId sc.U8, eventDepositor primitives.EventDepositor, constants *consts, key support.StorageValue[primitives.AccountId]) primitives.Call {
+	call := callRemoveKey{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		key:            key,
+	}
+
+	return call
+}
+
+func (c callRemoveKey) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	return c, nil
+}
+
+func (c callRemoveKey) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRemoveKey) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRemoveKey) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callRemoveKey) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callRemoveKey) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callRemoveKey) BaseWeight() primitives.Weight {
+	return callRemoveKeyWeight(c.constants.DbWeight)
+}
+
+func (_ callRemoveKey) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRemoveKey) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRemoveKey) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callRemoveKey) Dispatch(origin primitives.RuntimeOrigin, _ sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSudo(c.ModuleId, origin, c.key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.eventDepositor.DepositEvent(newEventKeyRemoved(c.ModuleId))
+	c.key.Clear()
+
+	// Sudo user does not pay a fee.
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+}
+
+func (_ callRemoveKey) Docs() string {
+	return "Permanently removes the sudo key. **This cannot be un-done.**"
+}
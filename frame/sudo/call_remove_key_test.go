@@ -0,0 +1,101 @@
+package sudo
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_RemoveKey_New(t *testing.T) {
+	target := setupCallRemoveKey()
+	expected := callRemoveKey{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionRemoveKeyIndex,
+			Arguments:  sc.NewVaryingData(),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight),
+		key:            mockStorageKey,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_RemoveKey_DecodeArgs(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	call, err := target.DecodeArgs(&bytes.Buffer{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(), call.Args())
+}
+
+func Test_Call_RemoveKey_Bytes(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	assert.Equal(t, []byte{moduleId, functionRemoveKeyIndex}, target.Bytes())
+}
+
+func Test_Call_RemoveKey_ModuleIndex(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_RemoveKey_FunctionIndex(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	assert.Equal(t, sc.U8(functionRemoveKeyIndex), target.FunctionIndex())
+}
+
+func Test_Call_RemoveKey_BaseWeight(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	assert.Equal(t, callRemoveKeyWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_RemoveKey_ClassifyDispatch(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_RemoveKey_Dispatch(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockEventDepositor.On("DepositEvent", newEventKeyRemoved(moduleId)).Return()
+	mockStorageKey.On("Clear").Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), sc.NewVaryingData())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventKeyRemoved(moduleId))
+	mockStorageKey.AssertCalled(t, "Clear")
+}
+
+func Test_Call_RemoveKey_Dispatch_RequireSudo(t *testing.T) {
+	target := setupCallRemoveKey()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(otherAccountId), sc.NewVaryingData())
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+	mockStorageKey.AssertNotCalled(t, "Clear")
+}
+
+func setupCallRemoveKey() primitives.Call {
+	setupCallMocks()
+
+	return newCallRemoveKey(moduleId, functionRemoveKeyIndex, mockEventDepositor, newConstants(dbWeight), mockStorageKey)
+}
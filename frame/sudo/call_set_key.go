@@ -0,0 +1,106 @@
+package sudo
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Authenticates the current sudo key and sets the given account as the new sudo key.
+type callSetKey struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	lookup         primitives.StaticLookup
+	key            support.StorageValue[primitives.AccountId]
+}
+
+func newCallSetKey(moduleId sc.U8, functionId sc.U8, eventDepositor primitives.EventDepositor, constants *consts, lookup primitives.StaticLookup, key support.StorageValue[primitives.AccountId]) primitives.Call {
+	call := callSetKey{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		lookup:         lookup,
+		key:            key,
+	}
+
+	return call
+}
+
+func (c callSetKey) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	newKey, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(newKey)
+	return c, nil
+}
+
+func (c callSetKey) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSetKey) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSetKey) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callSetKey) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callSetKey) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callSetKey) BaseWeight() primitives.Weight {
+	return callSetKeyWeight(c.constants.DbWeight)
+}
+
+func (_ callSetKey) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callSetKey) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callSetKey) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callSetKey) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSudo(c.ModuleId, origin, c.key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	newKey, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+
+	oldKey, err := storageKey(c.key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.eventDepositor.DepositEvent(newEventKeyChanged(c.ModuleId, oldKey, newKey))
+	c.key.Put(newKey)
+
+	// Sudo user does not pay a fee.
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+}
+
+func (_ callSetKey) Docs() string {
+	return "Authenticates the current sudo key and sets the given AccountId (`new`) as the new sudo key."
+}
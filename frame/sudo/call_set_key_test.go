@@ -0,0 +1,167 @@
+package sudo
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_SetKey_New(t *testing.T) {
+	target := setupCallSetKey()
+	expected := callSetKey{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionSetKeyIndex,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight),
+		lookup:         testLookup,
+		key:            mockStorageKey,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_SetKey_DecodeArgs(t *testing.T) {
+	target := setupCallSetKey()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(otherAddress.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(otherAddress), call.Args())
+}
+
+func Test_Call_SetKey_Encode(t *testing.T) {
+	target := setupCallSetKey()
+	call, err := target.DecodeArgs(bytes.NewBuffer(otherAddress.Bytes()))
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	err = call.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte{moduleId, functionSetKeyIndex}, otherAddress.Bytes()...), buffer.Bytes())
+}
+
+func Test_Call_SetKey_Bytes(t *testing.T) {
+	target := setupCallSetKey()
+	call, err := target.DecodeArgs(bytes.NewBuffer(otherAddress.Bytes()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, append([]byte{moduleId, functionSetKeyIndex}, otherAddress.Bytes()...), call.Bytes())
+}
+
+func Test_Call_SetKey_ModuleIndex(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_SetKey_FunctionIndex(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, sc.U8(functionSetKeyIndex), target.FunctionIndex())
+}
+
+func Test_Call_SetKey_BaseWeight(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, callSetKeyWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_SetKey_WeighData(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_SetKey_ClassifyDispatch(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_SetKey_PaysFee(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_SetKey_Dispatch(t *testing.T) {
+	target := setupCallSetKey()
+	expectedEvent := newEventKeyChanged(moduleId, sc.NewOption[primitives.AccountId](sudoAccountId), otherAccountId)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageKey.On("Put", otherAccountId).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), sc.NewVaryingData(otherAddress))
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+	mockStorageKey.AssertCalled(t, "Put", otherAccountId)
+}
+
+func Test_Call_SetKey_Dispatch_AccountIndex(t *testing.T) {
+	setupCallMocks()
+	mockLookup := new(mocks.StaticLookup)
+	target := newCallSetKey(moduleId, functionSetKeyIndex, mockEventDepositor, newConstants(dbWeight), mockLookup, mockStorageKey)
+	expectedEvent := newEventKeyChanged(moduleId, sc.NewOption[primitives.AccountId](sudoAccountId), otherAccountId)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockLookup.On("Lookup", indexAddress).Return(otherAccountId, nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+	mockStorageKey.On("Put", otherAccountId).Return()
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), sc.NewVaryingData(indexAddress))
+
+	assert.Nil(t, err)
+	mockLookup.AssertCalled(t, "Lookup", indexAddress)
+	mockStorageKey.AssertCalled(t, "Put", otherAccountId)
+}
+
+func Test_Call_SetKey_Dispatch_CannotLookup(t *testing.T) {
+	target := setupCallSetKey()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), sc.NewVaryingData(primitives.NewMultiAddress20(primitives.Address20{})))
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockStorageKey.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Call_SetKey_Dispatch_RequireSudo(t *testing.T) {
+	target := setupCallSetKey()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(otherAccountId), sc.NewVaryingData(otherAddress))
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+	mockStorageKey.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Call_SetKey_Docs(t *testing.T) {
+	target := setupCallSetKey()
+
+	assert.Equal(t, "Authenticates the current sudo key and sets the given AccountId (`new`) as the new sudo key.", target.Docs())
+}
+
+func setupCallSetKey() primitives.Call {
+	setupCallMocks()
+
+	return newCallSetKey(moduleId, functionSetKeyIndex, mockEventDepositor, newConstants(dbWeight), testLookup, mockStorageKey)
+}
@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkSudoSetKey benchmark.
+
+package sudo
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callSetKeyWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(9600000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,119 @@
+package sudo
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Authenticates the sudo key and dispatches a function call with `Root` origin.
+type callSudo struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	key            support.StorageValue[primitives.AccountId]
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newCallSudo(
+	moduleId sc.U8,
+	functionId sc.U8,
+	eventDepositor primitives.EventDepositor,
+	constants *consts,
+	key support.StorageValue[primitives.AccountId],
+	transactional support.Transactional[primitives.PostDispatchInfo],
+) primitives.Call {
+	call := callSudo{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.RuntimeCall{}),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		key:            key,
+		transactional:  transactional,
+	}
+
+	return call
+}
+
+func (c callSudo) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callSudo) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(primitives.NewRuntimeCall(call))
+	return c, nil
+}
+
+func (c callSudo) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSudo) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSudo) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callSudo) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callSudo) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callSudo) BaseWeight() primitives.Weight {
+	dispatchInfo := primitives.GetDispatchInfo(c.Arguments[0].(primitives.RuntimeCall))
+
+	return callSudoWeight(c.constants.DbWeight).SaturatingAdd(dispatchInfo.Weight)
+}
+
+func (_ callSudo) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callSudo) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[0].(primitives.RuntimeCall)).Class
+}
+
+func (_ callSudo) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callSudo) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSudo(c.ModuleId, origin, c.key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	call := args[0].(primitives.RuntimeCall)
+
+	_, dispatchErr := c.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		return call.Dispatch(primitives.NewRawOriginRoot(), call.Args())
+	})
+
+	sudoResult, err := primitives.NewDispatchOutcomeFromError(dispatchErr)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.eventDepositor.DepositEvent(newEventSudid(c.ModuleId, sudoResult))
+
+	// Sudo user does not pay a fee.
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+}
+
+func (_ callSudo) Docs() string {
+	return "Authenticates the sudo key and dispatches a function call with `Root` origin."
+}
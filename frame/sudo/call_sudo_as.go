@@ -0,0 +1,131 @@
+package sudo
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Authenticates the sudo key and dispatches a function call with `Signed` origin from a given account.
+type callSudoAs struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	lookup         primitives.StaticLookup
+	key            support.StorageValue[primitives.AccountId]
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newCallSudoAs(
+	moduleId sc.U8,
+	functionId sc.U8,
+	eventDepositor primitives.EventDepositor,
+	constants *consts,
+	lookup primitives.StaticLookup,
+	key support.StorageValue[primitives.AccountId],
+	transactional support.Transactional[primitives.PostDispatchInfo],
+) primitives.Call {
+	call := callSudoAs{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.RuntimeCall{}),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		lookup:         lookup,
+		key:            key,
+		transactional:  transactional,
+	}
+
+	return call
+}
+
+func (c callSudoAs) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callSudoAs) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	who, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(who, primitives.NewRuntimeCall(call))
+	return c, nil
+}
+
+func (c callSudoAs) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSudoAs) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSudoAs) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callSudoAs) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callSudoAs) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callSudoAs) BaseWeight() primitives.Weight {
+	dispatchInfo := primitives.GetDispatchInfo(c.Arguments[1].(primitives.RuntimeCall))
+
+	return callSudoAsWeight(c.constants.DbWeight).SaturatingAdd(dispatchInfo.Weight)
+}
+
+func (_ callSudoAs) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callSudoAs) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[1].(primitives.RuntimeCall)).Class
+}
+
+func (_ callSudoAs) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callSudoAs) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSudo(c.ModuleId, origin, c.key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	who, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+
+	call := args[1].(primitives.RuntimeCall)
+
+	_, dispatchErr := c.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		return call.Dispatch(primitives.NewRawOriginSigned(who), call.Args())
+	})
+
+	sudoResult, err := primitives.NewDispatchOutcomeFromError(dispatchErr)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.eventDepositor.DepositEvent(newEventSudoAsDone(c.ModuleId, sudoResult))
+
+	// Sudo user does not pay a fee.
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+}
+
+func (_ callSudoAs) Docs() string {
+	return "Authenticates the sudo key and dispatches a function call with `Signed` origin from a given account."
+}
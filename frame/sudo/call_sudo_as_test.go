@@ -0,0 +1,181 @@
+package sudo
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	otherAddress = primitives.NewMultiAddressId(otherAccountId)
+)
+
+func Test_Call_SudoAs_New(t *testing.T) {
+	target := setupCallSudoAs()
+	expected := callSudoAs{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionSudoAsIndex,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.RuntimeCall{}),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight),
+		lookup:         testLookup,
+		key:            mockStorageKey,
+		transactional:  mockTransactional,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_SudoAs_DecodeArgs(t *testing.T) {
+	target := setupCallSudoAs()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(otherAddress.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_SudoAs_DecodeNestedArgs(t *testing.T) {
+	target := setupCallSudoAs()
+	buffer := bytes.NewBuffer(otherAddress.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(otherAddress, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_SudoAs_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallSudoAs()
+	buffer := bytes.NewBuffer(otherAddress.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_SudoAs_ModuleIndex(t *testing.T) {
+	target := setupCallSudoAs()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_SudoAs_FunctionIndex(t *testing.T) {
+	target := setupCallSudoAs()
+
+	assert.Equal(t, sc.U8(functionSudoAsIndex), target.FunctionIndex())
+}
+
+func Test_Call_SudoAs_BaseWeight(t *testing.T) {
+	target := setupDecodedCallSudoAs()
+	setupInnerCallDispatchInfo(primitives.NewDispatchClassNormal())
+
+	assert.Equal(t, callSudoAsWeight(dbWeight).SaturatingAdd(innerCallWeight), target.BaseWeight())
+}
+
+func Test_Call_SudoAs_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallSudoAs()
+	setupInnerCallDispatchInfo(primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_SudoAs_PaysFee(t *testing.T) {
+	target := setupCallSudoAs()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_SudoAs_Dispatch(t *testing.T) {
+	target := setupDecodedCallSudoAs()
+	innerArgs := sc.NewVaryingData(sc.U8(1))
+	sudoResult, _ := primitives.NewDispatchOutcome(nil)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockCall.On("Args").Return(innerArgs)
+	mockCall.On("Dispatch", primitives.NewRawOriginSigned(otherAccountId), innerArgs).Return(primitives.PostDispatchInfo{}, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventSudoAsDone(moduleId, sudoResult)).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginSigned(otherAccountId), innerArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventSudoAsDone(moduleId, sudoResult))
+}
+
+func Test_Call_SudoAs_Dispatch_AccountIndex(t *testing.T) {
+	setupCallMocks()
+	mockLookup := new(mocks.StaticLookup)
+	target := newCallSudoAs(moduleId, functionSudoAsIndex, mockEventDepositor, newConstants(dbWeight), mockLookup, mockStorageKey, mockTransactional)
+	innerArgs := sc.NewVaryingData(sc.U8(1))
+	sudoResult, _ := primitives.NewDispatchOutcome(nil)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockLookup.On("Lookup", indexAddress).Return(otherAccountId, nil)
+	mockCall.On("Args").Return(innerArgs)
+	mockCall.On("Dispatch", primitives.NewRawOriginSigned(otherAccountId), innerArgs).Return(primitives.PostDispatchInfo{}, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventSudoAsDone(moduleId, sudoResult)).Return()
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), sc.NewVaryingData(indexAddress, primitives.NewRuntimeCall(mockCall)))
+
+	assert.Nil(t, err)
+	mockLookup.AssertCalled(t, "Lookup", indexAddress)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginSigned(otherAccountId), innerArgs)
+}
+
+func Test_Call_SudoAs_Dispatch_CannotLookup(t *testing.T) {
+	target := setupCallSudoAs()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	_, err := target.Dispatch(
+		primitives.NewRawOriginSigned(sudoAccountId),
+		sc.NewVaryingData(primitives.NewMultiAddress20(primitives.Address20{}), primitives.NewRuntimeCall(mockCall)),
+	)
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_SudoAs_Dispatch_RequireSudo(t *testing.T) {
+	target := setupDecodedCallSudoAs()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(otherAccountId), target.Args())
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func setupCallSudoAs() primitives.Call {
+	setupCallMocks()
+
+	return newCallSudoAs(moduleId, functionSudoAsIndex, mockEventDepositor, newConstants(dbWeight), testLookup, mockStorageKey, mockTransactional)
+}
+
+func setupDecodedCallSudoAs() primitives.Call {
+	target := setupCallSudoAs().(callSudoAs)
+	target.Arguments = sc.NewVaryingData(otherAddress, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
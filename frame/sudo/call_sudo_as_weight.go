@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkSudoSudoAs benchmark.
+
+package sudo
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callSudoAsWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(10059000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
@@ -0,0 +1,229 @@
+package sudo
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	innerCallBytes  = []byte{1, 2, 3}
+	innerCallWeight = primitives.WeightFromParts(1_000, 10)
+	innerCallErr    = primitives.NewDispatchErrorCannotLookup()
+)
+
+var (
+	mockRuntimeDecoder *mocks.RuntimeDecoder
+)
+
+func Test_Call_Sudo_New(t *testing.T) {
+	target := setupCallSudo()
+	expected := callSudo{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionSudoIndex,
+			Arguments:  sc.NewVaryingData(primitives.RuntimeCall{}),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight),
+		key:            mockStorageKey,
+		transactional:  mockTransactional,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_Sudo_DecodeArgs(t *testing.T) {
+	target := setupCallSudo()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(innerCallBytes))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_Sudo_DecodeNestedArgs(t *testing.T) {
+	target := setupCallSudo()
+	buffer := bytes.NewBuffer(innerCallBytes)
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(primitives.NewRuntimeCall(mockCall)), call.Args())
+	mockRuntimeDecoder.AssertCalled(t, "DecodeCall", buffer)
+}
+
+func Test_Call_Sudo_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallSudo()
+	buffer := bytes.NewBuffer(innerCallBytes)
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_Sudo_Encode(t *testing.T) {
+	target := setupDecodedCallSudo()
+	buffer := &bytes.Buffer{}
+
+	mockCall.On("Encode", buffer).Run(func(args mock.Arguments) {
+		args.Get(0).(*bytes.Buffer).Write(innerCallBytes)
+	})
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte{moduleId, functionSudoIndex}, innerCallBytes...), buffer.Bytes())
+}
+
+func Test_Call_Sudo_ModuleIndex(t *testing.T) {
+	target := setupCallSudo()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Sudo_FunctionIndex(t *testing.T) {
+	target := setupCallSudo()
+
+	assert.Equal(t, sc.U8(functionSudoIndex), target.FunctionIndex())
+}
+
+func Test_Call_Sudo_BaseWeight(t *testing.T) {
+	target := setupDecodedCallSudo()
+	setupInnerCallDispatchInfo(primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, callSudoWeight(dbWeight).SaturatingAdd(innerCallWeight), target.BaseWeight())
+}
+
+func Test_Call_Sudo_WeighData(t *testing.T) {
+	target := setupCallSudo()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Sudo_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallSudo()
+	setupInnerCallDispatchInfo(primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Sudo_PaysFee(t *testing.T) {
+	target := setupCallSudo()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Sudo_Dispatch(t *testing.T) {
+	target := setupDecodedCallSudo()
+	innerArgs := sc.NewVaryingData(sc.U8(1))
+	sudoResult, _ := primitives.NewDispatchOutcome(nil)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockCall.On("Args").Return(innerArgs)
+	mockCall.On("Dispatch", primitives.NewRawOriginRoot(), innerArgs).Return(primitives.PostDispatchInfo{}, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventSudid(moduleId, sudoResult)).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginRoot(), innerArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventSudid(moduleId, sudoResult))
+}
+
+func Test_Call_Sudo_Dispatch_InnerCallFails(t *testing.T) {
+	target := setupDecodedCallSudo()
+	innerArgs := sc.NewVaryingData(sc.U8(1))
+	sudoResult, _ := primitives.NewDispatchOutcome(innerCallErr)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockCall.On("Args").Return(innerArgs)
+	mockCall.On("Dispatch", primitives.NewRawOriginRoot(), innerArgs).Return(primitives.PostDispatchInfo{}, innerCallErr)
+	runInStorageLayer(innerCallErr)
+	mockEventDepositor.On("DepositEvent", newEventSudid(moduleId, sudoResult)).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventSudid(moduleId, sudoResult))
+}
+
+func Test_Call_Sudo_Dispatch_RequireSudo(t *testing.T) {
+	target := setupDecodedCallSudo()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(otherAccountId), target.Args())
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Call_Sudo_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallSudo()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func Test_Call_Sudo_Docs(t *testing.T) {
+	target := setupCallSudo()
+
+	assert.Equal(t, "Authenticates the sudo key and dispatches a function call with `Root` origin.", target.Docs())
+}
+
+func setupCallSudo() primitives.Call {
+	setupCallMocks()
+
+	return newCallSudo(moduleId, functionSudoIndex, mockEventDepositor, newConstants(dbWeight), mockStorageKey, mockTransactional)
+}
+
+func setupDecodedCallSudo() primitives.Call {
+	target := setupCallSudo().(callSudo)
+	target.Arguments = sc.NewVaryingData(primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
+
+func setupCallMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockStorageKey = new(mocks.StorageValue[primitives.AccountId])
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockCall = new(mocks.Call)
+}
+
+func setupInnerCallDispatchInfo(class primitives.DispatchClass) {
+	mockCall.On("BaseWeight").Return(innerCallWeight)
+	mockCall.On("WeighData", innerCallWeight).Return(innerCallWeight)
+	mockCall.On("ClassifyDispatch", innerCallWeight).Return(class)
+	mockCall.On("PaysFee", innerCallWeight).Return(primitives.PaysYes)
+}
+
+// runInStorageLayer executes the function passed to the storage layer and returns the given error.
+func runInStorageLayer(err error) {
+	mockTransactional.On("WithStorageLayer", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+			fn()
+		}).
+		Return(primitives.PostDispatchInfo{}, err)
+}
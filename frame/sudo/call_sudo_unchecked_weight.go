@@ -0,0 +1,122 @@
+package sudo
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Authenticates the sudo key and dispatches a function call with `Root` origin.
+// This function does not check the weight of the call, and instead allows the
+// Sudo user to specify the weight of the call.
+type callSudoUncheckedWeight struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	key            support.StorageValue[primitives.AccountId]
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newCallSudoUncheckedWeight(
+	moduleId sc.U8,
+	functionId sc.U8,
+	eventDepositor primitives.EventDepositor,
+	key support.StorageValue[primitives.AccountId],
+	transactional support.Transactional[primitives.PostDispatchInfo],
+) primitives.Call {
+	call := callSudoUncheckedWeight{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.RuntimeCall{}, primitives.Weight{}),
+		},
+		eventDepositor: eventDepositor,
+		key:            key,
+		transactional:  transactional,
+	}
+
+	return call
+}
+
+func (c callSudoUncheckedWeight) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callSudoUncheckedWeight) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	weight, err := primitives.DecodeWeight(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(primitives.NewRuntimeCall(call), weight)
+	return c, nil
+}
+
+func (c callSudoUncheckedWeight) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSudoUncheckedWeight) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSudoUncheckedWeight) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callSudoUncheckedWeight) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callSudoUncheckedWeight) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// BaseWeight returns the weight, specified by the sudo key.
+func (c callSudoUncheckedWeight) BaseWeight() primitives.Weight {
+	return c.Arguments[1].(primitives.Weight)
+}
+
+func (_ callSudoUncheckedWeight) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return baseWeight
+}
+
+func (c callSudoUncheckedWeight) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[0].(primitives.RuntimeCall)).Class
+}
+
+func (_ callSudoUncheckedWeight) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callSudoUncheckedWeight) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSudo(c.ModuleId, origin, c.key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	call := args[0].(primitives.RuntimeCall)
+
+	_, dispatchErr := c.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		return call.Dispatch(primitives.NewRawOriginRoot(), call.Args())
+	})
+
+	sudoResult, err := primitives.NewDispatchOutcomeFromError(dispatchErr)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.eventDepositor.DepositEvent(newEventSudid(c.ModuleId, sudoResult))
+
+	// Sudo user does not pay a fee.
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+}
+
+func (_ callSudoUncheckedWeight) Docs() string {
+	return "Authenticates the sudo key and dispatches a function call with `Root` origin. " +
+		"This function does not check the weight of the call, and instead allows the Sudo user to specify the weight of the call."
+}
@@ -0,0 +1,145 @@
+package sudo
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	uncheckedWeight = primitives.WeightFromParts(123_456, 789)
+)
+
+func Test_Call_SudoUncheckedWeight_New(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+	expected := callSudoUncheckedWeight{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionSudoUncheckedWeightIndex,
+			Arguments:  sc.NewVaryingData(primitives.RuntimeCall{}, primitives.Weight{}),
+		},
+		eventDepositor: mockEventDepositor,
+		key:            mockStorageKey,
+		transactional:  mockTransactional,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_SudoUncheckedWeight_DecodeArgs(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(innerCallBytes))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_SudoUncheckedWeight_DecodeNestedArgs(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+	buffer := bytes.NewBuffer(uncheckedWeight.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(primitives.NewRuntimeCall(mockCall), uncheckedWeight), call.Args())
+}
+
+func Test_Call_SudoUncheckedWeight_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+	buffer := bytes.NewBuffer(uncheckedWeight.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_SudoUncheckedWeight_ModuleIndex(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_SudoUncheckedWeight_FunctionIndex(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+
+	assert.Equal(t, sc.U8(functionSudoUncheckedWeightIndex), target.FunctionIndex())
+}
+
+func Test_Call_SudoUncheckedWeight_BaseWeight(t *testing.T) {
+	target := setupDecodedCallSudoUncheckedWeight()
+
+	assert.Equal(t, uncheckedWeight, target.BaseWeight())
+}
+
+func Test_Call_SudoUncheckedWeight_WeighData(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+
+	assert.Equal(t, uncheckedWeight, target.WeighData(uncheckedWeight))
+}
+
+func Test_Call_SudoUncheckedWeight_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallSudoUncheckedWeight()
+	setupInnerCallDispatchInfo(primitives.NewDispatchClassMandatory())
+
+	assert.Equal(t, primitives.NewDispatchClassMandatory(), target.ClassifyDispatch(uncheckedWeight))
+}
+
+func Test_Call_SudoUncheckedWeight_PaysFee(t *testing.T) {
+	target := setupCallSudoUncheckedWeight()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(uncheckedWeight))
+}
+
+func Test_Call_SudoUncheckedWeight_Dispatch(t *testing.T) {
+	target := setupDecodedCallSudoUncheckedWeight()
+	innerArgs := sc.NewVaryingData(sc.U8(1))
+	sudoResult, _ := primitives.NewDispatchOutcome(nil)
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+	mockCall.On("Args").Return(innerArgs)
+	mockCall.On("Dispatch", primitives.NewRawOriginRoot(), innerArgs).Return(primitives.PostDispatchInfo{}, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventSudid(moduleId, sudoResult)).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginRoot(), innerArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventSudid(moduleId, sudoResult))
+}
+
+func Test_Call_SudoUncheckedWeight_Dispatch_RequireSudo(t *testing.T) {
+	target := setupDecodedCallSudoUncheckedWeight()
+
+	mockStorageKey.On("Exists").Return(false)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(sudoAccountId), target.Args())
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func setupCallSudoUncheckedWeight() primitives.Call {
+	setupCallMocks()
+
+	return newCallSudoUncheckedWeight(moduleId, functionSudoUncheckedWeightIndex, mockEventDepositor, mockStorageKey, mockTransactional)
+}
+
+func setupDecodedCallSudoUncheckedWeight() primitives.Call {
+	target := setupCallSudoUncheckedWeight().(callSudoUncheckedWeight)
+	target.Arguments = sc.NewVaryingData(primitives.NewRuntimeCall(mockCall), uncheckedWeight)
+
+	return target
+}
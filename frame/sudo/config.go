@@ -0,0 +1,19 @@
+package sudo
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight       primitives.RuntimeDbWeight
+	EventDepositor primitives.EventDepositor
+	Lookup         primitives.StaticLookup
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, lookup primitives.StaticLookup) *Config {
+	return &Config{
+		DbWeight:       dbWeight,
+		EventDepositor: eventDepositor,
+		Lookup:         lookup,
+	}
+}
@@ -0,0 +1,15 @@
+package sudo
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight primitives.RuntimeDbWeight
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight) *consts {
+	return &consts{
+		DbWeight: dbWeight,
+	}
+}
@@ -0,0 +1,82 @@
+package sudo
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Sudo module events.
+const (
+	EventSudid sc.U8 = iota
+	EventKeyChanged
+	EventKeyRemoved
+	EventSudoAsDone
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid sudo.Event module")
+	errInvalidEventType   = errors.New("invalid sudo.Event type")
+)
+
+func newEventSudid(moduleIndex sc.U8, sudoResult primitives.DispatchOutcome) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventSudid, sudoResult)
+}
+
+func newEventKeyChanged(moduleIndex sc.U8, oldKey sc.Option[primitives.AccountId], newKey primitives.AccountId) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventKeyChanged, oldKey, newKey)
+}
+
+func newEventKeyRemoved(moduleIndex sc.U8) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventKeyRemoved)
+}
+
+func newEventSudoAsDone(moduleIndex sc.U8, sudoResult primitives.DispatchOutcome) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventSudoAsDone, sudoResult)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventSudid:
+		sudoResult, err := primitives.DecodeDispatchOutcome(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventSudid(moduleIndex, sudoResult), nil
+	case EventKeyChanged:
+		oldKey, err := sc.DecodeOptionWith(buffer, primitives.DecodeAccountId)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		newKey, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventKeyChanged(moduleIndex, oldKey, newKey), nil
+	case EventKeyRemoved:
+		return newEventKeyRemoved(moduleIndex), nil
+	case EventSudoAsDone:
+		sudoResult, err := primitives.DecodeDispatchOutcome(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventSudoAsDone(moduleIndex, sudoResult), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
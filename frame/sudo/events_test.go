@@ -0,0 +1,115 @@
+package sudo
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sudo_DecodeEvent_Sudid(t *testing.T) {
+	sudoResult, err := primitives.NewDispatchOutcome(primitives.NewDispatchErrorBadOrigin())
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventSudid.Bytes())
+	buffer.Write(sudoResult.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventSudid, sudoResult)},
+		result,
+	)
+}
+
+func Test_Sudo_DecodeEvent_KeyChanged(t *testing.T) {
+	oldKey := sc.NewOption[primitives.AccountId](sudoAccountId)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventKeyChanged.Bytes())
+	buffer.Write(oldKey.Bytes())
+	buffer.Write(otherAccountId.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventKeyChanged, oldKey, otherAccountId)},
+		result,
+	)
+}
+
+func Test_Sudo_DecodeEvent_KeyChanged_NoOldKey(t *testing.T) {
+	oldKey := sc.NewOption[primitives.AccountId](nil)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventKeyChanged.Bytes())
+	buffer.Write(oldKey.Bytes())
+	buffer.Write(otherAccountId.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventKeyChanged, oldKey, otherAccountId)},
+		result,
+	)
+}
+
+func Test_Sudo_DecodeEvent_KeyRemoved(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventKeyRemoved.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventKeyRemoved)},
+		result,
+	)
+}
+
+func Test_Sudo_DecodeEvent_SudoAsDone(t *testing.T) {
+	sudoResult, err := primitives.NewDispatchOutcome(nil)
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventSudoAsDone.Bytes())
+	buffer.Write(sudoResult.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{sc.NewVaryingData(sc.U8(moduleId), EventSudoAsDone, sudoResult)},
+		result,
+	)
+}
+
+func Test_Sudo_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(0)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_Sudo_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,65 @@
+package sudo
+
+import (
+	"encoding/json"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/vedhavyas/go-subkey"
+)
+
+type GenesisConfig struct {
+	Key sc.Option[types.AccountId]
+}
+
+type genesisConfigJsonStruct struct {
+	SudoGenesisConfig struct {
+		Key *string `json:"key"`
+	} `json:"sudo"`
+}
+
+func (gc *GenesisConfig) UnmarshalJSON(data []byte) error {
+	gcJson := genesisConfigJsonStruct{}
+
+	if err := json.Unmarshal(data, &gcJson); err != nil {
+		return err
+	}
+
+	if gcJson.SudoGenesisConfig.Key == nil {
+		gc.Key = sc.NewOption[types.AccountId](nil)
+		return nil
+	}
+
+	_, publicKey, err := subkey.SS58Decode(*gcJson.SudoGenesisConfig.Key)
+	if err != nil {
+		return err
+	}
+
+	key, err := types.NewAccountId(sc.BytesToSequenceU8(publicKey)...)
+	if err != nil {
+		return err
+	}
+
+	gc.Key = sc.NewOption[types.AccountId](key)
+
+	return nil
+}
+
+func (m Module) CreateDefaultConfig() ([]byte, error) {
+	gc := &genesisConfigJsonStruct{}
+
+	return json.Marshal(gc)
+}
+
+func (m Module) BuildConfig(config []byte) error {
+	gc := GenesisConfig{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	if gc.Key.HasValue {
+		m.storage.Key.Put(gc.Key.Value)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package sudo
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	aliceAccountId, _ = types.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+)
+
+func Test_GenesisConfig_CreateDefaultConfig(t *testing.T) {
+	target := setupModule()
+
+	expectedGc := []byte("{\"sudo\":{\"key\":null}}")
+
+	gc, err := target.CreateDefaultConfig()
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedGc, gc)
+}
+
+func Test_GenesisConfig_BuildConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		gcJson      string
+		expectedErr error
+		expectedKey sc.Option[types.AccountId]
+	}{
+		{
+			name:        "valid",
+			gcJson:      "{\"sudo\":{\"key\":\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\"}}",
+			expectedKey: sc.NewOption[types.AccountId](aliceAccountId),
+		},
+		{
+			name:        "no key",
+			gcJson:      "{\"sudo\":{\"key\":null}}",
+			expectedKey: sc.NewOption[types.AccountId](nil),
+		},
+		{
+			name:        "no sudo config",
+			gcJson:      "{\"aura\":{\"authorities\":[]}}",
+			expectedKey: sc.NewOption[types.AccountId](nil),
+		},
+		{
+			name:        "invalid ss58 address",
+			gcJson:      "{\"sudo\":{\"key\":\"invalid\"}}",
+			expectedErr: errors.New("expected at least 2 bytes in base58 decoded address"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+
+			if tt.expectedKey.HasValue {
+				mockStorageKey.On("Put", tt.expectedKey.Value).Return()
+			}
+
+			err := target.BuildConfig([]byte(tt.gcJson))
+			assert.Equal(t, tt.expectedErr, err)
+
+			if tt.expectedKey.HasValue {
+				mockStorageKey.AssertCalled(t, "Put", tt.expectedKey.Value)
+			} else {
+				mockStorageKey.AssertNotCalled(t, "Put")
+			}
+		})
+	}
+}
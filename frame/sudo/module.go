@@ -0,0 +1,241 @@
+package sudo
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	functionSudoIndex = iota
+	functionSudoUncheckedWeightIndex
+	functionSetKeyIndex
+	functionSudoAsIndex
+	functionRemoveKeyIndex
+)
+
+const (
+	name           = sc.Str("Sudo")
+	storageVersion = sc.U16(0)
+)
+
+// Module allows a single account (called the "sudo key") to execute dispatchable functions
+// that require a `Root` origin or to designate a new account to replace them as the sudo key.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+	logger      log.WarnLogger
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight)
+	storage := newStorage()
+
+	module := Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keySudo, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		mdGenerator:          mdGenerator,
+		logger:               logger,
+	}
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionSudoIndex] = newCallSudo(index, functionSudoIndex, config.EventDepositor, constants, storage.Key, support.NewTransactional[primitives.PostDispatchInfo](logger))
+	functions[functionSudoUncheckedWeightIndex] = newCallSudoUncheckedWeight(index, functionSudoUncheckedWeightIndex, config.EventDepositor, storage.Key, support.NewTransactional[primitives.PostDispatchInfo](logger))
+	functions[functionSetKeyIndex] = newCallSetKey(index, functionSetKeyIndex, config.EventDepositor, constants, config.Lookup, storage.Key)
+	functions[functionSudoAsIndex] = newCallSudoAs(index, functionSudoAsIndex, config.EventDepositor, constants, config.Lookup, storage.Key, support.NewTransactional[primitives.PostDispatchInfo](logger))
+	functions[functionRemoveKeyIndex] = newCallRemoveKey(index, functionRemoveKeyIndex, config.EventDepositor, constants, storage.Key)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// Key returns the current sudo key, if set.
+func (m Module) Key() (sc.Option[primitives.AccountId], error) {
+	return storageKey(m.storage.Key)
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdSudoCalls := m.mdGenerator.BuildCallsMetadata("Sudo", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdSudoCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdSudoCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Sudo, Runtime>"),
+				},
+				m.Index,
+				"Call.Sudo"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSudoEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSudoEvent, "pallet_sudo::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Sudo"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSudoErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSudoErrors),
+				},
+				m.Index,
+				"Errors.Sudo"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesSudoEvent, "pallet_sudo pallet Event", sc.Sequence[sc.Str]{"pallet_sudo", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Sudid",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTuple, "sudo_result", "DispatchResult"),
+					},
+					EventSudid,
+					"Events.Sudid"),
+				primitives.NewMetadataDefinitionVariant(
+					"KeyChanged",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionAddress32, "old", "Option<T::AccountId>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "new", "T::AccountId"),
+					},
+					EventKeyChanged,
+					"Events.KeyChanged"),
+				primitives.NewMetadataDefinitionVariant(
+					"KeyRemoved",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventKeyRemoved,
+					"Events.KeyRemoved"),
+				primitives.NewMetadataDefinitionVariant(
+					"SudoAsDone",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTuple, "sudo_result", "DispatchResult"),
+					},
+					EventSudoAsDone,
+					"Events.SudoAsDone"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesSudoErrors,
+			"pallet_sudo pallet Error",
+			sc.Sequence[sc.Str]{"pallet_sudo", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"RequireSudo",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorRequireSudo,
+						"Sender must be the Sudo account."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Key",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesAddress32)),
+				"The `AccountId` of the sudo key."),
+		},
+	})
+}
+
+// storageKey returns the sudo key, if one is set.
+func storageKey(key support.StorageValue[primitives.AccountId]) (sc.Option[primitives.AccountId], error) {
+	if !key.Exists() {
+		return sc.NewOption[primitives.AccountId](nil), nil
+	}
+
+	sudoKey, err := key.Get()
+	if err != nil {
+		return sc.Option[primitives.AccountId]{}, err
+	}
+
+	return sc.NewOption[primitives.AccountId](sudoKey), nil
+}
+
+// ensureSudo ensures that the origin is signed by the current sudo key.
+func ensureSudo(moduleId sc.U8, origin primitives.RuntimeOrigin, key support.StorageValue[primitives.AccountId]) error {
+	if !origin.IsSignedOrigin() {
+		return primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return err
+	}
+
+	sudoKey, err := storageKey(key)
+	if err != nil {
+		return err
+	}
+
+	if !sudoKey.HasValue || !reflect.DeepEqual(sudoKey.Value, who) {
+		return NewDispatchErrorRequireSudo(moduleId)
+	}
+
+	return nil
+}
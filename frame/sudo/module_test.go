@@ -0,0 +1,273 @@
+package sudo
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	moduleId = 7
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	sudoAccountId  = constants.OneAccountId
+	otherAccountId = constants.TwoAccountId
+	expectedErr    = errors.New("error")
+	mdGenerator    = primitives.NewMetadataTypeGenerator()
+	logger         = log.NewLogger()
+	testLookup     = primitives.NewIdentityLookup()
+	indexAddress   = primitives.NewMultiAddressIndex(1)
+)
+
+var (
+	mockEventDepositor *mocks.EventDepositor
+	mockStorageKey     *mocks.StorageValue[primitives.AccountId]
+	mockTransactional  *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockCall           *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 5, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Key(t *testing.T) {
+	target := setupModule()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	result, err := target.Key()
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[primitives.AccountId](sudoAccountId), result)
+}
+
+func Test_Module_Key_NotSet(t *testing.T) {
+	target := setupModule()
+
+	mockStorageKey.On("Exists").Return(false)
+
+	result, err := target.Key()
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), result)
+	mockStorageKey.AssertNotCalled(t, "Get")
+}
+
+func Test_Module_Key_Error(t *testing.T) {
+	target := setupModule()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(primitives.AccountId{}, expectedErr)
+
+	_, err := target.Key()
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_ensureSudo(t *testing.T) {
+	setupModule()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	err := ensureSudo(moduleId, primitives.NewRawOriginSigned(sudoAccountId), mockStorageKey)
+
+	assert.Nil(t, err)
+}
+
+func Test_ensureSudo_BadOrigin(t *testing.T) {
+	setupModule()
+
+	err := ensureSudo(moduleId, primitives.NewRawOriginRoot(), mockStorageKey)
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageKey.AssertNotCalled(t, "Exists")
+}
+
+func Test_ensureSudo_RequireSudo(t *testing.T) {
+	setupModule()
+
+	mockStorageKey.On("Exists").Return(true)
+	mockStorageKey.On("Get").Return(sudoAccountId, nil)
+
+	err := ensureSudo(moduleId, primitives.NewRawOriginSigned(otherAccountId), mockStorageKey)
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+}
+
+func Test_ensureSudo_RequireSudo_KeyNotSet(t *testing.T) {
+	setupModule()
+
+	mockStorageKey.On("Exists").Return(false)
+
+	err := ensureSudo(moduleId, primitives.NewRawOriginSigned(sudoAccountId), mockStorageKey)
+
+	assert.Equal(t, NewDispatchErrorRequireSudo(moduleId), err)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedSudoCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedSudoCallsMetadataId, "Sudo calls", sc.Sequence[sc.Str]{"pallet_sudo", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"sudo",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionSudoIndex,
+					"Authenticates the sudo key and dispatches a function call with `Root` origin."),
+				primitives.NewMetadataDefinitionVariant(
+					"sudo_unchecked_weight",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesWeight),
+					},
+					functionSudoUncheckedWeightIndex,
+					"Authenticates the sudo key and dispatches a function call with `Root` origin. "+
+						"This function does not check the weight of the call, and instead allows the Sudo user to specify the weight of the call."),
+				primitives.NewMetadataDefinitionVariant(
+					"set_key",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+					},
+					functionSetKeyIndex,
+					"Authenticates the current sudo key and sets the given AccountId (`new`) as the new sudo key."),
+				primitives.NewMetadataDefinitionVariant(
+					"sudo_as",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionSudoAsIndex,
+					"Authenticates the sudo key and dispatches a function call with `Signed` origin from a given account."),
+				primitives.NewMetadataDefinitionVariant(
+					"remove_key",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					functionRemoveKeyIndex,
+					"Permanently removes the sudo key. **This cannot be un-done.**"),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name: name,
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: name,
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Key",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesAddress32)),
+					"The `AccountId` of the sudo key."),
+			},
+		}),
+		Call: sc.NewOption[sc.Compact](sc.ToCompact(expectedSudoCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedSudoCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Sudo, Runtime>"),
+				},
+				moduleId,
+				"Call.Sudo"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSudoEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSudoEvent, "pallet_sudo::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Sudo"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSudoErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSudoErrors),
+				},
+				moduleId,
+				"Errors.Sudo"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func setupModule() Module {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockStorageKey = new(mocks.StorageValue[primitives.AccountId])
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockCall = new(mocks.Call)
+
+	mdGenerator.ClearMetadata()
+
+	config := NewConfig(dbWeight, mockEventDepositor, testLookup)
+
+	target := New(moduleId, config, mdGenerator, logger)
+	target.storage.Key = mockStorageKey
+
+	return target
+}
@@ -8,25 +8,32 @@ import (
 )
 
 type baseStorage[T sc.Encodable] struct {
-	storage      io.Storage
-	decodeFunc   func(buffer *bytes.Buffer) (T, error)
-	defaultValue *T
+	storage    io.Storage
+	decodeFunc func(buffer *bytes.Buffer) (T, error)
+	queryKind  QueryKind[T]
 }
 
-func newBaseStorage[T sc.Encodable](decodeFunc func(buffer *bytes.Buffer) (T, error), defaultValue *T) baseStorage[T] {
+func newBaseStorage[T sc.Encodable](decodeFunc func(buffer *bytes.Buffer) (T, error), queryKind QueryKind[T]) baseStorage[T] {
 	return baseStorage[T]{
-		storage:      io.NewStorage(),
-		decodeFunc:   decodeFunc,
-		defaultValue: defaultValue,
+		storage:    io.NewStorage(),
+		decodeFunc: decodeFunc,
+		queryKind:  queryKind,
 	}
 }
 
+// get gets the storage value and returns it decoded.
+// If there is no value, the result depends on the query kind.
 func (bs baseStorage[T]) get(key []byte) (T, error) {
-	if bs.defaultValue == nil {
-		return bs.getDecode(key)
+	option, err := bs.getOption(key)
+	if err != nil {
+		return *new(T), err
+	}
+
+	if !option.HasValue {
+		return bs.queryKind.OnEmpty()
 	}
 
-	return bs.getDecodeOnEmpty(key)
+	return option.Value, nil
 }
 
 func (bs baseStorage[T]) getBytes(key []byte) (sc.Option[sc.Sequence[sc.U8]], error) {
@@ -103,27 +110,6 @@ func (bs baseStorage[T]) getDecode(key []byte) (T, error) {
 	return f, nil
 }
 
-func (bs baseStorage[T]) getDecodeOnEmpty(key []byte) (T, error) {
-	option, err := bs.storage.Get(key)
-	if err != nil {
-		return *new(T), err
-	}
-
-	if !option.HasValue {
-		return *bs.defaultValue, nil
-	}
-
-	buffer := &bytes.Buffer{}
-	buffer.Write(sc.SequenceU8ToBytes(option.Value))
-
-	f, err := bs.decodeFunc(buffer)
-	if err != nil {
-		return *new(T), err
-	}
-
-	return f, nil
-}
-
 // takeBytes gets the storage value. The result from Get is Option<sc.Sequence[sc.U8]>.
 // If the option is empty, it returns nil.
 // If the option is not empty, it clears it and returns the sequence as bytes.
@@ -143,7 +129,7 @@ func (bs baseStorage[T]) takeBytes(key []byte) ([]byte, error) {
 }
 
 // TakeDecode gets the storage value and returns it decoded. The result from Get is Option<sc.Sequence[sc.U8]>.
-// If the option is empty, it returns default value T.
+// If the option is empty, the result depends on the query kind.
 // If the option is not empty, it clears it and returns decodeFunc(value).
 func (bs baseStorage[T]) takeDecode(key []byte) (T, error) {
 	option, err := bs.storage.Get(key)
@@ -152,7 +138,7 @@ func (bs baseStorage[T]) takeDecode(key []byte) (T, error) {
 	}
 
 	if !option.HasValue {
-		return *new(T), nil
+		return bs.queryKind.OnEmpty()
 	}
 
 	bs.storage.Clear(key)
@@ -167,3 +153,67 @@ func (bs baseStorage[T]) takeDecode(key []byte) (T, error) {
 
 	return f, nil
 }
+
+// getOption gets the storage value and returns it decoded as an option, which is empty if there is no value.
+func (bs baseStorage[T]) getOption(key []byte) (sc.Option[T], error) {
+	option, err := bs.storage.Get(key)
+	if err != nil {
+		return sc.Option[T]{}, err
+	}
+
+	if !option.HasValue {
+		return sc.NewOption[T](nil), nil
+	}
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.SequenceU8ToBytes(option.Value))
+
+	value, err := bs.decodeFunc(buffer)
+	if err != nil {
+		return sc.Option[T]{}, err
+	}
+
+	return sc.NewOption[T](value), nil
+}
+
+// mutate applies f to the storage value and stores the result, unless f returns an error.
+func (bs baseStorage[T]) mutate(key []byte, f func(*T) (sc.Encodable, error)) (sc.Encodable, error) {
+	value, err := bs.get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := f(&value)
+	if err == nil {
+		bs.put(key, value)
+	}
+
+	return result, err
+}
+
+// tryMutateExists applies f to the optional storage value, unless f returns an error.
+// If the resulting option is empty, the value is removed from the storage.
+func (bs baseStorage[T]) tryMutateExists(key []byte, f func(option *sc.Option[T]) (sc.Encodable, error)) (sc.Encodable, error) {
+	option, err := bs.getOption(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := f(&option)
+	if err != nil {
+		return result, err
+	}
+
+	if option.HasValue {
+		bs.put(key, option.Value)
+	} else {
+		bs.clear(key)
+	}
+
+	return result, nil
+}
+
+// clearPrefix removes up to limit values, whose keys start with prefix.
+func (bs baseStorage[T]) clearPrefix(prefix []byte, limit sc.U32) {
+	bs.storage.ClearPrefix(prefix, sc.NewOption[sc.U32](limit).Bytes())
+}
@@ -0,0 +1,150 @@
+package support
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const counterPrefix = "CounterFor"
+
+// CountedHashStorageMap is a HashStorageMap, which maintains the number of its entries in a sibling
+// `CounterFor<name>` storage value. The counter is updated by each operation, which inserts or removes entries.
+type CountedHashStorageMap[K, V sc.Encodable] struct {
+	HashStorageMap[K, V]
+	counter StorageValue[sc.U32]
+}
+
+func NewCountedHashStorageMap[K, V sc.Encodable](prefix []byte, name []byte, hasher StorageHasher, decodeKeyFunc func(buffer *bytes.Buffer) (K, error), decodeFunc func(buffer *bytes.Buffer) (V, error)) CountedStorageMap[K, V] {
+	return NewCountedHashStorageMapWithQuery(prefix, name, hasher, decodeKeyFunc, decodeFunc, NewOptionQuery[V]())
+}
+
+func NewCountedHashStorageMapWithQuery[K, V sc.Encodable](prefix []byte, name []byte, hasher StorageHasher, decodeKeyFunc func(buffer *bytes.Buffer) (K, error), decodeFunc func(buffer *bytes.Buffer) (V, error), queryKind QueryKind[V]) CountedStorageMap[K, V] {
+	return CountedHashStorageMap[K, V]{
+		NewHashStorageMapWithQuery(prefix, name, hasher, decodeKeyFunc, decodeFunc, queryKind).(HashStorageMap[K, V]),
+		NewHashStorageValueWithQuery(prefix, append([]byte(counterPrefix), name...), sc.DecodeU32, NewValueQuery(sc.U32(0))),
+	}
+}
+
+// Count returns the number of entries in the map.
+func (chsm CountedHashStorageMap[K, V]) Count() (sc.U32, error) {
+	return chsm.counter.Get()
+}
+
+func (chsm CountedHashStorageMap[K, V]) Put(k K, value V) {
+	if !chsm.HashStorageMap.Exists(k) {
+		chsm.increment()
+	}
+	chsm.HashStorageMap.Put(k, value)
+}
+
+func (chsm CountedHashStorageMap[K, V]) Append(k K, value V) {
+	if !chsm.HashStorageMap.Exists(k) {
+		chsm.increment()
+	}
+	chsm.HashStorageMap.Append(k, value)
+}
+
+func (chsm CountedHashStorageMap[K, V]) TakeBytes(k K) ([]byte, error) {
+	value, err := chsm.HashStorageMap.TakeBytes(k)
+	if err != nil {
+		return nil, err
+	}
+
+	if value != nil {
+		chsm.decrement()
+	}
+
+	return value, nil
+}
+
+func (chsm CountedHashStorageMap[K, V]) Remove(k K) {
+	if chsm.HashStorageMap.Exists(k) {
+		chsm.decrement()
+	}
+	chsm.HashStorageMap.Remove(k)
+}
+
+// Clear removes up to `limit` entries of the map, decrementing the counter by the number of removed entries.
+func (chsm CountedHashStorageMap[K, V]) Clear(limit sc.U32) {
+	if limit == 0 {
+		return
+	}
+
+	removed := sc.U32(0)
+
+	chsm.HashStorageMap.Drain(func(k K, v V) error {
+		removed++
+		if removed >= limit {
+			return ErrStopIteration
+		}
+		return nil
+	})
+
+	chsm.counter.Put(sc.SaturatingSubU32(chsm.count(), removed))
+}
+
+func (chsm CountedHashStorageMap[K, V]) Mutate(k K, f func(*V) (sc.Encodable, error)) (sc.Encodable, error) {
+	existed := chsm.HashStorageMap.Exists(k)
+
+	result, err := chsm.HashStorageMap.Mutate(k, f)
+	if err == nil && !existed {
+		chsm.increment()
+	}
+
+	return result, err
+}
+
+func (chsm CountedHashStorageMap[K, V]) TryMutateExists(k K, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error) {
+	return chsm.HashStorageMap.TryMutateExists(k, func(option *sc.Option[V]) (sc.Encodable, error) {
+		existed := option.HasValue
+
+		result, err := f(option)
+		if err != nil {
+			return result, err
+		}
+
+		if !existed && option.HasValue {
+			chsm.increment()
+		} else if existed && !option.HasValue {
+			chsm.decrement()
+		}
+
+		return result, nil
+	})
+}
+
+func (chsm CountedHashStorageMap[K, V]) Drain(f func(k K, v V) error) error {
+	return chsm.HashStorageMap.Drain(func(k K, v V) error {
+		chsm.decrement()
+		return f(k, v)
+	})
+}
+
+func (chsm CountedHashStorageMap[K, V]) count() sc.U32 {
+	// The counter has a default value, so it is only missing its value if it cannot be decoded.
+	count, _ := chsm.counter.Get()
+	return count
+}
+
+func (chsm CountedHashStorageMap[K, V]) increment() {
+	chsm.counter.Put(sc.SaturatingAddU32(chsm.count(), 1))
+}
+
+func (chsm CountedHashStorageMap[K, V]) decrement() {
+	chsm.counter.Put(sc.SaturatingSubU32(chsm.count(), 1))
+}
+
+// NewMetadataCountedStorageMapEntries returns the metadata entries of a counted storage map and of its counter.
+func NewMetadataCountedStorageMapEntries(name string, modifier primitives.MetadataModuleStorageEntryModifier, definition primitives.MetadataModuleStorageEntryDefinition, docs string) sc.Sequence[primitives.MetadataModuleStorageEntry] {
+	return sc.Sequence[primitives.MetadataModuleStorageEntry]{
+		primitives.NewMetadataModuleStorageEntry(name, modifier, definition, docs),
+		primitives.NewMetadataModuleStorageEntry(
+			counterPrefix+name,
+			primitives.MetadataModuleStorageEntryModifierDefault,
+			primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+			"Counter for the related counted storage map"),
+	}
+}
@@ -0,0 +1,194 @@
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	mockCounter *mocks.StorageValue[sc.U32]
+)
+
+func Test_CountedHashStorageMap_Count(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockCounter.On("Get").Return(sc.U32(3), nil)
+
+	result, err := target.Count()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(3), result)
+}
+
+func Test_CountedHashStorageMap_Put_New(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Exists", concatHashStorageMapKeyKey).Return(false)
+	mockStorage.On("Set", concatHashStorageMapKeyKey, storageValue.Bytes()).Return()
+	mockCounter.On("Get").Return(sc.U32(3), nil)
+	mockCounter.On("Put", sc.U32(4)).Return()
+
+	target.Put(keyValue, storageValue)
+
+	mockCounter.AssertCalled(t, "Put", sc.U32(4))
+	mockStorage.AssertCalled(t, "Set", concatHashStorageMapKeyKey, storageValue.Bytes())
+}
+
+func Test_CountedHashStorageMap_Put_Existing(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Exists", concatHashStorageMapKeyKey).Return(true)
+	mockStorage.On("Set", concatHashStorageMapKeyKey, storageValue.Bytes()).Return()
+
+	target.Put(keyValue, storageValue)
+
+	mockCounter.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorage.AssertCalled(t, "Set", concatHashStorageMapKeyKey, storageValue.Bytes())
+}
+
+func Test_CountedHashStorageMap_Remove(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Exists", concatHashStorageMapKeyKey).Return(true)
+	mockStorage.On("Clear", concatHashStorageMapKeyKey).Return()
+	mockCounter.On("Get").Return(sc.U32(3), nil)
+	mockCounter.On("Put", sc.U32(2)).Return()
+
+	target.Remove(keyValue)
+
+	mockCounter.AssertCalled(t, "Put", sc.U32(2))
+	mockStorage.AssertCalled(t, "Clear", concatHashStorageMapKeyKey)
+}
+
+func Test_CountedHashStorageMap_Remove_Missing(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Exists", concatHashStorageMapKeyKey).Return(false)
+	mockStorage.On("Clear", concatHashStorageMapKeyKey).Return()
+
+	target.Remove(keyValue)
+
+	mockCounter.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_CountedHashStorageMap_TakeBytes(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Clear", concatHashStorageMapKeyKey).Return()
+	mockCounter.On("Get").Return(sc.U32(1), nil)
+	mockCounter.On("Put", sc.U32(0)).Return()
+
+	result, err := target.TakeBytes(keyValue)
+
+	assert.NoError(t, err)
+	assert.Equal(t, storageValue.Bytes(), result)
+	mockCounter.AssertCalled(t, "Put", sc.U32(0))
+}
+
+func Test_CountedHashStorageMap_TryMutateExists_Insert(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Set", concatHashStorageMapKeyKey, storageValue.Bytes()).Return()
+	mockCounter.On("Get").Return(sc.U32(0), nil)
+	mockCounter.On("Put", sc.U32(1)).Return()
+
+	_, err := target.TryMutateExists(keyValue, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		*option = sc.NewOption[sc.U32](storageValue)
+		return nil, nil
+	})
+
+	assert.NoError(t, err)
+	mockCounter.AssertCalled(t, "Put", sc.U32(1))
+}
+
+func Test_CountedHashStorageMap_TryMutateExists_Remove(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Clear", concatHashStorageMapKeyKey).Return()
+	mockCounter.On("Get").Return(sc.U32(2), nil)
+	mockCounter.On("Put", sc.U32(1)).Return()
+
+	_, err := target.TryMutateExists(keyValue, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		*option = sc.NewOption[sc.U32](nil)
+		return nil, nil
+	})
+
+	assert.NoError(t, err)
+	mockCounter.AssertCalled(t, "Put", sc.U32(1))
+}
+
+func Test_CountedHashStorageMap_TryMutateExists_Error(t *testing.T) {
+	target := setupCountedHashStorageMap()
+
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	_, err := target.TryMutateExists(keyValue, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		*option = sc.NewOption[sc.U32](storageValue)
+		return nil, errPanic
+	})
+
+	assert.Equal(t, errPanic, err)
+	mockCounter.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_CountedHashStorageMap_Clear(t *testing.T) {
+	target := setupCountedHashStorageMap()
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(1).Bytes()...)
+	key2 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(2).Bytes()...)
+
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+	mockStorage.On("NextKey", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key2)), nil)
+	mockStorage.On("Get", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Get", key2).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Clear", key1).Return()
+	mockStorage.On("Clear", key2).Return()
+	mockCounter.On("Get").Return(sc.U32(5), nil)
+	mockCounter.On("Put", sc.U32(3)).Return()
+
+	target.Clear(2)
+
+	mockStorage.AssertNumberOfCalls(t, "Clear", 2)
+	mockCounter.AssertCalled(t, "Put", sc.U32(3))
+}
+
+func Test_NewMetadataCountedStorageMapEntries(t *testing.T) {
+	definition := primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64))
+
+	result := NewMetadataCountedStorageMapEntries("Members", primitives.MetadataModuleStorageEntryModifierOptional, definition, "docs")
+
+	assert.Equal(t, sc.Sequence[primitives.MetadataModuleStorageEntry]{
+		primitives.NewMetadataModuleStorageEntry("Members", primitives.MetadataModuleStorageEntryModifierOptional, definition, "docs"),
+		primitives.NewMetadataModuleStorageEntry(
+			"CounterForMembers",
+			primitives.MetadataModuleStorageEntryModifierDefault,
+			primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+			"Counter for the related counted storage map"),
+	}, result)
+}
+
+func setupCountedHashStorageMap() CountedHashStorageMap[sc.U64, sc.U32] {
+	mockHashing = new(mocks.IoHashing)
+	mockStorage = new(mocks.IoStorage)
+	mockCounter = new(mocks.StorageValue[sc.U32])
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Twox64", keyValue.Bytes()).Return(keyValueHash)
+
+	target := NewCountedHashStorageMap[sc.U64, sc.U32](prefix, name, hasherTwox64Concat{mockHashing}, sc.DecodeU64, decodeFunc).(CountedHashStorageMap[sc.U64, sc.U32])
+	target.HashStorageMap.hashing = mockHashing
+	target.HashStorageMap.storage = mockStorage
+	target.counter = mockCounter
+
+	return target
+}
@@ -0,0 +1,9 @@
+package support
+
+import sc "github.com/LimeChain/goscale"
+
+// CountedStorageMap is a storage map, which keeps track of the number of its entries.
+type CountedStorageMap[K, V sc.Encodable] interface {
+	StorageMap[K, V]
+	Count() (sc.U32, error)
+}
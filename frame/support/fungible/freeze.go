@@ -0,0 +1,27 @@
+package fungible
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// InspectFreeze provides read-only access to freezes placed on an account.
+// A freeze keeps part of the balance from being reduced, without moving it.
+type InspectFreeze interface {
+	// BalanceFrozen returns the amount of `who` frozen under `id`.
+	BalanceFrozen(id [8]byte, who primitives.AccountId) (primitives.Balance, error)
+	// CanFreeze returns whether a new freeze with `id` can be placed on `who`.
+	CanFreeze(id [8]byte, who primitives.AccountId) (bool, error)
+}
+
+// MutateFreeze provides operations which create, change and remove freezes.
+type MutateFreeze interface {
+	InspectFreeze
+	// SetFreeze freezes `amount` of `who` under `id`, replacing any existing freeze with that id.
+	// If `amount` is zero, the freeze is removed.
+	SetFreeze(id [8]byte, who primitives.AccountId, amount sc.U128) error
+	// ExtendFreeze sets the freeze under `id` to the greater of the existing amount and `amount`.
+	ExtendFreeze(id [8]byte, who primitives.AccountId, amount sc.U128) error
+	// Thaw removes the freeze under `id` from `who`.
+	Thaw(id [8]byte, who primitives.AccountId) error
+}
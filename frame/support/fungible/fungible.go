@@ -0,0 +1,49 @@
+package fungible
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Inspect provides read-only access to the balances of a single fungible asset.
+type Inspect interface {
+	// TotalIssuance returns the total amount of the asset in existence.
+	TotalIssuance() (primitives.Balance, error)
+	// MinimumBalance returns the minimum balance an account must hold in order to exist.
+	MinimumBalance() primitives.Balance
+	// TotalBalance returns the total amount of funds owned by `who`, including funds on hold.
+	TotalBalance(who primitives.AccountId) (primitives.Balance, error)
+	// Balance returns the free balance of `who`.
+	Balance(who primitives.AccountId) (primitives.Balance, error)
+	// ReducibleBalance returns the maximum amount by which the balance of `who` can be reduced,
+	// given the `preservation` requirement and whether freezes are respected.
+	ReducibleBalance(who primitives.AccountId, preservation Preservation, force Fortitude) (primitives.Balance, error)
+	// CanDeposit returns an error if `amount` cannot be deposited into `who`.
+	CanDeposit(who primitives.AccountId, amount sc.U128, provenance Provenance) error
+	// CanWithdraw returns an error if `amount` cannot be withdrawn from `who`.
+	CanWithdraw(who primitives.AccountId, amount sc.U128) error
+}
+
+// Mutate provides operations which change balances and the total issuance accordingly.
+type Mutate interface {
+	Inspect
+	// MintInto creates `amount` new funds in `who`, increasing the total issuance.
+	MintInto(who primitives.AccountId, amount sc.U128) (primitives.Balance, error)
+	// BurnFrom destroys up to `amount` funds of `who`, decreasing the total issuance.
+	// Returns the amount actually burned.
+	BurnFrom(who primitives.AccountId, amount sc.U128, precision Precision, force Fortitude) (primitives.Balance, error)
+	// Transfer moves `amount` free funds from `source` to `dest`.
+	Transfer(source primitives.AccountId, dest primitives.AccountId, amount sc.U128, preservation Preservation) (primitives.Balance, error)
+}
+
+// Balanced provides operations which change the balance of an account without changing the total
+// issuance. The caller is responsible for settling the resulting imbalance.
+type Balanced interface {
+	Inspect
+	// Deposit increases the free balance of `who` by `value`.
+	// Returns the amount deposited.
+	Deposit(who primitives.AccountId, value sc.U128, precision Precision) (primitives.Balance, error)
+	// Withdraw decreases the free balance of `who` by up to `value`.
+	// Returns the amount withdrawn.
+	Withdraw(who primitives.AccountId, value sc.U128, precision Precision, preservation Preservation, force Fortitude) (primitives.Balance, error)
+}
@@ -0,0 +1,33 @@
+package fungible
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// InspectHold provides read-only access to funds which are held for a given reason.
+type InspectHold interface {
+	Inspect
+	// TotalBalanceOnHold returns the amount of funds of `who` held for all reasons.
+	TotalBalanceOnHold(who primitives.AccountId) (primitives.Balance, error)
+	// BalanceOnHold returns the amount of funds of `who` held for `reason`.
+	BalanceOnHold(reason [8]byte, who primitives.AccountId) (primitives.Balance, error)
+	// CanHold returns an error if `amount` of the free funds of `who` cannot be held for `reason`.
+	CanHold(reason [8]byte, who primitives.AccountId, amount sc.U128) error
+}
+
+// MutateHold provides operations which place funds on hold and release them.
+type MutateHold interface {
+	InspectHold
+	// Hold moves `amount` from the free balance of `who` to be held for `reason`.
+	Hold(reason [8]byte, who primitives.AccountId, amount sc.U128) error
+	// Release moves up to `amount` held for `reason` back to the free balance of `who`.
+	// Returns the amount released.
+	Release(reason [8]byte, who primitives.AccountId, amount sc.U128, precision Precision) (primitives.Balance, error)
+	// BurnHeld destroys up to `amount` held for `reason`, decreasing the total issuance.
+	// Returns the amount burned.
+	BurnHeld(reason [8]byte, who primitives.AccountId, amount sc.U128, precision Precision, force Fortitude) (primitives.Balance, error)
+	// TransferOnHold moves up to `amount` held for `reason` from `source` to `dest`, placing
+	// the funds according to `mode`. Returns the amount transferred.
+	TransferOnHold(reason [8]byte, source primitives.AccountId, dest primitives.AccountId, amount sc.U128, precision Precision, mode Restriction, force Fortitude) (primitives.Balance, error)
+}
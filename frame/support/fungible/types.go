@@ -0,0 +1,56 @@
+package fungible
+
+import sc "github.com/LimeChain/goscale"
+
+// Preservation describes whether an operation may reduce the balance of an account below the
+// existential deposit or remove the last provider reference of the account.
+type Preservation sc.U8
+
+const (
+	// PreservationExpendable allows the account to be reaped.
+	PreservationExpendable Preservation = iota
+	// PreservationProtect keeps the account alive, but allows its last provider reference to be removed.
+	PreservationProtect
+	// PreservationPreserve keeps the account alive and does not remove its last provider reference.
+	PreservationPreserve
+)
+
+// Precision describes whether an operation must succeed for the full amount or may affect less.
+type Precision sc.U8
+
+const (
+	// PrecisionExact requires the operation to affect the exact amount, or fail.
+	PrecisionExact Precision = iota
+	// PrecisionBestEffort allows the operation to affect as much as possible up to the amount.
+	PrecisionBestEffort
+)
+
+// Fortitude describes whether an operation may reduce balance which is frozen.
+type Fortitude sc.U8
+
+const (
+	// FortitudePolite respects all freezes on the account.
+	FortitudePolite Fortitude = iota
+	// FortitudeForce ignores freezes and may reduce frozen balance.
+	FortitudeForce
+)
+
+// Provenance describes the origin of funds which are deposited into an account.
+type Provenance sc.U8
+
+const (
+	// ProvenanceMinted means the funds are newly created and increase the total issuance.
+	ProvenanceMinted Provenance = iota
+	// ProvenanceExtant means the funds already exist and the total issuance is unaffected.
+	ProvenanceExtant
+)
+
+// Restriction describes where held funds are placed when they are transferred.
+type Restriction sc.U8
+
+const (
+	// RestrictionFree places the funds into the free balance of the destination.
+	RestrictionFree Restriction = iota
+	// RestrictionOnHold places the funds on hold in the destination under the same reason.
+	RestrictionOnHold
+)
@@ -0,0 +1,111 @@
+package support
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+// HashStorageDoubleMap is a storage map with two keys. The storage key is composed of `prefix` and `name`
+// hashed using hashing.Twox128, followed by each key hashed with its own hasher.
+type HashStorageDoubleMap[K1, K2, V sc.Encodable] struct {
+	baseStorage[V]
+	prefix         []byte
+	name           []byte
+	hasher1        StorageHasher
+	hasher2        StorageHasher
+	decodeKey2Func func(buffer *bytes.Buffer) (K2, error)
+	hashing        io.Hashing
+}
+
+func NewHashStorageDoubleMap[K1, K2, V sc.Encodable](prefix []byte, name []byte, hasher1 StorageHasher, hasher2 StorageHasher, decodeKey2Func func(buffer *bytes.Buffer) (K2, error), decodeFunc func(buffer *bytes.Buffer) (V, error)) StorageDoubleMap[K1, K2, V] {
+	return HashStorageDoubleMap[K1, K2, V]{
+		newBaseStorage[V](decodeFunc, NewOptionQuery[V]()),
+		prefix,
+		name,
+		hasher1,
+		hasher2,
+		decodeKey2Func,
+		io.NewHashing(),
+	}
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Get(k1 K1, k2 K2) (V, error) {
+	return hsdm.baseStorage.get(hsdm.key(k1, k2))
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Exists(k1 K1, k2 K2) bool {
+	return hsdm.baseStorage.exists(hsdm.key(k1, k2))
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Put(k1 K1, k2 K2, value V) {
+	hsdm.baseStorage.put(hsdm.key(k1, k2), value)
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Append(k1 K1, k2 K2, value V) {
+	hsdm.baseStorage.append(hsdm.key(k1, k2), value)
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Take(k1 K1, k2 K2) (V, error) {
+	return hsdm.baseStorage.take(hsdm.key(k1, k2))
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) TakeBytes(k1 K1, k2 K2) ([]byte, error) {
+	return hsdm.baseStorage.takeBytes(hsdm.key(k1, k2))
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Remove(k1 K1, k2 K2) {
+	hsdm.baseStorage.clear(hsdm.key(k1, k2))
+}
+
+// RemovePrefix removes up to `limit` values stored under the first key `k1`.
+func (hsdm HashStorageDoubleMap[K1, K2, V]) RemovePrefix(k1 K1, limit sc.U32) {
+	hsdm.baseStorage.clearPrefix(append(hsdm.storagePrefix(), hsdm.hasher1.Hash(k1.Bytes())...), limit)
+}
+
+// IterPrefix visits all entries stored under the first key `k1`, in the order of their storage keys.
+func (hsdm HashStorageDoubleMap[K1, K2, V]) IterPrefix(k1 K1, f func(k2 K2, v V) error) error {
+	prefix := append(hsdm.storagePrefix(), hsdm.hasher1.Hash(k1.Bytes())...)
+
+	return iterateKeys(hsdm.baseStorage.storage, prefix, prefix, func(key []byte) error {
+		k2, err := hsdm.decodeKey2Func(bytes.NewBuffer(hsdm.hasher2.Reverse(key[len(prefix):])))
+		if err != nil {
+			return err
+		}
+
+		v, err := hsdm.baseStorage.getDecode(key)
+		if err != nil {
+			return err
+		}
+
+		return f(k2, v)
+	})
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Clear(limit sc.U32) {
+	hsdm.baseStorage.clearPrefix(hsdm.storagePrefix(), limit)
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) Mutate(k1 K1, k2 K2, f func(*V) (sc.Encodable, error)) (sc.Encodable, error) {
+	return hsdm.baseStorage.mutate(hsdm.key(k1, k2), f)
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) TryMutateExists(k1 K1, k2 K2, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error) {
+	return hsdm.baseStorage.tryMutateExists(hsdm.key(k1, k2), f)
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) storagePrefix() []byte {
+	prefixHash := hsdm.hashing.Twox128(hsdm.prefix)
+	nameHash := hsdm.hashing.Twox128(hsdm.name)
+
+	return append(prefixHash, nameHash...)
+}
+
+func (hsdm HashStorageDoubleMap[K1, K2, V]) key(k1 K1, k2 K2) []byte {
+	concatKey := hsdm.storagePrefix()
+	concatKey = append(concatKey, hsdm.hasher1.Hash(k1.Bytes())...)
+	concatKey = append(concatKey, hsdm.hasher2.Hash(k2.Bytes())...)
+
+	return concatKey
+}
@@ -0,0 +1,207 @@
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	doubleMapKey1     = sc.U64(1)
+	doubleMapKey2     = sc.U32(2)
+	doubleMapKey1Hash = []byte("blake128_1")
+	doubleMapKey2Hash = []byte("twox64_2")
+
+	doubleMapPrefixKey = append(
+		append(append([]byte{}, prefixHash...), nameHash...),
+		append(append([]byte{}, doubleMapKey1Hash...), doubleMapKey1.Bytes()...)...)
+	doubleMapKey = append(
+		append([]byte{}, doubleMapPrefixKey...),
+		append(append([]byte{}, doubleMapKey2Hash...), doubleMapKey2.Bytes()...)...)
+)
+
+func Test_HashStorageDoubleMap_Get(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Get", doubleMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+
+	result, err := target.Get(doubleMapKey1, doubleMapKey2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, storageValue, result)
+	mockHashing.AssertCalled(t, "Blake128", doubleMapKey1.Bytes())
+	mockHashing.AssertCalled(t, "Twox64", doubleMapKey2.Bytes())
+	mockStorage.AssertCalled(t, "Get", doubleMapKey)
+}
+
+func Test_HashStorageDoubleMap_Exists(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Exists", doubleMapKey).Return(true)
+
+	assert.True(t, target.Exists(doubleMapKey1, doubleMapKey2))
+	mockStorage.AssertCalled(t, "Exists", doubleMapKey)
+}
+
+func Test_HashStorageDoubleMap_Put(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Set", doubleMapKey, storageValue.Bytes()).Return()
+
+	target.Put(doubleMapKey1, doubleMapKey2, storageValue)
+
+	mockStorage.AssertCalled(t, "Set", doubleMapKey, storageValue.Bytes())
+}
+
+func Test_HashStorageDoubleMap_Take(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Get", doubleMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Clear", doubleMapKey).Return()
+
+	result, err := target.Take(doubleMapKey1, doubleMapKey2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, storageValue, result)
+	mockStorage.AssertCalled(t, "Clear", doubleMapKey)
+}
+
+func Test_HashStorageDoubleMap_Remove(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Clear", doubleMapKey).Return()
+
+	target.Remove(doubleMapKey1, doubleMapKey2)
+
+	mockStorage.AssertCalled(t, "Clear", doubleMapKey)
+}
+
+func Test_HashStorageDoubleMap_RemovePrefix(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+	limit := sc.U32(7)
+
+	mockStorage.On("ClearPrefix", doubleMapPrefixKey, sc.NewOption[sc.U32](limit).Bytes()).Return()
+
+	target.RemovePrefix(doubleMapKey1, limit)
+
+	mockHashing.AssertNotCalled(t, "Twox64", doubleMapKey2.Bytes())
+	mockStorage.AssertCalled(t, "ClearPrefix", doubleMapPrefixKey, sc.NewOption[sc.U32](limit).Bytes())
+}
+
+func Test_HashStorageDoubleMap_Clear(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+	limit := sc.U32(7)
+
+	mockStorage.On("ClearPrefix", append(append([]byte{}, prefixHash...), nameHash...), sc.NewOption[sc.U32](limit).Bytes()).Return()
+
+	target.Clear(limit)
+
+	mockStorage.AssertCalled(t, "ClearPrefix", append(append([]byte{}, prefixHash...), nameHash...), sc.NewOption[sc.U32](limit).Bytes())
+}
+
+func Test_HashStorageDoubleMap_Mutate(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+	expectedResult := sc.NewU128(3)
+
+	mockStorage.On("Get", doubleMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Set", doubleMapKey, sc.U32(6).Bytes()).Return()
+
+	result, err := target.Mutate(doubleMapKey1, doubleMapKey2, func(v *sc.U32) (sc.Encodable, error) {
+		*v = *v + 1
+		return expectedResult, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	mockStorage.AssertCalled(t, "Set", doubleMapKey, sc.U32(6).Bytes())
+}
+
+func Test_HashStorageDoubleMap_Mutate_Error(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Get", doubleMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+
+	_, err := target.Mutate(doubleMapKey1, doubleMapKey2, func(v *sc.U32) (sc.Encodable, error) {
+		return nil, errPanic
+	})
+
+	assert.Equal(t, errPanic, err)
+	mockStorage.AssertNotCalled(t, "Set", mock.Anything, mock.Anything)
+}
+
+func Test_HashStorageDoubleMap_TryMutateExists_Remove(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Get", doubleMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Clear", doubleMapKey).Return()
+
+	_, err := target.TryMutateExists(doubleMapKey1, doubleMapKey2, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		assert.Equal(t, sc.NewOption[sc.U32](storageValue), *option)
+		*option = sc.NewOption[sc.U32](nil)
+		return nil, nil
+	})
+
+	assert.NoError(t, err)
+	mockStorage.AssertCalled(t, "Clear", doubleMapKey)
+}
+
+func Test_HashStorageDoubleMap_TryMutateExists_Insert(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+
+	mockStorage.On("Get", doubleMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Set", doubleMapKey, storageValue.Bytes()).Return()
+
+	_, err := target.TryMutateExists(doubleMapKey1, doubleMapKey2, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		assert.False(t, option.HasValue)
+		*option = sc.NewOption[sc.U32](storageValue)
+		return nil, nil
+	})
+
+	assert.NoError(t, err)
+	mockStorage.AssertCalled(t, "Set", doubleMapKey, storageValue.Bytes())
+}
+
+func setupHashStorageDoubleMap() HashStorageDoubleMap[sc.U64, sc.U32, sc.U32] {
+	mockHashing = new(mocks.IoHashing)
+	mockStorage = new(mocks.IoStorage)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Blake128", doubleMapKey1.Bytes()).Return(doubleMapKey1Hash)
+	mockHashing.On("Twox64", doubleMapKey2.Bytes()).Return(doubleMapKey2Hash)
+
+	target := NewHashStorageDoubleMap[sc.U64, sc.U32, sc.U32](
+		prefix,
+		name,
+		hasherBlake128Concat{mockHashing},
+		hasherTwox64Concat{mockHashing},
+		sc.DecodeU32,
+		decodeFunc,
+	).(HashStorageDoubleMap[sc.U64, sc.U32, sc.U32])
+	target.hashing = mockHashing
+	target.storage = mockStorage
+
+	return target
+}
+
+func Test_HashStorageDoubleMap_IterPrefix(t *testing.T) {
+	target := setupHashStorageDoubleMap()
+	entryKey := append(append(append([]byte{}, doubleMapPrefixKey...), make([]byte, 8)...), sc.U32(5).Bytes()...)
+
+	mockStorage.On("NextKey", doubleMapPrefixKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(entryKey)), nil)
+	mockStorage.On("NextKey", entryKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Get", entryKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+
+	keys := []sc.U32{}
+	err := target.IterPrefix(doubleMapKey1, func(k2 sc.U32, v sc.U32) error {
+		keys = append(keys, k2)
+		assert.Equal(t, storageValue, v)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sc.U32{5}, keys)
+}
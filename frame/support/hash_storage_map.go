@@ -10,26 +10,37 @@ import (
 // HashStorageMap is a key-value storage map, which takes `prefix` and `name` that are hashed using hashing.Twox128 and appended before each key value.
 type HashStorageMap[K, V sc.Encodable] struct {
 	baseStorage[V]
-	prefix      []byte
-	name        []byte
-	keyHashFunc func([]byte) []byte
-	decodeFunc  func(buffer *bytes.Buffer) (V, error)
-	hashing     io.Hashing
+	prefix        []byte
+	name          []byte
+	hasher        StorageHasher
+	decodeKeyFunc func(buffer *bytes.Buffer) (K, error)
+	decodeFunc    func(buffer *bytes.Buffer) (V, error)
+	hashing       io.Hashing
 }
 
-func NewHashStorageMap[K, V sc.Encodable](prefix []byte, name []byte, keyHashFunc func([]byte) []byte, decodeFunc func(buffer *bytes.Buffer) (V, error)) StorageMap[K, V] {
+func NewHashStorageMap[K, V sc.Encodable](prefix []byte, name []byte, hasher StorageHasher, decodeKeyFunc func(buffer *bytes.Buffer) (K, error), decodeFunc func(buffer *bytes.Buffer) (V, error)) StorageMap[K, V] {
+	return NewHashStorageMapWithQuery(prefix, name, hasher, decodeKeyFunc, decodeFunc, NewOptionQuery[V]())
+}
+
+func NewHashStorageMapWithQuery[K, V sc.Encodable](prefix []byte, name []byte, hasher StorageHasher, decodeKeyFunc func(buffer *bytes.Buffer) (K, error), decodeFunc func(buffer *bytes.Buffer) (V, error), queryKind QueryKind[V]) StorageMap[K, V] {
 	return HashStorageMap[K, V]{
-		newBaseStorage[V](decodeFunc, nil),
+		newBaseStorage[V](decodeFunc, queryKind),
 		prefix,
 		name,
-		keyHashFunc,
+		hasher,
+		decodeKeyFunc,
 		decodeFunc,
 		io.NewHashing(),
 	}
 }
 
 func (hsm HashStorageMap[K, V]) Get(k K) (V, error) {
-	return hsm.baseStorage.getDecode(hsm.key(k))
+	return hsm.baseStorage.get(hsm.key(k))
+}
+
+// TryGet returns the value stored under `k`, if any.
+func (hsm HashStorageMap[K, V]) TryGet(k K) (sc.Option[V], error) {
+	return hsm.baseStorage.getOption(hsm.key(k))
 }
 
 func (hsm HashStorageMap[K, V]) Exists(k K) bool {
@@ -53,10 +64,65 @@ func (hsm HashStorageMap[K, V]) Remove(k K) {
 }
 
 func (hsm HashStorageMap[K, V]) Clear(limit sc.U32) {
-	prefixHash := hsm.hashing.Twox128(hsm.prefix)
-	nameHash := hsm.hashing.Twox128(hsm.name)
+	hsm.baseStorage.clearPrefix(hsm.storagePrefix(), limit)
+}
+
+// Iter visits all entries of the map in the order of their storage keys.
+// The order is not related to the order of the keys themselves, unless the hasher is identity.
+func (hsm HashStorageMap[K, V]) Iter(f func(k K, v V) error) error {
+	prefix := hsm.storagePrefix()
+	return iterateKeys(hsm.baseStorage.storage, prefix, prefix, hsm.visitEntry(prefix, f))
+}
+
+// IterKeys visits all keys of the map, without reading the values.
+func (hsm HashStorageMap[K, V]) IterKeys(f func(k K) error) error {
+	prefix := hsm.storagePrefix()
+	return iterateKeys(hsm.baseStorage.storage, prefix, prefix, func(key []byte) error {
+		k, err := hsm.decodeKey(prefix, key)
+		if err != nil {
+			return err
+		}
+		return f(k)
+	})
+}
+
+// IterFromKey visits the entries of the map which follow the entry of `start`.
+// It allows resuming an iteration, which was stopped at `start`, in a later block.
+func (hsm HashStorageMap[K, V]) IterFromKey(start K, f func(k K, v V) error) error {
+	prefix := hsm.storagePrefix()
+	return iterateKeys(hsm.baseStorage.storage, prefix, hsm.key(start), hsm.visitEntry(prefix, f))
+}
+
+// IterPrefix visits the entries of the map whose encoded key starts with `prefix`.
+// The key must be recoverable from the storage key, as it is with the concat hashers. Since the hash
+// precedes the key, matching entries are not adjacent in storage and all entries of the map are walked.
+func (hsm HashStorageMap[K, V]) IterPrefix(prefix []byte, f func(k K, v V) error) error {
+	storagePrefix := hsm.storagePrefix()
+	return iterateKeys(hsm.baseStorage.storage, storagePrefix, storagePrefix, func(key []byte) error {
+		if !bytes.HasPrefix(hsm.hasher.Reverse(key[len(storagePrefix):]), prefix) {
+			return nil
+		}
+		return hsm.visitEntry(storagePrefix, f)(key)
+	})
+}
+
+// Drain removes all entries of the map, visiting each of them after its removal.
+// If the iteration is stopped, the remaining entries are kept.
+func (hsm HashStorageMap[K, V]) Drain(f func(k K, v V) error) error {
+	prefix := hsm.storagePrefix()
+	return iterateKeys(hsm.baseStorage.storage, prefix, prefix, func(key []byte) error {
+		k, err := hsm.decodeKey(prefix, key)
+		if err != nil {
+			return err
+		}
+
+		v, err := hsm.baseStorage.takeDecode(key)
+		if err != nil {
+			return err
+		}
 
-	hsm.baseStorage.storage.ClearPrefix(append(prefixHash, nameHash...), sc.NewOption[sc.U32](limit).Bytes())
+		return f(k, v)
+	})
 }
 
 func (hsm HashStorageMap[K, V]) Mutate(k K, f func(*V) (sc.Encodable, error)) (sc.Encodable, error) {
@@ -73,40 +139,41 @@ func (hsm HashStorageMap[K, V]) Mutate(k K, f func(*V) (sc.Encodable, error)) (s
 	return result, err
 }
 
+// TryMutateExists applies `f` to the optional value stored under `k`, unless `f` returns an error.
+// The entry is removed if `f` leaves the option empty.
 func (hsm HashStorageMap[K, V]) TryMutateExists(k K, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error) {
-	// TODO: This should get the storage value and try to decode it. It should return an Option<value>
-	// If it cannot decode it, return Empty Option.
-	// If it can decode it, return Option with the value.
-	v, err := hsm.Get(k)
-	if err != nil {
-		return nil, err
-	}
-	option := sc.NewOption[V](v)
+	return hsm.baseStorage.tryMutateExists(hsm.key(k), f)
+}
 
-	result, err := f(&option)
-	if err == nil {
-		if option.HasValue {
-			hsm.Put(k, option.Value)
+func (hsm HashStorageMap[K, V]) visitEntry(prefix []byte, f func(k K, v V) error) func(key []byte) error {
+	return func(key []byte) error {
+		k, err := hsm.decodeKey(prefix, key)
+		if err != nil {
+			return err
 		}
-		// }
-		// else {
-		// hsm.Remove(k)
-		// }
-	}
 
-	return result, err
+		v, err := hsm.baseStorage.getDecode(key)
+		if err != nil {
+			return err
+		}
+
+		return f(k, v)
+	}
 }
 
-func (hsm HashStorageMap[K, V]) key(key K) []byte {
+func (hsm HashStorageMap[K, V]) storagePrefix() []byte {
 	prefixHash := hsm.hashing.Twox128(hsm.prefix)
 	nameHash := hsm.hashing.Twox128(hsm.name)
 
-	keyBytes := key.Bytes()
-	keyHash := hsm.keyHashFunc(keyBytes)
+	return append(prefixHash, nameHash...)
+}
 
-	concatKey := append(prefixHash, nameHash...)
-	concatKey = append(concatKey, keyHash...)
-	concatKey = append(concatKey, keyBytes...)
+func (hsm HashStorageMap[K, V]) key(key K) []byte {
+	return append(hsm.storagePrefix(), hsm.hasher.Hash(key.Bytes())...)
+}
 
-	return concatKey
+// decodeKey recovers the key of the map from the storage key of one of its entries.
+func (hsm HashStorageMap[K, V]) decodeKey(prefix []byte, key []byte) (K, error) {
+	buffer := bytes.NewBuffer(hsm.hasher.Reverse(key[len(prefix):]))
+	return hsm.decodeKeyFunc(buffer)
 }
@@ -46,6 +46,35 @@ func Test_HashStorageMap_Get(t *testing.T) {
 	mockStorage.AssertCalled(t, "Get", concatHashStorageMapKeyKey)
 }
 
+func Test_HashStorageMap_TryGet(t *testing.T) {
+	target := setupHashStorageMap()
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Twox64", keyValue.Bytes()).Return(keyValueHash)
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	result, err := target.TryGet(keyValue)
+	assert.NoError(t, err)
+
+	assert.Equal(t, sc.NewOption[sc.U32](nil), result)
+	mockStorage.AssertCalled(t, "Get", concatHashStorageMapKeyKey)
+}
+
+func Test_HashStorageMap_Get_ResultQuery(t *testing.T) {
+	target := setupHashStorageMap()
+	target.queryKind = NewResultQuery[sc.U32](errPanic)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Twox64", keyValue.Bytes()).Return(keyValueHash)
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	_, err := target.Get(keyValue)
+
+	assert.Equal(t, errPanic, err)
+}
+
 func Test_HashStorageMap_Exists(t *testing.T) {
 	target := setupHashStorageMap()
 
@@ -258,18 +287,39 @@ func Test_HashStorageMap_TryMutateExists(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, expectedResult, result)
-	mockHashing.AssertNumberOfCalls(t, "Twox128", 4)
+	mockHashing.AssertNumberOfCalls(t, "Twox128", 2)
 	mockHashing.AssertCalled(t, "Twox128", prefix)
 	mockHashing.AssertCalled(t, "Twox128", name)
-	mockHashing.AssertNumberOfCalls(t, "Twox64", 2)
+	mockHashing.AssertNumberOfCalls(t, "Twox64", 1)
 	mockHashing.AssertCalled(t, "Twox64", keyValue.Bytes())
 	mockStorage.AssertCalled(t, "Get", concatHashStorageMapKeyKey)
 	mockStorage.AssertCalled(t, "Set", concatHashStorageMapKeyKey, storageValue.Bytes())
 }
 
+func Test_HashStorageMap_TryMutateExists_Remove(t *testing.T) {
+	target := setupHashStorageMap()
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Twox64", keyValue.Bytes()).Return(keyValueHash)
+	mockStorage.On("Get", concatHashStorageMapKeyKey).Return(
+		sc.NewOption[sc.Sequence[sc.U8]](
+			sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+	mockStorage.On("Clear", concatHashStorageMapKeyKey).Return()
+
+	_, err := target.TryMutateExists(keyValue, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		*option = sc.NewOption[sc.U32](nil)
+		return nil, nil
+	})
+	assert.NoError(t, err)
+
+	mockStorage.AssertCalled(t, "Clear", concatHashStorageMapKeyKey)
+	mockStorage.AssertNotCalled(t, "Set", mock.Anything, mock.Anything)
+}
+
 func Test_HashStorageMap_TryMutateExists_Error(t *testing.T) {
 	target := setupHashStorageMap()
-	expectOption := sc.NewOption[sc.U32](sc.U32(0))
+	expectOption := sc.NewOption[sc.U32](nil)
 	expectResult := sc.NewU128(5)
 	expectedErr := errPanic
 
@@ -291,6 +341,7 @@ func Test_HashStorageMap_TryMutateExists_Error(t *testing.T) {
 	mockHashing.AssertNumberOfCalls(t, "Twox64", 1)
 	mockHashing.AssertCalled(t, "Twox64", keyValue.Bytes())
 	mockStorage.AssertCalled(t, "Get", concatHashStorageMapKeyKey)
+	mockStorage.AssertNotCalled(t, "Clear", mock.Anything)
 }
 
 func Test_HashStorageMap_TryMutateExists_GetError(t *testing.T) {
@@ -336,9 +387,170 @@ func setupHashStorageMap() HashStorageMap[sc.U64, sc.U32] {
 	mockHashing = new(mocks.IoHashing)
 	mockStorage = new(mocks.IoStorage)
 
-	target := NewHashStorageMap[sc.U64, sc.U32](prefix, name, mockHashing.Twox64, decodeFunc).(HashStorageMap[sc.U64, sc.U32])
+	target := NewHashStorageMap[sc.U64, sc.U32](prefix, name, hasherTwox64Concat{mockHashing}, sc.DecodeU64, decodeFunc).(HashStorageMap[sc.U64, sc.U32])
 	target.hashing = mockHashing
 	target.storage = mockStorage
 
 	return target
 }
+
+func Test_HashStorageMap_Iter(t *testing.T) {
+	target := setupHashStorageMap()
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(1).Bytes()...)
+	key2 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(2).Bytes()...)
+	otherKey := []byte("other")
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+	mockStorage.On("NextKey", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key2)), nil)
+	mockStorage.On("NextKey", key2).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(otherKey)), nil)
+	mockStorage.On("Get", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(10).Bytes())), nil)
+	mockStorage.On("Get", key2).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(20).Bytes())), nil)
+
+	keys := []sc.U64{}
+	values := []sc.U32{}
+	err := target.Iter(func(k sc.U64, v sc.U32) error {
+		keys = append(keys, k)
+		values = append(values, v)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sc.U64{1, 2}, keys)
+	assert.Equal(t, []sc.U32{10, 20}, values)
+	mockStorage.AssertNumberOfCalls(t, "NextKey", 3)
+}
+
+func Test_HashStorageMap_IterKeys_Stop(t *testing.T) {
+	target := setupHashStorageMap()
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(1).Bytes()...)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+
+	keys := []sc.U64{}
+	err := target.IterKeys(func(k sc.U64) error {
+		keys = append(keys, k)
+		return ErrStopIteration
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sc.U64{1}, keys)
+	mockStorage.AssertNumberOfCalls(t, "NextKey", 1)
+	mockStorage.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_HashStorageMap_IterFromKey(t *testing.T) {
+	target := setupHashStorageMap()
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Twox64", keyValue.Bytes()).Return(keyValueHash)
+	mockStorage.On("NextKey", concatHashStorageMapKeyKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	err := target.IterFromKey(keyValue, func(k sc.U64, v sc.U32) error {
+		return errPanic
+	})
+
+	assert.NoError(t, err)
+	mockStorage.AssertCalled(t, "NextKey", concatHashStorageMapKeyKey)
+}
+
+func Test_HashStorageMap_IterPrefix(t *testing.T) {
+	target := setupHashStorageMap()
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(1).Bytes()...)
+	key2 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(2).Bytes()...)
+	key257 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(257).Bytes()...)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+	mockStorage.On("NextKey", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key2)), nil)
+	mockStorage.On("NextKey", key2).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key257)), nil)
+	mockStorage.On("NextKey", key257).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Get", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(10).Bytes())), nil)
+	mockStorage.On("Get", key257).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(30).Bytes())), nil)
+
+	keys := []sc.U64{}
+	values := []sc.U32{}
+	err := target.IterPrefix([]byte{1}, func(k sc.U64, v sc.U32) error {
+		keys = append(keys, k)
+		values = append(values, v)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sc.U64{1, 257}, keys)
+	assert.Equal(t, []sc.U32{10, 30}, values)
+	mockStorage.AssertNotCalled(t, "Get", key2)
+}
+
+func Test_HashStorageMap_IterPrefix_Blake128Concat(t *testing.T) {
+	target := setupHashStorageMap()
+	target.hasher = hasherBlake128Concat{mockHashing}
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 16)...), sc.U64(1).Bytes()...)
+	key2 := append(append(append([]byte{}, storagePrefix...), make([]byte, 16)...), sc.U64(2).Bytes()...)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+	mockStorage.On("NextKey", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key2)), nil)
+	mockStorage.On("NextKey", key2).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Get", key2).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(20).Bytes())), nil)
+
+	keys := []sc.U64{}
+	err := target.IterPrefix(sc.U64(2).Bytes(), func(k sc.U64, v sc.U32) error {
+		keys = append(keys, k)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sc.U64{2}, keys)
+	mockStorage.AssertNotCalled(t, "Get", key1)
+}
+
+func Test_HashStorageMap_Drain(t *testing.T) {
+	target := setupHashStorageMap()
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(1).Bytes()...)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+	mockStorage.On("NextKey", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Get", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(10).Bytes())), nil)
+	mockStorage.On("Clear", key1).Return()
+
+	values := []sc.U32{}
+	err := target.Drain(func(k sc.U64, v sc.U32) error {
+		values = append(values, v)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sc.U32{10}, values)
+	mockStorage.AssertCalled(t, "Clear", key1)
+}
+
+func Test_HashStorageMap_Iter_Error(t *testing.T) {
+	target := setupHashStorageMap()
+	storagePrefix := append(append([]byte{}, prefixHash...), nameHash...)
+	key1 := append(append(append([]byte{}, storagePrefix...), make([]byte, 8)...), sc.U64(1).Bytes()...)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockStorage.On("NextKey", storagePrefix).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(key1)), nil)
+	mockStorage.On("Get", key1).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(10).Bytes())), nil)
+
+	err := target.Iter(func(k sc.U64, v sc.U32) error {
+		return errPanic
+	})
+
+	assert.Equal(t, errPanic, err)
+}
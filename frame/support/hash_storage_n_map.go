@@ -0,0 +1,102 @@
+package support
+
+import (
+	"bytes"
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+// HashStorageNMap is a storage map with an arbitrary number of keys. The storage key is composed of `prefix` and `name`
+// hashed using hashing.Twox128, followed by each key component hashed with the hasher at the same position.
+type HashStorageNMap[V sc.Encodable] struct {
+	baseStorage[V]
+	prefix  []byte
+	name    []byte
+	hashers []StorageHasher
+	hashing io.Hashing
+}
+
+func NewHashStorageNMap[V sc.Encodable](prefix []byte, name []byte, hashers []StorageHasher, decodeFunc func(buffer *bytes.Buffer) (V, error)) StorageNMap[V] {
+	return HashStorageNMap[V]{
+		newBaseStorage[V](decodeFunc, NewOptionQuery[V]()),
+		prefix,
+		name,
+		hashers,
+		io.NewHashing(),
+	}
+}
+
+func (hsnm HashStorageNMap[V]) Get(keys sc.VaryingData) (V, error) {
+	return hsnm.baseStorage.get(hsnm.key(keys))
+}
+
+func (hsnm HashStorageNMap[V]) Exists(keys sc.VaryingData) bool {
+	return hsnm.baseStorage.exists(hsnm.key(keys))
+}
+
+func (hsnm HashStorageNMap[V]) Put(keys sc.VaryingData, value V) {
+	hsnm.baseStorage.put(hsnm.key(keys), value)
+}
+
+func (hsnm HashStorageNMap[V]) Append(keys sc.VaryingData, value V) {
+	hsnm.baseStorage.append(hsnm.key(keys), value)
+}
+
+func (hsnm HashStorageNMap[V]) Take(keys sc.VaryingData) (V, error) {
+	return hsnm.baseStorage.take(hsnm.key(keys))
+}
+
+func (hsnm HashStorageNMap[V]) TakeBytes(keys sc.VaryingData) ([]byte, error) {
+	return hsnm.baseStorage.takeBytes(hsnm.key(keys))
+}
+
+func (hsnm HashStorageNMap[V]) Remove(keys sc.VaryingData) {
+	hsnm.baseStorage.clear(hsnm.key(keys))
+}
+
+// RemovePrefix removes up to `limit` values stored under the leading key components `partialKeys`.
+func (hsnm HashStorageNMap[V]) RemovePrefix(partialKeys sc.VaryingData, limit sc.U32) {
+	hsnm.baseStorage.clearPrefix(hsnm.partialKey(partialKeys), limit)
+}
+
+func (hsnm HashStorageNMap[V]) Clear(limit sc.U32) {
+	hsnm.baseStorage.clearPrefix(hsnm.storagePrefix(), limit)
+}
+
+func (hsnm HashStorageNMap[V]) Mutate(keys sc.VaryingData, f func(*V) (sc.Encodable, error)) (sc.Encodable, error) {
+	return hsnm.baseStorage.mutate(hsnm.key(keys), f)
+}
+
+func (hsnm HashStorageNMap[V]) TryMutateExists(keys sc.VaryingData, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error) {
+	return hsnm.baseStorage.tryMutateExists(hsnm.key(keys), f)
+}
+
+func (hsnm HashStorageNMap[V]) storagePrefix() []byte {
+	prefixHash := hsnm.hashing.Twox128(hsnm.prefix)
+	nameHash := hsnm.hashing.Twox128(hsnm.name)
+
+	return append(prefixHash, nameHash...)
+}
+
+func (hsnm HashStorageNMap[V]) key(keys sc.VaryingData) []byte {
+	if len(keys) != len(hsnm.hashers) {
+		panic(fmt.Sprintf("storage n map expects [%d] keys, got [%d]", len(hsnm.hashers), len(keys)))
+	}
+
+	return hsnm.partialKey(keys)
+}
+
+func (hsnm HashStorageNMap[V]) partialKey(keys sc.VaryingData) []byte {
+	if len(keys) > len(hsnm.hashers) {
+		panic(fmt.Sprintf("storage n map expects at most [%d] keys, got [%d]", len(hsnm.hashers), len(keys)))
+	}
+
+	concatKey := hsnm.storagePrefix()
+	for i, key := range keys {
+		concatKey = append(concatKey, hsnm.hashers[i].Hash(key.Bytes())...)
+	}
+
+	return concatKey
+}
@@ -0,0 +1,109 @@
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	nMapKeys = sc.NewVaryingData(sc.U64(1), sc.U32(2), sc.U8(3))
+
+	nMapPartialKey = append(
+		append(append([]byte{}, prefixHash...), nameHash...),
+		append(append([]byte{}, doubleMapKey1Hash...), sc.U64(1).Bytes()...)...)
+	nMapKey = append(
+		append(append([]byte{}, nMapPartialKey...), append(append([]byte{}, doubleMapKey2Hash...), sc.U32(2).Bytes()...)...),
+		sc.U8(3).Bytes()...)
+)
+
+func Test_HashStorageNMap_Get(t *testing.T) {
+	target := setupHashStorageNMap()
+
+	mockStorage.On("Get", nMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(storageValue.Bytes())), nil)
+
+	result, err := target.Get(nMapKeys)
+
+	assert.NoError(t, err)
+	assert.Equal(t, storageValue, result)
+	mockStorage.AssertCalled(t, "Get", nMapKey)
+}
+
+func Test_HashStorageNMap_Put(t *testing.T) {
+	target := setupHashStorageNMap()
+
+	mockStorage.On("Set", nMapKey, storageValue.Bytes()).Return()
+
+	target.Put(nMapKeys, storageValue)
+
+	mockStorage.AssertCalled(t, "Set", nMapKey, storageValue.Bytes())
+}
+
+func Test_HashStorageNMap_Remove(t *testing.T) {
+	target := setupHashStorageNMap()
+
+	mockStorage.On("Clear", nMapKey).Return()
+
+	target.Remove(nMapKeys)
+
+	mockStorage.AssertCalled(t, "Clear", nMapKey)
+}
+
+func Test_HashStorageNMap_RemovePrefix(t *testing.T) {
+	target := setupHashStorageNMap()
+	limit := sc.U32(7)
+
+	mockStorage.On("ClearPrefix", nMapPartialKey, sc.NewOption[sc.U32](limit).Bytes()).Return()
+
+	target.RemovePrefix(sc.NewVaryingData(sc.U64(1)), limit)
+
+	mockStorage.AssertCalled(t, "ClearPrefix", nMapPartialKey, sc.NewOption[sc.U32](limit).Bytes())
+}
+
+func Test_HashStorageNMap_TryMutateExists(t *testing.T) {
+	target := setupHashStorageNMap()
+
+	mockStorage.On("Get", nMapKey).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockStorage.On("Clear", nMapKey).Return()
+
+	_, err := target.TryMutateExists(nMapKeys, func(option *sc.Option[sc.U32]) (sc.Encodable, error) {
+		return nil, nil
+	})
+
+	assert.NoError(t, err)
+	mockStorage.AssertCalled(t, "Clear", nMapKey)
+}
+
+func Test_HashStorageNMap_InvalidKeys(t *testing.T) {
+	target := setupHashStorageNMap()
+
+	assert.PanicsWithValue(t, "storage n map expects [3] keys, got [1]", func() {
+		target.Get(sc.NewVaryingData(sc.U64(1)))
+	})
+	assert.PanicsWithValue(t, "storage n map expects at most [3] keys, got [4]", func() {
+		target.RemovePrefix(sc.NewVaryingData(sc.U64(1), sc.U32(2), sc.U8(3), sc.U8(4)), 1)
+	})
+}
+
+func setupHashStorageNMap() HashStorageNMap[sc.U32] {
+	mockHashing = new(mocks.IoHashing)
+	mockStorage = new(mocks.IoStorage)
+
+	mockHashing.On("Twox128", prefix).Return(prefixHash)
+	mockHashing.On("Twox128", name).Return(nameHash)
+	mockHashing.On("Blake128", sc.U64(1).Bytes()).Return(doubleMapKey1Hash)
+	mockHashing.On("Twox64", sc.U32(2).Bytes()).Return(doubleMapKey2Hash)
+
+	target := NewHashStorageNMap[sc.U32](
+		prefix,
+		name,
+		[]StorageHasher{hasherBlake128Concat{mockHashing}, hasherTwox64Concat{mockHashing}, hasherIdentity{}},
+		decodeFunc,
+	).(HashStorageNMap[sc.U32])
+	target.hashing = mockHashing
+	target.storage = mockStorage
+
+	return target
+}
@@ -20,8 +20,15 @@ func NewHashStorageValue[T sc.Encodable](prefix []byte, name []byte, decodeFunc
 }
 
 func NewHashStorageValueWithDefault[T sc.Encodable](prefix []byte, name []byte, decodeFunc func(buffer *bytes.Buffer) (T, error), defaultValue *T) StorageValue[T] {
+	if defaultValue == nil {
+		return NewHashStorageValueWithQuery(prefix, name, decodeFunc, NewOptionQuery[T]())
+	}
+	return NewHashStorageValueWithQuery(prefix, name, decodeFunc, NewValueQuery(*defaultValue))
+}
+
+func NewHashStorageValueWithQuery[T sc.Encodable](prefix []byte, name []byte, decodeFunc func(buffer *bytes.Buffer) (T, error), queryKind QueryKind[T]) StorageValue[T] {
 	return HashStorageValue[T]{
-		baseStorage: newBaseStorage[T](decodeFunc, defaultValue),
+		baseStorage: newBaseStorage[T](decodeFunc, queryKind),
 		prefix:      prefix,
 		name:        name,
 		hashing:     io.NewHashing(),
@@ -32,6 +39,10 @@ func (hsv HashStorageValue[T]) Get() (T, error) {
 	return hsv.baseStorage.get(hsv.key())
 }
 
+func (hsv HashStorageValue[T]) TryGet() (sc.Option[T], error) {
+	return hsv.baseStorage.getOption(hsv.key())
+}
+
 func (hsv HashStorageValue[T]) GetBytes() (sc.Option[sc.Sequence[sc.U8]], error) {
 	return hsv.baseStorage.getBytes(hsv.key())
 }
@@ -51,7 +51,7 @@ func Test_HashStorageValue_Get_Nil(t *testing.T) {
 
 func Test_HashStorageValue_Get_OnEmpty(t *testing.T) {
 	target := setupHashStorageValue()
-	target.defaultValue = &defaultValue
+	target.queryKind = NewValueQuery(defaultValue)
 
 	mockHashing.On("Twox128", prefix).Return(prefixHash)
 	mockHashing.On("Twox128", name).Return(nameHash)
@@ -69,7 +69,7 @@ func Test_HashStorageValue_Get_OnEmpty(t *testing.T) {
 
 func Test_HashStorageValue_Get_Default_HasStorageValue(t *testing.T) {
 	target := setupHashStorageValue()
-	target.defaultValue = &defaultValue
+	target.queryKind = NewValueQuery(defaultValue)
 
 	mockHashing.On("Twox128", prefix).Return(prefixHash)
 	mockHashing.On("Twox128", name).Return(nameHash)
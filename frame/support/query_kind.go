@@ -0,0 +1,63 @@
+package support
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// QueryKind defines the result of querying a storage value or map entry, which does not exist.
+type QueryKind[T sc.Encodable] interface {
+	// OnEmpty returns the result of a query for a missing value.
+	OnEmpty() (T, error)
+	// Modifier returns the storage entry modifier, as described in the metadata.
+	Modifier() primitives.MetadataModuleStorageEntryModifier
+}
+
+// optionQuery returns the zero value for a missing value. Use TryGet to distinguish it from a stored zero value.
+type optionQuery[T sc.Encodable] struct{}
+
+func NewOptionQuery[T sc.Encodable]() QueryKind[T] {
+	return optionQuery[T]{}
+}
+
+func (q optionQuery[T]) OnEmpty() (T, error) {
+	return *new(T), nil
+}
+
+func (q optionQuery[T]) Modifier() primitives.MetadataModuleStorageEntryModifier {
+	return primitives.MetadataModuleStorageEntryModifierOptional
+}
+
+// valueQuery returns a default value for a missing value.
+type valueQuery[T sc.Encodable] struct {
+	defaultValue T
+}
+
+func NewValueQuery[T sc.Encodable](defaultValue T) QueryKind[T] {
+	return valueQuery[T]{defaultValue}
+}
+
+func (q valueQuery[T]) OnEmpty() (T, error) {
+	return q.defaultValue, nil
+}
+
+func (q valueQuery[T]) Modifier() primitives.MetadataModuleStorageEntryModifier {
+	return primitives.MetadataModuleStorageEntryModifierDefault
+}
+
+// resultQuery returns an error for a missing value.
+type resultQuery[T sc.Encodable] struct {
+	err error
+}
+
+func NewResultQuery[T sc.Encodable](err error) QueryKind[T] {
+	return resultQuery[T]{err}
+}
+
+func (q resultQuery[T]) OnEmpty() (T, error) {
+	return *new(T), q.err
+}
+
+func (q resultQuery[T]) Modifier() primitives.MetadataModuleStorageEntryModifier {
+	return primitives.MetadataModuleStorageEntryModifierOptional
+}
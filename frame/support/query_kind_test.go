@@ -0,0 +1,38 @@
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OptionQuery(t *testing.T) {
+	target := NewOptionQuery[sc.U32]()
+
+	result, err := target.OnEmpty()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(0), result)
+	assert.Equal(t, primitives.MetadataModuleStorageEntryModifierOptional, target.Modifier())
+}
+
+func Test_ValueQuery(t *testing.T) {
+	target := NewValueQuery(sc.U32(7))
+
+	result, err := target.OnEmpty()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(7), result)
+	assert.Equal(t, primitives.MetadataModuleStorageEntryModifier(primitives.MetadataModuleStorageEntryModifierDefault), target.Modifier())
+}
+
+func Test_ResultQuery(t *testing.T) {
+	target := NewResultQuery[sc.U32](errPanic)
+
+	_, err := target.OnEmpty()
+
+	assert.Equal(t, errPanic, err)
+	assert.Equal(t, primitives.MetadataModuleStorageEntryModifierOptional, target.Modifier())
+}
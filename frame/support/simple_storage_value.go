@@ -14,7 +14,7 @@ type SimpleStorageValue[T sc.Encodable] struct {
 
 func NewSimpleStorageValue[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) (T, error)) StorageValue[T] {
 	return SimpleStorageValue[T]{
-		baseStorage: newBaseStorage[T](decodeFunc, nil),
+		baseStorage: newBaseStorage[T](decodeFunc, NewOptionQuery[T]()),
 		key:         key,
 	}
 }
@@ -23,6 +23,10 @@ func (ssv SimpleStorageValue[T]) Get() (T, error) {
 	return ssv.baseStorage.get(ssv.key)
 }
 
+func (ssv SimpleStorageValue[T]) TryGet() (sc.Option[T], error) {
+	return ssv.baseStorage.getOption(ssv.key)
+}
+
 func (ssv SimpleStorageValue[T]) GetBytes() (sc.Option[sc.Sequence[sc.U8]], error) {
 	return ssv.baseStorage.getBytes(ssv.key)
 }
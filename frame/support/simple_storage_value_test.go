@@ -43,7 +43,7 @@ func Test_SimpleStorageValue_Get_Nil(t *testing.T) {
 
 func Test_SimpleStorageValue_Get_OnEmpty(t *testing.T) {
 	target := setupSimpleStorageValue()
-	target.defaultValue = &defaultValue
+	target.queryKind = NewValueQuery(defaultValue)
 
 	mockStorage.On("Get", key).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
 
@@ -56,7 +56,7 @@ func Test_SimpleStorageValue_Get_OnEmpty(t *testing.T) {
 
 func Test_SimpleStorageValue_Get_Default_HasStorageValue(t *testing.T) {
 	target := setupSimpleStorageValue()
-	target.defaultValue = &defaultValue
+	target.queryKind = NewValueQuery(defaultValue)
 
 	mockStorage.On("Get", key).Return(
 		sc.NewOption[sc.Sequence[sc.U8]](
@@ -70,6 +70,47 @@ func Test_SimpleStorageValue_Get_Default_HasStorageValue(t *testing.T) {
 	mockStorage.AssertCalled(t, "Get", key)
 }
 
+func Test_SimpleStorageValue_Get_ResultQuery(t *testing.T) {
+	target := setupSimpleStorageValue()
+	target.queryKind = NewResultQuery[sc.U32](errPanic)
+
+	mockStorage.On("Get", key).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	_, err := target.Get()
+
+	assert.Equal(t, errPanic, err)
+	mockStorage.AssertCalled(t, "Get", key)
+}
+
+func Test_SimpleStorageValue_TryGet(t *testing.T) {
+	target := setupSimpleStorageValue()
+	target.queryKind = NewValueQuery(defaultValue)
+
+	mockStorage.On("Get", key).Return(
+		sc.NewOption[sc.Sequence[sc.U8]](
+			sc.BytesToSequenceU8(storageValue.Bytes()),
+		), nil)
+
+	result, err := target.TryGet()
+	assert.NoError(t, err)
+
+	assert.Equal(t, sc.NewOption[sc.U32](storageValue), result)
+	mockStorage.AssertCalled(t, "Get", key)
+}
+
+func Test_SimpleStorageValue_TryGet_Empty(t *testing.T) {
+	target := setupSimpleStorageValue()
+	target.queryKind = NewValueQuery(defaultValue)
+
+	mockStorage.On("Get", key).Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+
+	result, err := target.TryGet()
+	assert.NoError(t, err)
+
+	assert.Equal(t, sc.NewOption[sc.U32](nil), result)
+	mockStorage.AssertCalled(t, "Get", key)
+}
+
 func Test_SimpleStorageValue_GetBytes(t *testing.T) {
 	target := setupSimpleStorageValue()
 	expect := sc.NewOption[sc.Sequence[sc.U8]](nil)
@@ -0,0 +1,18 @@
+package support
+
+import sc "github.com/LimeChain/goscale"
+
+type StorageDoubleMap[K1, K2, V sc.Encodable] interface {
+	Get(k1 K1, k2 K2) (V, error)
+	Exists(k1 K1, k2 K2) bool
+	Put(k1 K1, k2 K2, value V)
+	Append(k1 K1, k2 K2, value V)
+	Take(k1 K1, k2 K2) (V, error)
+	TakeBytes(k1 K1, k2 K2) ([]byte, error)
+	Remove(k1 K1, k2 K2)
+	RemovePrefix(k1 K1, limit sc.U32)
+	IterPrefix(k1 K1, f func(k2 K2, v V) error) error
+	Clear(limit sc.U32)
+	Mutate(k1 K1, k2 K2, f func(v *V) (sc.Encodable, error)) (sc.Encodable, error)
+	TryMutateExists(k1 K1, k2 K2, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error)
+}
@@ -0,0 +1,97 @@
+package support
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageHasher hashes a single key component of a storage map.
+type StorageHasher interface {
+	// Hash returns the final form of the key component, as it is placed in the storage key.
+	Hash(key []byte) []byte
+	// Reverse returns the original key component from its final form, followed by the rest of the storage key.
+	Reverse(hashed []byte) []byte
+	// Metadata returns the hash function, as described in the metadata.
+	Metadata() primitives.MetadataModuleStorageHashFunc
+}
+
+const (
+	blake128HashLen = 16
+	twox64HashLen   = 8
+)
+
+// hasherBlake128Concat hashes the key with hashing.Blake128 and appends the key itself,
+// which makes the key transparent and recoverable when iterating.
+type hasherBlake128Concat struct {
+	hashing io.Hashing
+}
+
+func NewHasherBlake128Concat() StorageHasher {
+	return hasherBlake128Concat{io.NewHashing()}
+}
+
+func (h hasherBlake128Concat) Hash(key []byte) []byte {
+	return append(h.hashing.Blake128(key), key...)
+}
+
+func (h hasherBlake128Concat) Reverse(hashed []byte) []byte {
+	return hashed[blake128HashLen:]
+}
+
+func (h hasherBlake128Concat) Metadata() primitives.MetadataModuleStorageHashFunc {
+	return primitives.MetadataModuleStorageHashFuncMultiBlake128Concat
+}
+
+// hasherTwox64Concat hashes the key with hashing.Twox64 and appends the key itself.
+// It must only be used for keys which cannot be chosen by users.
+type hasherTwox64Concat struct {
+	hashing io.Hashing
+}
+
+func NewHasherTwox64Concat() StorageHasher {
+	return hasherTwox64Concat{io.NewHashing()}
+}
+
+func (h hasherTwox64Concat) Hash(key []byte) []byte {
+	return append(h.hashing.Twox64(key), key...)
+}
+
+func (h hasherTwox64Concat) Reverse(hashed []byte) []byte {
+	return hashed[twox64HashLen:]
+}
+
+func (h hasherTwox64Concat) Metadata() primitives.MetadataModuleStorageHashFunc {
+	return primitives.MetadataModuleStorageHashFuncMultiXX64
+}
+
+// hasherIdentity places the key as it is.
+// It must only be used for keys which are already hashes or cannot be chosen by users.
+type hasherIdentity struct{}
+
+func NewHasherIdentity() StorageHasher {
+	return hasherIdentity{}
+}
+
+func (h hasherIdentity) Hash(key []byte) []byte {
+	return key
+}
+
+func (h hasherIdentity) Reverse(hashed []byte) []byte {
+	return hashed
+}
+
+func (h hasherIdentity) Metadata() primitives.MetadataModuleStorageHashFunc {
+	return primitives.MetadataModuleStorageHashFuncIdentity
+}
+
+// NewMetadataStorageDefinitionMap returns the metadata definition of a storage map, whose keys are hashed with hashers.
+// For maps with more than one hasher, keyTypeId refers to the tuple of all key components.
+func NewMetadataStorageDefinitionMap(keyTypeId int, valueTypeId int, hashers ...StorageHasher) primitives.MetadataModuleStorageEntryDefinition {
+	hashFuncs := sc.Sequence[primitives.MetadataModuleStorageHashFunc]{}
+	for _, hasher := range hashers {
+		hashFuncs = append(hashFuncs, hasher.Metadata())
+	}
+
+	return primitives.NewMetadataModuleStorageEntryDefinitionMap(hashFuncs, sc.ToCompact(keyTypeId), sc.ToCompact(valueTypeId))
+}
@@ -0,0 +1,44 @@
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StorageHasher_Hash(t *testing.T) {
+	hashing := new(mocks.IoHashing)
+	hashing.On("Blake128", []byte{1, 2}).Return([]byte{9, 9})
+	hashing.On("Twox64", []byte{1, 2}).Return([]byte{8})
+
+	assert.Equal(t, []byte{9, 9, 1, 2}, hasherBlake128Concat{hashing}.Hash([]byte{1, 2}))
+	assert.Equal(t, []byte{8, 1, 2}, hasherTwox64Concat{hashing}.Hash([]byte{1, 2}))
+	assert.Equal(t, []byte{1, 2}, hasherIdentity{}.Hash([]byte{1, 2}))
+}
+
+func Test_NewMetadataStorageDefinitionMap(t *testing.T) {
+	expect := primitives.NewMetadataModuleStorageEntryDefinitionMap(
+		sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+			primitives.MetadataModuleStorageHashFuncMultiBlake128Concat,
+			primitives.MetadataModuleStorageHashFuncMultiXX64,
+			primitives.MetadataModuleStorageHashFuncIdentity,
+		},
+		sc.ToCompact(1),
+		sc.ToCompact(2),
+	)
+
+	result := NewMetadataStorageDefinitionMap(1, 2, NewHasherBlake128Concat(), NewHasherTwox64Concat(), NewHasherIdentity())
+
+	assert.Equal(t, expect, result)
+}
+
+func Test_StorageHasher_Reverse(t *testing.T) {
+	hashed := append(make([]byte, 16), 1, 2)
+
+	assert.Equal(t, []byte{1, 2}, hasherBlake128Concat{}.Reverse(hashed))
+	assert.Equal(t, append(make([]byte, 8), 1, 2), hasherTwox64Concat{}.Reverse(hashed))
+	assert.Equal(t, hashed, hasherIdentity{}.Reverse(hashed))
+}
@@ -0,0 +1,44 @@
+package support
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+// ErrStopIteration is returned by an iteration callback to stop the iteration early. The iteration itself then succeeds.
+var ErrStopIteration = errors.New("stop iteration")
+
+// iterateKeys visits the storage keys which start with prefix in lexicographic order, beginning with the first key after start.
+// The next key is looked up only after the visit, so the visited key can be safely removed by f.
+func iterateKeys(storage io.Storage, prefix []byte, start []byte, f func(key []byte) error) error {
+	previousKey := start
+
+	for {
+		next, err := storage.NextKey(previousKey)
+		if err != nil {
+			return err
+		}
+
+		if !next.HasValue {
+			return nil
+		}
+
+		key := sc.SequenceU8ToBytes(next.Value)
+		if !bytes.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		err = f(key)
+		if errors.Is(err, ErrStopIteration) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		previousKey = key
+	}
+}
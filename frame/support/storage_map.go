@@ -4,6 +4,7 @@ import sc "github.com/LimeChain/goscale"
 
 type StorageMap[K, V sc.Encodable] interface {
 	Get(k K) (V, error)
+	TryGet(k K) (sc.Option[V], error)
 	Exists(k K) bool
 	Put(k K, value V)
 	Append(k K, value V)
@@ -12,4 +13,9 @@ type StorageMap[K, V sc.Encodable] interface {
 	Clear(limit sc.U32)
 	Mutate(k K, f func(v *V) (sc.Encodable, error)) (sc.Encodable, error)
 	TryMutateExists(k K, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error)
+	Iter(f func(k K, v V) error) error
+	IterKeys(f func(k K) error) error
+	IterFromKey(start K, f func(k K, v V) error) error
+	IterPrefix(prefix []byte, f func(k K, v V) error) error
+	Drain(f func(k K, v V) error) error
 }
@@ -0,0 +1,19 @@
+package support
+
+import sc "github.com/LimeChain/goscale"
+
+// StorageNMap is a storage map with an arbitrary number of key components.
+// The keys are passed as a tuple with one component per hasher of the map.
+type StorageNMap[V sc.Encodable] interface {
+	Get(keys sc.VaryingData) (V, error)
+	Exists(keys sc.VaryingData) bool
+	Put(keys sc.VaryingData, value V)
+	Append(keys sc.VaryingData, value V)
+	Take(keys sc.VaryingData) (V, error)
+	TakeBytes(keys sc.VaryingData) ([]byte, error)
+	Remove(keys sc.VaryingData)
+	RemovePrefix(partialKeys sc.VaryingData, limit sc.U32)
+	Clear(limit sc.U32)
+	Mutate(keys sc.VaryingData, f func(v *V) (sc.Encodable, error)) (sc.Encodable, error)
+	TryMutateExists(keys sc.VaryingData, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error)
+}
@@ -4,6 +4,7 @@ import sc "github.com/LimeChain/goscale"
 
 type StorageValue[T sc.Encodable] interface {
 	Get() (T, error)
+	TryGet() (sc.Option[T], error)
 	GetBytes() (sc.Option[sc.Sequence[sc.U8]], error)
 	Exists() bool
 	Put(value T)
@@ -0,0 +1,44 @@
+package support
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+// StorageVersionKey is the key under the module prefix, at which the storage version of the module is stored.
+const StorageVersionKey = ":__STORAGE_VERSION__:"
+
+// NewStorageVersion returns the storage version of the module with the given prefix,
+// stored at `twox128(prefix) ++ twox128(":__STORAGE_VERSION__:")`. Modules without a stored version are at version 0.
+func NewStorageVersion(prefix []byte) StorageValue[sc.U16] {
+	return NewHashStorageValueWithQuery(prefix, []byte(StorageVersionKey), sc.DecodeU16, NewValueQuery(sc.U16(0)))
+}
+
+// ModuleStorageVersion is embedded in modules to implement primitives.StorageVersioned.
+// It holds the storage version, which is expected by the code of the module, and the one stored under its prefix.
+type ModuleStorageVersion struct {
+	inCodeVersion  sc.U16
+	storageVersion StorageValue[sc.U16]
+}
+
+func NewModuleStorageVersion(prefix []byte, inCodeVersion sc.U16) ModuleStorageVersion {
+	return ModuleStorageVersion{
+		inCodeVersion:  inCodeVersion,
+		storageVersion: NewStorageVersion(prefix),
+	}
+}
+
+// InCodeStorageVersion returns the storage version, which is expected by the code of the module.
+func (sv ModuleStorageVersion) InCodeStorageVersion() sc.U16 {
+	return sv.inCodeVersion
+}
+
+// OnChainStorageVersion returns the storage version, which is stored under the module prefix.
+func (sv ModuleStorageVersion) OnChainStorageVersion() (sc.U16, error) {
+	return sv.storageVersion.Get()
+}
+
+// PutInCodeStorageVersion stores the in-code storage version under the module prefix.
+// It is executed at genesis, when the storage is created in the layout expected by the code.
+func (sv ModuleStorageVersion) PutInCodeStorageVersion() {
+	sv.storageVersion.Put(sv.inCodeVersion)
+}
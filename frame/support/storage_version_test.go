@@ -0,0 +1,61 @@
+package support
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockModuleStorageVersion *mocks.StorageValue[sc.U16]
+)
+
+func Test_ModuleStorageVersion_InCodeStorageVersion(t *testing.T) {
+	target := setupModuleStorageVersion()
+
+	assert.Equal(t, sc.U16(3), target.InCodeStorageVersion())
+}
+
+func Test_ModuleStorageVersion_OnChainStorageVersion(t *testing.T) {
+	target := setupModuleStorageVersion()
+
+	mockModuleStorageVersion.On("Get").Return(sc.U16(2), nil)
+
+	result, err := target.OnChainStorageVersion()
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.U16(2), result)
+}
+
+func Test_ModuleStorageVersion_OnChainStorageVersion_Error(t *testing.T) {
+	target := setupModuleStorageVersion()
+	expectedErr := errors.New("decode error")
+
+	mockModuleStorageVersion.On("Get").Return(sc.U16(0), expectedErr)
+
+	_, err := target.OnChainStorageVersion()
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_ModuleStorageVersion_PutInCodeStorageVersion(t *testing.T) {
+	target := setupModuleStorageVersion()
+
+	mockModuleStorageVersion.On("Put", sc.U16(3)).Return()
+
+	target.PutInCodeStorageVersion()
+
+	mockModuleStorageVersion.AssertCalled(t, "Put", sc.U16(3))
+}
+
+func setupModuleStorageVersion() ModuleStorageVersion {
+	mockModuleStorageVersion = new(mocks.StorageValue[sc.U16])
+
+	target := NewModuleStorageVersion(prefix, 3)
+	target.storageVersion = mockModuleStorageVersion
+
+	return target
+}
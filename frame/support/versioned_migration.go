@@ -0,0 +1,93 @@
+package support
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// VersionedMigration executes a migration of the storage of a module from version `from` to version `to`.
+// The migration is executed only if the on-chain storage version of the module is `from`, after which the
+// storage version is set to `to`. Otherwise, it is skipped, which makes it safe to keep in the runtime across upgrades.
+type VersionedMigration struct {
+	from           sc.U16
+	to             sc.U16
+	migration      primitives.OnRuntimeUpgrade
+	storageVersion StorageValue[sc.U16]
+	dbWeight       primitives.RuntimeDbWeight
+	logger         log.WarnLogger
+}
+
+func NewVersionedMigration(from, to sc.U16, modulePrefix []byte, migration primitives.OnRuntimeUpgrade, dbWeight primitives.RuntimeDbWeight, logger log.WarnLogger) VersionedMigration {
+	return VersionedMigration{
+		from:           from,
+		to:             to,
+		migration:      migration,
+		storageVersion: NewStorageVersion(modulePrefix),
+		dbWeight:       dbWeight,
+		logger:         logger,
+	}
+}
+
+func (vm VersionedMigration) OnRuntimeUpgrade() primitives.Weight {
+	onChainVersion, err := vm.storageVersion.Get()
+	if err != nil {
+		vm.logger.Warnf("failed to read storage version, skipping migration from [%d] to [%d]: %v", vm.from, vm.to, err)
+		return vm.dbWeight.Reads(1)
+	}
+
+	if onChainVersion != vm.from {
+		vm.logger.Debugf("on-chain storage version is [%d], skipping migration from [%d] to [%d]", onChainVersion, vm.from, vm.to)
+		return vm.dbWeight.Reads(1)
+	}
+
+	weight := vm.migration.OnRuntimeUpgrade()
+	vm.storageVersion.Put(vm.to)
+
+	return weight.SaturatingAdd(vm.dbWeight.ReadsWrites(1, 1))
+}
+
+// PreUpgrade executes the PreUpgrade check of the migration, if the migration is going to be executed.
+// The returned state is prefixed with whether the migration is going to be executed.
+func (vm VersionedMigration) PreUpgrade() (sc.Sequence[sc.U8], error) {
+	onChainVersion, err := vm.storageVersion.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	willExecute := sc.Bool(onChainVersion == vm.from)
+	state := sc.BytesToSequenceU8(willExecute.Bytes())
+	if !willExecute {
+		return state, nil
+	}
+
+	upgradeCheck, ok := vm.migration.(primitives.UpgradeCheck)
+	if !ok {
+		return state, nil
+	}
+
+	migrationState, err := upgradeCheck.PreUpgrade()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(state, migrationState...), nil
+}
+
+// PostUpgrade executes the PostUpgrade check of the migration, if it was executed.
+func (vm VersionedMigration) PostUpgrade(state sc.Sequence[sc.U8]) error {
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(state))
+	executed, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return err
+	}
+
+	upgradeCheck, ok := vm.migration.(primitives.UpgradeCheck)
+	if !executed || !ok {
+		return nil
+	}
+
+	return upgradeCheck.PostUpgrade(sc.BytesToSequenceU8(buffer.Bytes()))
+}
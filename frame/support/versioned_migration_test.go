@@ -0,0 +1,115 @@
+package support
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	migrationDbWeight = primitives.RuntimeDbWeight{Read: 1, Write: 2}
+	migrationWeight   = primitives.WeightFromParts(100, 10)
+)
+
+var (
+	mockStorageVersion *mocks.StorageValue[sc.U16]
+	mockMigration      *mocks.DefaultOnRuntimeUpgrade
+)
+
+func Test_VersionedMigration_OnRuntimeUpgrade(t *testing.T) {
+	target := setupVersionedMigration()
+
+	mockStorageVersion.On("Get").Return(sc.U16(1), nil)
+	mockMigration.On("OnRuntimeUpgrade").Return(migrationWeight)
+	mockStorageVersion.On("Put", sc.U16(2)).Return()
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, migrationWeight.SaturatingAdd(migrationDbWeight.ReadsWrites(1, 1)), result)
+	mockMigration.AssertCalled(t, "OnRuntimeUpgrade")
+	mockStorageVersion.AssertCalled(t, "Put", sc.U16(2))
+}
+
+func Test_VersionedMigration_OnRuntimeUpgrade_DifferentVersion(t *testing.T) {
+	target := setupVersionedMigration()
+
+	mockStorageVersion.On("Get").Return(sc.U16(2), nil)
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, migrationDbWeight.Reads(1), result)
+	mockMigration.AssertNotCalled(t, "OnRuntimeUpgrade")
+	mockStorageVersion.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_VersionedMigration_OnRuntimeUpgrade_StorageVersionError(t *testing.T) {
+	target := setupVersionedMigration()
+
+	mockStorageVersion.On("Get").Return(sc.U16(0), errors.New("decode error"))
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, migrationDbWeight.Reads(1), result)
+	mockMigration.AssertNotCalled(t, "OnRuntimeUpgrade")
+	mockStorageVersion.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func setupVersionedMigration() VersionedMigration {
+	mockStorageVersion = new(mocks.StorageValue[sc.U16])
+	mockMigration = new(mocks.DefaultOnRuntimeUpgrade)
+
+	target := NewVersionedMigration(1, 2, prefix, mockMigration, migrationDbWeight, log.NewLogger())
+	target.storageVersion = mockStorageVersion
+
+	return target
+}
+
+func Test_VersionedMigration_PreUpgrade(t *testing.T) {
+	target := setupVersionedMigration()
+
+	mockStorageVersion.On("Get").Return(sc.U16(1), nil)
+	mockMigration.On("PreUpgrade").Return(sc.Sequence[sc.U8]{7, 8}, nil)
+
+	result, err := target.PreUpgrade()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[sc.U8]{1, 7, 8}, result)
+}
+
+func Test_VersionedMigration_PreUpgrade_DifferentVersion(t *testing.T) {
+	target := setupVersionedMigration()
+
+	mockStorageVersion.On("Get").Return(sc.U16(2), nil)
+
+	result, err := target.PreUpgrade()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[sc.U8]{0}, result)
+	mockMigration.AssertNotCalled(t, "PreUpgrade")
+}
+
+func Test_VersionedMigration_PostUpgrade(t *testing.T) {
+	target := setupVersionedMigration()
+
+	mockMigration.On("PostUpgrade", sc.Sequence[sc.U8]{7, 8}).Return(nil)
+
+	err := target.PostUpgrade(sc.Sequence[sc.U8]{1, 7, 8})
+
+	assert.NoError(t, err)
+	mockMigration.AssertCalled(t, "PostUpgrade", sc.Sequence[sc.U8]{7, 8})
+}
+
+func Test_VersionedMigration_PostUpgrade_NotExecuted(t *testing.T) {
+	target := setupVersionedMigration()
+
+	err := target.PostUpgrade(sc.Sequence[sc.U8]{0})
+
+	assert.NoError(t, err)
+	mockMigration.AssertNotCalled(t, "PostUpgrade", mock.Anything)
+}
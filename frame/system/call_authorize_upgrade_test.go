@@ -148,6 +148,14 @@ func Test_Call_AuthorizeUpgrade_Dispatch(t *testing.T) {
 	codeUpgrader.AssertCalled(t, "DoAuthorizeUpgrade", codeHash, sc.Bool(true))
 }
 
+func Test_Call_AuthorizeUpgrade_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallAuthorizeUpgrade()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallAuthorizeUpgrade() primitives.Call {
 	codeUpgrader = new(mocks.SystemModule)
 	return newCallAuthorizeUpgrade(moduleId, functionAuthorizeUpgradeIndex, codeUpgrader)
@@ -137,6 +137,14 @@ func Test_Call_AuthorizeUpgradeWithoutChecks_Dispatch(t *testing.T) {
 	codeUpgrader.AssertCalled(t, "DoAuthorizeUpgrade", codeHash, sc.Bool(false))
 }
 
+func Test_Call_AuthorizeUpgradeWithoutChecks_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallAuthorizeUpgradeWithoutChecks()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallAuthorizeUpgradeWithoutChecks() primitives.Call {
 	codeUpgrader = new(mocks.SystemModule)
 	return newCallAuthorizeUpgradeWithoutChecks(moduleId, functionAuthorizeUpgradeWithoutChecksIndex, codeUpgrader)
@@ -147,6 +147,14 @@ func Test_Call_KillPrefix_Dispatch(t *testing.T) {
 	mockIoStorage.AssertCalled(t, "ClearPrefix", prefixBytes, sc.NewOption[sc.U32](subkeys).Bytes())
 }
 
+func Test_Call_KillPrefix_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallKillPrefix()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallKillPrefix() primitives.Call {
 	initMockStorage()
 	return newCallKillPrefix(moduleId, functionKillPrefixIndex, mockIoStorage)
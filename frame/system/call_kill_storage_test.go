@@ -152,6 +152,14 @@ func Test_Call_KillStorage_Dispatch(t *testing.T) {
 	mockIoStorage.AssertCalled(t, "Clear", []byte("testkey2"))
 }
 
+func Test_Call_KillStorage_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallKillStorage()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallKillStorage() primitives.Call {
 	initMockStorage()
 	return newCallKillStorage(moduleId, functionKillStorageIndex, mockIoStorage)
@@ -195,6 +195,14 @@ func Test_Call_SetCode_Dispatch(t *testing.T) {
 	assert.Equal(t, sc.NewOption[primitives.Weight](blockWeights.MaxBlock), res.ActualWeight)
 }
 
+func Test_Call_SetCode_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallSetCode()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallSetCode() primitives.Call {
 	mockCodeUpgrader = new(mocks.SystemModule)
 	mockOnSetCode = new(mocks.DefaultOnSetCode)
@@ -150,6 +150,14 @@ func Test_Call_SetCodeWithoutChecks_Dispatch(t *testing.T) {
 	assert.Equal(t, sc.NewOption[primitives.Weight](blockWeights.MaxBlock), res.ActualWeight)
 }
 
+func Test_Call_SetCodeWithoutChecks_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallSetCodeWithoutChecks()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallSetCodeWithoutChecks() primitives.Call {
 	mockOnSetCode = new(mocks.DefaultOnSetCode)
 	return newCallSetCodeWithoutChecks(moduleId, functionSetCodeWithoutChecksIndex, *moduleConstants, mockOnSetCode)
@@ -150,6 +150,14 @@ func Test_Call_SetHeapPages_Dispatch_Success(t *testing.T) {
 	mockLogDepositor.AssertCalled(t, "DepositLog", digestItem)
 }
 
+func Test_Call_SetHeapPages_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallSetHeapPages()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallSetHeapPages() primitives.Call {
 	mockLogDepositor = new(mocks.SystemModule)
 	return newCallSetHeapPages(moduleId, functionSetHeapPagesIndex, mockStorageHeapPages, mockLogDepositor)
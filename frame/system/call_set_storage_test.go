@@ -159,6 +159,14 @@ func Test_Call_SetStorage_Dispatch(t *testing.T) {
 	mockIoStorage.AssertCalled(t, "Set", []byte("testkey2"), []byte("testvalue2"))
 }
 
+func Test_Call_SetStorage_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallSetStorage()
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+}
+
 func setupCallSetStorage() primitives.Call {
 	initMockStorage()
 	return newCallSetStorage(moduleId, functionSetStorageIndex, mockIoStorage)
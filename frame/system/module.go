@@ -9,6 +9,7 @@ import (
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	execTypes "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/log"
@@ -32,7 +33,8 @@ const (
 )
 
 const (
-	name = sc.Str("System")
+	name           = sc.Str("System")
+	storageVersion = sc.U16(0)
 )
 
 type Module interface {
@@ -52,6 +54,8 @@ type Module interface {
 	ResetEvents()
 	Get(key primitives.AccountId) (primitives.AccountInfo, error)
 	CanDecProviders(who primitives.AccountId) (bool, error)
+	IncConsumers(who primitives.AccountId) error
+	DecConsumers(who primitives.AccountId)
 
 	TryMutateExists(who primitives.AccountId, f func(who *primitives.AccountData) (sc.Encodable, error)) (sc.Encodable, error)
 	Metadata() primitives.MetadataModule
@@ -74,6 +78,8 @@ type Module interface {
 	StorageBlockNumber() (sc.U64, error)
 	StorageBlockNumberSet(sc.U64)
 
+	StorageExtrinsicIndex() (sc.U32, error)
+
 	StorageLastRuntimeUpgrade() (types.LastRuntimeUpgradeInfo, error)
 	StorageLastRuntimeUpgradeSet(lrui types.LastRuntimeUpgradeInfo)
 
@@ -89,6 +95,7 @@ type Module interface {
 type module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	OnSetCode hooks.OnSetCode
 
 	Index       sc.U8
@@ -112,17 +119,18 @@ func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenera
 	ioHashing := io.NewHashing()
 
 	moduleInstance := module{
-		Index:       index,
-		Config:      config,
-		storage:     storage,
-		constants:   constants,
-		functions:   functions,
-		trie:        io.NewTrie(),
-		ioStorage:   ioStorage,
-		ioHashing:   ioHashing,
-		ioMisc:      io.NewMisc(),
-		mdGenerator: mdGenerator,
-		logger:      logger,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keySystem, storageVersion),
+		Index:                index,
+		Config:               config,
+		storage:              storage,
+		constants:            constants,
+		functions:            functions,
+		trie:                 io.NewTrie(),
+		ioStorage:            ioStorage,
+		ioHashing:            ioHashing,
+		ioMisc:               io.NewMisc(),
+		mdGenerator:          mdGenerator,
+		logger:               logger,
 	}
 
 	// TODO: pass it from the constructor
@@ -236,6 +244,10 @@ func (m module) StorageBlockNumberSet(blockNumber sc.U64) {
 	m.storage.BlockNumber.Put(blockNumber)
 }
 
+func (m module) StorageExtrinsicIndex() (sc.U32, error) {
+	return m.storage.ExtrinsicIndex.Get()
+}
+
 func (m module) StorageLastRuntimeUpgrade() (types.LastRuntimeUpgradeInfo, error) {
 	return m.storage.LastRuntimeUpgrade.Get()
 }
@@ -453,6 +465,28 @@ func (m module) CanDecProviders(who primitives.AccountId) (bool, error) {
 	return acc.Consumers == 0 || acc.Providers > 1, nil
 }
 
+// IncConsumers increments the reference counter on an account, indicating that a module
+// depends on its existence. Returns an error if the account has no providers.
+func (m module) IncConsumers(who primitives.AccountId) error {
+	_, err := m.storage.Account.Mutate(who, func(account *primitives.AccountInfo) (sc.Encodable, error) {
+		return nil, incrementConsumers(account)
+	})
+
+	return err
+}
+
+// DecConsumers decrements the consumer reference counter on an account.
+// Logs a warning if the counter is already zero.
+func (m module) DecConsumers(who primitives.AccountId) {
+	_, err := m.storage.Account.Mutate(who, func(account *primitives.AccountInfo) (sc.Encodable, error) {
+		m.decrementConsumers(account)
+		return nil, nil
+	})
+	if err != nil {
+		m.logger.Warn(err.Error())
+	}
+}
+
 // DepositEvent deposits an event into block's event record.
 func (m module) DepositEvent(event primitives.Event) {
 	m.depositEventIndexed([]primitives.H256{}, event)
@@ -495,7 +529,7 @@ func (m module) TryMutateExists(who primitives.AccountId, f func(*primitives.Acc
 		if err != nil {
 			return nil, err
 		}
-		if status == primitives.DecRefStatusExists {
+		if status == primitives.DecRefStatusReaped {
 			return result, nil
 		}
 	} else if !wasProviding && !isProviding {
@@ -533,6 +567,7 @@ func (m module) decrementProviders(who primitives.AccountId, maybeAccount *sc.Op
 		if account.Providers == 1 && account.Consumers == 0 && account.Sufficients == 0 {
 			m.onKilledAccount(who)
 			// No providers left (and no consumers) and no sufficients. Account dead.
+			*maybeAccount = sc.NewOption[primitives.AccountInfo](nil)
 			return primitives.DecRefStatusReaped, nil
 		}
 		if account.Providers == 1 && account.Consumers > 0 {
@@ -574,6 +609,24 @@ func (m module) decProviders(who primitives.AccountId) (primitives.DecRefStatus,
 	return result.(primitives.DecRefStatus), nil
 }
 
+func incrementConsumers(account *primitives.AccountInfo) error {
+	if account.Providers == 0 {
+		return primitives.NewDispatchErrorNoProviders()
+	}
+
+	account.Consumers = sc.SaturatingAddU32(account.Consumers, 1)
+	return nil
+}
+
+func (m module) decrementConsumers(account *primitives.AccountInfo) {
+	if account.Consumers == 0 {
+		m.logger.Warn("Logic error: Unexpected underflow in reducing consumer")
+		return
+	}
+
+	account.Consumers = account.Consumers - 1
+}
+
 // depositEventIndexed Deposits an event into this block's event record adding this event
 // to the corresponding topic indexes.
 //
@@ -328,6 +328,18 @@ func Test_Module_StorageBlockNumber(t *testing.T) {
 	mockStorageBlockNumber.AssertCalled(t, "Get")
 }
 
+func Test_Module_StorageExtrinsicIndex(t *testing.T) {
+	target := setupModule()
+
+	mockStorageExtrinsicIndex.On("Get").Return(sc.U32(2), nil)
+
+	result, err := target.StorageExtrinsicIndex()
+	assert.Nil(t, err)
+
+	assert.Equal(t, sc.U32(2), result)
+	mockStorageExtrinsicIndex.AssertCalled(t, "Get")
+}
+
 func Test_Module_StorageBlockNumberSet(t *testing.T) {
 	target := setupModule()
 
@@ -823,11 +835,12 @@ func Test_Module_TryMutateExists_NoProviding(t *testing.T) {
 		mockTypeMutateAccountInfo)
 }
 
-func Test_Module_TryMutateExists_WasProviding_NoLongerProviding_DecRefStatus_Success(t *testing.T) {
+func Test_Module_TryMutateExists_WasProviding_NoLongerProviding_DecRefStatus_Reaped(t *testing.T) {
 	target := setupModule()
 	expectedResult := sc.NewU128(5)
 
 	accountInfo := primitives.AccountInfo{
+		Providers: 1,
 		Data: primitives.AccountData{
 			Free: sc.NewU128(1),
 		},
@@ -836,19 +849,28 @@ func Test_Module_TryMutateExists_WasProviding_NoLongerProviding_DecRefStatus_Suc
 		account.Free = primitives.Balance{}
 		return expectedResult, nil
 	}
+	maybeAccount := sc.NewOption[primitives.AccountInfo](accountInfo)
 
+	mockStorageBlockNumber.On("Get").Return(sc.U64(0), nil)
 	mockStorageAccount.On("Get", targetAccountId).Return(accountInfo, nil)
 	mockStorageAccount.
 		On(
 			"TryMutateExists",
 			targetAccountId,
 			mockTypeMutateOptionAccountInfo).
-		Return(primitives.DecRefStatusExists, nil)
+		Run(func(args mock.Arguments) {
+			mutate := args.Get(1).(func(option *sc.Option[primitives.AccountInfo]) (sc.Encodable, error))
+			_, err := mutate(&maybeAccount)
+			assert.NoError(t, err)
+		}).
+		Return(primitives.DecRefStatusReaped, nil)
 
 	result, err := target.TryMutateExists(targetAccountId, f)
 	assert.Nil(t, err)
 
 	assert.Equal(t, expectedResult, result)
+	// The storage map removes the key, as the account is left empty.
+	assert.False(t, maybeAccount.HasValue)
 
 	mockStorageAccount.AssertCalled(t, "Get", targetAccountId)
 	mockStorageAccount.
@@ -862,6 +884,46 @@ func Test_Module_TryMutateExists_WasProviding_NoLongerProviding_DecRefStatus_Suc
 		mockTypeMutateAccountInfo)
 }
 
+func Test_Module_TryMutateExists_WasProviding_NoLongerProviding_DecRefStatus_Exists(t *testing.T) {
+	target := setupModule()
+	expectedResult := sc.NewU128(5)
+
+	accountInfo := primitives.AccountInfo{
+		Data: primitives.AccountData{
+			Free: sc.NewU128(1),
+		},
+	}
+	f := func(account *primitives.AccountData) (sc.Encodable, error) {
+		account.Free = primitives.Balance{}
+		return expectedResult, nil
+	}
+
+	mockStorageAccount.On("Get", targetAccountId).Return(accountInfo, nil)
+	mockStorageAccount.
+		On(
+			"TryMutateExists",
+			targetAccountId,
+			mockTypeMutateOptionAccountInfo).
+		Return(primitives.DecRefStatusExists, nil)
+	mockStorageAccount.On("Mutate", targetAccountId, mockTypeMutateAccountInfo).Return(sc.Empty{}, nil)
+
+	result, err := target.TryMutateExists(targetAccountId, f)
+	assert.Nil(t, err)
+
+	assert.Equal(t, expectedResult, result)
+
+	mockStorageAccount.AssertCalled(t, "Get", targetAccountId)
+	mockStorageAccount.
+		AssertCalled(t,
+			"TryMutateExists",
+			targetAccountId,
+			mockTypeMutateOptionAccountInfo)
+	mockStorageAccount.AssertCalled(t,
+		"Mutate",
+		targetAccountId,
+		mockTypeMutateAccountInfo)
+}
+
 func Test_Module_TryMutateExists_WasProviding_NoLongerProviding_Error(t *testing.T) {
 	target := setupModule()
 	expectedErr := primitives.NewDispatchErrorCannotLookup()
@@ -1211,7 +1273,7 @@ func Test_Module_decrementProviders_HasAccount_NoProvidersLeft(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResult, result)
-	assert.Equal(t, sc.U32(1), maybeAccount.Value.Providers)
+	assert.Equal(t, sc.NewOption[primitives.AccountInfo](nil), maybeAccount)
 
 	mockStorageBlockNumber.AssertCalled(t, "Get")
 	mockStorageExecutionPhase.AssertNotCalled(t, "Get")
@@ -2245,3 +2307,71 @@ func initMockStorage() {
 	mockIoMisc = new(mocks.IoMisc)
 	mockIoTrie = new(mocks.IoTrie)
 }
+
+func Test_Module_IncConsumers(t *testing.T) {
+	target := setupModule()
+
+	mockStorageAccount.On("Mutate", targetAccountId, mockTypeMutateAccountInfo).Return(sc.Empty{}, nil)
+
+	err := target.IncConsumers(targetAccountId)
+
+	assert.NoError(t, err)
+	mockStorageAccount.AssertCalled(t, "Mutate", targetAccountId, mockTypeMutateAccountInfo)
+}
+
+func Test_Module_IncConsumers_Error(t *testing.T) {
+	target := setupModule()
+	expectedErr := primitives.NewDispatchErrorNoProviders()
+
+	mockStorageAccount.On("Mutate", targetAccountId, mockTypeMutateAccountInfo).Return(sc.Empty{}, expectedErr)
+
+	err := target.IncConsumers(targetAccountId)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Module_DecConsumers(t *testing.T) {
+	target := setupModule()
+
+	mockStorageAccount.On("Mutate", targetAccountId, mockTypeMutateAccountInfo).Return(sc.Empty{}, nil)
+
+	target.DecConsumers(targetAccountId)
+
+	mockStorageAccount.AssertCalled(t, "Mutate", targetAccountId, mockTypeMutateAccountInfo)
+}
+
+func Test_Module_incrementConsumers(t *testing.T) {
+	accountInfo := &primitives.AccountInfo{Providers: 1, Consumers: 1}
+
+	err := incrementConsumers(accountInfo)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(2), accountInfo.Consumers)
+}
+
+func Test_Module_incrementConsumers_NoProviders(t *testing.T) {
+	accountInfo := &primitives.AccountInfo{}
+
+	err := incrementConsumers(accountInfo)
+
+	assert.Equal(t, primitives.NewDispatchErrorNoProviders(), err)
+	assert.Equal(t, sc.U32(0), accountInfo.Consumers)
+}
+
+func Test_Module_decrementConsumers(t *testing.T) {
+	target := setupModule()
+	accountInfo := &primitives.AccountInfo{Consumers: 2}
+
+	target.decrementConsumers(accountInfo)
+
+	assert.Equal(t, sc.U32(1), accountInfo.Consumers)
+}
+
+func Test_Module_decrementConsumers_Underflow(t *testing.T) {
+	target := setupModule()
+	accountInfo := &primitives.AccountInfo{}
+
+	target.decrementConsumers(accountInfo)
+
+	assert.Equal(t, sc.U32(0), accountInfo.Consumers)
+}
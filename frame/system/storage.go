@@ -5,7 +5,6 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/support"
-	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -53,22 +52,20 @@ type storage struct {
 }
 
 func newStorage() *storage {
-	hashing := io.NewHashing()
-
 	return &storage{
-		Account:            support.NewHashStorageMap[types.AccountId](keySystem, keyAccount, hashing.Blake128, types.DecodeAccountInfo),
+		Account:            support.NewHashStorageMap[types.AccountId](keySystem, keyAccount, support.NewHasherBlake128Concat(), types.DecodeAccountId, types.DecodeAccountInfo),
 		BlockWeight:        support.NewHashStorageValue(keySystem, keyBlockWeight, types.DecodeConsumedWeight),
-		BlockHash:          support.NewHashStorageMap[sc.U64, types.Blake2bHash](keySystem, keyBlockHash, hashing.Twox64, types.DecodeBlake2bHash),
+		BlockHash:          support.NewHashStorageMap[sc.U64, types.Blake2bHash](keySystem, keyBlockHash, support.NewHasherTwox64Concat(), sc.DecodeU64, types.DecodeBlake2bHash),
 		BlockNumber:        support.NewHashStorageValue(keySystem, keyNumber, sc.DecodeU64),
 		AllExtrinsicsLen:   support.NewHashStorageValue(keySystem, keyAllExtrinsicsLen, sc.DecodeU32),
 		ExtrinsicIndex:     support.NewSimpleStorageValue(keyExtrinsicIndex, sc.DecodeU32),
-		ExtrinsicData:      support.NewHashStorageMap[sc.U32, sc.Sequence[sc.U8]](keySystem, keyExtrinsicData, hashing.Twox64, sc.DecodeSequence[sc.U8]),
+		ExtrinsicData:      support.NewHashStorageMap[sc.U32, sc.Sequence[sc.U8]](keySystem, keyExtrinsicData, support.NewHasherTwox64Concat(), sc.DecodeU32, sc.DecodeSequence[sc.U8]),
 		ExtrinsicCount:     support.NewHashStorageValue(keySystem, keyExtrinsicCount, sc.DecodeU32),
 		ParentHash:         support.NewHashStorageValue(keySystem, keyParentHash, types.DecodeBlake2bHash),
 		Digest:             support.NewHashStorageValue(keySystem, keyDigest, types.DecodeDigest),
 		Events:             support.NewHashStorageValue(keySystem, keyEvents, func(*bytes.Buffer) (types.EventRecord, error) { return types.EventRecord{}, nil }),
 		EventCount:         support.NewHashStorageValue(keySystem, keyEventCount, sc.DecodeU32),
-		EventTopics:        support.NewHashStorageMap[types.H256, sc.VaryingData](keySystem, keyEventTopics, hashing.Blake128, func(buffer *bytes.Buffer) (sc.VaryingData, error) { return sc.NewVaryingData(), nil }),
+		EventTopics:        support.NewHashStorageMap[types.H256, sc.VaryingData](keySystem, keyEventTopics, support.NewHasherBlake128Concat(), types.DecodeH256, func(buffer *bytes.Buffer) (sc.VaryingData, error) { return sc.NewVaryingData(), nil }),
 		LastRuntimeUpgrade: support.NewHashStorageValue(keySystem, keyLastRuntimeUpgrade, types.DecodeLastRuntimeUpgradeInfo),
 		ExecutionPhase:     support.NewHashStorageValue(keySystem, keyExecutionPhase, types.DecodeExtrinsicPhase),
 		HeapPages:          support.NewSimpleStorageValue(keyHeapPages, sc.DecodeU64),
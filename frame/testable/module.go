@@ -2,6 +2,7 @@ package testable
 
 import (
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -10,9 +11,18 @@ const (
 	functionTestIndex = iota
 )
 
+const (
+	storageVersion = sc.U16(0)
+)
+
+var (
+	keyTestable = []byte("Testable")
+)
+
 type Module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	Index       sc.U8
 	functions   map[sc.U8]primitives.Call
 	mdGenerator *primitives.MetadataTypeGenerator
@@ -23,9 +33,10 @@ func New(index sc.U8, mdGenerator *primitives.MetadataTypeGenerator) Module {
 	functions[functionTestIndex] = newCallTest(index, functionTestIndex)
 
 	return Module{
-		Index:       index,
-		functions:   functions,
-		mdGenerator: mdGenerator,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyTestable, storageVersion),
+		Index:                index,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
 	}
 }
 
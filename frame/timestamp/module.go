@@ -6,6 +6,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/hooks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -13,6 +14,7 @@ import (
 const (
 	functionSetIndex = iota
 	name             = sc.Str("Timestamp")
+	storageVersion   = sc.U16(0)
 )
 
 var (
@@ -27,6 +29,7 @@ var (
 
 type Module struct {
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	Index       sc.U8
 	Config      *Config
 	storage     *storage
@@ -42,12 +45,13 @@ func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenera
 	functions[functionSetIndex] = newCallSet(index, functionSetIndex, storage, constants, config.OnTimestampSet)
 
 	return Module{
-		Index:       index,
-		Config:      config,
-		storage:     storage,
-		constants:   constants,
-		functions:   functions,
-		mdGenerator: mdGenerator,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyTimestamp, storageVersion),
+		Index:                index,
+		Config:               config,
+		storage:              storage,
+		constants:            constants,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
 	}
 }
 
@@ -10,13 +10,15 @@ type Config struct {
 	WeightToFee              types.WeightToFee
 	LengthToFee              types.WeightToFee
 	BlockWeights             types.BlockWeights
+	FeeMultiplierUpdate      MultiplierUpdate
 }
 
-func NewConfig(operationalFeeMultiplier sc.U8, weightToFee, lengthToFee types.WeightToFee, blockWeights types.BlockWeights) *Config {
+func NewConfig(operationalFeeMultiplier sc.U8, weightToFee, lengthToFee types.WeightToFee, blockWeights types.BlockWeights, feeMultiplierUpdate MultiplierUpdate) *Config {
 	return &Config{
 		operationalFeeMultiplier,
 		weightToFee,
 		lengthToFee,
 		blockWeights,
+		feeMultiplierUpdate,
 	}
 }
@@ -1,13 +1,20 @@
 package transaction_payment
 
-import sc "github.com/LimeChain/goscale"
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
 
 type consts struct {
 	OperationalFeeMultiplier sc.U8
+	WeightToFee              sc.Sequence[primitives.WeightToFeeCoefficient]
+	LengthToFee              sc.Sequence[primitives.WeightToFeeCoefficient]
 }
 
-func newConstants(operationalFeeMultiplier sc.U8) *consts {
+func newConstants(operationalFeeMultiplier sc.U8, weightToFee, lengthToFee primitives.WeightToFee) *consts {
 	return &consts{
 		operationalFeeMultiplier,
+		weightToFee.Polynomial(),
+		lengthToFee.Polynomial(),
 	}
 }
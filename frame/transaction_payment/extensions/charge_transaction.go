@@ -3,15 +3,19 @@ package extensions
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// chargeTransaction withdraws transaction fees through fungible.Balanced, keeping the account alive.
+// The fee is withdrawn politely, so balance frozen by any freeze or balance lock, regardless of its
+// withdraw reasons, cannot be used to pay it.
 type chargeTransaction struct {
-	currencyAdapter primitives.CurrencyAdapter
+	currency fungible.Balanced
 }
 
-func newChargeTransaction(currencyAdapter primitives.CurrencyAdapter) chargeTransaction {
-	return chargeTransaction{currencyAdapter: currencyAdapter}
+func newChargeTransaction(currency fungible.Balanced) chargeTransaction {
+	return chargeTransaction{currency: currency}
 }
 
 func (ct chargeTransaction) WithdrawFee(who primitives.AccountId, call primitives.Call, info *primitives.DispatchInfo, fee primitives.Balance, tip primitives.Balance) (sc.Option[primitives.Balance], error) {
@@ -19,14 +23,7 @@ func (ct chargeTransaction) WithdrawFee(who primitives.AccountId, call primitive
 		return sc.NewOption[primitives.Balance](nil), nil
 	}
 
-	withdrawReasons := primitives.WithdrawReasonsTransactionPayment
-	if tip.Eq(constants.Zero) {
-		withdrawReasons = primitives.WithdrawReasonsTransactionPayment
-	} else {
-		withdrawReasons = primitives.WithdrawReasonsTransactionPayment | primitives.WithdrawReasonsTip
-	}
-
-	imbalance, err := ct.currencyAdapter.Withdraw(who, fee, sc.U8(withdrawReasons), primitives.ExistenceRequirementKeepAlive)
+	imbalance, err := ct.currency.Withdraw(who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
 	if err != nil {
 		return sc.NewOption[primitives.Balance](nil), primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
 	}
@@ -39,7 +36,7 @@ func (ct chargeTransaction) CorrectAndDepositFee(who primitives.AccountId, corre
 		alreadyPaidNegativeImbalance := alreadyWithdrawn.Value
 		refundAmount := sc.SaturatingSubU128(alreadyPaidNegativeImbalance, correctedFee)
 
-		refundPositiveImbalance, err := ct.currencyAdapter.DepositIntoExisting(who, refundAmount)
+		refundPositiveImbalance, err := ct.currency.Deposit(who, refundAmount, fungible.PrecisionBestEffort)
 		if err != nil {
 			return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
 		}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	"github.com/LimeChain/gosemble/hooks"
@@ -22,11 +23,11 @@ type ChargeTransactionPayment struct {
 	typesInfoAdditionalSignedData sc.VaryingData
 }
 
-func NewChargeTransactionPayment(module system.Module, txPaymentModule transaction_payment.Module, currencyAdapter primitives.CurrencyAdapter) primitives.SignedExtension {
+func NewChargeTransactionPayment(module system.Module, txPaymentModule transaction_payment.Module, currency fungible.Balanced) primitives.SignedExtension {
 	return &ChargeTransactionPayment{
 		systemModule:                  module,
 		txPaymentModule:               txPaymentModule,
-		onChargeTransaction:           newChargeTransaction(currencyAdapter),
+		onChargeTransaction:           newChargeTransaction(currency),
 		typesInfoAdditionalSignedData: sc.NewVaryingData(),
 	}
 }
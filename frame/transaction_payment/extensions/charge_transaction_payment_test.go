@@ -59,25 +59,25 @@ var (
 )
 
 var (
-	targetChargeTxPayment                 ChargeTransactionPayment
-	mockSystemModule                      *mocks.SystemModule
-	mockTxPaymentModule                   *mocks.TransactionPaymentModule
-	mockOnChargeTransaction               *mocks.OnChargeTransaction
-	mockCurrencyAdapterForChargeTxPayment *mocks.CurrencyAdapter
-	mockCall                              *mocks.Call
+	targetChargeTxPayment          ChargeTransactionPayment
+	mockSystemModule               *mocks.SystemModule
+	mockTxPaymentModule            *mocks.TransactionPaymentModule
+	mockOnChargeTransaction        *mocks.OnChargeTransaction
+	mockCurrencyForChargeTxPayment *mocks.FungibleBalanced
+	mockCall                       *mocks.Call
 )
 
 func setup(fee types.Balance) {
 	mockSystemModule = new(mocks.SystemModule)
 	mockTxPaymentModule = new(mocks.TransactionPaymentModule)
 	mockOnChargeTransaction = new(mocks.OnChargeTransaction)
-	mockCurrencyAdapterForChargeTxPayment = new(mocks.CurrencyAdapter)
+	mockCurrencyForChargeTxPayment = new(mocks.FungibleBalanced)
 	mockCall = new(mocks.Call)
 
 	targetChargeTxPayment = ChargeTransactionPayment{
 		systemModule:        mockSystemModule,
 		txPaymentModule:     mockTxPaymentModule,
-		onChargeTransaction: newChargeTransaction(mockCurrencyAdapterForChargeTxPayment),
+		onChargeTransaction: newChargeTransaction(mockCurrencyForChargeTxPayment),
 	}
 
 	targetChargeTxPayment.onChargeTransaction = mockOnChargeTransaction
@@ -403,9 +403,9 @@ func Test_NewChargeTxPayment(t *testing.T) {
 	expected := &ChargeTransactionPayment{
 		systemModule:                  mockSystemModule,
 		txPaymentModule:               mockTxPaymentModule,
-		onChargeTransaction:           newChargeTransaction(mockCurrencyAdapterForChargeTxPayment),
+		onChargeTransaction:           newChargeTransaction(mockCurrencyForChargeTxPayment),
 		typesInfoAdditionalSignedData: sc.NewVaryingData(),
 	}
-	txPayment := NewChargeTransactionPayment(mockSystemModule, mockTxPaymentModule, mockCurrencyAdapterForChargeTxPayment)
+	txPayment := NewChargeTransactionPayment(mockSystemModule, mockTxPaymentModule, mockCurrencyForChargeTxPayment)
 	assert.Equal(t, txPayment, expected)
 }
@@ -5,21 +5,21 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
 	"github.com/LimeChain/gosemble/mocks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
 )
 
 var (
-	mockCurrencyAdapter *mocks.CurrencyAdapter
-	target              chargeTransaction
+	mockCurrency *mocks.FungibleBalanced
+	target       chargeTransaction
 
 	who               = constants.ZeroAccountId
 	fee               = sc.NewU128(5)
 	imbalance         = sc.NewU128(1)
 	expectedImbalance = sc.NewOption[sc.U128](imbalance)
 	tip               = sc.NewU128(0)
-	reasons           = sc.U8(primitives.WithdrawReasonsTransactionPayment)
 
 	correctedFee     = sc.NewU128(10)
 	alreadyWithdrawn = sc.NewOption[sc.U128](sc.NewU128(11))
@@ -30,13 +30,13 @@ var (
 
 func Test_ChargeTransaction_WithdrawFee_Success(t *testing.T) {
 	setUp()
-	mockCurrencyAdapter.On("Withdraw", who, fee, reasons, primitives.ExistenceRequirementKeepAlive).Return(imbalance, nil)
+	mockCurrency.On("Withdraw", who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite).Return(imbalance, nil)
 
 	result, err := target.WithdrawFee(who, nil, nil, fee, tip)
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedImbalance, result)
-	mockCurrencyAdapter.AssertCalled(t, "Withdraw", who, fee, reasons, primitives.ExistenceRequirementKeepAlive)
+	mockCurrency.AssertCalled(t, "Withdraw", who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
 }
 
 func Test_ChargeTransaction_WithdrawFee_ZeroFee(t *testing.T) {
@@ -47,21 +47,20 @@ func Test_ChargeTransaction_WithdrawFee_ZeroFee(t *testing.T) {
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedImbalance, result)
-	mockCurrencyAdapter.AssertNotCalled(t, "Withdraw")
+	mockCurrency.AssertNotCalled(t, "Withdraw")
 }
 
 func Test_ChargeTransaction_WithdrawFee_WithTip(t *testing.T) {
 	setUp()
 
-	reasons := sc.U8(primitives.WithdrawReasonsTip)
-	mockCurrencyAdapter.On("Withdraw", who, fee, reasons, primitives.ExistenceRequirementKeepAlive).Return(imbalance, nil)
+	mockCurrency.On("Withdraw", who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite).Return(imbalance, nil)
 	tip := fee
 
 	result, err := target.WithdrawFee(who, nil, nil, fee, tip)
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedImbalance, result)
-	mockCurrencyAdapter.AssertCalled(t, "Withdraw", who, fee, reasons, primitives.ExistenceRequirementKeepAlive)
+	mockCurrency.AssertCalled(t, "Withdraw", who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
 }
 
 func Test_ChargeTransaction_WithdrawFee_Fail(t *testing.T) {
@@ -69,23 +68,23 @@ func Test_ChargeTransaction_WithdrawFee_Fail(t *testing.T) {
 	expectedImbalance := sc.NewOption[sc.U128](nil)
 
 	mockError := primitives.NewDispatchErrorBadOrigin()
-	mockCurrencyAdapter.On("Withdraw", who, fee, reasons, primitives.ExistenceRequirementKeepAlive).Return(imbalance, mockError)
+	mockCurrency.On("Withdraw", who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite).Return(imbalance, mockError)
 
 	result, err := target.WithdrawFee(who, nil, nil, fee, tip)
 
 	assert.Equal(t, expectedError, err)
 	assert.Equal(t, expectedImbalance, result)
-	mockCurrencyAdapter.AssertCalled(t, "Withdraw", who, fee, reasons, primitives.ExistenceRequirementKeepAlive)
+	mockCurrency.AssertCalled(t, "Withdraw", who, fee, fungible.PrecisionExact, fungible.PreservationPreserve, fungible.FortitudePolite)
 }
 
 func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_Success(t *testing.T) {
 	setUp()
-	mockCurrencyAdapter.On("DepositIntoExisting", who, refundAmount).Return(refundAmount, nil)
+	mockCurrency.On("Deposit", who, refundAmount, fungible.PrecisionBestEffort).Return(refundAmount, nil)
 
 	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
 
 	assert.Nil(t, result)
-	mockCurrencyAdapter.AssertCalled(t, "DepositIntoExisting", who, refundAmount)
+	mockCurrency.AssertCalled(t, "Deposit", who, refundAmount, fungible.PrecisionBestEffort)
 }
 
 func Test_ChargeTransaction_CorrectAndDepositFee_NotWithdrawn(t *testing.T) {
@@ -95,31 +94,31 @@ func Test_ChargeTransaction_CorrectAndDepositFee_NotWithdrawn(t *testing.T) {
 	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
 
 	assert.Nil(t, result)
-	mockCurrencyAdapter.AssertNotCalled(t, "DepositIntoExisting")
+	mockCurrency.AssertNotCalled(t, "Deposit")
 }
 
-func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_DepositIntoExisting_Fail(t *testing.T) {
+func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_Deposit_Fail(t *testing.T) {
 	setUp()
-	mockCurrencyAdapter.On("DepositIntoExisting", who, refundAmount).Return(imbalance, primitives.NewDispatchErrorBadOrigin())
+	mockCurrency.On("Deposit", who, refundAmount, fungible.PrecisionBestEffort).Return(imbalance, primitives.NewDispatchErrorBadOrigin())
 
 	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
 
 	assert.Equal(t, expectedError, result)
-	mockCurrencyAdapter.AssertCalled(t, "DepositIntoExisting", who, refundAmount)
+	mockCurrency.AssertCalled(t, "Deposit", who, refundAmount, fungible.PrecisionBestEffort)
 }
 
 func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_Fail(t *testing.T) {
 	setUp()
 	positiveImbalance := sc.NewU128(50)
-	mockCurrencyAdapter.On("DepositIntoExisting", who, refundAmount).Return(positiveImbalance, nil)
+	mockCurrency.On("Deposit", who, refundAmount, fungible.PrecisionBestEffort).Return(positiveImbalance, nil)
 
 	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
 
 	assert.Equal(t, expectedError, result)
-	mockCurrencyAdapter.AssertCalled(t, "DepositIntoExisting", who, refundAmount)
+	mockCurrency.AssertCalled(t, "Deposit", who, refundAmount, fungible.PrecisionBestEffort)
 }
 
 func setUp() {
-	mockCurrencyAdapter = new(mocks.CurrencyAdapter)
-	target = newChargeTransaction(mockCurrencyAdapter)
+	mockCurrency = new(mocks.FungibleBalanced)
+	target = newChargeTransaction(mockCurrency)
 }
@@ -3,6 +3,7 @@ package transaction_payment
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/frame/transaction_payment/types"
 	"github.com/LimeChain/gosemble/hooks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -17,9 +18,14 @@ type Module interface {
 	OperationalFeeMultiplier() sc.U8
 }
 
+const (
+	storageVersion = sc.U16(0)
+)
+
 type module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
 	index       sc.U8
 	config      *Config
 	constants   *consts
@@ -29,11 +35,12 @@ type module struct {
 
 func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator) Module {
 	return module{
-		index:       index,
-		config:      config,
-		constants:   newConstants(config.OperationalFeeMultiplier),
-		storage:     newStorage(),
-		mdGenerator: mdGenerator,
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyTransactionPayment, storageVersion),
+		index:                index,
+		config:               config,
+		constants:            newConstants(config.OperationalFeeMultiplier, config.WeightToFee, config.LengthToFee),
+		storage:              newStorage(),
+		mdGenerator:          mdGenerator,
 	}
 }
 
@@ -57,6 +64,22 @@ func (m module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Ca
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
+// OnFinalize updates the fee multiplier for the next block based on the weight of the current block.
+func (m module) OnFinalize(_ sc.U64) error {
+	multiplier, err := m.storage.NextFeeMultiplier.Get()
+	if err != nil {
+		return err
+	}
+
+	next, err := m.config.FeeMultiplierUpdate.Convert(multiplier)
+	if err != nil {
+		return err
+	}
+
+	m.storage.NextFeeMultiplier.Put(next)
+	return nil
+}
+
 func (m module) Metadata() primitives.MetadataModule {
 	dataV14 := primitives.MetadataModuleV14{
 		Name:    m.name(),
@@ -80,6 +103,18 @@ func (m module) Metadata() primitives.MetadataModule {
 				sc.BytesToSequenceU8(m.constants.OperationalFeeMultiplier.Bytes()),
 				"A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
 			),
+			primitives.NewMetadataModuleConstant(
+				"WeightToFee",
+				sc.ToCompact(metadata.TypesSequenceWeightToFeeCoefficient),
+				sc.BytesToSequenceU8(m.constants.WeightToFee.Bytes()),
+				"The polynomial that is applied in order to derive fee from weight.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"LengthToFee",
+				sc.ToCompact(metadata.TypesSequenceWeightToFeeCoefficient),
+				sc.BytesToSequenceU8(m.constants.LengthToFee.Bytes()),
+				"The polynomial that is applied in order to derive fee from the length of an extrinsic.",
+			),
 		},
 		Error:    sc.NewOption[sc.Compact](nil),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
@@ -166,6 +201,18 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 				primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU128, "Balance")}),
 			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
 		),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesWeightToFeeCoefficient, "WeightToFeeCoefficient", sc.Sequence[sc.Str]{"frame_support", "weights", "WeightToFeeCoefficient"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "coeff_integer", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesPerquintill, "coeff_frac", "Perquintill"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "negative", "bool"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "degree", "u8"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceWeightToFeeCoefficient, "[]WeightToFeeCoefficient",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesWeightToFeeCoefficient))),
 	}
 }
 
@@ -212,13 +259,7 @@ func (m module) computeFeeRaw(len sc.U32, weight primitives.Weight, tip primitiv
 		if err != nil {
 			return types.FeeDetails{}, err
 		}
-		// Storage value is FixedU128, which is different from U128.
-		// It implements a decimal fixed point number, which is `1 / VALUE`
-		// Example: FixedU128, VALUE is 1_000_000_000_000_000_000.
-		// FixedU64, VALUE is 1_000_000_000.
-		fixedU128Div := sc.NewU128(uint64(1_000_000_000_000_000_000))
-		bnAdjustedWeightFee := multiplier.Mul(unadjustedWeightFee)
-		adjustedWeightFee := bnAdjustedWeightFee.Div(fixedU128Div) // TODO: Create FixedU128 type
+		adjustedWeightFee := multiplier.SaturatingMulInt(unadjustedWeightFee)
 
 		dispatchClass, err := m.config.BlockWeights.Get(class)
 		if err != nil {
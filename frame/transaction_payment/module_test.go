@@ -1,6 +1,7 @@
 package transaction_payment
 
 import (
+	"errors"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
@@ -10,6 +11,7 @@ import (
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -120,6 +122,18 @@ var (
 				primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU128, "Balance")}),
 			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
 		),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesWeightToFeeCoefficient, "WeightToFeeCoefficient", sc.Sequence[sc.Str]{"frame_support", "weights", "WeightToFeeCoefficient"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "coeff_integer", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesPerquintill, "coeff_frac", "Perquintill"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "negative", "bool"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "degree", "u8"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceWeightToFeeCoefficient, "[]WeightToFeeCoefficient",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesWeightToFeeCoefficient))),
 	}
 
 	moduleV14 = types.MetadataModuleV14{
@@ -158,6 +172,18 @@ var (
 				sc.BytesToSequenceU8(operationalFeeMultiplier.Bytes()),
 				"A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
 			),
+			types.NewMetadataModuleConstant(
+				"WeightToFee",
+				sc.ToCompact(metadata.TypesSequenceWeightToFeeCoefficient),
+				sc.BytesToSequenceU8(weightToFee.Polynomial().Bytes()),
+				"The polynomial that is applied in order to derive fee from weight.",
+			),
+			types.NewMetadataModuleConstant(
+				"LengthToFee",
+				sc.ToCompact(metadata.TypesSequenceWeightToFeeCoefficient),
+				sc.BytesToSequenceU8(lengthToFee.Polynomial().Bytes()),
+				"The polynomial that is applied in order to derive fee from the length of an extrinsic.",
+			),
 		},
 		Error:    sc.NewOption[sc.Compact](nil),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
@@ -173,13 +199,13 @@ var (
 var (
 	target                module
 	mockCall              *mocks.Call
-	mockNextFeeMultiplier *mocks.StorageValue[sc.U128]
+	mockNextFeeMultiplier *mocks.StorageValue[primitives.FixedU128]
 )
 
 func setup() {
-	mockNextFeeMultiplier = new(mocks.StorageValue[sc.U128])
+	mockNextFeeMultiplier = new(mocks.StorageValue[primitives.FixedU128])
 
-	config := NewConfig(operationalFeeMultiplier, weightToFee, lengthToFee, blockWeights)
+	config := NewConfig(operationalFeeMultiplier, weightToFee, lengthToFee, blockWeights, newTestFeeAdjustment(types.WeightFromParts(1000, 0), maximumMultiplier))
 	target = New(moduleId, config, mdGenerator).(module)
 	target.storage.NextFeeMultiplier = mockNextFeeMultiplier
 }
@@ -226,6 +252,31 @@ func Test_Metadata(t *testing.T) {
 	assert.Equal(t, expectedMetadataModule, metadataModule)
 }
 
+func Test_OnFinalize(t *testing.T) {
+	setup()
+	expected := primitives.FixedU128{Inner: sc.NewU128(uint64(1_000_022_500_253_125_000))}
+
+	mockNextFeeMultiplier.On("Get").Return(multiplierOne, nil)
+	mockNextFeeMultiplier.On("Put", expected).Return()
+
+	err := target.OnFinalize(1)
+
+	assert.Nil(t, err)
+	mockNextFeeMultiplier.AssertCalled(t, "Put", expected)
+}
+
+func Test_OnFinalize_GetError(t *testing.T) {
+	setup()
+	expectedErr := errors.New("storage error")
+
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(0)}, expectedErr)
+
+	err := target.OnFinalize(1)
+
+	assert.Equal(t, expectedErr, err)
+	mockNextFeeMultiplier.AssertNotCalled(t, "Put", mock.Anything)
+}
+
 func Test_ComputeFee_TipOnlyNoFee(t *testing.T) {
 	setup()
 
@@ -251,7 +302,7 @@ func Test_ComputeFee_NoTipOnlyBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(0)}, nil)
 
 	fee, err := target.ComputeFee(0, info, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -269,7 +320,7 @@ func Test_ComputeFee_TipPlusBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(2), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(2)}, nil)
 
 	fee, err := target.ComputeFee(0, info, sc.NewU128(69))
 	assert.Nil(t, err)
@@ -287,7 +338,7 @@ func Test_ComputeFee_ByteFeePlusBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(0)}, nil)
 
 	fee, err := target.ComputeFee(42, info, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -305,7 +356,7 @@ func Test_ComputeFee_WeightFeePlusBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(0)}, nil)
 
 	fee, err := target.ComputeFee(0, info, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -314,6 +365,25 @@ func Test_ComputeFee_WeightFeePlusBaseFee(t *testing.T) {
 	assert.Equal(t, sc.NewU128(100), fee)
 }
 
+func Test_ComputeFee_WeightFeeDefaultMultiplier(t *testing.T) {
+	setup()
+
+	info := primitives.DispatchInfo{
+		Weight:  primitives.WeightFromParts(1, 0),
+		Class:   primitives.NewDispatchClassOperational(),
+		PaysFee: primitives.PaysYes,
+	}
+
+	mockNextFeeMultiplier.On("Get").Return(defaultMultiplierValue, nil)
+
+	result, err := target.ComputeFeeDetails(0, info, sc.NewU128(0))
+	assert.NoError(t, err)
+
+	mockNextFeeMultiplier.AssertCalled(t, "Get")
+	assert.Equal(t, sc.NewU128(1), result.InclusionFee.Value.AdjustedWeightFee)
+	assert.Equal(t, sc.NewU128(101), result.FinalFee())
+}
+
 func Test_ComputeFeeDetails(t *testing.T) {
 	setup()
 
@@ -323,7 +393,7 @@ func Test_ComputeFeeDetails(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(0)}, nil)
 
 	result, err := target.ComputeFeeDetails(5, info, sc.NewU128(3))
 	assert.NoError(t, err)
@@ -348,7 +418,7 @@ func Test_ComputeActualFee(t *testing.T) {
 		ActualWeight: sc.NewOption[types.Weight](primitives.WeightFromParts(0, 0)),
 		PaysFee:      0,
 	}
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.FixedU128{Inner: sc.NewU128(0)}, nil)
 
 	result, err := target.ComputeActualFee(0, info, postInfo, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -1,8 +1,8 @@
 package transaction_payment
 
 import (
-	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 var (
@@ -10,10 +10,10 @@ var (
 	keyNextFeeMultiplier  = []byte("NextFeeMultiplier")
 )
 
-var defaultMultiplierValue = sc.NewU128(1)
+var defaultMultiplierValue = primitives.FixedU128One()
 
 type storage struct {
-	NextFeeMultiplier support.StorageValue[sc.U128]
+	NextFeeMultiplier support.StorageValue[primitives.FixedU128]
 }
 
 func newStorage() *storage {
@@ -21,7 +21,7 @@ func newStorage() *storage {
 		NextFeeMultiplier: support.NewHashStorageValueWithDefault(
 			keyTransactionPayment,
 			keyNextFeeMultiplier,
-			sc.DecodeU128,
+			primitives.DecodeFixedU128,
 			&defaultMultiplierValue,
 		),
 	}
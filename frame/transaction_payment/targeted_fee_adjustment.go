@@ -0,0 +1,114 @@
+package transaction_payment
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// MultiplierUpdate computes the fee multiplier for the next block from the one of the current block.
+type MultiplierUpdate interface {
+	Convert(previous primitives.FixedU128) (primitives.FixedU128, error)
+}
+
+// TargetedFeeAdjustment updates the fee multiplier based on how full the current block is
+// compared to a target block fullness:
+//
+//	diff = (block_weight - target_weight) / max_weight
+//	next = previous * (1 + (v * diff) + (v * diff)^2 / 2)
+//
+// Only the weight of the normal dispatch class is considered, using the dimension (ref time
+// or proof size) which is closer to its limit. The result is clamped between the minimum and
+// maximum multiplier.
+type TargetedFeeAdjustment struct {
+	TargetBlockFullness primitives.Perquintill
+	AdjustmentVariable  primitives.FixedU128
+	MinimumMultiplier   primitives.FixedU128
+	MaximumMultiplier   primitives.FixedU128
+	blockWeights        primitives.BlockWeights
+	storageBlockWeight  func() (primitives.ConsumedWeight, error)
+}
+
+func NewTargetedFeeAdjustment(targetBlockFullness primitives.Perquintill, adjustmentVariable, minimumMultiplier, maximumMultiplier primitives.FixedU128, blockWeights primitives.BlockWeights, storageBlockWeight func() (primitives.ConsumedWeight, error)) TargetedFeeAdjustment {
+	return TargetedFeeAdjustment{
+		TargetBlockFullness: targetBlockFullness,
+		AdjustmentVariable:  adjustmentVariable,
+		MinimumMultiplier:   minimumMultiplier,
+		MaximumMultiplier:   maximumMultiplier,
+		blockWeights:        blockWeights,
+		storageBlockWeight:  storageBlockWeight,
+	}
+}
+
+func (tfa TargetedFeeAdjustment) Convert(previous primitives.FixedU128) (primitives.FixedU128, error) {
+	// Defensive only. Any multiplier below the minimum would never change.
+	if previous.Cmp(tfa.MinimumMultiplier) < 0 {
+		previous = tfa.MinimumMultiplier
+	}
+
+	normalWeights, err := tfa.blockWeights.Get(primitives.NewDispatchClassNormal())
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	normalMaxWeight := tfa.blockWeights.MaxBlock
+	if normalWeights.MaxTotal.HasValue {
+		normalMaxWeight = normalWeights.MaxTotal.Value
+	}
+
+	currentBlockWeight, err := tfa.storageBlockWeight()
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	normalBlockWeight, err := currentBlockWeight.Get(primitives.NewDispatchClassNormal())
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+
+	blockLimit, maxLimit := limitingDimension(normalBlockWeight.Min(normalMaxWeight), normalMaxWeight)
+
+	targetWeight := tfa.TargetBlockFullness.MulFloor(sc.NewU128(maxLimit))
+	blockWeight := sc.NewU128(blockLimit)
+
+	positive := blockWeight.Gte(targetWeight)
+	diffAbs := sc.Max128(blockWeight, targetWeight).Sub(sc.Min128(blockWeight, targetWeight))
+
+	diff := primitives.FixedU128SaturatingFromRational(diffAbs, sc.NewU128(sc.Max64(maxLimit, 1)))
+	diffSquared := diff.SaturatingPow(2)
+
+	vSquared, err := tfa.AdjustmentVariable.CheckedPow(2)
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	vSquared2 := vSquared.SaturatingDiv(primitives.FixedU128SaturatingFromInteger(sc.NewU128(2)))
+
+	firstTerm := tfa.AdjustmentVariable.SaturatingMul(diff)
+	secondTerm := vSquared2.SaturatingMul(diffSquared)
+
+	var next primitives.FixedU128
+	if positive {
+		excess := firstTerm.SaturatingAdd(secondTerm).SaturatingMul(previous)
+		next = previous.SaturatingAdd(excess)
+	} else {
+		// Defensive only. The first term is always greater than the second term.
+		negative := firstTerm.SaturatingSub(secondTerm).SaturatingMul(previous)
+		next = previous.SaturatingSub(negative)
+	}
+
+	if next.Cmp(tfa.MinimumMultiplier) < 0 {
+		return tfa.MinimumMultiplier, nil
+	}
+	if next.Cmp(tfa.MaximumMultiplier) > 0 {
+		return tfa.MaximumMultiplier, nil
+	}
+	return next, nil
+}
+
+// limitingDimension returns the block and maximum weight of the dimension in which the block is fuller.
+func limitingDimension(block, maxWeight primitives.Weight) (sc.U64, sc.U64) {
+	normalizedRefTime := primitives.PerquintillFromRational(sc.NewU128(block.RefTime), sc.NewU128(sc.Max64(maxWeight.RefTime, 1)))
+	normalizedProofSize := primitives.PerquintillFromRational(sc.NewU128(block.ProofSize), sc.NewU128(sc.Max64(maxWeight.ProofSize, 1)))
+
+	if normalizedRefTime.Parts < normalizedProofSize.Parts {
+		return block.ProofSize, maxWeight.ProofSize
+	}
+	return block.RefTime, maxWeight.RefTime
+}
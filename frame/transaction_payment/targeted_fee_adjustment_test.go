@@ -0,0 +1,98 @@
+package transaction_payment
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetBlockFullness = primitives.PerquintillFromPercent(25)
+	adjustmentVariable  = primitives.FixedU128SaturatingFromRational(sc.NewU128(3), sc.NewU128(100_000))
+	minimumMultiplier   = primitives.FixedU128SaturatingFromRational(sc.NewU128(1), sc.NewU128(1_000_000_000))
+	maximumMultiplier   = primitives.FixedU128{Inner: sc.MaxU128()}
+	multiplierOne       = primitives.FixedU128One()
+)
+
+func newTestFeeAdjustment(normalWeight primitives.Weight, maximumMultiplier primitives.FixedU128) TargetedFeeAdjustment {
+	return NewTargetedFeeAdjustment(
+		targetBlockFullness,
+		adjustmentVariable,
+		minimumMultiplier,
+		maximumMultiplier,
+		blockWeights,
+		func() (primitives.ConsumedWeight, error) {
+			return primitives.ConsumedWeight{Normal: normalWeight}, nil
+		},
+	)
+}
+
+func Test_TargetedFeeAdjustment_Convert_FullBlock(t *testing.T) {
+	target := newTestFeeAdjustment(primitives.WeightFromParts(1000, 0), maximumMultiplier)
+
+	result, err := target.Convert(multiplierOne)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.FixedU128{Inner: sc.NewU128(uint64(1_000_022_500_253_125_000))}, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_EmptyBlock(t *testing.T) {
+	target := newTestFeeAdjustment(primitives.WeightZero(), maximumMultiplier)
+
+	result, err := target.Convert(multiplierOne)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.FixedU128{Inner: sc.NewU128(uint64(999_992_500_028_125_000))}, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_TargetBlock(t *testing.T) {
+	target := newTestFeeAdjustment(primitives.WeightFromParts(250, 0), maximumMultiplier)
+
+	result, err := target.Convert(multiplierOne)
+
+	assert.NoError(t, err)
+	assert.Equal(t, multiplierOne, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_ClampsToMinimum(t *testing.T) {
+	target := newTestFeeAdjustment(primitives.WeightZero(), maximumMultiplier)
+
+	result, err := target.Convert(primitives.FixedU128Zero())
+
+	assert.NoError(t, err)
+	assert.Equal(t, minimumMultiplier, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_ClampsToMaximum(t *testing.T) {
+	target := newTestFeeAdjustment(primitives.WeightFromParts(1000, 0), multiplierOne)
+
+	result, err := target.Convert(multiplierOne)
+
+	assert.NoError(t, err)
+	assert.Equal(t, multiplierOne, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_StorageBlockWeightError(t *testing.T) {
+	expectedErr := errors.New("block weight error")
+	target := NewTargetedFeeAdjustment(targetBlockFullness, adjustmentVariable, minimumMultiplier, maximumMultiplier, blockWeights,
+		func() (primitives.ConsumedWeight, error) {
+			return primitives.ConsumedWeight{}, expectedErr
+		},
+	)
+
+	_, err := target.Convert(multiplierOne)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_TargetedFeeAdjustment_Convert_AdjustmentVariableOverflow(t *testing.T) {
+	target := newTestFeeAdjustment(primitives.WeightFromParts(1000, 0), maximumMultiplier)
+	target.AdjustmentVariable = primitives.FixedU128{Inner: sc.MaxU128()}
+
+	_, err := target.Convert(multiplierOne)
+
+	assert.Equal(t, primitives.NewArithmeticErrorOverflow(), err)
+}
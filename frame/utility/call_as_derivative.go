@@ -0,0 +1,140 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	derivativeAccountPrefix = []byte("modlpy/utilisuba")
+)
+
+// Send a call through an indexed pseudonym of the sender.
+// The dispatch origin for this call must be `Signed`.
+type callAsDerivative struct {
+	primitives.Callable
+	constants *consts
+	hashing   io.Hashing
+}
+
+func newCallAsDerivative(moduleId sc.U8, functionId sc.U8, constants *consts, hashing io.Hashing) primitives.Call {
+	call := callAsDerivative{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U16(0), primitives.RuntimeCall{}),
+		},
+		constants: constants,
+		hashing:   hashing,
+	}
+
+	return call
+}
+
+func (c callAsDerivative) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callAsDerivative) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	index, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decoder.DecodeCall(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(index, primitives.NewRuntimeCall(call))
+	return c, nil
+}
+
+func (c callAsDerivative) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAsDerivative) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAsDerivative) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callAsDerivative) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callAsDerivative) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callAsDerivative) BaseWeight() primitives.Weight {
+	dispatchInfo := primitives.GetDispatchInfo(c.Arguments[1].(primitives.RuntimeCall))
+
+	return callAsDerivativeWeight(c.constants.DbWeight).
+		SaturatingAdd(dispatchInfo.Weight).
+		// AccountData for inner call origin account data.
+		SaturatingAdd(c.constants.DbWeight.ReadsWrites(1, 1))
+}
+
+func (_ callAsDerivative) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callAsDerivative) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.GetDispatchInfo(c.Arguments[1].(primitives.RuntimeCall)).Class
+}
+
+func (_ callAsDerivative) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callAsDerivative) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	index := args[0].(sc.U16)
+	call := args[1].(primitives.RuntimeCall)
+
+	pseudonym, err := c.derivativeAccountId(who, index)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	dispatchInfo := primitives.GetDispatchInfo(call)
+	postInfo, dispatchErr := call.Dispatch(primitives.NewRawOriginSigned(pseudonym), call.Args())
+
+	// Always take into account the base weight of this call.
+	weight := callAsDerivativeWeight(c.constants.DbWeight).
+		SaturatingAdd(c.constants.DbWeight.ReadsWrites(1, 1)).
+		// Add the real weight of the dispatch.
+		SaturatingAdd(postInfo.CalcActualWeight(&dispatchInfo))
+
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](weight),
+		PaysFee:      primitives.PaysYes,
+	}, dispatchErr
+}
+
+func (_ callAsDerivative) Docs() string {
+	return "Send a call through an indexed pseudonym of the sender. " +
+		"The dispatch origin for this call must be `Signed`."
+}
+
+// derivativeAccountId derives a sub-account id from the account id and the index.
+func (c callAsDerivative) derivativeAccountId(who primitives.AccountId, index sc.U16) (primitives.AccountId, error) {
+	entropy := append([]byte{}, derivativeAccountPrefix...)
+	entropy = append(entropy, who.Bytes()...)
+	entropy = append(entropy, index.Bytes()...)
+
+	return primitives.NewAccountId(sc.BytesToSequenceU8(c.hashing.Blake256(entropy))...)
+}
@@ -0,0 +1,160 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	derivativeIndex     = sc.U16(1)
+	derivativeAccountId = constants.TwoAccountId
+	derivativeEntropy   = append(append(append([]byte{}, derivativeAccountPrefix...), whoAccountId.Bytes()...), derivativeIndex.Bytes()...)
+)
+
+func Test_Call_AsDerivative_New(t *testing.T) {
+	target := setupCallAsDerivative()
+	expected := callAsDerivative{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionAsDerivativeIndex,
+			Arguments:  sc.NewVaryingData(sc.U16(0), primitives.RuntimeCall{}),
+		},
+		constants: newConstants(dbWeight, batchedCallsLimit),
+		hashing:   mockHashing,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_AsDerivative_DecodeArgs(t *testing.T) {
+	target := setupCallAsDerivative()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(derivativeIndex.Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_AsDerivative_DecodeNestedArgs(t *testing.T) {
+	target := setupCallAsDerivative()
+	buffer := bytes.NewBuffer(derivativeIndex.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(derivativeIndex, primitives.NewRuntimeCall(mockCall)), call.Args())
+}
+
+func Test_Call_AsDerivative_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallAsDerivative()
+	buffer := bytes.NewBuffer(derivativeIndex.Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_AsDerivative_ModuleIndex(t *testing.T) {
+	target := setupCallAsDerivative()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_AsDerivative_FunctionIndex(t *testing.T) {
+	target := setupCallAsDerivative()
+
+	assert.Equal(t, sc.U8(functionAsDerivativeIndex), target.FunctionIndex())
+}
+
+func Test_Call_AsDerivative_BaseWeight(t *testing.T) {
+	target := setupDecodedCallAsDerivative()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+
+	expected := callAsDerivativeWeight(dbWeight).
+		SaturatingAdd(callWeight).
+		SaturatingAdd(dbWeight.ReadsWrites(1, 1))
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_AsDerivative_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallAsDerivative()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AsDerivative_PaysFee(t *testing.T) {
+	target := setupCallAsDerivative()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_AsDerivative_Dispatch(t *testing.T) {
+	target := setupDecodedCallAsDerivative()
+	derivativeOrigin := primitives.NewRawOriginSigned(derivativeAccountId)
+
+	mockHashing.On("Blake256", derivativeEntropy).Return(derivativeAccountId.Bytes())
+	setupCallDispatch(mockCall, derivativeOrigin, nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callAsDerivativeWeight(dbWeight).
+		SaturatingAdd(dbWeight.ReadsWrites(1, 1)).
+		SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockHashing.AssertCalled(t, "Blake256", derivativeEntropy)
+	mockCall.AssertCalled(t, "Dispatch", derivativeOrigin, callArgs)
+}
+
+func Test_Call_AsDerivative_Dispatch_CallFails(t *testing.T) {
+	target := setupDecodedCallAsDerivative()
+	derivativeOrigin := primitives.NewRawOriginSigned(derivativeAccountId)
+
+	mockHashing.On("Blake256", derivativeEntropy).Return(derivativeAccountId.Bytes())
+	setupCallDispatch(mockCall, derivativeOrigin, callErr)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callAsDerivativeWeight(dbWeight).
+		SaturatingAdd(dbWeight.ReadsWrites(1, 1)).
+		SaturatingAdd(callWeight)
+
+	assert.Equal(t, callErr, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+}
+
+func Test_Call_AsDerivative_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallAsDerivative()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func setupCallAsDerivative() primitives.Call {
+	setupMocks()
+
+	return newCallAsDerivative(moduleId, functionAsDerivativeIndex, newConstants(dbWeight, batchedCallsLimit), mockHashing)
+}
+
+func setupDecodedCallAsDerivative() primitives.Call {
+	target := setupCallAsDerivative().(callAsDerivative)
+	target.Arguments = sc.NewVaryingData(derivativeIndex, primitives.NewRuntimeCall(mockCall))
+
+	return target
+}
@@ -0,0 +1,13 @@
+// Reference weight, to be replaced by the output of the BenchmarkUtilityAsDerivative benchmark.
+
+package utility
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsDerivativeWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(3710000, 0).
+		SaturatingAdd(dbWeight.Reads(0)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
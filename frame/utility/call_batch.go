@@ -0,0 +1,147 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Send a batch of dispatch calls.
+// May be called from any origin except `None`.
+// This will return `Ok` in all circumstances. To determine the success of the batch, an
+// event is deposited. If a call failed and the batch was interrupted, then the
+// `BatchInterrupted` event is deposited, along with the number of successful calls made
+// and the error of the failed call. If all were successful, then the `BatchCompleted`
+// event is deposited.
+type callBatch struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newCallBatch(moduleId sc.U8, functionId sc.U8, eventDepositor primitives.EventDepositor, constants *consts, transactional support.Transactional[primitives.PostDispatchInfo]) primitives.Call {
+	call := callBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{}),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		transactional:  transactional,
+	}
+
+	return call
+}
+
+func (c callBatch) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callBatch) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	calls, err := decodeCalls(decoder, buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(calls)
+	return c, nil
+}
+
+func (c callBatch) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callBatch) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callBatch) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callBatch) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callBatch) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callBatch) BaseWeight() primitives.Weight {
+	calls := c.Arguments[0].(sc.Sequence[primitives.RuntimeCall])
+	callsWeight, _ := callsDispatchInfo(calls)
+
+	return callBatchWeight(c.constants.DbWeight, sc.U64(len(calls))).SaturatingAdd(callsWeight)
+}
+
+func (_ callBatch) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callBatch) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	_, class := callsDispatchInfo(c.Arguments[0].(sc.Sequence[primitives.RuntimeCall]))
+	return class
+}
+
+func (_ callBatch) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callBatch) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSignedOrRoot(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	calls := args[0].(sc.Sequence[primitives.RuntimeCall])
+	if sc.U32(len(calls)) > c.constants.BatchedCallsLimit {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorTooManyCalls(c.ModuleId)
+	}
+
+	// Track the actual weight of each of the batch calls.
+	weight := primitives.WeightZero()
+	for index, call := range calls {
+		dispatchInfo := primitives.GetDispatchInfo(call)
+
+		postInfo, dispatchErr := dispatchWithStorageLayer(c.transactional, call, origin)
+		// Add the weight of this call.
+		weight = weight.SaturatingAdd(postInfo.CalcActualWeight(&dispatchInfo))
+
+		if dispatchErr != nil {
+			err, unexpectedErr := toDispatchError(dispatchErr)
+			if unexpectedErr != nil {
+				return primitives.PostDispatchInfo{}, unexpectedErr
+			}
+			c.eventDepositor.DepositEvent(newEventBatchInterrupted(c.ModuleId, sc.U32(index), err))
+			// Take the weight of this function itself into account.
+			baseWeight := callBatchWeight(c.constants.DbWeight, sc.U64(index+1))
+			// Return the actual used weight + base weight of this call.
+			return primitives.PostDispatchInfo{
+				ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+				PaysFee:      primitives.PaysYes,
+			}, nil
+		}
+
+		c.eventDepositor.DepositEvent(newEventItemCompleted(c.ModuleId))
+	}
+
+	c.eventDepositor.DepositEvent(newEventBatchCompleted(c.ModuleId))
+
+	baseWeight := callBatchWeight(c.constants.DbWeight, sc.U64(len(calls)))
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+		PaysFee:      primitives.PaysYes,
+	}, nil
+}
+
+func (_ callBatch) Docs() string {
+	return "Send a batch of dispatch calls. " +
+		"May be called from any origin except `None`. " +
+		"This will return `Ok` in all circumstances. To determine the success of the batch, an event is deposited. " +
+		"If a call failed and the batch was interrupted, then the `BatchInterrupted` event is deposited, " +
+		"along with the number of successful calls made and the error of the failed call. " +
+		"If all were successful, then the `BatchCompleted` event is deposited."
+}
@@ -0,0 +1,139 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Send a batch of dispatch calls and atomically execute them.
+// The whole transaction will rollback and fail if any of the calls failed.
+// May be called from any origin except `None`.
+type callBatchAll struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newCallBatchAll(moduleId sc.U8, functionId sc.U8, eventDepositor primitives.EventDepositor, constants *consts, transactional support.Transactional[primitives.PostDispatchInfo]) primitives.Call {
+	call := callBatchAll{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{}),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		transactional:  transactional,
+	}
+
+	return call
+}
+
+func (c callBatchAll) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callBatchAll) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	calls, err := decodeCalls(decoder, buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(calls)
+	return c, nil
+}
+
+func (c callBatchAll) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callBatchAll) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callBatchAll) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callBatchAll) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callBatchAll) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callBatchAll) BaseWeight() primitives.Weight {
+	calls := c.Arguments[0].(sc.Sequence[primitives.RuntimeCall])
+	callsWeight, _ := callsDispatchInfo(calls)
+
+	return callBatchAllAllWeight(c.constants.DbWeight, sc.U64(len(calls))).SaturatingAdd(callsWeight)
+}
+
+func (_ callBatchAll) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callBatchAll) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	_, class := callsDispatchInfo(c.Arguments[0].(sc.Sequence[primitives.RuntimeCall]))
+	return class
+}
+
+func (_ callBatchAll) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callBatchAll) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSignedOrRoot(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	calls := args[0].(sc.Sequence[primitives.RuntimeCall])
+	if sc.U32(len(calls)) > c.constants.BatchedCallsLimit {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorTooManyCalls(c.ModuleId)
+	}
+
+	// Track the actual weight of each of the batch calls.
+	weight := primitives.WeightZero()
+	// Track the number of dispatched calls.
+	dispatched := 0
+
+	_, dispatchErr := c.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		for _, call := range calls {
+			dispatchInfo := primitives.GetDispatchInfo(call)
+
+			postInfo, err := call.Dispatch(origin, call.Args())
+			// Add the weight of this call.
+			weight = weight.SaturatingAdd(postInfo.CalcActualWeight(&dispatchInfo))
+			dispatched++
+
+			if err != nil {
+				return primitives.PostDispatchInfo{}, err
+			}
+
+			c.eventDepositor.DepositEvent(newEventItemCompleted(c.ModuleId))
+		}
+
+		c.eventDepositor.DepositEvent(newEventBatchCompleted(c.ModuleId))
+
+		return primitives.PostDispatchInfo{}, nil
+	})
+
+	// Take the weight of this function itself into account.
+	baseWeight := callBatchAllWeight(c.constants.DbWeight, sc.U64(dispatched))
+	// Return the actual used weight + base weight of this call.
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+		PaysFee:      primitives.PaysYes,
+	}, dispatchErr
+}
+
+func (_ callBatchAll) Docs() string {
+	return "Send a batch of dispatch calls and atomically execute them. " +
+		"The whole transaction will rollback and fail if any of the calls failed. " +
+		"May be called from any origin except `None`."
+}
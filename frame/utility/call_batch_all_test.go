@@ -0,0 +1,134 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_BatchAll_New(t *testing.T) {
+	target := setupCallBatchAll()
+	expected := callBatchAll{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionBatchAllIndex,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{}),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight, batchedCallsLimit),
+		transactional:  mockTransactional,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_BatchAll_DecodeNestedArgs(t *testing.T) {
+	target := setupCallBatchAll()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(1)).Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall)}), call.Args())
+}
+
+func Test_Call_BatchAll_FunctionIndex(t *testing.T) {
+	target := setupCallBatchAll()
+
+	assert.Equal(t, sc.U8(functionBatchAllIndex), target.FunctionIndex())
+}
+
+func Test_Call_BatchAll_BaseWeight(t *testing.T) {
+	target := setupDecodedCallBatchAll()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassNormal())
+
+	expected := callBatchAllWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_BatchAll_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallBatchAll()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassNormal())
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_BatchAll_Dispatch(t *testing.T) {
+	target := setupDecodedCallBatchAll()
+	setupCallDispatch(mockCall, signedOrigin, nil)
+	setupCallDispatch(mockCallOther, signedOrigin, nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventItemCompleted(moduleId)).Return()
+	mockEventDepositor.On("DepositEvent", newEventBatchCompleted(moduleId)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callBatchAllWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockTransactional.AssertNumberOfCalls(t, "WithStorageLayer", 1)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 3)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_BatchAll_Dispatch_Fails(t *testing.T) {
+	target := setupDecodedCallBatchAll()
+	setupCallDispatch(mockCall, signedOrigin, nil)
+	setupCallDispatch(mockCallOther, signedOrigin, callErr)
+	runInStorageLayer(callErr)
+	mockEventDepositor.On("DepositEvent", newEventItemCompleted(moduleId)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callBatchAllWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Equal(t, callErr, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_BatchAll_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallBatchAll()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_BatchAll_Dispatch_TooManyCalls(t *testing.T) {
+	target := setupCallBatchAll()
+	calls := sc.Sequence[primitives.RuntimeCall]{
+		primitives.NewRuntimeCall(mockCall),
+		primitives.NewRuntimeCall(mockCall),
+		primitives.NewRuntimeCall(mockCall),
+		primitives.NewRuntimeCall(mockCall),
+	}
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(calls))
+
+	assert.Equal(t, NewDispatchErrorTooManyCalls(moduleId), err)
+}
+
+func setupCallBatchAll() primitives.Call {
+	setupMocks()
+
+	return newCallBatchAll(moduleId, functionBatchAllIndex, mockEventDepositor, newConstants(dbWeight, batchedCallsLimit), mockTransactional)
+}
+
+func setupDecodedCallBatchAll() primitives.Call {
+	target := setupCallBatchAll().(callBatchAll)
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)})
+
+	return target
+}
@@ -0,0 +1,234 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	innerCallBytes = []byte{1, 2, 3}
+)
+
+func Test_Call_Batch_New(t *testing.T) {
+	target := setupCallBatch()
+	expected := callBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionBatchIndex,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{}),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight, batchedCallsLimit),
+		transactional:  mockTransactional,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_Batch_DecodeArgs(t *testing.T) {
+	target := setupCallBatch()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(sc.ToCompact(sc.U32(1)).Bytes()))
+
+	assert.Nil(t, call)
+	assert.Equal(t, primitives.ErrNestedCallDecoder, err)
+}
+
+func Test_Call_Batch_DecodeNestedArgs(t *testing.T) {
+	target := setupCallBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(2)).Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil).Once()
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCallOther, nil).Once()
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)}),
+		call.Args(),
+	)
+}
+
+func Test_Call_Batch_DecodeNestedArgs_Error(t *testing.T) {
+	target := setupCallBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(2)).Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, call)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Call_Batch_Encode(t *testing.T) {
+	target := setupDecodedCallBatch()
+	buffer := &bytes.Buffer{}
+
+	mockCall.On("Encode", buffer).Run(func(args mock.Arguments) {
+		args.Get(0).(*bytes.Buffer).Write(innerCallBytes)
+	})
+	mockCallOther.On("Encode", buffer).Run(func(args mock.Arguments) {
+		args.Get(0).(*bytes.Buffer).Write(innerCallBytes)
+	})
+
+	err := target.Encode(buffer)
+
+	expectedBytes := []byte{moduleId, functionBatchIndex}
+	expectedBytes = append(expectedBytes, sc.ToCompact(sc.U32(2)).Bytes()...)
+	expectedBytes = append(expectedBytes, innerCallBytes...)
+	expectedBytes = append(expectedBytes, innerCallBytes...)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBytes, buffer.Bytes())
+}
+
+func Test_Call_Batch_ModuleIndex(t *testing.T) {
+	target := setupCallBatch()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Batch_FunctionIndex(t *testing.T) {
+	target := setupCallBatch()
+
+	assert.Equal(t, sc.U8(functionBatchIndex), target.FunctionIndex())
+}
+
+func Test_Call_Batch_BaseWeight(t *testing.T) {
+	target := setupDecodedCallBatch()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassNormal())
+
+	expected := callBatchWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_Batch_WeighData(t *testing.T) {
+	target := setupCallBatch()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Batch_ClassifyDispatch(t *testing.T) {
+	target := setupDecodedCallBatch()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Batch_PaysFee(t *testing.T) {
+	target := setupCallBatch()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Batch_Dispatch(t *testing.T) {
+	target := setupDecodedCallBatch()
+	setupCallDispatch(mockCall, signedOrigin, nil)
+	setupCallDispatch(mockCallOther, signedOrigin, nil)
+	runInStorageLayer(nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventItemCompleted(moduleId)).Return()
+	mockEventDepositor.On("DepositEvent", newEventBatchCompleted(moduleId)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callBatchWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockCall.AssertCalled(t, "Dispatch", signedOrigin, callArgs)
+	mockCallOther.AssertCalled(t, "Dispatch", signedOrigin, callArgs)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 3)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_Batch_Dispatch_Root(t *testing.T) {
+	target := setupDecodedCallBatch()
+	rootOrigin := primitives.NewRawOriginRoot()
+	setupCallDispatch(mockCall, rootOrigin, nil)
+	setupCallDispatch(mockCallOther, rootOrigin, nil)
+	runInStorageLayer(nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", mock.Anything).Return()
+
+	_, err := target.Dispatch(rootOrigin, target.Args())
+
+	assert.Nil(t, err)
+	mockCall.AssertCalled(t, "Dispatch", rootOrigin, callArgs)
+	mockCallOther.AssertCalled(t, "Dispatch", rootOrigin, callArgs)
+}
+
+func Test_Call_Batch_Dispatch_Interrupted(t *testing.T) {
+	target := setupDecodedCallBatch()
+	setupCallDispatch(mockCall, signedOrigin, callErr)
+	runInStorageLayer(callErr)
+	mockEventDepositor.On("DepositEvent", newEventBatchInterrupted(moduleId, 0, callErr)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callBatchWeight(dbWeight, 1).SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockCallOther.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchInterrupted(moduleId, 0, callErr))
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 1)
+}
+
+func Test_Call_Batch_Dispatch_UnexpectedError(t *testing.T) {
+	target := setupDecodedCallBatch()
+	setupCallDispatch(mockCall, signedOrigin, expectedErr)
+	runInStorageLayer(expectedErr)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, expectedErr, err)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Call_Batch_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallBatch()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_Batch_Dispatch_TooManyCalls(t *testing.T) {
+	target := setupCallBatch()
+	calls := sc.Sequence[primitives.RuntimeCall]{
+		primitives.NewRuntimeCall(mockCall),
+		primitives.NewRuntimeCall(mockCall),
+		primitives.NewRuntimeCall(mockCall),
+		primitives.NewRuntimeCall(mockCall),
+	}
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(calls))
+
+	assert.Equal(t, NewDispatchErrorTooManyCalls(moduleId), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func setupCallBatch() primitives.Call {
+	setupMocks()
+
+	return newCallBatch(moduleId, functionBatchIndex, mockEventDepositor, newConstants(dbWeight, batchedCallsLimit), mockTransactional)
+}
+
+func setupDecodedCallBatch() primitives.Call {
+	target := setupCallBatch().(callBatch)
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)})
+
+	return target
+}
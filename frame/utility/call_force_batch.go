@@ -0,0 +1,143 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Send a batch of dispatch calls.
+// Unlike `batch`, it allows errors and won't interrupt.
+// May be called from any origin except `None`.
+// If a call failed, then the `ItemFailed` event is deposited and the batch continues
+// with the next call. At the end, either `BatchCompleted` or `BatchCompletedWithErrors`
+// event is deposited.
+type callForceBatch struct {
+	primitives.Callable
+	eventDepositor primitives.EventDepositor
+	constants      *consts
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+}
+
+func newCallForceBatch(moduleId sc.U8, functionId sc.U8, eventDepositor primitives.EventDepositor, constants *consts, transactional support.Transactional[primitives.PostDispatchInfo]) primitives.Call {
+	call := callForceBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{}),
+		},
+		eventDepositor: eventDepositor,
+		constants:      constants,
+		transactional:  transactional,
+	}
+
+	return call
+}
+
+func (c callForceBatch) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	return nil, primitives.ErrNestedCallDecoder
+}
+
+func (c callForceBatch) DecodeNestedArgs(decoder primitives.CallDecoder, buffer *bytes.Buffer) (primitives.Call, error) {
+	calls, err := decodeCalls(decoder, buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(calls)
+	return c, nil
+}
+
+func (c callForceBatch) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callForceBatch) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callForceBatch) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callForceBatch) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callForceBatch) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callForceBatch) BaseWeight() primitives.Weight {
+	calls := c.Arguments[0].(sc.Sequence[primitives.RuntimeCall])
+	callsWeight, _ := callsDispatchInfo(calls)
+
+	return callForceBatchWeight(c.constants.DbWeight, sc.U64(len(calls))).SaturatingAdd(callsWeight)
+}
+
+func (_ callForceBatch) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callForceBatch) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	_, class := callsDispatchInfo(c.Arguments[0].(sc.Sequence[primitives.RuntimeCall]))
+	return class
+}
+
+func (_ callForceBatch) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callForceBatch) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := ensureSignedOrRoot(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	calls := args[0].(sc.Sequence[primitives.RuntimeCall])
+	if sc.U32(len(calls)) > c.constants.BatchedCallsLimit {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorTooManyCalls(c.ModuleId)
+	}
+
+	// Track the actual weight of each of the batch calls.
+	weight := primitives.WeightZero()
+	// Track failed dispatch occur.
+	hasError := false
+	for _, call := range calls {
+		dispatchInfo := primitives.GetDispatchInfo(call)
+
+		postInfo, dispatchErr := dispatchWithStorageLayer(c.transactional, call, origin)
+		// Add the weight of this call.
+		weight = weight.SaturatingAdd(postInfo.CalcActualWeight(&dispatchInfo))
+
+		if dispatchErr != nil {
+			err, unexpectedErr := toDispatchError(dispatchErr)
+			if unexpectedErr != nil {
+				return primitives.PostDispatchInfo{}, unexpectedErr
+			}
+			hasError = true
+			c.eventDepositor.DepositEvent(newEventItemFailed(c.ModuleId, err))
+		} else {
+			c.eventDepositor.DepositEvent(newEventItemCompleted(c.ModuleId))
+		}
+	}
+
+	if hasError {
+		c.eventDepositor.DepositEvent(newEventBatchCompletedWithErrors(c.ModuleId))
+	} else {
+		c.eventDepositor.DepositEvent(newEventBatchCompleted(c.ModuleId))
+	}
+
+	baseWeight := callForceBatchWeight(c.constants.DbWeight, sc.U64(len(calls)))
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+		PaysFee:      primitives.PaysYes,
+	}, nil
+}
+
+func (_ callForceBatch) Docs() string {
+	return "Send a batch of dispatch calls. " +
+		"Unlike `batch`, it allows errors and won't interrupt. " +
+		"May be called from any origin except `None`."
+}
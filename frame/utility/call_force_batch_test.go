@@ -0,0 +1,119 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ForceBatch_New(t *testing.T) {
+	target := setupCallForceBatch()
+	expected := callForceBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionForceBatchIndex,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{}),
+		},
+		eventDepositor: mockEventDepositor,
+		constants:      newConstants(dbWeight, batchedCallsLimit),
+		transactional:  mockTransactional,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_ForceBatch_DecodeNestedArgs(t *testing.T) {
+	target := setupCallForceBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(1)).Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil)
+
+	call, err := target.(primitives.NestedCall).DecodeNestedArgs(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall)}), call.Args())
+}
+
+func Test_Call_ForceBatch_FunctionIndex(t *testing.T) {
+	target := setupCallForceBatch()
+
+	assert.Equal(t, sc.U8(functionForceBatchIndex), target.FunctionIndex())
+}
+
+func Test_Call_ForceBatch_BaseWeight(t *testing.T) {
+	target := setupDecodedCallForceBatch()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassNormal())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassNormal())
+
+	expected := callForceBatchWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Equal(t, expected, target.BaseWeight())
+}
+
+func Test_Call_ForceBatch_Dispatch(t *testing.T) {
+	target := setupDecodedCallForceBatch()
+	setupCallDispatch(mockCall, signedOrigin, nil)
+	setupCallDispatch(mockCallOther, signedOrigin, nil)
+	runInStorageLayer(nil)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventItemCompleted(moduleId)).Return()
+	mockEventDepositor.On("DepositEvent", newEventBatchCompleted(moduleId)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callForceBatchWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 3)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_ForceBatch_Dispatch_WithErrors(t *testing.T) {
+	target := setupDecodedCallForceBatch()
+	setupCallDispatch(mockCall, signedOrigin, callErr)
+	setupCallDispatch(mockCallOther, signedOrigin, nil)
+	runInStorageLayer(callErr)
+	runInStorageLayer(nil)
+	mockEventDepositor.On("DepositEvent", newEventItemFailed(moduleId, callErr)).Return()
+	mockEventDepositor.On("DepositEvent", newEventItemCompleted(moduleId)).Return()
+	mockEventDepositor.On("DepositEvent", newEventBatchCompletedWithErrors(moduleId)).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	expectedWeight := callForceBatchWeight(dbWeight, 2).SaturatingAdd(callWeight).SaturatingAdd(callWeight)
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](expectedWeight)}, result)
+	mockCallOther.AssertCalled(t, "Dispatch", signedOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventItemFailed(moduleId, callErr))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventItemCompleted(moduleId))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompletedWithErrors(moduleId))
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_ForceBatch_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallForceBatch()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func setupCallForceBatch() primitives.Call {
+	setupMocks()
+
+	return newCallForceBatch(moduleId, functionForceBatchIndex, mockEventDepositor, newConstants(dbWeight, batchedCallsLimit), mockTransactional)
+}
+
+func setupDecodedCallForceBatch() primitives.Call {
+	target := setupCallForceBatch().(callForceBatch)
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)})
+
+	return target
+}
@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkUtilityForceBatch benchmark.
+
+package utility
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callForceBatchWeight(dbWeight primitives.RuntimeDbWeight, size sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(5145000, 0).
+		SaturatingAdd(primitives.WeightFromParts(3028000, 0).SaturatingMul(size)).
+		SaturatingAdd(dbWeight.Reads(0)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
@@ -0,0 +1,20 @@
+package utility
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight          primitives.RuntimeDbWeight
+	EventDepositor    primitives.EventDepositor
+	BatchedCallsLimit sc.U32
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, batchedCallsLimit sc.U32) *Config {
+	return &Config{
+		DbWeight:          dbWeight,
+		EventDepositor:    eventDepositor,
+		BatchedCallsLimit: batchedCallsLimit,
+	}
+}
@@ -0,0 +1,22 @@
+package utility
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight          primitives.RuntimeDbWeight
+	BatchedCallsLimit sc.U32
+}
+
+type metadataConstants struct {
+	BatchedCallsLimit primitives.BatchedCallsLimit
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, batchedCallsLimit sc.U32) *consts {
+	return &consts{
+		DbWeight:          dbWeight,
+		BatchedCallsLimit: batchedCallsLimit,
+	}
+}
@@ -0,0 +1,19 @@
+package utility
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Utility module errors.
+const (
+	ErrorTooManyCalls sc.U8 = iota
+)
+
+func NewDispatchErrorTooManyCalls(moduleId sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyCalls),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
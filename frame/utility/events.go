@@ -0,0 +1,85 @@
+package utility
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Utility module events.
+const (
+	EventBatchInterrupted sc.U8 = iota
+	EventBatchCompleted
+	EventBatchCompletedWithErrors
+	EventItemCompleted
+	EventItemFailed
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid utility.Event module")
+	errInvalidEventType   = errors.New("invalid utility.Event type")
+)
+
+func newEventBatchInterrupted(moduleIndex sc.U8, index sc.U32, err primitives.DispatchError) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventBatchInterrupted, index, err)
+}
+
+func newEventBatchCompleted(moduleIndex sc.U8) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventBatchCompleted)
+}
+
+func newEventBatchCompletedWithErrors(moduleIndex sc.U8) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventBatchCompletedWithErrors)
+}
+
+func newEventItemCompleted(moduleIndex sc.U8) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventItemCompleted)
+}
+
+func newEventItemFailed(moduleIndex sc.U8, err primitives.DispatchError) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventItemFailed, err)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventBatchInterrupted:
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		dispatchErr, err := primitives.DecodeDispatchError(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventBatchInterrupted(moduleIndex, index, dispatchErr), nil
+	case EventBatchCompleted:
+		return newEventBatchCompleted(moduleIndex), nil
+	case EventBatchCompletedWithErrors:
+		return newEventBatchCompletedWithErrors(moduleIndex), nil
+	case EventItemCompleted:
+		return newEventItemCompleted(moduleIndex), nil
+	case EventItemFailed:
+		dispatchErr, err := primitives.DecodeDispatchError(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventItemFailed(moduleIndex, dispatchErr), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,263 @@
+package utility
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_utility`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionBatchIndex        = 0
+	functionAsDerivativeIndex = 1
+	functionBatchAllIndex     = 2
+	functionForceBatchIndex   = 4
+)
+
+const (
+	name           = sc.Str("Utility")
+	storageVersion = sc.U16(0)
+)
+
+// Module provides stateless helpers for dispatching batches of calls and calls
+// from derivative accounts.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.BatchedCallsLimit)
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionBatchIndex] = newCallBatch(index, functionBatchIndex, config.EventDepositor, constants, support.NewTransactional[primitives.PostDispatchInfo](logger))
+	functions[functionAsDerivativeIndex] = newCallAsDerivative(index, functionAsDerivativeIndex, constants, io.NewHashing())
+	functions[functionBatchAllIndex] = newCallBatchAll(index, functionBatchAllIndex, config.EventDepositor, constants, support.NewTransactional[primitives.PostDispatchInfo](logger))
+	functions[functionForceBatchIndex] = newCallForceBatch(index, functionForceBatchIndex, config.EventDepositor, constants, support.NewTransactional[primitives.PostDispatchInfo](logger))
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion([]byte(name), storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdUtilityCalls := m.mdGenerator.BuildCallsMetadata("Utility", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	mdConstants := metadataConstants{
+		BatchedCallsLimit: primitives.BatchedCallsLimit{U32: m.constants.BatchedCallsLimit},
+	}
+
+	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: sc.Option[primitives.MetadataModuleStorage]{},
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdUtilityCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdUtilityCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Utility, Runtime>"),
+				},
+				m.Index,
+				"Call.Utility"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesUtilityEvent, "pallet_utility::Event"),
+				},
+				m.Index,
+				"Events.Utility"),
+		),
+		Constants: moduleMdConstants,
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesUtilityErrors),
+				},
+				m.Index,
+				"Errors.Utility"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesUtilityEvent, "pallet_utility pallet Event", sc.Sequence[sc.Str]{"pallet_utility", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"BatchInterrupted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "error", "DispatchError"),
+					},
+					EventBatchInterrupted,
+					"Events.BatchInterrupted"),
+				primitives.NewMetadataDefinitionVariant(
+					"BatchCompleted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventBatchCompleted,
+					"Events.BatchCompleted"),
+				primitives.NewMetadataDefinitionVariant(
+					"BatchCompletedWithErrors",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventBatchCompletedWithErrors,
+					"Events.BatchCompletedWithErrors"),
+				primitives.NewMetadataDefinitionVariant(
+					"ItemCompleted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					EventItemCompleted,
+					"Events.ItemCompleted"),
+				primitives.NewMetadataDefinitionVariant(
+					"ItemFailed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "error", "DispatchError"),
+					},
+					EventItemFailed,
+					"Events.ItemFailed"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesUtilityErrors,
+			"pallet_utility pallet Error",
+			sc.Sequence[sc.Str]{"pallet_utility", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyCalls",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyCalls,
+						"Too many calls batched."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+// decodeCalls decodes a sequence of calls, using the runtime decoder for each of them.
+func decodeCalls(decoder primitives.CallDecoder, buffer *bytes.Buffer) (sc.Sequence[primitives.RuntimeCall], error) {
+	length, err := sc.DecodeCompact[sc.U32](buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	calls := sc.Sequence[primitives.RuntimeCall]{}
+	for i := sc.U32(0); i < length.Number.(sc.U32); i++ {
+		call, err := decoder.DecodeCall(buffer)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, primitives.NewRuntimeCall(call))
+	}
+
+	return calls, nil
+}
+
+// callsDispatchInfo returns the sum of the weights of the calls and the dispatch class
+// of the batch, which is `Operational` only if all calls are operational.
+func callsDispatchInfo(calls sc.Sequence[primitives.RuntimeCall]) (primitives.Weight, primitives.DispatchClass) {
+	weight := primitives.WeightZero()
+	allOperational := true
+
+	for _, call := range calls {
+		dispatchInfo := primitives.GetDispatchInfo(call)
+		weight = weight.SaturatingAdd(dispatchInfo.Weight)
+
+		isOperational, err := dispatchInfo.Class.Is(primitives.DispatchClassOperational)
+		if err != nil || !isOperational {
+			allOperational = false
+		}
+	}
+
+	if allOperational {
+		return weight, primitives.NewDispatchClassOperational()
+	}
+
+	return weight, primitives.NewDispatchClassNormal()
+}
+
+// dispatchWithStorageLayer dispatches the call in a new storage layer. The post dispatch
+// info of the call is returned even if the call fails and the storage layer is rolled back.
+func dispatchWithStorageLayer(transactional support.Transactional[primitives.PostDispatchInfo], call primitives.Call, origin primitives.RuntimeOrigin) (primitives.PostDispatchInfo, error) {
+	postInfo := primitives.PostDispatchInfo{}
+
+	_, err := transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		var dispatchErr error
+		postInfo, dispatchErr = call.Dispatch(origin, call.Args())
+		return postInfo, dispatchErr
+	})
+
+	return postInfo, err
+}
+
+// ensureSignedOrRoot ensures that the origin is either signed or root.
+func ensureSignedOrRoot(origin primitives.RuntimeOrigin) error {
+	if !origin.IsSignedOrigin() && !origin.IsRootOrigin() {
+		return primitives.NewDispatchErrorBadOrigin()
+	}
+	return nil
+}
+
+// toDispatchError returns the dispatch error of a failed call. Errors, which are not
+// dispatch errors, are returned as they are, in the second return value.
+func toDispatchError(err error) (primitives.DispatchError, error) {
+	dispatchErr, ok := err.(primitives.DispatchError)
+	if !ok {
+		return primitives.DispatchError{}, err
+	}
+	return dispatchErr, nil
+}
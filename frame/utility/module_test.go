@@ -0,0 +1,318 @@
+package utility
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId          = 8
+	batchedCallsLimit = 3
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	whoAccountId    = constants.OneAccountId
+	callWeight      = primitives.WeightFromParts(1_000, 10)
+	callArgs        = sc.NewVaryingData(sc.U8(1))
+	callErr         = primitives.NewDispatchErrorCannotLookup()
+	expectedErr     = errors.New("error")
+	mdGenerator     = primitives.NewMetadataTypeGenerator()
+	logger          = log.NewLogger()
+	signedOrigin    = primitives.NewRawOriginSigned(whoAccountId)
+	successPostInfo = primitives.PostDispatchInfo{}
+)
+
+var (
+	mockEventDepositor *mocks.EventDepositor
+	mockTransactional  *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockRuntimeDecoder *mocks.RuntimeDecoder
+	mockHashing        *mocks.IoHashing
+	mockCall           *mocks.Call
+	mockCallOther      *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 4, len(functions))
+	assert.Equal(t, sc.U8(functionBatchIndex), functions[functionBatchIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionAsDerivativeIndex), functions[functionAsDerivativeIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionBatchAllIndex), functions[functionBatchAllIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionForceBatchIndex), functions[functionForceBatchIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, mockCall)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedUtilityCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+	expectedSequenceRuntimeCallId := expectedUtilityCallsMetadataId + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(expectedSequenceRuntimeCallId, "SequenceRuntimeCall", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.RuntimeCall))),
+		primitives.NewMetadataTypeWithParam(expectedUtilityCallsMetadataId, "Utility calls", sc.Sequence[sc.Str]{"pallet_utility", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"batch",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(expectedSequenceRuntimeCallId),
+					},
+					functionBatchIndex,
+					target.functions[functionBatchIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"as_derivative",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU16),
+						primitives.NewMetadataTypeDefinitionField(metadata.RuntimeCall),
+					},
+					functionAsDerivativeIndex,
+					target.functions[functionAsDerivativeIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"batch_all",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(expectedSequenceRuntimeCallId),
+					},
+					functionBatchAllIndex,
+					target.functions[functionBatchAllIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"force_batch",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(expectedSequenceRuntimeCallId),
+					},
+					functionForceBatchIndex,
+					target.functions[functionForceBatchIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: sc.Option[primitives.MetadataModuleStorage]{},
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedUtilityCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedUtilityCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Utility, Runtime>"),
+				},
+				moduleId,
+				"Call.Utility"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesUtilityEvent, "pallet_utility::Event"),
+				},
+				moduleId,
+				"Events.Utility"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"BatchedCallsLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(batchedCallsLimit).Bytes()),
+				"The limit on the number of batched calls.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesUtilityErrors),
+				},
+				moduleId,
+				"Errors.Utility"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_decodeCalls(t *testing.T) {
+	setupModule()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(2)).Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCall, nil).Once()
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(mockCallOther, nil).Once()
+
+	result, err := decodeCalls(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)}, result)
+	mockRuntimeDecoder.AssertNumberOfCalls(t, "DecodeCall", 2)
+}
+
+func Test_decodeCalls_Error(t *testing.T) {
+	setupModule()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(2)).Bytes())
+
+	mockRuntimeDecoder.On("DecodeCall", buffer).Return(nil, expectedErr)
+
+	result, err := decodeCalls(mockRuntimeDecoder, buffer)
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedErr, err)
+	mockRuntimeDecoder.AssertNumberOfCalls(t, "DecodeCall", 1)
+}
+
+func Test_callsDispatchInfo_Operational(t *testing.T) {
+	setupModule()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassOperational())
+
+	weight, class := callsDispatchInfo(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)})
+
+	assert.Equal(t, callWeight.SaturatingAdd(callWeight), weight)
+	assert.Equal(t, primitives.NewDispatchClassOperational(), class)
+}
+
+func Test_callsDispatchInfo_Normal(t *testing.T) {
+	setupModule()
+	setupCallDispatchInfo(mockCall, primitives.NewDispatchClassOperational())
+	setupCallDispatchInfo(mockCallOther, primitives.NewDispatchClassNormal())
+
+	weight, class := callsDispatchInfo(sc.Sequence[primitives.RuntimeCall]{primitives.NewRuntimeCall(mockCall), primitives.NewRuntimeCall(mockCallOther)})
+
+	assert.Equal(t, callWeight.SaturatingAdd(callWeight), weight)
+	assert.Equal(t, primitives.NewDispatchClassNormal(), class)
+}
+
+func Test_callsDispatchInfo_Empty(t *testing.T) {
+	weight, class := callsDispatchInfo(sc.Sequence[primitives.RuntimeCall]{})
+
+	assert.Equal(t, primitives.WeightZero(), weight)
+	assert.Equal(t, primitives.NewDispatchClassOperational(), class)
+}
+
+func Test_dispatchWithStorageLayer_KeepsPostDispatchInfo(t *testing.T) {
+	setupModule()
+	postInfo := primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](callWeight)}
+
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", signedOrigin, callArgs).Return(postInfo, callErr)
+	runInStorageLayer(callErr)
+
+	result, err := dispatchWithStorageLayer(mockTransactional, mockCall, signedOrigin)
+
+	assert.Equal(t, callErr, err)
+	assert.Equal(t, postInfo, result)
+}
+
+func Test_ensureSignedOrRoot(t *testing.T) {
+	assert.Nil(t, ensureSignedOrRoot(signedOrigin))
+	assert.Nil(t, ensureSignedOrRoot(primitives.NewRawOriginRoot()))
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), ensureSignedOrRoot(primitives.NewRawOriginNone()))
+}
+
+func Test_toDispatchError(t *testing.T) {
+	dispatchErr, err := toDispatchError(callErr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, callErr, dispatchErr)
+
+	_, err = toDispatchError(expectedErr)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	config := NewConfig(dbWeight, mockEventDepositor, batchedCallsLimit)
+
+	return New(moduleId, config, mdGenerator, logger)
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockHashing = new(mocks.IoHashing)
+	mockCall = new(mocks.Call)
+	mockCallOther = new(mocks.Call)
+}
+
+func setupCallDispatchInfo(call *mocks.Call, class primitives.DispatchClass) {
+	call.On("BaseWeight").Return(callWeight)
+	call.On("WeighData", callWeight).Return(callWeight)
+	call.On("ClassifyDispatch", callWeight).Return(class)
+	call.On("PaysFee", callWeight).Return(primitives.PaysYes)
+}
+
+// setupCallDispatch sets up a call, which is dispatched with the given origin and result.
+func setupCallDispatch(call *mocks.Call, origin primitives.RuntimeOrigin, err error) {
+	setupCallDispatchInfo(call, primitives.NewDispatchClassNormal())
+	call.On("Args").Return(callArgs)
+	call.On("Dispatch", origin, callArgs).Return(successPostInfo, err)
+}
+
+// runInStorageLayer executes the function passed to the storage layer and returns the given error.
+func runInStorageLayer(err error) {
+	mockTransactional.On("WithStorageLayer", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+			fn()
+		}).
+		Return(primitives.PostDispatchInfo{}, err).
+		Once()
+}
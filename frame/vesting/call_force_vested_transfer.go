@@ -0,0 +1,113 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Force a vested transfer.
+// The dispatch origin for this call must be `Root`.
+type callForceVestedTransfer struct {
+	primitives.Callable
+	unlocking
+}
+
+func newCallForceVestedTransfer(moduleId sc.U8, functionId sc.U8, unlocking unlocking) primitives.Call {
+	call := callForceVestedTransfer{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.MultiAddress{}, VestingInfo{}),
+		},
+		unlocking: unlocking,
+	}
+
+	return call
+}
+
+func (c callForceVestedTransfer) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	source, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := DecodeVestingInfo(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		source,
+		target,
+		schedule,
+	)
+	return c, nil
+}
+
+func (c callForceVestedTransfer) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callForceVestedTransfer) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callForceVestedTransfer) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callForceVestedTransfer) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callForceVestedTransfer) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callForceVestedTransfer) BaseWeight() primitives.Weight {
+	return callForceVestedTransferWeight(c.constants.DbWeight, sc.U64(c.constants.MaxVestingSchedules))
+}
+
+func (_ callForceVestedTransfer) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callForceVestedTransfer) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callForceVestedTransfer) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callForceVestedTransfer) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsRootOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	source, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	target, err := c.lookup.Lookup(args[1].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	schedule := args[2].(VestingInfo)
+
+	return primitives.PostDispatchInfo{}, c.doVestedTransfer(source, target, schedule)
+}
+
+func (_ callForceVestedTransfer) Docs() string {
+	return "Force a vested transfer. " +
+		"The dispatch origin for this call must be _Root_. " +
+		"`source`: The account whose funds should be transferred. " +
+		"`target`: The account that should be transferred the vested funds. " +
+		"`schedule`: The vesting schedule attached to the transfer. " +
+		"Emits `VestingUpdated`. " +
+		"NOTE: This will unlock all schedules through the current block."
+}
@@ -0,0 +1,137 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ForceVestedTransfer_New(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionForceVestedTransferIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.MultiAddress{}, VestingInfo{}),
+	}
+
+	assert.Equal(t, expected, target.(callForceVestedTransfer).Callable)
+}
+
+func Test_Call_ForceVestedTransfer_DecodeArgs(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(targetAddress.Bytes())
+	buffer.Write(schedule.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, targetAddress, schedule), call.Args())
+}
+
+func Test_Call_ForceVestedTransfer_Encode(t *testing.T) {
+	target := setupDecodedCallForceVestedTransfer(whoAddress, targetAddress)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionForceVestedTransferIndex})
+	expectedBuffer.Write(whoAddress.Bytes())
+	expectedBuffer.Write(targetAddress.Bytes())
+	expectedBuffer.Write(schedule.Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_ForceVestedTransfer_ModuleIndex(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_ForceVestedTransfer_FunctionIndex(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	assert.Equal(t, sc.U8(functionForceVestedTransferIndex), target.FunctionIndex())
+}
+
+func Test_Call_ForceVestedTransfer_BaseWeight(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	assert.Equal(t, callForceVestedTransferWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_ForceVestedTransfer_ClassifyDispatch(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ForceVestedTransfer_PaysFee(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_ForceVestedTransfer_Dispatch(t *testing.T) {
+	target := setupDecodedCallForceVestedTransfer(whoAddress, targetAddress)
+	expectedEvent := newEventVestingUpdated(moduleId, targetAccountId, sc.NewU128(950))
+
+	mockStorageVesting.On("Get", targetAccountId).Return(sc.Sequence[VestingInfo]{}, nil)
+	mockCurrency.On("Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable).Return(schedule.Locked, nil)
+	mockStorageVesting.On("Put", targetAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_ForceVestedTransfer_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallForceVestedTransfer(whoAddress, targetAddress)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Call_ForceVestedTransfer_Dispatch_CannotLookupSource(t *testing.T) {
+	target := setupDecodedCallForceVestedTransfer(primitives.NewMultiAddressIndex(1), targetAddress)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Call_ForceVestedTransfer_Dispatch_CannotLookupTarget(t *testing.T) {
+	target := setupDecodedCallForceVestedTransfer(whoAddress, primitives.NewMultiAddressIndex(1))
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallForceVestedTransfer() primitives.Call {
+	return newCallForceVestedTransfer(moduleId, functionForceVestedTransferIndex, setupUnlocking())
+}
+
+func setupDecodedCallForceVestedTransfer(source primitives.MultiAddress, target primitives.MultiAddress) primitives.Call {
+	call := setupCallForceVestedTransfer().(callForceVestedTransfer)
+	call.Arguments = sc.NewVaryingData(source, target, schedule)
+
+	return call
+}
@@ -0,0 +1,110 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Merge two vesting schedules together, creating a new vesting schedule that unlocks over
+// the highest possible start and end blocks.
+// The dispatch origin for this call must be `Signed`.
+type callMergeSchedules struct {
+	primitives.Callable
+	unlocking
+}
+
+func newCallMergeSchedules(moduleId sc.U8, functionId sc.U8, unlocking unlocking) primitives.Call {
+	call := callMergeSchedules{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U32(0), sc.U32(0)),
+		},
+		unlocking: unlocking,
+	}
+
+	return call
+}
+
+func (c callMergeSchedules) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	schedule1Index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	schedule2Index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		schedule1Index,
+		schedule2Index,
+	)
+	return c, nil
+}
+
+func (c callMergeSchedules) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callMergeSchedules) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callMergeSchedules) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callMergeSchedules) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callMergeSchedules) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callMergeSchedules) BaseWeight() primitives.Weight {
+	return callMergeSchedulesWeight(c.constants.DbWeight, sc.U64(c.constants.MaxVestingSchedules))
+}
+
+func (_ callMergeSchedules) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callMergeSchedules) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callMergeSchedules) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callMergeSchedules) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	schedule1Index := args[0].(sc.U32)
+	schedule2Index := args[1].(sc.U32)
+
+	return primitives.PostDispatchInfo{}, c.mergeSchedules(who, schedule1Index, schedule2Index)
+}
+
+func (_ callMergeSchedules) Docs() string {
+	return "Merge two vesting schedules together, creating a new vesting schedule that unlocks over " +
+		"the highest possible start and end blocks. If both schedules have already started the current " +
+		"block will be used as the schedule start; with the caveat that if one schedule is finished by " +
+		"the current block, the other will be treated as the new merged schedule, unmodified. " +
+		"NOTE: If `schedule1_index == schedule2_index` this is a no-op. " +
+		"NOTE: This will unlock all schedules through the current block prior to merging. " +
+		"NOTE: If both schedules have ended by the current block, no new schedule will be created " +
+		"and both will be removed. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`schedule1_index`: index of the first schedule to merge. " +
+		"`schedule2_index`: index of the second schedule to merge."
+}
@@ -0,0 +1,119 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_MergeSchedules_New(t *testing.T) {
+	target := setupCallMergeSchedules()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionMergeSchedulesIndex,
+		Arguments:  sc.NewVaryingData(sc.U32(0), sc.U32(0)),
+	}
+
+	assert.Equal(t, expected, target.(callMergeSchedules).Callable)
+}
+
+func Test_Call_MergeSchedules_DecodeArgs(t *testing.T) {
+	target := setupCallMergeSchedules()
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.U32(0).Bytes())
+	buffer.Write(sc.U32(1).Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.U32(0), sc.U32(1)), call.Args())
+}
+
+func Test_Call_MergeSchedules_Encode(t *testing.T) {
+	target := setupDecodedCallMergeSchedules(0, 1)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionMergeSchedulesIndex})
+	expectedBuffer.Write(sc.U32(0).Bytes())
+	expectedBuffer.Write(sc.U32(1).Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_MergeSchedules_ModuleIndex(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_MergeSchedules_FunctionIndex(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	assert.Equal(t, sc.U8(functionMergeSchedulesIndex), target.FunctionIndex())
+}
+
+func Test_Call_MergeSchedules_BaseWeight(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	assert.Equal(t, callMergeSchedulesWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_MergeSchedules_ClassifyDispatch(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_MergeSchedules_PaysFee(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_MergeSchedules_Dispatch(t *testing.T) {
+	target := setupDecodedCallMergeSchedules(0, 1)
+	merged := VestingInfo{
+		Locked:        sc.NewU128(1_350),
+		PerBlock:      sc.NewU128(14),
+		StartingBlock: blockNumber,
+	}
+	expectedEvent := newEventVestingUpdated(moduleId, whoAccountId, sc.NewU128(1_350))
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule, otherSchedule}), nil)
+	mockStorageVesting.On("Put", whoAccountId, sc.Sequence[VestingInfo]{merged}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, whoAccountId, sc.NewU128(1_350), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageVesting.AssertCalled(t, "Put", whoAccountId, sc.Sequence[VestingInfo]{merged})
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_MergeSchedules_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallMergeSchedules(0, 1)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageVesting.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallMergeSchedules() primitives.Call {
+	return newCallMergeSchedules(moduleId, functionMergeSchedulesIndex, setupUnlocking())
+}
+
+func setupDecodedCallMergeSchedules(schedule1Index sc.U32, schedule2Index sc.U32) primitives.Call {
+	call := setupCallMergeSchedules().(callMergeSchedules)
+	call.Arguments = sc.NewVaryingData(schedule1Index, schedule2Index)
+
+	return call
+}
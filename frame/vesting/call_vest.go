@@ -0,0 +1,89 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Unlock any vested funds of the sender account.
+// The dispatch origin for this call must be `Signed` and the sender must have funds still
+// locked under this module.
+type callVest struct {
+	primitives.Callable
+	unlocking
+}
+
+func newCallVest(moduleId sc.U8, functionId sc.U8, unlocking unlocking) primitives.Call {
+	call := callVest{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(),
+		},
+		unlocking: unlocking,
+	}
+
+	return call
+}
+
+func (c callVest) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	return c, nil
+}
+
+func (c callVest) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callVest) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callVest) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callVest) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callVest) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callVest) BaseWeight() primitives.Weight {
+	return callVestWeight(c.constants.DbWeight, sc.U64(c.constants.MaxVestingSchedules))
+}
+
+func (_ callVest) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callVest) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callVest) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callVest) Dispatch(origin primitives.RuntimeOrigin, _ sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.doVest(who)
+}
+
+func (_ callVest) Docs() string {
+	return "Unlock any vested funds of the sender account. " +
+		"The dispatch origin for this call must be _Signed_ and the sender must have funds still " +
+		"locked under this pallet. " +
+		"Emits either `VestingCompleted` or `VestingUpdated`."
+}
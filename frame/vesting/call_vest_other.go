@@ -0,0 +1,95 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Unlock any vested funds of a `target` account.
+// The dispatch origin for this call must be `Signed` and the `target` must have funds still
+// locked under this module.
+type callVestOther struct {
+	primitives.Callable
+	unlocking
+}
+
+func newCallVestOther(moduleId sc.U8, functionId sc.U8, unlocking unlocking) primitives.Call {
+	call := callVestOther{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}),
+		},
+		unlocking: unlocking,
+	}
+
+	return call
+}
+
+func (c callVestOther) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(target)
+	return c, nil
+}
+
+func (c callVestOther) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callVestOther) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callVestOther) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callVestOther) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callVestOther) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callVestOther) BaseWeight() primitives.Weight {
+	return callVestOtherWeight(c.constants.DbWeight, sc.U64(c.constants.MaxVestingSchedules))
+}
+
+func (_ callVestOther) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callVestOther) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callVestOther) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callVestOther) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	target, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+
+	return primitives.PostDispatchInfo{}, c.doVest(target)
+}
+
+func (_ callVestOther) Docs() string {
+	return "Unlock any vested funds of a `target` account. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`target`: The account whose vested funds should be unlocked. Must have funds still " +
+		"locked under this pallet. " +
+		"Emits either `VestingCompleted` or `VestingUpdated`."
+}
@@ -0,0 +1,140 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_VestOther_New(t *testing.T) {
+	target := setupCallVestOther()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionVestOtherIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}),
+	}
+
+	assert.Equal(t, expected, target.(callVestOther).Callable)
+}
+
+func Test_Call_VestOther_DecodeArgs(t *testing.T) {
+	target := setupCallVestOther()
+
+	call, err := target.DecodeArgs(bytes.NewBuffer(targetAddress.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(targetAddress), call.Args())
+}
+
+func Test_Call_VestOther_Encode(t *testing.T) {
+	target := setupDecodedCallVestOther(targetAddress)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionVestOtherIndex})
+	expectedBuffer.Write(targetAddress.Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_VestOther_ModuleIndex(t *testing.T) {
+	target := setupCallVestOther()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_VestOther_FunctionIndex(t *testing.T) {
+	target := setupCallVestOther()
+
+	assert.Equal(t, sc.U8(functionVestOtherIndex), target.FunctionIndex())
+}
+
+func Test_Call_VestOther_BaseWeight(t *testing.T) {
+	target := setupCallVestOther()
+
+	assert.Equal(t, callVestOtherWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_VestOther_ClassifyDispatch(t *testing.T) {
+	target := setupCallVestOther()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_VestOther_PaysFee(t *testing.T) {
+	target := setupCallVestOther()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_VestOther_Dispatch(t *testing.T) {
+	target := setupDecodedCallVestOther(targetAddress)
+	expectedEvent := newEventVestingUpdated(moduleId, targetAccountId, sc.NewU128(950))
+
+	mockStorageVesting.On("TryGet", targetAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule}), nil)
+	mockStorageVesting.On("Put", targetAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_VestOther_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallVestOther(targetAddress)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageVesting.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Call_VestOther_Dispatch_AccountIndex(t *testing.T) {
+	target := setupDecodedCallVestOther(indexAddress).(callVestOther)
+	mockLookup := new(mocks.StaticLookup)
+	target.lookup = mockLookup
+	expectedEvent := newEventVestingUpdated(moduleId, targetAccountId, sc.NewU128(950))
+
+	mockLookup.On("Lookup", indexAddress).Return(targetAccountId, nil)
+	mockStorageVesting.On("TryGet", targetAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule}), nil)
+	mockStorageVesting.On("Put", targetAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockLookup.AssertCalled(t, "Lookup", indexAddress)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll)
+}
+
+func Test_Call_VestOther_Dispatch_CannotLookup(t *testing.T) {
+	target := setupDecodedCallVestOther(indexAddress)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockStorageVesting.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallVestOther() primitives.Call {
+	return newCallVestOther(moduleId, functionVestOtherIndex, setupUnlocking())
+}
+
+func setupDecodedCallVestOther(target primitives.MultiAddress) primitives.Call {
+	call := setupCallVestOther().(callVestOther)
+	call.Arguments = sc.NewVaryingData(target)
+
+	return call
+}
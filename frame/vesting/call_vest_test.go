@@ -0,0 +1,124 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Vest_New(t *testing.T) {
+	target := setupCallVest()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionVestIndex,
+		Arguments:  sc.NewVaryingData(),
+	}
+
+	assert.Equal(t, expected, target.(callVest).Callable)
+}
+
+func Test_Call_Vest_DecodeArgs(t *testing.T) {
+	target := setupCallVest()
+
+	call, err := target.DecodeArgs(&bytes.Buffer{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(), call.Args())
+}
+
+func Test_Call_Vest_Encode(t *testing.T) {
+	target := setupCallVest()
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionVestIndex})
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_Vest_Bytes(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, []byte{moduleId, functionVestIndex}, target.Bytes())
+}
+
+func Test_Call_Vest_ModuleIndex(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_Vest_FunctionIndex(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, sc.U8(functionVestIndex), target.FunctionIndex())
+}
+
+func Test_Call_Vest_BaseWeight(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, callVestWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_Vest_WeighData(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), target.WeighData(primitives.WeightFromParts(567, 123)))
+}
+
+func Test_Call_Vest_ClassifyDispatch(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Vest_PaysFee(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_Vest_Dispatch(t *testing.T) {
+	target := setupCallVest()
+	expectedEvent := newEventVestingUpdated(moduleId, whoAccountId, sc.NewU128(950))
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule}), nil)
+	mockStorageVesting.On("Put", whoAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, whoAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, whoAccountId, sc.NewU128(950), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_Vest_Dispatch_NotVesting(t *testing.T) {
+	target := setupCallVest()
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](nil), nil)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotVesting(moduleId), err)
+}
+
+func Test_Call_Vest_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallVest()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageVesting.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func setupCallVest() primitives.Call {
+	return newCallVest(moduleId, functionVestIndex, setupUnlocking())
+}
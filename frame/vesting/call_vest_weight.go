@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkVestingVest benchmark.
+
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callVestWeight(dbWeight primitives.RuntimeDbWeight, schedules sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(33000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(70000, 0).SaturatingMul(schedules)).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
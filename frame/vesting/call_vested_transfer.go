@@ -0,0 +1,108 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Create a vested transfer.
+// The dispatch origin for this call must be `Signed`.
+type callVestedTransfer struct {
+	primitives.Callable
+	unlocking
+}
+
+func newCallVestedTransfer(moduleId sc.U8, functionId sc.U8, unlocking unlocking) primitives.Call {
+	call := callVestedTransfer{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, VestingInfo{}),
+		},
+		unlocking: unlocking,
+	}
+
+	return call
+}
+
+func (c callVestedTransfer) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := DecodeVestingInfo(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(
+		target,
+		schedule,
+	)
+	return c, nil
+}
+
+func (c callVestedTransfer) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callVestedTransfer) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callVestedTransfer) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callVestedTransfer) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callVestedTransfer) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callVestedTransfer) BaseWeight() primitives.Weight {
+	return callVestedTransferWeight(c.constants.DbWeight, sc.U64(c.constants.MaxVestingSchedules))
+}
+
+func (_ callVestedTransfer) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callVestedTransfer) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callVestedTransfer) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callVestedTransfer) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if !origin.IsSignedOrigin() {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin()
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	target, err := c.lookup.Lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	schedule := args[1].(VestingInfo)
+
+	return primitives.PostDispatchInfo{}, c.doVestedTransfer(who, target, schedule)
+}
+
+func (_ callVestedTransfer) Docs() string {
+	return "Create a vested transfer. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`target`: The account receiving the vested funds. " +
+		"`schedule`: The vesting schedule attached to the transfer. " +
+		"Emits `VestingUpdated`. " +
+		"NOTE: This will unlock all schedules through the current block."
+}
@@ -0,0 +1,126 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_VestedTransfer_New(t *testing.T) {
+	target := setupCallVestedTransfer()
+	expected := primitives.Callable{
+		ModuleId:   moduleId,
+		FunctionId: functionVestedTransferIndex,
+		Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, VestingInfo{}),
+	}
+
+	assert.Equal(t, expected, target.(callVestedTransfer).Callable)
+}
+
+func Test_Call_VestedTransfer_DecodeArgs(t *testing.T) {
+	target := setupCallVestedTransfer()
+	buffer := &bytes.Buffer{}
+	buffer.Write(targetAddress.Bytes())
+	buffer.Write(schedule.Bytes())
+
+	call, err := target.DecodeArgs(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewVaryingData(targetAddress, schedule), call.Args())
+}
+
+func Test_Call_VestedTransfer_Encode(t *testing.T) {
+	target := setupDecodedCallVestedTransfer(targetAddress, schedule)
+	expectedBuffer := bytes.NewBuffer([]byte{moduleId, functionVestedTransferIndex})
+	expectedBuffer.Write(targetAddress.Bytes())
+	expectedBuffer.Write(schedule.Bytes())
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuffer, buffer)
+}
+
+func Test_Call_VestedTransfer_ModuleIndex(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	assert.Equal(t, sc.U8(moduleId), target.ModuleIndex())
+}
+
+func Test_Call_VestedTransfer_FunctionIndex(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	assert.Equal(t, sc.U8(functionVestedTransferIndex), target.FunctionIndex())
+}
+
+func Test_Call_VestedTransfer_BaseWeight(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	assert.Equal(t, callVestedTransferWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_VestedTransfer_ClassifyDispatch(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_VestedTransfer_PaysFee(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(primitives.WeightFromParts(567, 0)))
+}
+
+func Test_Call_VestedTransfer_Dispatch(t *testing.T) {
+	target := setupDecodedCallVestedTransfer(targetAddress, schedule)
+	expectedEvent := newEventVestingUpdated(moduleId, targetAccountId, sc.NewU128(950))
+
+	mockStorageVesting.On("Get", targetAccountId).Return(sc.Sequence[VestingInfo]{}, nil)
+	mockCurrency.On("Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable).Return(schedule.Locked, nil)
+	mockStorageVesting.On("Put", targetAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Nil(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, targetAccountId, sc.NewU128(950), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Call_VestedTransfer_Dispatch_BadOrigin(t *testing.T) {
+	target := setupDecodedCallVestedTransfer(targetAddress, schedule)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Call_VestedTransfer_Dispatch_CannotLookup(t *testing.T) {
+	target := setupDecodedCallVestedTransfer(primitives.NewMultiAddressIndex(1), schedule)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallVestedTransfer() primitives.Call {
+	return newCallVestedTransfer(moduleId, functionVestedTransferIndex, setupUnlocking())
+}
+
+func setupDecodedCallVestedTransfer(target primitives.MultiAddress, schedule VestingInfo) primitives.Call {
+	call := setupCallVestedTransfer().(callVestedTransfer)
+	call.Arguments = sc.NewVaryingData(target, schedule)
+
+	return call
+}
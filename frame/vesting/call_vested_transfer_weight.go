@@ -0,0 +1,15 @@
+// Reference weight, to be replaced by the output of the BenchmarkVestingVestedTransfer benchmark.
+
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callVestedTransferWeight(dbWeight primitives.RuntimeDbWeight, schedules sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(70000000, 0).
+		SaturatingAdd(primitives.WeightFromParts(75000, 0).SaturatingMul(schedules)).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(3))
+}
@@ -0,0 +1,31 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	DbWeight            primitives.RuntimeDbWeight
+	EventDepositor      primitives.EventDepositor
+	Currency            fungible.Mutate
+	LockableCurrency    primitives.LockableCurrency
+	MinVestedTransfer   sc.U128
+	MaxVestingSchedules sc.U32
+	StorageBlockNumber  func() (sc.U64, error)
+	Lookup              primitives.StaticLookup
+}
+
+func NewConfig(dbWeight primitives.RuntimeDbWeight, eventDepositor primitives.EventDepositor, currency fungible.Mutate, lockableCurrency primitives.LockableCurrency, minVestedTransfer sc.U128, maxVestingSchedules sc.U32, storageBlockNumber func() (sc.U64, error), lookup primitives.StaticLookup) *Config {
+	return &Config{
+		DbWeight:            dbWeight,
+		EventDepositor:      eventDepositor,
+		Currency:            currency,
+		LockableCurrency:    lockableCurrency,
+		MinVestedTransfer:   minVestedTransfer,
+		MaxVestingSchedules: maxVestingSchedules,
+		StorageBlockNumber:  storageBlockNumber,
+		Lookup:              lookup,
+	}
+}
@@ -0,0 +1,25 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type consts struct {
+	DbWeight            primitives.RuntimeDbWeight
+	MinVestedTransfer   sc.U128
+	MaxVestingSchedules sc.U32
+}
+
+type metadataConstants struct {
+	MinVestedTransfer   primitives.MinVestedTransfer
+	MaxVestingSchedules primitives.MaxVestingSchedules
+}
+
+func newConstants(dbWeight primitives.RuntimeDbWeight, minVestedTransfer sc.U128, maxVestingSchedules sc.U32) *consts {
+	return &consts{
+		DbWeight:            dbWeight,
+		MinVestedTransfer:   minVestedTransfer,
+		MaxVestingSchedules: maxVestingSchedules,
+	}
+}
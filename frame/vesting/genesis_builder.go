@@ -0,0 +1,159 @@
+package vesting
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/vedhavyas/go-subkey"
+)
+
+var (
+	errInvalidAddrValue        = errors.New("invalid address in genesis config json")
+	errInvalidBlockNumberValue = errors.New("invalid block number in genesis config json")
+	errInvalidLiquidValue      = errors.New("invalid liquid balance in genesis config json")
+	errInvalidVestingInfo      = errors.New("invalid VestingInfo params at genesis")
+	errTooManyVestingSchedules = errors.New("too many vesting schedules at genesis")
+)
+
+// genesisConfigVesting is a vesting schedule of an account, starting at block `Begin`.
+// All of the account balance, except `Liquid`, is locked and unlocked linearly over `Length` blocks.
+type genesisConfigVesting struct {
+	AccountId types.AccountId
+	Begin     sc.U64
+	Length    sc.U64
+	Liquid    types.Balance
+}
+
+type GenesisConfig struct {
+	Vesting []genesisConfigVesting
+}
+
+type genesisConfigJsonStruct struct {
+	VestingGenesisConfig struct {
+		Vesting [][4]interface{} `json:"vesting"`
+	} `json:"vesting"`
+}
+
+func (gc *GenesisConfig) UnmarshalJSON(data []byte) error {
+	gcJson := genesisConfigJsonStruct{}
+
+	jsonDecoder := json.NewDecoder(bytes.NewReader(data))
+	jsonDecoder.UseNumber()
+	if err := jsonDecoder.Decode(&gcJson); err != nil {
+		return err
+	}
+
+	for _, v := range gcJson.VestingGenesisConfig.Vesting {
+		addrString, ok := v[0].(string)
+		if !ok {
+			return errInvalidAddrValue
+		}
+
+		_, publicKey, err := subkey.SS58Decode(addrString)
+		if err != nil {
+			return err
+		}
+
+		accId, err := types.NewAccountId(sc.BytesToSequenceU8(publicKey)...)
+		if err != nil {
+			return err
+		}
+
+		begin, err := decodeBlockNumber(v[1])
+		if err != nil {
+			return err
+		}
+
+		length, err := decodeBlockNumber(v[2])
+		if err != nil {
+			return err
+		}
+
+		liquid, ok := v[3].(json.Number)
+		if !ok {
+			return errInvalidLiquidValue
+		}
+
+		liquidU128, err := sc.NewU128FromString(liquid.String())
+		if err != nil {
+			return err
+		}
+
+		gc.Vesting = append(gc.Vesting, genesisConfigVesting{
+			AccountId: accId,
+			Begin:     begin,
+			Length:    length,
+			Liquid:    liquidU128,
+		})
+	}
+
+	return nil
+}
+
+func (m Module) CreateDefaultConfig() ([]byte, error) {
+	gc := &genesisConfigJsonStruct{}
+	gc.VestingGenesisConfig.Vesting = [][4]interface{}{}
+
+	return json.Marshal(gc)
+}
+
+// BuildConfig locks the balances of the configured accounts. It relies on the balances
+// being set up beforehand, which is why the module must come after balances in the runtime.
+func (m Module) BuildConfig(config []byte) error {
+	gc := GenesisConfig{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	for _, v := range gc.Vesting {
+		balance, err := m.Config.Currency.Balance(v.AccountId)
+		if err != nil {
+			return err
+		}
+
+		locked := sc.SaturatingSubU128(balance, v.Liquid)
+		length := sc.Max128(sc.NewU128(uint64(v.Length)), sc.NewU128(1))
+		schedule := VestingInfo{
+			Locked:        locked,
+			PerBlock:      sc.NewU128(new(big.Int).Quo(locked.ToBigInt(), length.ToBigInt())),
+			StartingBlock: v.Begin,
+		}
+		if !schedule.isValid() {
+			return errInvalidVestingInfo
+		}
+
+		schedules, err := m.storage.Vesting.Get(v.AccountId)
+		if err != nil {
+			return err
+		}
+		if sc.U32(len(schedules)) >= m.constants.MaxVestingSchedules {
+			return errTooManyVestingSchedules
+		}
+		m.storage.Vesting.Put(v.AccountId, append(schedules, schedule))
+
+		if err := m.Config.LockableCurrency.SetLock(vestingId, v.AccountId, locked, types.ReasonsAll); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeBlockNumber(value interface{}) (sc.U64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, errInvalidBlockNumberValue
+	}
+
+	blockNumber, err := strconv.ParseUint(number.String(), 10, 64)
+	if err != nil {
+		return 0, errInvalidBlockNumberValue
+	}
+
+	return sc.U64(blockNumber), nil
+}
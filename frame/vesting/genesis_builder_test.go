@@ -0,0 +1,124 @@
+package vesting
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	validGcJson = "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",5,90,100]]}}"
+	accId, _    = types.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	genesisInfo = VestingInfo{
+		Locked:        sc.NewU128(900),
+		PerBlock:      sc.NewU128(10),
+		StartingBlock: 5,
+	}
+)
+
+func Test_GenesisConfig_BuildConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name               string
+		gcJson             string
+		expectedErr        error
+		shouldAssertCalled bool
+		existing           sc.Sequence[VestingInfo]
+		balanceErr         error
+		setLockErr         error
+	}{
+		{
+			name:               "valid",
+			gcJson:             validGcJson,
+			existing:           sc.Sequence[VestingInfo]{},
+			shouldAssertCalled: true,
+		},
+		{
+			name:   "no vesting",
+			gcJson: "{\"aura\":{\"authorities\":[]}}",
+		},
+		{
+			name:        "invalid genesis address",
+			gcJson:      "{\"vesting\":{\"vesting\":[[1,5,90,100]]}}",
+			expectedErr: errInvalidAddrValue,
+		},
+		{
+			name:        "invalid ss58 address",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"invalid\",5,90,100]]}}",
+			expectedErr: errors.New("expected at least 2 bytes in base58 decoded address"),
+		},
+		{
+			name:        "invalid genesis begin",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",\"invalid\",90,100]]}}",
+			expectedErr: errInvalidBlockNumberValue,
+		},
+		{
+			name:        "negative genesis length",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",5,-1,100]]}}",
+			expectedErr: errInvalidBlockNumberValue,
+		},
+		{
+			name:        "invalid genesis liquid",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",5,90,\"invalid\"]]}}",
+			expectedErr: errInvalidLiquidValue,
+		},
+		{
+			name:        "Balance error",
+			gcJson:      validGcJson,
+			balanceErr:  expectedErr,
+			expectedErr: expectedErr,
+		},
+		{
+			name:        "nothing locked",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",5,90,1000]]}}",
+			expectedErr: errInvalidVestingInfo,
+		},
+		{
+			name:        "too many vesting schedules",
+			gcJson:      validGcJson,
+			existing:    sc.Sequence[VestingInfo]{genesisInfo, genesisInfo, genesisInfo},
+			expectedErr: errTooManyVestingSchedules,
+		},
+		{
+			name:               "SetLock error",
+			gcJson:             validGcJson,
+			existing:           sc.Sequence[VestingInfo]{},
+			setLockErr:         expectedErr,
+			expectedErr:        expectedErr,
+			shouldAssertCalled: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+
+			mockCurrency.On("Balance", accId).Return(sc.NewU128(1_000), tt.balanceErr)
+			mockStorageVesting.On("Get", accId).Return(tt.existing, nil)
+			mockStorageVesting.On("Put", accId, sc.Sequence[VestingInfo]{genesisInfo}).Return()
+			mockLockableCurrency.On("SetLock", vestingId, accId, genesisInfo.Locked, types.ReasonsAll).Return(tt.setLockErr)
+
+			err := target.BuildConfig([]byte(tt.gcJson))
+			assert.Equal(t, tt.expectedErr, err)
+
+			if tt.shouldAssertCalled {
+				mockStorageVesting.AssertCalled(t, "Put", accId, sc.Sequence[VestingInfo]{genesisInfo})
+				mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, accId, genesisInfo.Locked, types.ReasonsAll)
+			} else {
+				mockStorageVesting.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func Test_GenesisConfig_CreateDefaultConfig(t *testing.T) {
+	target := setupModule()
+
+	expectedGc := []byte("{\"vesting\":{\"vesting\":[]}}")
+
+	gc, err := target.CreateDefaultConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedGc, gc)
+}
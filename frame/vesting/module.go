@@ -0,0 +1,208 @@
+package vesting
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Function indices follow the ones in `pallet_vesting`, so that the calls are encoded
+// the same way as in Substrate based chains.
+const (
+	functionVestIndex                = 0
+	functionVestOtherIndex           = 1
+	functionVestedTransferIndex      = 2
+	functionForceVestedTransferIndex = 3
+	functionMergeSchedulesIndex      = 4
+)
+
+const (
+	name           = sc.Str("Vesting")
+	storageVersion = sc.U16(0)
+)
+
+// Module places a balance lock on funds of an account, which is lifted linearly over a number of blocks.
+//
+// An account may have up to MaxVestingSchedules schedules, created at genesis or with a vested transfer.
+// The lock is not updated automatically, instead the vested funds are unlocked by calling `vest`
+// or `vest_other`.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	support.ModuleStorageVersion
+	Index       sc.U8
+	Config      *Config
+	constants   *consts
+	storage     *storage
+	functions   map[sc.U8]primitives.Call
+	mdGenerator *primitives.MetadataTypeGenerator
+}
+
+func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.WarnLogger) Module {
+	constants := newConstants(config.DbWeight, config.MinVestedTransfer, config.MaxVestingSchedules)
+	storage := newStorage()
+	unlocking := newUnlocking(index, config, constants, storage)
+
+	functions := make(map[sc.U8]primitives.Call)
+	functions[functionVestIndex] = newCallVest(index, functionVestIndex, unlocking)
+	functions[functionVestOtherIndex] = newCallVestOther(index, functionVestOtherIndex, unlocking)
+	functions[functionVestedTransferIndex] = newCallVestedTransfer(index, functionVestedTransferIndex, unlocking)
+	functions[functionForceVestedTransferIndex] = newCallForceVestedTransfer(index, functionForceVestedTransferIndex, unlocking)
+	functions[functionMergeSchedulesIndex] = newCallMergeSchedules(index, functionMergeSchedulesIndex, unlocking)
+
+	return Module{
+		ModuleStorageVersion: support.NewModuleStorageVersion(keyVesting, storageVersion),
+		Index:                index,
+		Config:               config,
+		constants:            constants,
+		storage:              storage,
+		functions:            functions,
+		mdGenerator:          mdGenerator,
+	}
+}
+
+func (m Module) GetIndex() sc.U8 {
+	return m.Index
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) Functions() map[sc.U8]primitives.Call {
+	return m.functions
+}
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
+	return sc.Empty{}, nil
+}
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	metadataIdVestingCalls := m.mdGenerator.BuildCallsMetadata("Vesting", m.functions, &sc.Sequence[primitives.MetadataTypeParameter]{
+		primitives.NewMetadataEmptyTypeParameter("T"),
+	})
+
+	mdConstants := metadataConstants{
+		MinVestedTransfer:   primitives.MinVestedTransfer{U128: m.constants.MinVestedTransfer},
+		MaxVestingSchedules: primitives.MaxVestingSchedules{U32: m.constants.MaxVestingSchedules},
+	}
+
+	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
+
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadataIdVestingCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadataIdVestingCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Vesting, Runtime>"),
+				},
+				m.Index,
+				"Call.Vesting"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesVestingEvent, "pallet_vesting::Event<Runtime>"),
+				},
+				m.Index,
+				"Events.Vesting"),
+		),
+		Constants: moduleMdConstants,
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesVestingErrors),
+				},
+				m.Index,
+				"Errors.Vesting"),
+		),
+		Index: m.Index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesVestingInfo, "VestingInfo", sc.Sequence[sc.Str]{"pallet_vesting", "vesting_info", "VestingInfo"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "locked", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "per_block", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "starting_block", "BlockNumber"),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesSequenceVestingInfo, "[]VestingInfo",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesVestingInfo))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesVestingEvent, "pallet_vesting pallet Event", sc.Sequence[sc.Str]{"pallet_vesting", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"VestingUpdated",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "unvested", "BalanceOf<T>"),
+					},
+					EventVestingUpdated,
+					"Events.VestingUpdated"),
+				primitives.NewMetadataDefinitionVariant(
+					"VestingCompleted",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+					},
+					EventVestingCompleted,
+					"Events.VestingCompleted"),
+			},
+		)),
+		primitives.NewMetadataTypeWithParams(metadata.TypesVestingErrors,
+			"pallet_vesting pallet Error",
+			sc.Sequence[sc.Str]{"pallet_vesting", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("NotVesting", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorNotVesting, "The account given is not vesting."),
+					primitives.NewMetadataDefinitionVariant("AtMaxVestingSchedules", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorAtMaxVestingSchedules, "The account already has `MaxVestingSchedules` count of schedules and thus cannot add another one. Consider merging existing schedules in order to add another."),
+					primitives.NewMetadataDefinitionVariant("AmountLow", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorAmountLow, "Amount being transferred is too low to create a vesting schedule."),
+					primitives.NewMetadataDefinitionVariant("ScheduleIndexOutOfBounds", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorScheduleIndexOutOfBounds, "An index was out of bounds of the vesting schedules."),
+					primitives.NewMetadataDefinitionVariant("InvalidScheduleParams", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, ErrorInvalidScheduleParams, "Failed to create a new schedule because some parameter was invalid."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Vesting",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				support.NewMetadataStorageDefinitionMap(
+					metadata.TypesAddress32,
+					metadata.TypesSequenceVestingInfo,
+					support.NewHasherBlake128Concat(),
+				),
+				"Information regarding the vesting of a given account."),
+		},
+	})
+}
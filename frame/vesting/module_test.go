@@ -0,0 +1,282 @@
+package vesting
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	moduleId            = 12
+	maxVestingSchedules = 3
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	minVestedTransfer = sc.NewU128(100)
+	blockNumber       = sc.U64(10)
+
+	whoAccountId    = constants.OneAccountId
+	targetAccountId = constants.TwoAccountId
+	whoAddress      = primitives.NewMultiAddressId(whoAccountId)
+	targetAddress   = primitives.NewMultiAddressId(targetAccountId)
+	indexAddress    = primitives.NewMultiAddressIndex(1)
+	testLookup      = primitives.NewIdentityLookup()
+
+	// schedule unlocks 1_000 in 100 blocks, starting at block 5.
+	schedule = VestingInfo{
+		Locked:        sc.NewU128(1_000),
+		PerBlock:      sc.NewU128(10),
+		StartingBlock: 5,
+	}
+	// otherSchedule unlocks 500 in 10 blocks, starting at block 8.
+	otherSchedule = VestingInfo{
+		Locked:        sc.NewU128(500),
+		PerBlock:      sc.NewU128(50),
+		StartingBlock: 8,
+	}
+
+	expectedErr  = errors.New("error")
+	mdGenerator  = primitives.NewMetadataTypeGenerator()
+	logger       = log.NewLogger()
+	signedOrigin = primitives.NewRawOriginSigned(whoAccountId)
+)
+
+var (
+	mockEventDepositor     *mocks.EventDepositor
+	mockCurrency           *mocks.FungibleMutate
+	mockLockableCurrency   *mocks.LockableCurrency
+	mockStorageVesting     *mocks.StorageMap[primitives.AccountId, sc.Sequence[VestingInfo]]
+	mockStorageBlockNumber func() (sc.U64, error)
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_name(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, name, target.name())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	functions := target.Functions()
+
+	assert.Equal(t, 5, len(functions))
+	assert.Equal(t, sc.U8(functionVestIndex), functions[functionVestIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionVestOtherIndex), functions[functionVestOtherIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionVestedTransferIndex), functions[functionVestedTransferIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionForceVestedTransferIndex), functions[functionForceVestedTransferIndex].FunctionIndex())
+	assert.Equal(t, sc.U8(functionMergeSchedulesIndex), functions[functionMergeSchedulesIndex].FunctionIndex())
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(new(mocks.Call))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, new(mocks.Call))
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	expectedVestingCallsMetadataId := mdGenerator.GetLastAvailableIndex() + 1
+
+	expectMetadataTypes := sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(expectedVestingCallsMetadataId, "Vesting calls", sc.Sequence[sc.Str]{"pallet_vesting", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"vest",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					functionVestIndex,
+					target.functions[functionVestIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"vest_other",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+					},
+					functionVestOtherIndex,
+					target.functions[functionVestOtherIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"vested_transfer",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesVestingInfo),
+					},
+					functionVestedTransferIndex,
+					target.functions[functionVestedTransferIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"force_vested_transfer",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesMultiAddress),
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesVestingInfo),
+					},
+					functionForceVestedTransferIndex,
+					target.functions[functionForceVestedTransferIndex].Docs()),
+				primitives.NewMetadataDefinitionVariant(
+					"merge_schedules",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					functionMergeSchedulesIndex,
+					target.functions[functionMergeSchedulesIndex].Docs()),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+	expectMetadataTypes = append(expectMetadataTypes, target.metadataTypes()...)
+
+	moduleV14 := primitives.MetadataModuleV14{
+		Name:    name,
+		Storage: target.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(expectedVestingCallsMetadataId)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(expectedVestingCallsMetadataId, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Vesting, Runtime>"),
+				},
+				moduleId,
+				"Call.Vesting"),
+		),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesVestingEvent, "pallet_vesting::Event<Runtime>"),
+				},
+				moduleId,
+				"Events.Vesting"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MinVestedTransfer",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(minVestedTransfer.Bytes()),
+				"The minimum amount transferred to call `vested_transfer`.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxVestingSchedules",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(maxVestingSchedules).Bytes()),
+				"The maximum number of vesting schedules of a single account.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				name,
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesVestingErrors),
+				},
+				moduleId,
+				"Errors.Vesting"),
+		),
+		Index: moduleId,
+	}
+
+	expectMetadataModule := primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: moduleV14,
+	}
+
+	resultMetadataModule := target.Metadata()
+	resultTypes := mdGenerator.GetMetadataTypes()
+
+	assert.Equal(t, expectMetadataTypes, resultTypes)
+	assert.Equal(t, expectMetadataModule, resultMetadataModule)
+}
+
+func Test_Module_metadataStorage(t *testing.T) {
+	target := setupModule()
+
+	expect := sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: name,
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Vesting",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{
+						primitives.MetadataModuleStorageHashFuncMultiBlake128Concat,
+					},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSequenceVestingInfo),
+				),
+				"Information regarding the vesting of a given account."),
+		},
+	})
+
+	assert.Equal(t, expect, target.metadataStorage())
+}
+
+func setupModule() Module {
+	setupMocks()
+
+	mdGenerator.ClearMetadata()
+
+	target := New(moduleId, newTestConfig(), mdGenerator, logger)
+	target.storage.Vesting = mockStorageVesting
+
+	return target
+}
+
+func setupMocks() {
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockCurrency = new(mocks.FungibleMutate)
+	mockLockableCurrency = new(mocks.LockableCurrency)
+	mockStorageVesting = new(mocks.StorageMap[primitives.AccountId, sc.Sequence[VestingInfo]])
+	mockStorageBlockNumber = func() (sc.U64, error) { return blockNumber, nil }
+}
+
+func newTestConfig() *Config {
+	return NewConfig(
+		dbWeight,
+		mockEventDepositor,
+		mockCurrency,
+		mockLockableCurrency,
+		minVestedTransfer,
+		maxVestingSchedules,
+		mockStorageBlockNumber,
+		testLookup,
+	)
+}
+
+// setupUnlocking returns an unlocking, which uses the mocked storage.
+func setupUnlocking() unlocking {
+	setupMocks()
+
+	storage := &storage{
+		Vesting: mockStorageVesting,
+	}
+	constants := newConstants(dbWeight, minVestedTransfer, maxVestingSchedules)
+
+	return newUnlocking(moduleId, newTestConfig(), constants, storage)
+}
@@ -0,0 +1,28 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// keyVesting is both the prefix of the module and the name of its storage map, as in `pallet_vesting`.
+	keyVesting = []byte("Vesting")
+)
+
+type storage struct {
+	Vesting support.StorageMap[primitives.AccountId, sc.Sequence[VestingInfo]]
+}
+
+func newStorage() *storage {
+	return &storage{
+		Vesting: support.NewHashStorageMap[primitives.AccountId, sc.Sequence[VestingInfo]](keyVesting, keyVesting, support.NewHasherBlake128Concat(), primitives.DecodeAccountId, decodeVestingSchedules),
+	}
+}
+
+func decodeVestingSchedules(buffer *bytes.Buffer) (sc.Sequence[VestingInfo], error) {
+	return sc.DecodeSequenceWith(buffer, DecodeVestingInfo)
+}
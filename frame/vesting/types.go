@@ -0,0 +1,91 @@
+package vesting
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// VestingInfo is a linear vesting schedule. `Locked` is unlocked by `PerBlock` on every block,
+// starting from `StartingBlock`.
+type VestingInfo struct {
+	// Locked is the amount of balance, which is locked at the start of the schedule.
+	Locked primitives.Balance
+	// PerBlock is the amount of balance, which is unlocked per block.
+	PerBlock primitives.Balance
+	// StartingBlock is the block number, from which the unlocking starts.
+	StartingBlock sc.U64
+}
+
+func (vi VestingInfo) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		vi.Locked,
+		vi.PerBlock,
+		vi.StartingBlock,
+	)
+}
+
+func DecodeVestingInfo(buffer *bytes.Buffer) (VestingInfo, error) {
+	locked, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return VestingInfo{}, err
+	}
+	perBlock, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return VestingInfo{}, err
+	}
+	startingBlock, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return VestingInfo{}, err
+	}
+	return VestingInfo{
+		Locked:        locked,
+		PerBlock:      perBlock,
+		StartingBlock: startingBlock,
+	}, nil
+}
+
+func (vi VestingInfo) Bytes() []byte {
+	return sc.EncodedBytes(vi)
+}
+
+// isValid returns whether the schedule locks any balance and unlocks it in a finite number of blocks.
+func (vi VestingInfo) isValid() bool {
+	return !vi.Locked.Eq(sc.NewU128(0)) && !vi.PerBlock.Eq(sc.NewU128(0))
+}
+
+// perBlock returns the amount unlocked per block, which is at least 1.
+func (vi VestingInfo) perBlock() sc.U128 {
+	return sc.Max128(vi.PerBlock, sc.NewU128(1))
+}
+
+// lockedAt returns the amount of balance, which is still locked at block `n`.
+func (vi VestingInfo) lockedAt(n sc.U64) sc.U128 {
+	vestedBlocks := sc.SaturatingSubU64(n, vi.StartingBlock)
+	vested := new(big.Int).Mul(vi.perBlock().ToBigInt(), new(big.Int).SetUint64(uint64(vestedBlocks)))
+
+	locked := vi.Locked.ToBigInt()
+	if vested.Cmp(locked) >= 0 {
+		return sc.NewU128(0)
+	}
+	return sc.NewU128(locked.Sub(locked, vested))
+}
+
+// endingBlockAsBalance returns the block number, at which the schedule is fully unlocked, as a balance.
+func (vi VestingInfo) endingBlockAsBalance() sc.U128 {
+	locked := vi.Locked.ToBigInt()
+	perBlock := vi.perBlock().ToBigInt()
+
+	duration := big.NewInt(1)
+	if perBlock.Cmp(locked) < 0 {
+		remainder := new(big.Int)
+		duration, remainder = new(big.Int).QuoRem(locked, perBlock, remainder)
+		if remainder.Sign() != 0 {
+			duration.Add(duration, big.NewInt(1))
+		}
+	}
+
+	return sc.SaturatingAddU128(sc.NewU128(uint64(vi.StartingBlock)), sc.NewU128(duration))
+}
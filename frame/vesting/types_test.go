@@ -0,0 +1,57 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedVestingInfoBytes = []byte{
+		0xe8, 0x3, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x5, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	}
+)
+
+func Test_VestingInfo_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := schedule.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedVestingInfoBytes, buffer.Bytes())
+}
+
+func Test_VestingInfo_Bytes(t *testing.T) {
+	assert.Equal(t, expectedVestingInfoBytes, schedule.Bytes())
+}
+
+func Test_DecodeVestingInfo(t *testing.T) {
+	result, err := DecodeVestingInfo(bytes.NewBuffer(expectedVestingInfoBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, schedule, result)
+}
+
+func Test_VestingInfo_isValid(t *testing.T) {
+	assert.True(t, schedule.isValid())
+	assert.False(t, VestingInfo{Locked: sc.NewU128(0), PerBlock: sc.NewU128(1)}.isValid())
+	assert.False(t, VestingInfo{Locked: sc.NewU128(1), PerBlock: sc.NewU128(0)}.isValid())
+}
+
+func Test_VestingInfo_lockedAt(t *testing.T) {
+	assert.Equal(t, sc.NewU128(1_000), schedule.lockedAt(0))
+	assert.Equal(t, sc.NewU128(1_000), schedule.lockedAt(5))
+	assert.Equal(t, sc.NewU128(950), schedule.lockedAt(10))
+	assert.Equal(t, sc.NewU128(0), schedule.lockedAt(105))
+	assert.Equal(t, sc.NewU128(0), schedule.lockedAt(1_000))
+}
+
+func Test_VestingInfo_endingBlockAsBalance(t *testing.T) {
+	assert.Equal(t, sc.NewU128(105), schedule.endingBlockAsBalance())
+	assert.Equal(t, sc.NewU128(9), VestingInfo{Locked: sc.NewU128(10), PerBlock: sc.NewU128(3), StartingBlock: 5}.endingBlockAsBalance())
+	assert.Equal(t, sc.NewU128(6), VestingInfo{Locked: sc.NewU128(10), PerBlock: sc.NewU128(20), StartingBlock: 5}.endingBlockAsBalance())
+}
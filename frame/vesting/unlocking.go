@@ -0,0 +1,224 @@
+package vesting
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// vestingId is the identifier of the balance lock, which is placed on the unvested funds.
+	vestingId = [8]byte{'v', 'e', 's', 't', 'i', 'n', 'g', ' '}
+)
+
+// unlocking holds the dependencies and logic, shared by the calls and the genesis of the module.
+type unlocking struct {
+	moduleId           sc.U8
+	constants          *consts
+	storage            *storage
+	eventDepositor     primitives.EventDepositor
+	currency           fungible.Mutate
+	lockableCurrency   primitives.LockableCurrency
+	storageBlockNumber func() (sc.U64, error)
+	lookup             primitives.StaticLookup
+}
+
+func newUnlocking(moduleId sc.U8, config *Config, constants *consts, storage *storage) unlocking {
+	return unlocking{
+		moduleId:           moduleId,
+		constants:          constants,
+		storage:            storage,
+		eventDepositor:     config.EventDepositor,
+		currency:           config.Currency,
+		lockableCurrency:   config.LockableCurrency,
+		storageBlockNumber: config.StorageBlockNumber,
+		lookup:             config.Lookup,
+	}
+}
+
+// doVest unlocks the vested funds of `who` and removes its completed schedules.
+func (u unlocking) doVest(who primitives.AccountId) error {
+	schedules, err := u.vestingSchedules(who)
+	if err != nil {
+		return err
+	}
+
+	now, err := u.storageBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	schedules, lockedNow := reportScheduleUpdates(schedules, now, func(int) bool { return false })
+
+	return u.update(who, schedules, lockedNow)
+}
+
+// doVestedTransfer transfers `schedule.Locked` from `source` to `target` and locks it under `schedule`.
+func (u unlocking) doVestedTransfer(source primitives.AccountId, target primitives.AccountId, schedule VestingInfo) error {
+	if schedule.Locked.Lt(u.constants.MinVestedTransfer) {
+		return NewDispatchErrorAmountLow(u.moduleId)
+	}
+	if !schedule.isValid() {
+		return NewDispatchErrorInvalidScheduleParams(u.moduleId)
+	}
+
+	schedules, err := u.storage.Vesting.Get(target)
+	if err != nil {
+		return err
+	}
+	if sc.U32(len(schedules)) >= u.constants.MaxVestingSchedules {
+		return NewDispatchErrorAtMaxVestingSchedules(u.moduleId)
+	}
+
+	if _, err := u.currency.Transfer(source, target, schedule.Locked, fungible.PreservationExpendable); err != nil {
+		return err
+	}
+
+	return u.addVestingSchedule(target, append(schedules, schedule))
+}
+
+// addVestingSchedule stores `schedules`, which contain a newly added schedule, for `who` and updates the lock.
+func (u unlocking) addVestingSchedule(who primitives.AccountId, schedules sc.Sequence[VestingInfo]) error {
+	now, err := u.storageBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	schedules, lockedNow := reportScheduleUpdates(schedules, now, func(int) bool { return false })
+
+	return u.update(who, schedules, lockedNow)
+}
+
+// mergeSchedules merges the schedules of `who` at `index1` and `index2` into a new schedule,
+// which unlocks their remaining locked funds until the later of their ending blocks.
+func (u unlocking) mergeSchedules(who primitives.AccountId, index1 sc.U32, index2 sc.U32) error {
+	if index1 == index2 {
+		return nil
+	}
+
+	schedules, err := u.vestingSchedules(who)
+	if err != nil {
+		return err
+	}
+	if int(index1) >= len(schedules) || int(index2) >= len(schedules) {
+		return NewDispatchErrorScheduleIndexOutOfBounds(u.moduleId)
+	}
+
+	now, err := u.storageBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	schedule1, schedule2 := schedules[index1], schedules[index2]
+	schedules, lockedNow := reportScheduleUpdates(schedules, now, func(i int) bool {
+		return i == int(index1) || i == int(index2)
+	})
+
+	merged, ok := mergeVestingInfo(now, schedule1, schedule2)
+	if ok {
+		schedules = append(schedules, merged)
+		lockedNow = sc.SaturatingAddU128(lockedNow, merged.lockedAt(now))
+	}
+
+	return u.update(who, schedules, lockedNow)
+}
+
+// vestingSchedules returns the schedules of `who`, or an error if `who` is not vesting.
+func (u unlocking) vestingSchedules(who primitives.AccountId) (sc.Sequence[VestingInfo], error) {
+	schedules, err := u.storage.Vesting.TryGet(who)
+	if err != nil {
+		return nil, err
+	}
+	if !schedules.HasValue {
+		return nil, NewDispatchErrorNotVesting(u.moduleId)
+	}
+	return schedules.Value, nil
+}
+
+// update stores the schedules of `who` and sets the vesting lock to `lockedNow`.
+// The lock is removed once all schedules are completed.
+func (u unlocking) update(who primitives.AccountId, schedules sc.Sequence[VestingInfo], lockedNow sc.U128) error {
+	if sc.U32(len(schedules)) > u.constants.MaxVestingSchedules {
+		return NewDispatchErrorAtMaxVestingSchedules(u.moduleId)
+	}
+
+	if len(schedules) == 0 {
+		u.storage.Vesting.Remove(who)
+	} else {
+		u.storage.Vesting.Put(who, schedules)
+	}
+
+	if lockedNow.Eq(sc.NewU128(0)) {
+		if err := u.lockableCurrency.RemoveLock(vestingId, who); err != nil {
+			return err
+		}
+		u.eventDepositor.DepositEvent(newEventVestingCompleted(u.moduleId, who))
+		return nil
+	}
+
+	if err := u.lockableCurrency.SetLock(vestingId, who, lockedNow, primitives.ReasonsAll); err != nil {
+		return err
+	}
+	u.eventDepositor.DepositEvent(newEventVestingUpdated(u.moduleId, who, lockedNow))
+
+	return nil
+}
+
+// reportScheduleUpdates filters out the schedules, which are completed at block `now` or
+// which should be removed, and returns the remaining schedules with their total locked amount.
+func reportScheduleUpdates(schedules sc.Sequence[VestingInfo], now sc.U64, shouldRemove func(i int) bool) (sc.Sequence[VestingInfo], sc.U128) {
+	remaining := sc.Sequence[VestingInfo]{}
+	totalLockedNow := sc.NewU128(0)
+
+	for i, schedule := range schedules {
+		lockedNow := schedule.lockedAt(now)
+		if lockedNow.Eq(sc.NewU128(0)) || shouldRemove(i) {
+			continue
+		}
+		remaining = append(remaining, schedule)
+		totalLockedNow = sc.SaturatingAddU128(totalLockedNow, lockedNow)
+	}
+
+	return remaining, totalLockedNow
+}
+
+// mergeVestingInfo merges two schedules into one, which unlocks their funds, locked at block `now`,
+// until the later of their ending blocks. If a schedule has ended, the other one is returned
+// unchanged, and if both have ended, there is nothing to merge.
+func mergeVestingInfo(now sc.U64, schedule1 VestingInfo, schedule2 VestingInfo) (VestingInfo, bool) {
+	schedule1EndingBlock := schedule1.endingBlockAsBalance()
+	schedule2EndingBlock := schedule2.endingBlockAsBalance()
+	nowAsBalance := sc.NewU128(uint64(now))
+
+	schedule1Ended := !schedule1EndingBlock.Gt(nowAsBalance)
+	schedule2Ended := !schedule2EndingBlock.Gt(nowAsBalance)
+	switch {
+	case schedule1Ended && schedule2Ended:
+		return VestingInfo{}, false
+	case schedule1Ended:
+		return schedule2, true
+	case schedule2Ended:
+		return schedule1, true
+	}
+
+	locked := sc.SaturatingAddU128(schedule1.lockedAt(now), schedule2.lockedAt(now))
+	endingBlock := sc.Max128(schedule1EndingBlock, schedule2EndingBlock)
+	startingBlock := now
+	if schedule1.StartingBlock > startingBlock {
+		startingBlock = schedule1.StartingBlock
+	}
+	if schedule2.StartingBlock > startingBlock {
+		startingBlock = schedule2.StartingBlock
+	}
+
+	duration := sc.Max128(sc.SaturatingSubU128(endingBlock, sc.NewU128(uint64(startingBlock))), sc.NewU128(1))
+	perBlock := sc.Max128(sc.NewU128(new(big.Int).Quo(locked.ToBigInt(), duration.ToBigInt())), sc.NewU128(1))
+
+	return VestingInfo{
+		Locked:        locked,
+		PerBlock:      perBlock,
+		StartingBlock: startingBlock,
+	}, true
+}
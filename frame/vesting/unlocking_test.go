@@ -0,0 +1,230 @@
+package vesting
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Unlocking_doVest(t *testing.T) {
+	target := setupUnlocking()
+	expectedEvent := newEventVestingUpdated(moduleId, whoAccountId, sc.NewU128(950))
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule}), nil)
+	mockStorageVesting.On("Put", whoAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, whoAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doVest(whoAccountId)
+
+	assert.Nil(t, err)
+	mockStorageVesting.AssertCalled(t, "Put", whoAccountId, sc.Sequence[VestingInfo]{schedule})
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, whoAccountId, sc.NewU128(950), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Unlocking_doVest_Completed(t *testing.T) {
+	target := setupUnlocking()
+	target.storageBlockNumber = func() (sc.U64, error) { return 105, nil }
+	expectedEvent := newEventVestingCompleted(moduleId, whoAccountId)
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule, otherSchedule}), nil)
+	mockStorageVesting.On("Remove", whoAccountId).Return()
+	mockLockableCurrency.On("RemoveLock", vestingId, whoAccountId).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doVest(whoAccountId)
+
+	assert.Nil(t, err)
+	mockStorageVesting.AssertCalled(t, "Remove", whoAccountId)
+	mockLockableCurrency.AssertCalled(t, "RemoveLock", vestingId, whoAccountId)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Unlocking_doVest_NotVesting(t *testing.T) {
+	target := setupUnlocking()
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](nil), nil)
+
+	err := target.doVest(whoAccountId)
+
+	assert.Equal(t, NewDispatchErrorNotVesting(moduleId), err)
+	mockLockableCurrency.AssertNotCalled(t, "SetLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Unlocking_doVest_SetLockError(t *testing.T) {
+	target := setupUnlocking()
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule}), nil)
+	mockStorageVesting.On("Put", whoAccountId, sc.Sequence[VestingInfo]{schedule}).Return()
+	mockLockableCurrency.On("SetLock", vestingId, whoAccountId, sc.NewU128(950), primitives.ReasonsAll).Return(expectedErr)
+
+	err := target.doVest(whoAccountId)
+
+	assert.Equal(t, expectedErr, err)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Unlocking_doVestedTransfer(t *testing.T) {
+	target := setupUnlocking()
+	expectedSchedules := sc.Sequence[VestingInfo]{otherSchedule, schedule}
+	expectedEvent := newEventVestingUpdated(moduleId, targetAccountId, sc.NewU128(1_350))
+
+	mockStorageVesting.On("Get", targetAccountId).Return(sc.Sequence[VestingInfo]{otherSchedule}, nil)
+	mockCurrency.On("Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable).Return(schedule.Locked, nil)
+	mockStorageVesting.On("Put", targetAccountId, expectedSchedules).Return()
+	mockLockableCurrency.On("SetLock", vestingId, targetAccountId, sc.NewU128(1_350), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.doVestedTransfer(whoAccountId, targetAccountId, schedule)
+
+	assert.Nil(t, err)
+	mockCurrency.AssertCalled(t, "Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable)
+	mockStorageVesting.AssertCalled(t, "Put", targetAccountId, expectedSchedules)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, targetAccountId, sc.NewU128(1_350), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Unlocking_doVestedTransfer_AmountLow(t *testing.T) {
+	target := setupUnlocking()
+	lowSchedule := VestingInfo{Locked: sc.NewU128(99), PerBlock: sc.NewU128(1), StartingBlock: 5}
+
+	err := target.doVestedTransfer(whoAccountId, targetAccountId, lowSchedule)
+
+	assert.Equal(t, NewDispatchErrorAmountLow(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Unlocking_doVestedTransfer_InvalidScheduleParams(t *testing.T) {
+	target := setupUnlocking()
+	invalidSchedule := VestingInfo{Locked: sc.NewU128(1_000), PerBlock: sc.NewU128(0), StartingBlock: 5}
+
+	err := target.doVestedTransfer(whoAccountId, targetAccountId, invalidSchedule)
+
+	assert.Equal(t, NewDispatchErrorInvalidScheduleParams(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Unlocking_doVestedTransfer_AtMaxVestingSchedules(t *testing.T) {
+	target := setupUnlocking()
+
+	mockStorageVesting.On("Get", targetAccountId).Return(sc.Sequence[VestingInfo]{otherSchedule, otherSchedule, otherSchedule}, nil)
+
+	err := target.doVestedTransfer(whoAccountId, targetAccountId, schedule)
+
+	assert.Equal(t, NewDispatchErrorAtMaxVestingSchedules(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Unlocking_doVestedTransfer_TransferError(t *testing.T) {
+	target := setupUnlocking()
+
+	mockStorageVesting.On("Get", targetAccountId).Return(sc.Sequence[VestingInfo]{}, nil)
+	mockCurrency.On("Transfer", whoAccountId, targetAccountId, schedule.Locked, fungible.PreservationExpendable).Return(sc.NewU128(0), expectedErr)
+
+	err := target.doVestedTransfer(whoAccountId, targetAccountId, schedule)
+
+	assert.Equal(t, expectedErr, err)
+	mockStorageVesting.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockLockableCurrency.AssertNotCalled(t, "SetLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Unlocking_mergeSchedules(t *testing.T) {
+	target := setupUnlocking()
+	merged := VestingInfo{
+		Locked:        sc.NewU128(1_350),
+		PerBlock:      sc.NewU128(14),
+		StartingBlock: blockNumber,
+	}
+	expectedSchedules := sc.Sequence[VestingInfo]{schedule, merged}
+	expectedEvent := newEventVestingUpdated(moduleId, whoAccountId, sc.NewU128(2_300))
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule, otherSchedule, schedule}), nil)
+	mockStorageVesting.On("Put", whoAccountId, expectedSchedules).Return()
+	mockLockableCurrency.On("SetLock", vestingId, whoAccountId, sc.NewU128(2_300), primitives.ReasonsAll).Return(nil)
+	mockEventDepositor.On("DepositEvent", expectedEvent).Return()
+
+	err := target.mergeSchedules(whoAccountId, 1, 2)
+
+	assert.Nil(t, err)
+	mockStorageVesting.AssertCalled(t, "Put", whoAccountId, expectedSchedules)
+	mockLockableCurrency.AssertCalled(t, "SetLock", vestingId, whoAccountId, sc.NewU128(2_300), primitives.ReasonsAll)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", expectedEvent)
+}
+
+func Test_Unlocking_mergeSchedules_SameIndex(t *testing.T) {
+	target := setupUnlocking()
+
+	err := target.mergeSchedules(whoAccountId, 1, 1)
+
+	assert.Nil(t, err)
+	mockStorageVesting.AssertNotCalled(t, "TryGet", mock.Anything)
+}
+
+func Test_Unlocking_mergeSchedules_NotVesting(t *testing.T) {
+	target := setupUnlocking()
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](nil), nil)
+
+	err := target.mergeSchedules(whoAccountId, 0, 1)
+
+	assert.Equal(t, NewDispatchErrorNotVesting(moduleId), err)
+}
+
+func Test_Unlocking_mergeSchedules_ScheduleIndexOutOfBounds(t *testing.T) {
+	target := setupUnlocking()
+
+	mockStorageVesting.On("TryGet", whoAccountId).Return(sc.NewOption[sc.Sequence[VestingInfo]](sc.Sequence[VestingInfo]{schedule, otherSchedule}), nil)
+
+	err := target.mergeSchedules(whoAccountId, 0, 2)
+
+	assert.Equal(t, NewDispatchErrorScheduleIndexOutOfBounds(moduleId), err)
+	mockStorageVesting.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_reportScheduleUpdates(t *testing.T) {
+	schedules := sc.Sequence[VestingInfo]{schedule, otherSchedule, schedule}
+
+	remaining, lockedNow := reportScheduleUpdates(schedules, 20, func(i int) bool { return i == 2 })
+
+	assert.Equal(t, sc.Sequence[VestingInfo]{schedule}, remaining)
+	assert.Equal(t, sc.NewU128(850), lockedNow)
+}
+
+func Test_mergeVestingInfo(t *testing.T) {
+	merged, ok := mergeVestingInfo(blockNumber, schedule, otherSchedule)
+
+	assert.True(t, ok)
+	assert.Equal(t, VestingInfo{Locked: sc.NewU128(1_350), PerBlock: sc.NewU128(14), StartingBlock: blockNumber}, merged)
+}
+
+func Test_mergeVestingInfo_NotStarted(t *testing.T) {
+	future := VestingInfo{Locked: sc.NewU128(1_000), PerBlock: sc.NewU128(10), StartingBlock: 50}
+
+	merged, ok := mergeVestingInfo(blockNumber, future, otherSchedule)
+
+	assert.True(t, ok)
+	assert.Equal(t, VestingInfo{Locked: sc.NewU128(1_400), PerBlock: sc.NewU128(14), StartingBlock: 50}, merged)
+}
+
+func Test_mergeVestingInfo_OneEnded(t *testing.T) {
+	merged, ok := mergeVestingInfo(20, schedule, otherSchedule)
+
+	assert.True(t, ok)
+	assert.Equal(t, schedule, merged)
+
+	merged, ok = mergeVestingInfo(20, otherSchedule, schedule)
+
+	assert.True(t, ok)
+	assert.Equal(t, schedule, merged)
+}
+
+func Test_mergeVestingInfo_BothEnded(t *testing.T) {
+	_, ok := mergeVestingInfo(105, schedule, otherSchedule)
+
+	assert.False(t, ok)
+}
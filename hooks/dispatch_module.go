@@ -15,6 +15,12 @@ func (dmh DefaultDispatchModule) OnRuntimeUpgrade() primitives.Weight {
 	return primitives.WeightZero()
 }
 
+func (dmh DefaultDispatchModule) PreUpgrade() (sc.Sequence[sc.U8], error) {
+	return sc.Sequence[sc.U8]{}, nil
+}
+
+func (dmh DefaultDispatchModule) PostUpgrade(_ sc.Sequence[sc.U8]) error { return nil }
+
 func (dmh DefaultDispatchModule) OnFinalize(n sc.U64) error { return nil }
 
 func (dmh DefaultDispatchModule) OnIdle(n sc.U64, remainingWeight primitives.Weight) primitives.Weight {
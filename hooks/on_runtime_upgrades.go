@@ -0,0 +1,70 @@
+package hooks
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+// OnRuntimeUpgrades composes multiple OnRuntimeUpgrade hooks, such as storage migrations,
+// which are executed in the given order.
+type OnRuntimeUpgrades struct {
+	upgrades []primitives.OnRuntimeUpgrade
+}
+
+func NewOnRuntimeUpgrades(upgrades ...primitives.OnRuntimeUpgrade) OnRuntimeUpgrades {
+	return OnRuntimeUpgrades{upgrades: upgrades}
+}
+
+// NewModulesOnRuntimeUpgrade composes the OnRuntimeUpgrade hooks of modules, which are executed in the order of the modules.
+func NewModulesOnRuntimeUpgrade(modules []primitives.Module) OnRuntimeUpgrades {
+	upgrades := make([]primitives.OnRuntimeUpgrade, 0, len(modules))
+	for _, module := range modules {
+		upgrades = append(upgrades, module)
+	}
+	return NewOnRuntimeUpgrades(upgrades...)
+}
+
+func (o OnRuntimeUpgrades) OnRuntimeUpgrade() primitives.Weight {
+	weight := primitives.WeightZero()
+	for _, upgrade := range o.upgrades {
+		weight = weight.SaturatingAdd(upgrade.OnRuntimeUpgrade())
+	}
+	return weight
+}
+
+// TryOnRuntimeUpgrade executes the hooks in order, running the pre and post upgrade checks of each one if checks is set.
+func (o OnRuntimeUpgrades) TryOnRuntimeUpgrade(checks bool) (primitives.Weight, error) {
+	weight := primitives.WeightZero()
+	for _, upgrade := range o.upgrades {
+		upgradeWeight, err := TryOnRuntimeUpgrade(upgrade, checks)
+		if err != nil {
+			return primitives.WeightZero(), err
+		}
+		weight = weight.SaturatingAdd(upgradeWeight)
+	}
+	return weight, nil
+}
+
+// TryOnRuntimeUpgrade executes upgrade. If checks is set and upgrade implements primitives.UpgradeCheck,
+// its PreUpgrade is executed before and its PostUpgrade after the upgrade.
+func TryOnRuntimeUpgrade(upgrade primitives.OnRuntimeUpgrade, checks bool) (primitives.Weight, error) {
+	if composite, ok := upgrade.(OnRuntimeUpgrades); ok {
+		return composite.TryOnRuntimeUpgrade(checks)
+	}
+
+	upgradeCheck, ok := upgrade.(primitives.UpgradeCheck)
+	if !checks || !ok {
+		return upgrade.OnRuntimeUpgrade(), nil
+	}
+
+	state, err := upgradeCheck.PreUpgrade()
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	weight := upgrade.OnRuntimeUpgrade()
+
+	err = upgradeCheck.PostUpgrade(state)
+	if err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	return weight, nil
+}
@@ -0,0 +1,152 @@
+package hooks
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	firstWeight  = primitives.WeightFromParts(100, 10)
+	secondWeight = primitives.WeightFromParts(200, 20)
+	firstState   = sc.Sequence[sc.U8]{1}
+	secondState  = sc.Sequence[sc.U8]{2}
+	errUpgrade   = errors.New("upgrade check failed")
+)
+
+var (
+	mockFirstUpgrade  *mocks.DefaultOnRuntimeUpgrade
+	mockSecondUpgrade *mocks.DefaultOnRuntimeUpgrade
+	executed          []string
+)
+
+func Test_OnRuntimeUpgrades_OnRuntimeUpgrade(t *testing.T) {
+	target := setupOnRuntimeUpgrades()
+
+	mockFirstUpgrade.On("OnRuntimeUpgrade").Return(firstWeight).Run(record("first"))
+	mockSecondUpgrade.On("OnRuntimeUpgrade").Return(secondWeight).Run(record("second"))
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, firstWeight.Add(secondWeight), result)
+	assert.Equal(t, []string{"first", "second"}, executed)
+}
+
+func Test_OnRuntimeUpgrades_OnRuntimeUpgrade_Empty(t *testing.T) {
+	target := NewOnRuntimeUpgrades()
+
+	assert.Equal(t, primitives.WeightZero(), target.OnRuntimeUpgrade())
+}
+
+func Test_OnRuntimeUpgrades_OnRuntimeUpgrade_Saturating(t *testing.T) {
+	target := setupOnRuntimeUpgrades()
+	maxWeight := primitives.WeightFromParts(math.MaxUint64, math.MaxUint64)
+
+	mockFirstUpgrade.On("OnRuntimeUpgrade").Return(maxWeight)
+	mockSecondUpgrade.On("OnRuntimeUpgrade").Return(secondWeight)
+
+	assert.Equal(t, maxWeight, target.OnRuntimeUpgrade())
+}
+
+func Test_NewModulesOnRuntimeUpgrade(t *testing.T) {
+	executed = nil
+	firstModule := new(mocks.Module)
+	secondModule := new(mocks.Module)
+	target := NewModulesOnRuntimeUpgrade([]primitives.Module{firstModule, secondModule})
+
+	firstModule.On("OnRuntimeUpgrade").Return(firstWeight).Run(record("first"))
+	secondModule.On("OnRuntimeUpgrade").Return(secondWeight).Run(record("second"))
+
+	result := target.OnRuntimeUpgrade()
+
+	assert.Equal(t, firstWeight.Add(secondWeight), result)
+	assert.Equal(t, []string{"first", "second"}, executed)
+}
+
+func Test_OnRuntimeUpgrades_TryOnRuntimeUpgrade(t *testing.T) {
+	target := setupOnRuntimeUpgrades()
+
+	mockFirstUpgrade.On("PreUpgrade").Return(firstState, nil).Run(record("first pre"))
+	mockFirstUpgrade.On("OnRuntimeUpgrade").Return(firstWeight).Run(record("first"))
+	mockFirstUpgrade.On("PostUpgrade", firstState).Return(nil).Run(record("first post"))
+	mockSecondUpgrade.On("PreUpgrade").Return(secondState, nil).Run(record("second pre"))
+	mockSecondUpgrade.On("OnRuntimeUpgrade").Return(secondWeight).Run(record("second"))
+	mockSecondUpgrade.On("PostUpgrade", secondState).Return(nil).Run(record("second post"))
+
+	result, err := target.TryOnRuntimeUpgrade(true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, firstWeight.Add(secondWeight), result)
+	assert.Equal(t, []string{"first pre", "first", "first post", "second pre", "second", "second post"}, executed)
+}
+
+func Test_OnRuntimeUpgrades_TryOnRuntimeUpgrade_NoChecks(t *testing.T) {
+	target := setupOnRuntimeUpgrades()
+
+	mockFirstUpgrade.On("OnRuntimeUpgrade").Return(firstWeight)
+	mockSecondUpgrade.On("OnRuntimeUpgrade").Return(secondWeight)
+
+	result, err := target.TryOnRuntimeUpgrade(false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, firstWeight.Add(secondWeight), result)
+	mockFirstUpgrade.AssertNotCalled(t, "PreUpgrade")
+	mockSecondUpgrade.AssertNotCalled(t, "PostUpgrade", mock.Anything)
+}
+
+func Test_OnRuntimeUpgrades_TryOnRuntimeUpgrade_PostUpgradeError(t *testing.T) {
+	target := setupOnRuntimeUpgrades()
+
+	mockFirstUpgrade.On("PreUpgrade").Return(firstState, nil)
+	mockFirstUpgrade.On("OnRuntimeUpgrade").Return(firstWeight)
+	mockFirstUpgrade.On("PostUpgrade", firstState).Return(errUpgrade)
+
+	result, err := target.TryOnRuntimeUpgrade(true)
+
+	assert.Equal(t, errUpgrade, err)
+	assert.Equal(t, primitives.WeightZero(), result)
+	mockSecondUpgrade.AssertNotCalled(t, "PreUpgrade")
+	mockSecondUpgrade.AssertNotCalled(t, "OnRuntimeUpgrade")
+}
+
+func Test_TryOnRuntimeUpgrade_Nested(t *testing.T) {
+	executed = nil
+	mockFirstUpgrade = new(mocks.DefaultOnRuntimeUpgrade)
+	mockSecondUpgrade = new(mocks.DefaultOnRuntimeUpgrade)
+	target := NewOnRuntimeUpgrades(NewOnRuntimeUpgrades(mockFirstUpgrade), mockSecondUpgrade)
+
+	mockFirstUpgrade.On("PreUpgrade").Return(firstState, nil)
+	mockFirstUpgrade.On("OnRuntimeUpgrade").Return(firstWeight).Run(record("first"))
+	mockFirstUpgrade.On("PostUpgrade", firstState).Return(nil)
+	mockSecondUpgrade.On("PreUpgrade").Return(secondState, nil)
+	mockSecondUpgrade.On("OnRuntimeUpgrade").Return(secondWeight).Run(record("second"))
+	mockSecondUpgrade.On("PostUpgrade", secondState).Return(nil)
+
+	result, err := TryOnRuntimeUpgrade(target, true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, firstWeight.Add(secondWeight), result)
+	assert.Equal(t, []string{"first", "second"}, executed)
+	mockFirstUpgrade.AssertCalled(t, "PostUpgrade", firstState)
+}
+
+func setupOnRuntimeUpgrades() OnRuntimeUpgrades {
+	executed = nil
+	mockFirstUpgrade = new(mocks.DefaultOnRuntimeUpgrade)
+	mockSecondUpgrade = new(mocks.DefaultOnRuntimeUpgrade)
+
+	return NewOnRuntimeUpgrades(mockFirstUpgrade, mockSecondUpgrade)
+}
+
+// record returns a mock run function, which records the execution of `name`.
+func record(name string) func(mock.Arguments) {
+	return func(mock.Arguments) {
+		executed = append(executed, name)
+	}
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/mock"
+)
+
+type DisabledValidators struct {
+	mock.Mock
+}
+
+func (m *DisabledValidators) IsDisabled(index sc.U32) (bool, error) {
+	args := m.Called(index)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+
+	return args.Get(0).(bool), args.Get(1).(error)
+}
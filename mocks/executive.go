@@ -64,3 +64,19 @@ func (m *Executive) OffchainWorker(header primitives.Header) error {
 
 	return args.Get(0).(error)
 }
+
+func (m *Executive) TryRuntimeUpgrade(checks bool) (primitives.Weight, error) {
+	args := m.Called(checks)
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Weight), nil
+	}
+	return args.Get(0).(primitives.Weight), args.Get(1).(error)
+}
+
+func (m *Executive) TryExecuteBlock(block primitives.Block, stateRootCheck bool, signatureCheck bool) (primitives.Weight, error) {
+	args := m.Called(block, stateRootCheck, signatureCheck)
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Weight), nil
+	}
+	return args.Get(0).(primitives.Weight), args.Get(1).(error)
+}
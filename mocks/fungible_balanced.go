@@ -0,0 +1,98 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type FungibleBalanced struct {
+	mock.Mock
+}
+
+func (m *FungibleBalanced) TotalIssuance() (types.Balance, error) {
+	args := m.Called()
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleBalanced) MinimumBalance() types.Balance {
+	args := m.Called()
+
+	return args.Get(0).(types.Balance)
+}
+
+func (m *FungibleBalanced) TotalBalance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleBalanced) Balance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleBalanced) ReducibleBalance(who types.AccountId, preservation fungible.Preservation, force fungible.Fortitude) (types.Balance, error) {
+	args := m.Called(who, preservation, force)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleBalanced) CanDeposit(who types.AccountId, amount sc.U128, provenance fungible.Provenance) error {
+	args := m.Called(who, amount, provenance)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *FungibleBalanced) CanWithdraw(who types.AccountId, amount sc.U128) error {
+	args := m.Called(who, amount)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *FungibleBalanced) Deposit(who types.AccountId, value sc.U128, precision fungible.Precision) (types.Balance, error) {
+	args := m.Called(who, value, precision)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleBalanced) Withdraw(who types.AccountId, value sc.U128, precision fungible.Precision, preservation fungible.Preservation, force fungible.Fortitude) (types.Balance, error) {
+	args := m.Called(who, value, precision, preservation, force)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
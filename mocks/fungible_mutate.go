@@ -0,0 +1,108 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support/fungible"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type FungibleMutate struct {
+	mock.Mock
+}
+
+func (m *FungibleMutate) TotalIssuance() (types.Balance, error) {
+	args := m.Called()
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleMutate) MinimumBalance() types.Balance {
+	args := m.Called()
+
+	return args.Get(0).(types.Balance)
+}
+
+func (m *FungibleMutate) TotalBalance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleMutate) Balance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleMutate) ReducibleBalance(who types.AccountId, preservation fungible.Preservation, force fungible.Fortitude) (types.Balance, error) {
+	args := m.Called(who, preservation, force)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleMutate) CanDeposit(who types.AccountId, amount sc.U128, provenance fungible.Provenance) error {
+	args := m.Called(who, amount, provenance)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *FungibleMutate) CanWithdraw(who types.AccountId, amount sc.U128) error {
+	args := m.Called(who, amount)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *FungibleMutate) MintInto(who types.AccountId, amount sc.U128) (types.Balance, error) {
+	args := m.Called(who, amount)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleMutate) BurnFrom(who types.AccountId, amount sc.U128, precision fungible.Precision, force fungible.Fortitude) (types.Balance, error) {
+	args := m.Called(who, amount, precision, force)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *FungibleMutate) Transfer(source types.AccountId, dest types.AccountId, amount sc.U128, preservation fungible.Preservation) (types.Balance, error) {
+	args := m.Called(source, dest, amount, preservation)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
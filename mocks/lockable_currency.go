@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type LockableCurrency struct {
+	mock.Mock
+}
+
+func (m *LockableCurrency) SetLock(id [8]byte, who types.AccountId, amount sc.U128, reasons types.Reasons) error {
+	args := m.Called(id, who, amount, reasons)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *LockableCurrency) ExtendLock(id [8]byte, who types.AccountId, amount sc.U128, reasons types.Reasons) error {
+	args := m.Called(id, who, amount, reasons)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *LockableCurrency) RemoveLock(id [8]byte, who types.AccountId) error {
+	args := m.Called(id, who)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
@@ -127,3 +127,22 @@ func (m *Module) BuildConfig(config []byte) error {
 	}
 	return args.Get(0).(error)
 }
+
+func (m *Module) InCodeStorageVersion() sc.U16 {
+	args := m.Called()
+
+	return args.Get(0).(sc.U16)
+}
+
+func (m *Module) OnChainStorageVersion() (sc.U16, error) {
+	args := m.Called()
+
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.U16), nil
+	}
+	return args.Get(0).(sc.U16), args.Get(1).(error)
+}
+
+func (m *Module) PutInCodeStorageVersion() {
+	m.Called()
+}
@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+type MultiBlockMigrator struct {
+	mock.Mock
+}
+
+func (m *MultiBlockMigrator) Ongoing() (bool, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+	return args.Bool(0), args.Get(1).(error)
+}
@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type NamedReservableCurrency struct {
+	ReservableCurrency
+}
+
+func (m *NamedReservableCurrency) ReservedBalanceNamed(id [8]byte, who types.AccountId) (types.Balance, error) {
+	args := m.Called(id, who)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
+
+func (m *NamedReservableCurrency) ReserveNamed(id [8]byte, who types.AccountId, value sc.U128) error {
+	args := m.Called(id, who, value)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *NamedReservableCurrency) UnreserveNamed(id [8]byte, who types.AccountId, value sc.U128) (types.Balance, error) {
+	args := m.Called(id, who, value)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
+
+func (m *NamedReservableCurrency) SlashReservedNamed(id [8]byte, who types.AccountId, value sc.U128) (types.Balance, error) {
+	args := m.Called(id, who, value)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
+
+func (m *NamedReservableCurrency) RepatriateReservedNamed(id [8]byte, slashed types.AccountId, beneficiary types.AccountId, value sc.U128, status sc.U8) (types.Balance, error) {
+	args := m.Called(id, slashed, beneficiary, value, status)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
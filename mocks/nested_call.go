@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"bytes"
+
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type NestedCall struct {
+	Call
+}
+
+func (m *NestedCall) DecodeNestedArgs(decoder types.CallDecoder, buffer *bytes.Buffer) (types.Call, error) {
+	args := m.Called(decoder, buffer)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Call), nil
+	}
+
+	return args.Get(0).(types.Call), args.Get(1).(error)
+}
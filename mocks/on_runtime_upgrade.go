@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/mock"
 )
@@ -13,3 +14,19 @@ func (doru *DefaultOnRuntimeUpgrade) OnRuntimeUpgrade() types.Weight {
 	args := doru.Called()
 	return args.Get(0).(types.Weight)
 }
+
+func (doru *DefaultOnRuntimeUpgrade) PreUpgrade() (sc.Sequence[sc.U8], error) {
+	args := doru.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Sequence[sc.U8]), nil
+	}
+	return args.Get(0).(sc.Sequence[sc.U8]), args.Get(1).(error)
+}
+
+func (doru *DefaultOnRuntimeUpgrade) PostUpgrade(state sc.Sequence[sc.U8]) error {
+	args := doru.Called(state)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
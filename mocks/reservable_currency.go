@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReservableCurrency struct {
+	mock.Mock
+}
+
+func (m *ReservableCurrency) CanReserve(who types.AccountId, value sc.U128) (bool, error) {
+	args := m.Called(who, value)
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+	return args.Get(0).(bool), args.Get(1).(error)
+}
+
+func (m *ReservableCurrency) ReservedBalance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
+
+func (m *ReservableCurrency) Reserve(who types.AccountId, value sc.U128) error {
+	args := m.Called(who, value)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *ReservableCurrency) Unreserve(who types.AccountId, value sc.U128) (types.Balance, error) {
+	args := m.Called(who, value)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
+
+func (m *ReservableCurrency) SlashReserved(who types.AccountId, value sc.U128) (types.Balance, error) {
+	args := m.Called(who, value)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
+
+func (m *ReservableCurrency) RepatriateReserved(slashed types.AccountId, beneficiary types.AccountId, value sc.U128, status sc.U8) (types.Balance, error) {
+	args := m.Called(slashed, beneficiary, value, status)
+	if args.Get(1) == nil {
+		return args.Get(0).(types.Balance), nil
+	}
+	return args.Get(0).(types.Balance), args.Get(1).(error)
+}
@@ -53,6 +53,14 @@ func (re *RuntimeExtrinsic) OnRuntimeUpgrade() primitives.Weight {
 	return args.Get(0).(primitives.Weight)
 }
 
+func (re *RuntimeExtrinsic) TryOnRuntimeUpgrade(checks bool) (primitives.Weight, error) {
+	args := re.Called(checks)
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Weight), nil
+	}
+	return args.Get(0).(primitives.Weight), args.Get(1).(error)
+}
+
 func (re *RuntimeExtrinsic) OnFinalize(n sc.U64) error {
 	args := re.Called(n)
 	if args.Get(0) == nil {
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type SessionHandler struct {
+	mock.Mock
+}
+
+func (m *SessionHandler) KeyType() types.PublicKeyType {
+	args := m.Called()
+
+	return args.Get(0).(types.PublicKeyType)
+}
+
+func (m *SessionHandler) KeyTypeId() [4]byte {
+	args := m.Called()
+
+	return args.Get(0).([4]byte)
+}
+
+func (m *SessionHandler) OnGenesisSession(validators sc.Sequence[types.SessionValidator]) error {
+	args := m.Called(validators)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *SessionHandler) OnNewSession(changed bool, validators sc.Sequence[types.SessionValidator], queuedValidators sc.Sequence[types.SessionValidator]) error {
+	args := m.Called(changed, validators, queuedValidators)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
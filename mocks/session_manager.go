@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type SessionManager struct {
+	mock.Mock
+}
+
+func (m *SessionManager) NewSession(newIndex sc.U32) (sc.Option[sc.Sequence[types.AccountId]], error) {
+	args := m.Called(newIndex)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Option[sc.Sequence[types.AccountId]]), nil
+	}
+
+	return args.Get(0).(sc.Option[sc.Sequence[types.AccountId]]), args.Get(1).(error)
+}
+
+func (m *SessionManager) EndSession(endIndex sc.U32) {
+	m.Called(endIndex)
+}
+
+func (m *SessionManager) StartSession(startIndex sc.U32) {
+	m.Called(startIndex)
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type StaticLookup struct {
+	mock.Mock
+}
+
+func (l *StaticLookup) Lookup(a types.MultiAddress) (types.AccountId, error) {
+	args := l.Called(a)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(types.AccountId), nil
+	}
+
+	return args.Get(0).(types.AccountId), args.Get(1).(error)
+}
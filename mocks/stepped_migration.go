@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type SteppedMigration struct {
+	mock.Mock
+}
+
+func (m *SteppedMigration) Id() sc.Sequence[sc.U8] {
+	args := m.Called()
+	return args.Get(0).(sc.Sequence[sc.U8])
+}
+
+func (m *SteppedMigration) Step(cursor sc.Option[sc.Sequence[sc.U8]], weightLimit types.Weight) (sc.Option[sc.Sequence[sc.U8]], types.Weight, error) {
+	args := m.Called(cursor, weightLimit)
+	if args.Get(2) == nil {
+		return args.Get(0).(sc.Option[sc.Sequence[sc.U8]]), args.Get(1).(types.Weight), nil
+	}
+	return args.Get(0).(sc.Option[sc.Sequence[sc.U8]]), args.Get(1).(types.Weight), args.Get(2).(error)
+}
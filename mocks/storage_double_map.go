@@ -0,0 +1,86 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/mock"
+)
+
+type StorageDoubleMap[K1, K2, V sc.Encodable] struct {
+	mock.Mock
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Get(k1 K1, k2 K2) (V, error) {
+	args := m.Called(k1, k2)
+	if args.Get(1) == nil {
+		return args.Get(0).(V), nil
+	}
+
+	return args.Get(0).(V), args.Get(1).(error)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Exists(k1 K1, k2 K2) bool {
+	args := m.Called(k1, k2)
+
+	return args.Get(0).(bool)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Put(k1 K1, k2 K2, value V) {
+	m.Called(k1, k2, value)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Append(k1 K1, k2 K2, value V) {
+	m.Called(k1, k2, value)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Take(k1 K1, k2 K2) (V, error) {
+	args := m.Called(k1, k2)
+	if args.Get(1) == nil {
+		return args.Get(0).(V), nil
+	}
+
+	return args.Get(0).(V), args.Get(1).(error)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) TakeBytes(k1 K1, k2 K2) ([]byte, error) {
+	args := m.Called(k1, k2)
+	if args.Get(1) == nil {
+		return args.Get(0).([]byte), nil
+	}
+	return args.Get(0).([]byte), args.Get(1).(error)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Remove(k1 K1, k2 K2) {
+	m.Called(k1, k2)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) RemovePrefix(k1 K1, limit sc.U32) {
+	m.Called(k1, limit)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) IterPrefix(k1 K1, f func(k2 K2, v V) error) error {
+	args := m.Called(k1, f)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Clear(limit sc.U32) {
+	m.Called(limit)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) Mutate(k1 K1, k2 K2, f func(value *V) (sc.Encodable, error)) (sc.Encodable, error) {
+	args := m.Called(k1, k2, f)
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Encodable), nil
+	}
+	return args.Get(0).(sc.Encodable), args.Get(1).(error)
+}
+
+func (m *StorageDoubleMap[K1, K2, V]) TryMutateExists(k1 K1, k2 K2, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error) {
+	args := m.Called(k1, k2, f)
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Encodable), nil
+	}
+	return args.Get(0).(sc.Encodable), args.Get(1).(error)
+}
@@ -18,6 +18,15 @@ func (m *StorageMap[K, V]) Get(k K) (V, error) {
 	return args.Get(0).(V), args.Get(1).(error)
 }
 
+func (m *StorageMap[K, V]) TryGet(k K) (sc.Option[V], error) {
+	args := m.Called(k)
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Option[V]), nil
+	}
+
+	return args.Get(0).(sc.Option[V]), args.Get(1).(error)
+}
+
 func (m *StorageMap[K, V]) Exists(k K) bool {
 	args := m.Called(k)
 
@@ -63,3 +72,43 @@ func (m *StorageMap[K, V]) TryMutateExists(k K, f func(option *sc.Option[V]) (sc
 	}
 	return args.Get(0).(sc.Encodable), args.Get(1).(error)
 }
+
+func (m *StorageMap[K, V]) Iter(f func(k K, v V) error) error {
+	args := m.Called(f)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *StorageMap[K, V]) IterKeys(f func(k K) error) error {
+	args := m.Called(f)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *StorageMap[K, V]) IterFromKey(start K, f func(k K, v V) error) error {
+	args := m.Called(start, f)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *StorageMap[K, V]) IterPrefix(prefix []byte, f func(k K, v V) error) error {
+	args := m.Called(prefix, f)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *StorageMap[K, V]) Drain(f func(k K, v V) error) error {
+	args := m.Called(f)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/mock"
+)
+
+type StorageNMap[V sc.Encodable] struct {
+	mock.Mock
+}
+
+func (m *StorageNMap[V]) Get(keys sc.VaryingData) (V, error) {
+	args := m.Called(keys)
+	if args.Get(1) == nil {
+		return args.Get(0).(V), nil
+	}
+
+	return args.Get(0).(V), args.Get(1).(error)
+}
+
+func (m *StorageNMap[V]) Exists(keys sc.VaryingData) bool {
+	args := m.Called(keys)
+
+	return args.Get(0).(bool)
+}
+
+func (m *StorageNMap[V]) Put(keys sc.VaryingData, value V) {
+	m.Called(keys, value)
+}
+
+func (m *StorageNMap[V]) Append(keys sc.VaryingData, value V) {
+	m.Called(keys, value)
+}
+
+func (m *StorageNMap[V]) Take(keys sc.VaryingData) (V, error) {
+	args := m.Called(keys)
+	if args.Get(1) == nil {
+		return args.Get(0).(V), nil
+	}
+
+	return args.Get(0).(V), args.Get(1).(error)
+}
+
+func (m *StorageNMap[V]) TakeBytes(keys sc.VaryingData) ([]byte, error) {
+	args := m.Called(keys)
+	if args.Get(1) == nil {
+		return args.Get(0).([]byte), nil
+	}
+	return args.Get(0).([]byte), args.Get(1).(error)
+}
+
+func (m *StorageNMap[V]) Remove(keys sc.VaryingData) {
+	m.Called(keys)
+}
+
+func (m *StorageNMap[V]) RemovePrefix(partialKeys sc.VaryingData, limit sc.U32) {
+	m.Called(partialKeys, limit)
+}
+
+func (m *StorageNMap[V]) Clear(limit sc.U32) {
+	m.Called(limit)
+}
+
+func (m *StorageNMap[V]) Mutate(keys sc.VaryingData, f func(value *V) (sc.Encodable, error)) (sc.Encodable, error) {
+	args := m.Called(keys, f)
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Encodable), nil
+	}
+	return args.Get(0).(sc.Encodable), args.Get(1).(error)
+}
+
+func (m *StorageNMap[V]) TryMutateExists(keys sc.VaryingData, f func(option *sc.Option[V]) (sc.Encodable, error)) (sc.Encodable, error) {
+	args := m.Called(keys, f)
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Encodable), nil
+	}
+	return args.Get(0).(sc.Encodable), args.Get(1).(error)
+}
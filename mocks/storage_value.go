@@ -19,6 +19,16 @@ func (m *StorageValue[T]) Get() (T, error) {
 	return args.Get(0).(T), args.Get(1).(error)
 }
 
+func (m *StorageValue[T]) TryGet() (sc.Option[T], error) {
+	args := m.Called()
+
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Option[T]), nil
+	}
+
+	return args.Get(0).(sc.Option[T]), args.Get(1).(error)
+}
+
 func (m *StorageValue[T]) GetBytes() (sc.Option[sc.Sequence[sc.U8]], error) {
 	args := m.Called()
 
@@ -38,6 +38,18 @@ func (m *StoredMap) CanDecProviders(who types.AccountId) (bool, error) {
 	return args.Get(0).(bool), args.Get(1).(error)
 }
 
+func (m *StoredMap) IncConsumers(who types.AccountId) error {
+	args := m.Called(who)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *StoredMap) DecConsumers(who types.AccountId) {
+	m.Called(who)
+}
+
 func (m *StoredMap) TryMutateExists(who types.AccountId, f func(who *types.AccountData) (sc.Encodable, error)) (sc.Encodable, error) {
 	args := m.Called(who, f)
 
@@ -150,6 +150,18 @@ func (m *SystemModule) CanDecProviders(who primitives.AccountId) (bool, error) {
 	return args.Get(0).(bool), args.Get(1).(error)
 }
 
+func (m *SystemModule) IncConsumers(who primitives.AccountId) error {
+	args := m.Called(who)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *SystemModule) DecConsumers(who primitives.AccountId) {
+	m.Called(who)
+}
+
 func (m *SystemModule) DepositEvent(event primitives.Event) {
 	m.Called(event)
 }
@@ -291,6 +303,14 @@ func (m *SystemModule) StorageBlockNumber() (sc.U64, error) {
 	return args.Get(0).(sc.U64), args.Get(1).(error)
 }
 
+func (m *SystemModule) StorageExtrinsicIndex() (sc.U32, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.U32), nil
+	}
+	return args.Get(0).(sc.U32), args.Get(1).(error)
+}
+
 func (m *SystemModule) StorageBlockNumberSet(blockNumber sc.U64) {
 	m.Called(blockNumber)
 }
@@ -43,8 +43,14 @@ func (uxt *UncheckedExtrinsic) IsSigned() bool {
 }
 
 func (uxt *UncheckedExtrinsic) Check() (primitives.CheckedExtrinsic, error) {
-	args := uxt.Called()
+	return uxt.checkedCall(uxt.Called())
+}
+
+func (uxt *UncheckedExtrinsic) UncheckedIntoChecked() (primitives.CheckedExtrinsic, error) {
+	return uxt.checkedCall(uxt.Called())
+}
 
+func (uxt *UncheckedExtrinsic) checkedCall(args mock.Arguments) (primitives.CheckedExtrinsic, error) {
 	var arg0 primitives.CheckedExtrinsic
 	var arg1 error
 
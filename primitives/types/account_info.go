@@ -70,7 +70,7 @@ func (ai AccountInfo) Frozen(reasons Reasons) sc.U128 {
 	case ReasonsMisc:
 		return ai.Data.MiscFrozen
 	case ReasonsFee:
-		return ai.Data.MiscFrozen
+		return ai.Data.FeeFrozen
 	}
 
 	return sc.NewU128(0)
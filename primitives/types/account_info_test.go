@@ -55,7 +55,7 @@ func Test_DecodeAccountInfo(t *testing.T) {
 func Test_AccountInfo_Frozen(t *testing.T) {
 	assert.Equal(t, sc.NewU128(8), targetAccountInfo.Frozen(ReasonsAll))
 	assert.Equal(t, sc.NewU128(7), targetAccountInfo.Frozen(ReasonsMisc))
-	assert.Equal(t, sc.NewU128(7), targetAccountInfo.Frozen(ReasonsFee))
+	assert.Equal(t, sc.NewU128(8), targetAccountInfo.Frozen(ReasonsFee))
 	assert.Equal(t, sc.NewU128(0), targetAccountInfo.Frozen(3))
 }
 
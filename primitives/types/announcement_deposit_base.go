@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type AnnouncementDepositBase struct {
+	sc.U128
+}
+
+func (adb AnnouncementDepositBase) Docs() string {
+	return "The base amount of currency needed to reserve for creating an announcement."
+}
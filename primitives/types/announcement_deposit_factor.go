@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type AnnouncementDepositFactor struct {
+	sc.U128
+}
+
+func (adf AnnouncementDepositFactor) Docs() string {
+	return "The amount of currency needed per announcement made."
+}
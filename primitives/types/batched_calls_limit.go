@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type BatchedCallsLimit struct {
+	sc.U32
+}
+
+func (bcl BatchedCallsLimit) Docs() string {
+	return "The limit on the number of batched calls."
+}
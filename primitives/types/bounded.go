@@ -0,0 +1,34 @@
+package types
+
+import (
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// ErrBoundExceeded is returned when a bounded collection would contain more items than its bound.
+var ErrBoundExceeded = errors.New("bounded collection exceeds its bound")
+
+// Bound provides the maximum number of items of a bounded collection as a type parameter:
+//
+//	type MaxLocks struct{}
+//
+//	func (MaxLocks) Bound() sc.U32 { return 50 }
+//
+//	var locks BoundedVec[BalanceLock, MaxLocks]
+type Bound interface {
+	Bound() sc.U32
+}
+
+// boundedCollection is implemented by the bounded collections, which are described in the metadata
+// as a composite with a single field of their unbounded inner type.
+type boundedCollection interface {
+	metadataName() string
+	metadataPath() sc.Sequence[sc.Str]
+	buildInnerMetadataType(g *MetadataTypeGenerator) int
+}
+
+func boundOf[S Bound]() sc.U32 {
+	var s S
+	return s.Bound()
+}
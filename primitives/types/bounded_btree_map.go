@@ -0,0 +1,176 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+type boundedBTreeMapEntry[K, V sc.Encodable] struct {
+	key   K
+	value V
+}
+
+// BoundedBTreeMap is an ordered map, which can contain at most `S.Bound()` entries.
+// Decoding fails for encoded maps with more entries than the bound.
+//
+// Entries are ordered by the SCALE encoding of their keys, which matches the ordering of the
+// keys themselves for byte sequences and hashes, but not for little endian encoded integers.
+type BoundedBTreeMap[K, V sc.Encodable, S Bound] struct {
+	entries []boundedBTreeMapEntry[K, V]
+}
+
+// NewBoundedBTreeMap returns an empty BoundedBTreeMap.
+func NewBoundedBTreeMap[K, V sc.Encodable, S Bound]() BoundedBTreeMap[K, V, S] {
+	return BoundedBTreeMap[K, V, S]{}
+}
+
+func (m BoundedBTreeMap[K, V, S]) Encode(buffer *bytes.Buffer) error {
+	err := sc.ToCompact(len(m.entries)).Encode(buffer)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range m.entries {
+		err := entry.key.Encode(buffer)
+		if err != nil {
+			return err
+		}
+		err = entry.value.Encode(buffer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func DecodeBoundedBTreeMapWith[K, V sc.Encodable, S Bound](buffer *bytes.Buffer, decodeKeyFunc func(buffer *bytes.Buffer) (K, error), decodeValueFunc func(buffer *bytes.Buffer) (V, error)) (BoundedBTreeMap[K, V, S], error) {
+	length, err := decodeBoundedLength(buffer, boundOf[S]())
+	if err != nil {
+		return BoundedBTreeMap[K, V, S]{}, err
+	}
+
+	m := BoundedBTreeMap[K, V, S]{entries: make([]boundedBTreeMapEntry[K, V], 0, length)}
+	for i := 0; i < length; i++ {
+		key, err := decodeKeyFunc(buffer)
+		if err != nil {
+			return BoundedBTreeMap[K, V, S]{}, err
+		}
+		value, err := decodeValueFunc(buffer)
+		if err != nil {
+			return BoundedBTreeMap[K, V, S]{}, err
+		}
+		// Duplicate keys override the previous value, which can not exceed the bound.
+		m.insert(key, value)
+	}
+
+	return m, nil
+}
+
+func (m BoundedBTreeMap[K, V, S]) Bytes() []byte {
+	return sc.EncodedBytes(m)
+}
+
+func (m BoundedBTreeMap[K, V, S]) Len() int {
+	return len(m.entries)
+}
+
+func (m BoundedBTreeMap[K, V, S]) Bound() sc.U32 {
+	return boundOf[S]()
+}
+
+func (m BoundedBTreeMap[K, V, S]) Get(key K) (V, bool) {
+	index, found := m.search(key)
+	if !found {
+		return *new(V), false
+	}
+	return m.entries[index].value, true
+}
+
+func (m BoundedBTreeMap[K, V, S]) ContainsKey(key K) bool {
+	_, found := m.search(key)
+	return found
+}
+
+// Keys returns the keys of the map in order.
+func (m BoundedBTreeMap[K, V, S]) Keys() sc.Sequence[K] {
+	keys := make(sc.Sequence[K], 0, len(m.entries))
+	for _, entry := range m.entries {
+		keys = append(keys, entry.key)
+	}
+	return keys
+}
+
+// Values returns the values of the map in the order of their keys.
+func (m BoundedBTreeMap[K, V, S]) Values() sc.Sequence[V] {
+	values := make(sc.Sequence[V], 0, len(m.entries))
+	for _, entry := range m.entries {
+		values = append(values, entry.value)
+	}
+	return values
+}
+
+// TryInsert inserts value at key, overriding any existing value.
+// Returns ErrBoundExceeded if key is not present and the map is full.
+func (m *BoundedBTreeMap[K, V, S]) TryInsert(key K, value V) error {
+	if !m.ContainsKey(key) && sc.U32(len(m.entries)) >= m.Bound() {
+		return ErrBoundExceeded
+	}
+	m.insert(key, value)
+	return nil
+}
+
+// Remove removes key from the map and returns its value, if present.
+func (m *BoundedBTreeMap[K, V, S]) Remove(key K) (V, bool) {
+	index, found := m.search(key)
+	if !found {
+		return *new(V), false
+	}
+	value := m.entries[index].value
+	m.entries = append(m.entries[:index:index], m.entries[index+1:]...)
+	return value, true
+}
+
+func (m *BoundedBTreeMap[K, V, S]) insert(key K, value V) {
+	index, found := m.search(key)
+	if found {
+		m.entries[index].value = value
+		return
+	}
+	m.entries = insertAt(m.entries, index, boundedBTreeMapEntry[K, V]{key: key, value: value})
+}
+
+// search returns the index of key and whether it is present. If it is not, the index is where key should be inserted.
+func (m BoundedBTreeMap[K, V, S]) search(key K) (int, bool) {
+	keyBytes := sc.EncodedBytes(key)
+	index := sort.Search(len(m.entries), func(i int) bool {
+		return bytes.Compare(sc.EncodedBytes(m.entries[i].key), keyBytes) >= 0
+	})
+	found := index < len(m.entries) && bytes.Equal(sc.EncodedBytes(m.entries[index].key), keyBytes)
+	return index, found
+}
+
+func (m BoundedBTreeMap[K, V, S]) metadataName() string {
+	return "BoundedBTreeMap<" + m.keyTypeName() + "," + m.valueTypeName() + ">"
+}
+
+func (m BoundedBTreeMap[K, V, S]) metadataPath() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"bounded_collections", "bounded_btree_map", "BoundedBTreeMap"}
+}
+
+func (m BoundedBTreeMap[K, V, S]) buildInnerMetadataType(g *MetadataTypeGenerator) int {
+	keyId := g.BuildMetadataTypeRecursively(reflect.ValueOf(*new(K)), nil, nil, nil)
+	valueId := g.BuildMetadataTypeRecursively(reflect.ValueOf(*new(V)), nil, nil, nil)
+	return g.buildBTreeMapType(m.keyTypeName(), m.valueTypeName(), keyId, valueId)
+}
+
+func (m BoundedBTreeMap[K, V, S]) keyTypeName() string {
+	return reflect.TypeOf(*new(K)).Name()
+}
+
+func (m BoundedBTreeMap[K, V, S]) valueTypeName() string {
+	return reflect.TypeOf(*new(V)).Name()
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+// Entries are ordered by the encoded keys, so 512 < 1 < 3 in little endian U16.
+var boundedMapBytes = []byte{12, 0, 2, 20, 1, 0, 10, 3, 0, 30}
+
+func newTestBoundedBTreeMap(t *testing.T) BoundedBTreeMap[sc.U16, sc.U8, testBoundThree] {
+	target := NewBoundedBTreeMap[sc.U16, sc.U8, testBoundThree]()
+	assert.NoError(t, target.TryInsert(3, 30))
+	assert.NoError(t, target.TryInsert(1, 10))
+	assert.NoError(t, target.TryInsert(512, 20))
+	return target
+}
+
+func Test_BoundedBTreeMap_TryInsert(t *testing.T) {
+	target := newTestBoundedBTreeMap(t)
+
+	assert.Equal(t, 3, target.Len())
+	assert.Equal(t, sc.Sequence[sc.U16]{512, 1, 3}, target.Keys())
+	assert.Equal(t, sc.Sequence[sc.U8]{20, 10, 30}, target.Values())
+}
+
+func Test_BoundedBTreeMap_TryInsert_BoundExceeded(t *testing.T) {
+	target := newTestBoundedBTreeMap(t)
+
+	err := target.TryInsert(4, 40)
+
+	assert.Equal(t, ErrBoundExceeded, err)
+	assert.False(t, target.ContainsKey(4))
+}
+
+func Test_BoundedBTreeMap_TryInsert_OverrideWhenFull(t *testing.T) {
+	target := newTestBoundedBTreeMap(t)
+
+	err := target.TryInsert(1, 11)
+
+	assert.NoError(t, err)
+	value, ok := target.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, sc.U8(11), value)
+	assert.Equal(t, 3, target.Len())
+}
+
+func Test_BoundedBTreeMap_Get_Missing(t *testing.T) {
+	target := newTestBoundedBTreeMap(t)
+
+	value, ok := target.Get(2)
+
+	assert.False(t, ok)
+	assert.Equal(t, sc.U8(0), value)
+}
+
+func Test_BoundedBTreeMap_Remove(t *testing.T) {
+	target := newTestBoundedBTreeMap(t)
+
+	value, ok := target.Remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, sc.U8(10), value)
+	assert.Equal(t, sc.Sequence[sc.U16]{512, 3}, target.Keys())
+
+	_, ok = target.Remove(1)
+	assert.False(t, ok)
+}
+
+func Test_BoundedBTreeMap_Encode(t *testing.T) {
+	target := newTestBoundedBTreeMap(t)
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, boundedMapBytes, buffer.Bytes())
+	assert.Equal(t, boundedMapBytes, target.Bytes())
+}
+
+func Test_DecodeBoundedBTreeMapWith(t *testing.T) {
+	buffer := bytes.NewBuffer(boundedMapBytes)
+
+	result, err := DecodeBoundedBTreeMapWith[sc.U16, sc.U8, testBoundThree](buffer, sc.DecodeU16, sc.DecodeU8)
+
+	assert.NoError(t, err)
+	assert.Equal(t, newTestBoundedBTreeMap(t), result)
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func Test_DecodeBoundedBTreeMapWith_BoundExceeded(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{16, 1, 0, 10, 2, 0, 20, 3, 0, 30, 4, 0, 40})
+
+	_, err := DecodeBoundedBTreeMapWith[sc.U16, sc.U8, testBoundThree](buffer, sc.DecodeU16, sc.DecodeU8)
+
+	assert.Equal(t, ErrBoundExceeded, err)
+}
+
+func Test_BoundedBTreeMap_Metadata(t *testing.T) {
+	target := NewMetadataTypeGenerator()
+	tupleId := target.GetLastAvailableIndex() + 1
+	sequenceId := tupleId + 1
+	mapId := sequenceId + 1
+	expectedId := mapId + 1
+	expectedTypes := sc.Sequence[MetadataType]{
+		NewMetadataType(tupleId, "(U16,U8)",
+			NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.PrimitiveTypesU16), sc.ToCompact(metadata.PrimitiveTypesU8)})),
+		NewMetadataType(sequenceId, "Sequence(U16,U8)", NewMetadataTypeDefinitionSequence(sc.ToCompact(tupleId))),
+		NewMetadataTypeWithParams(mapId, "BTreeMap<U16,U8>", sc.Sequence[sc.Str]{"BTreeMap"},
+			NewMetadataTypeDefinitionComposite(sc.Sequence[MetadataTypeDefinitionField]{NewMetadataTypeDefinitionField(sequenceId)}),
+			sc.Sequence[MetadataTypeParameter]{
+				NewMetadataTypeParameter(metadata.PrimitiveTypesU16, "K"),
+				NewMetadataTypeParameter(metadata.PrimitiveTypesU8, "V"),
+			}),
+		NewMetadataTypeWithPath(expectedId, "BoundedBTreeMap<U16,U8>",
+			sc.Sequence[sc.Str]{"bounded_collections", "bounded_btree_map", "BoundedBTreeMap"},
+			NewMetadataTypeDefinitionComposite(sc.Sequence[MetadataTypeDefinitionField]{NewMetadataTypeDefinitionField(mapId)})),
+	}
+
+	result := target.BuildMetadataTypeRecursively(reflect.ValueOf(BoundedBTreeMap[sc.U16, sc.U8, testBoundThree]{}), nil, nil, nil)
+
+	assert.Equal(t, expectedId, result)
+	assert.Equal(t, expectedTypes, target.GetMetadataTypes())
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// BoundedVec is a sequence, which can contain at most `S.Bound()` items.
+// Decoding fails for encoded sequences longer than the bound.
+type BoundedVec[T sc.Encodable, S Bound] struct {
+	items sc.Sequence[T]
+}
+
+// NewBoundedVec returns a BoundedVec of items. Returns ErrBoundExceeded if there are more items than the bound.
+func NewBoundedVec[T sc.Encodable, S Bound](items sc.Sequence[T]) (BoundedVec[T, S], error) {
+	if sc.U32(len(items)) > boundOf[S]() {
+		return BoundedVec[T, S]{}, ErrBoundExceeded
+	}
+	return BoundedVec[T, S]{items: items}, nil
+}
+
+func (bv BoundedVec[T, S]) Encode(buffer *bytes.Buffer) error {
+	return bv.items.Encode(buffer)
+}
+
+func DecodeBoundedVecWith[T sc.Encodable, S Bound](buffer *bytes.Buffer, decodeFunc func(buffer *bytes.Buffer) (T, error)) (BoundedVec[T, S], error) {
+	length, err := decodeBoundedLength(buffer, boundOf[S]())
+	if err != nil {
+		return BoundedVec[T, S]{}, err
+	}
+
+	items := make(sc.Sequence[T], 0, length)
+	for i := 0; i < length; i++ {
+		item, err := decodeFunc(buffer)
+		if err != nil {
+			return BoundedVec[T, S]{}, err
+		}
+		items = append(items, item)
+	}
+
+	return BoundedVec[T, S]{items: items}, nil
+}
+
+func (bv BoundedVec[T, S]) Bytes() []byte {
+	return sc.EncodedBytes(bv)
+}
+
+// Items returns the items of the sequence. Appending to the result does not affect the BoundedVec.
+func (bv BoundedVec[T, S]) Items() sc.Sequence[T] {
+	return bv.items[:len(bv.items):len(bv.items)]
+}
+
+func (bv BoundedVec[T, S]) Len() int {
+	return len(bv.items)
+}
+
+func (bv BoundedVec[T, S]) Bound() sc.U32 {
+	return boundOf[S]()
+}
+
+func (bv BoundedVec[T, S]) IsFull() bool {
+	return sc.U32(len(bv.items)) >= bv.Bound()
+}
+
+// TryPush appends item to the end of the sequence. Returns ErrBoundExceeded if the sequence is full.
+func (bv *BoundedVec[T, S]) TryPush(item T) error {
+	if bv.IsFull() {
+		return ErrBoundExceeded
+	}
+	bv.items = append(bv.items, item)
+	return nil
+}
+
+// TryInsert inserts item at index, shifting all items after it. Returns ErrBoundExceeded if the sequence is full.
+// Panics if index is greater than the length of the sequence.
+func (bv *BoundedVec[T, S]) TryInsert(index int, item T) error {
+	if bv.IsFull() {
+		return ErrBoundExceeded
+	}
+	bv.items = insertAt(bv.items, index, item)
+	return nil
+}
+
+// Remove removes and returns the item at index, shifting all items after it.
+// Panics if index is out of bounds.
+func (bv *BoundedVec[T, S]) Remove(index int) T {
+	item := bv.items[index]
+	bv.items = append(bv.items[:index:index], bv.items[index+1:]...)
+	return item
+}
+
+func (bv BoundedVec[T, S]) metadataName() string {
+	return "BoundedVec<" + reflect.TypeOf(*new(T)).Name() + ">"
+}
+
+func (bv BoundedVec[T, S]) metadataPath() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"bounded_collections", "bounded_vec", "BoundedVec"}
+}
+
+func (bv BoundedVec[T, S]) buildInnerMetadataType(g *MetadataTypeGenerator) int {
+	return g.BuildMetadataTypeRecursively(reflect.ValueOf(sc.Sequence[T]{}), nil, nil, nil)
+}
+
+// decodeBoundedLength decodes the compact length prefix of a bounded collection and checks it against bound.
+func decodeBoundedLength(buffer *bytes.Buffer, bound sc.U32) (int, error) {
+	compact, err := sc.DecodeCompact[sc.U128](buffer)
+	if err != nil {
+		return 0, err
+	}
+
+	length := compact.ToBigInt()
+	if !length.IsUint64() || length.Uint64() > uint64(bound) {
+		return 0, ErrBoundExceeded
+	}
+
+	return int(length.Uint64()), nil
+}
+
+func insertAt[T any](items []T, index int, item T) []T {
+	result := make([]T, 0, len(items)+1)
+	result = append(result, items[:index]...)
+	result = append(result, item)
+	return append(result, items[index:]...)
+}
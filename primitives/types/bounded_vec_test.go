@@ -0,0 +1,122 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBoundThree struct{}
+
+func (testBoundThree) Bound() sc.U32 { return 3 }
+
+var (
+	boundedItems      = sc.Sequence[sc.U8]{1, 2, 3}
+	boundedItemsBytes = []byte{12, 1, 2, 3}
+)
+
+func Test_NewBoundedVec(t *testing.T) {
+	target, err := NewBoundedVec[sc.U8, testBoundThree](boundedItems)
+
+	assert.NoError(t, err)
+	assert.Equal(t, boundedItems, target.Items())
+	assert.Equal(t, 3, target.Len())
+	assert.Equal(t, sc.U32(3), target.Bound())
+	assert.True(t, target.IsFull())
+}
+
+func Test_NewBoundedVec_BoundExceeded(t *testing.T) {
+	_, err := NewBoundedVec[sc.U8, testBoundThree](sc.Sequence[sc.U8]{1, 2, 3, 4})
+
+	assert.Equal(t, ErrBoundExceeded, err)
+}
+
+func Test_BoundedVec_Encode(t *testing.T) {
+	target, _ := NewBoundedVec[sc.U8, testBoundThree](boundedItems)
+	buffer := &bytes.Buffer{}
+
+	err := target.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, boundedItemsBytes, buffer.Bytes())
+	assert.Equal(t, boundedItemsBytes, target.Bytes())
+}
+
+func Test_DecodeBoundedVecWith(t *testing.T) {
+	buffer := bytes.NewBuffer(boundedItemsBytes)
+
+	result, err := DecodeBoundedVecWith[sc.U8, testBoundThree](buffer, sc.DecodeU8)
+
+	assert.NoError(t, err)
+	assert.Equal(t, boundedItems, result.Items())
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func Test_DecodeBoundedVecWith_BoundExceeded(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{16, 1, 2, 3, 4})
+
+	_, err := DecodeBoundedVecWith[sc.U8, testBoundThree](buffer, sc.DecodeU8)
+
+	assert.Equal(t, ErrBoundExceeded, err)
+}
+
+func Test_BoundedVec_TryPush(t *testing.T) {
+	target, _ := NewBoundedVec[sc.U8, testBoundThree](sc.Sequence[sc.U8]{1, 2})
+
+	assert.NoError(t, target.TryPush(3))
+	assert.Equal(t, boundedItems, target.Items())
+	assert.Equal(t, ErrBoundExceeded, target.TryPush(4))
+	assert.Equal(t, boundedItems, target.Items())
+}
+
+func Test_BoundedVec_TryInsert(t *testing.T) {
+	target, _ := NewBoundedVec[sc.U8, testBoundThree](sc.Sequence[sc.U8]{1, 3})
+
+	assert.NoError(t, target.TryInsert(1, 2))
+	assert.Equal(t, boundedItems, target.Items())
+	assert.Equal(t, ErrBoundExceeded, target.TryInsert(0, 0))
+}
+
+func Test_BoundedVec_Remove(t *testing.T) {
+	target, _ := NewBoundedVec[sc.U8, testBoundThree](sc.Sequence[sc.U8]{1, 2, 3})
+
+	assert.Equal(t, sc.U8(2), target.Remove(1))
+	assert.Equal(t, sc.Sequence[sc.U8]{1, 3}, target.Items())
+	assert.False(t, target.IsFull())
+}
+
+func Test_BoundedVec_Items_AppendDoesNotModify(t *testing.T) {
+	target, _ := NewBoundedVec[sc.U8, testBoundThree](sc.Sequence[sc.U8]{1, 2})
+
+	_ = append(target.Items(), 5)
+	assert.NoError(t, target.TryPush(3))
+
+	assert.Equal(t, boundedItems, target.Items())
+}
+
+func Test_BoundedVec_Metadata(t *testing.T) {
+	target := NewMetadataTypeGenerator()
+	expectedId := target.GetLastAvailableIndex() + 1
+	expectedType := NewMetadataTypeWithPath(
+		expectedId,
+		"BoundedVec<U8>",
+		sc.Sequence[sc.Str]{"bounded_collections", "bounded_vec", "BoundedVec"},
+		NewMetadataTypeDefinitionComposite(
+			sc.Sequence[MetadataTypeDefinitionField]{
+				NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
+			}),
+	)
+
+	result := target.BuildMetadataTypeRecursively(reflect.ValueOf(BoundedVec[sc.U8, testBoundThree]{}), nil, nil, nil)
+
+	assert.Equal(t, expectedId, result)
+	assert.Equal(t, sc.Sequence[MetadataType]{expectedType}, target.GetMetadataTypes())
+
+	// Building it again reuses the existing type.
+	assert.Equal(t, expectedId, target.BuildMetadataTypeRecursively(reflect.ValueOf(BoundedVec[sc.U8, testBoundThree]{}), nil, nil, nil))
+	assert.Equal(t, 1, len(target.GetMetadataTypes()))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"errors"
 
 	sc "github.com/LimeChain/goscale"
 )
@@ -20,3 +21,18 @@ type Call interface {
 	DecodeArgs(buffer *bytes.Buffer) (Call, error)
 	Docs() string
 }
+
+// ErrNestedCallDecoder is returned when the arguments of a NestedCall are decoded without the runtime call decoder.
+var ErrNestedCallDecoder = errors.New("nested call arguments must be decoded with the runtime call decoder")
+
+// CallDecoder decodes a call, including its arguments, from its SCALE encoded representation.
+type CallDecoder interface {
+	DecodeCall(buffer *bytes.Buffer) (Call, error)
+}
+
+// NestedCall is implemented by calls, which take other runtime calls as arguments (e.g. sudo, batch).
+// Their arguments can only be decoded with the help of the runtime call decoder.
+type NestedCall interface {
+	Call
+	DecodeNestedArgs(decoder CallDecoder, buffer *bytes.Buffer) (Call, error)
+}
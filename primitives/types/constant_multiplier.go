@@ -0,0 +1,35 @@
+package types
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// ConstantMultiplier implements WeightToFee and multiplies the ref time of
+// the weight by a constant. Commonly used as a length to fee conversion,
+// where the multiplier is the fee per byte.
+type ConstantMultiplier struct {
+	Multiplier Balance
+}
+
+func NewConstantMultiplier(multiplier Balance) ConstantMultiplier {
+	return ConstantMultiplier{
+		Multiplier: multiplier,
+	}
+}
+
+func (c ConstantMultiplier) WeightToFee(weight Weight) Balance {
+	return saturatingU128FromBig(new(big.Int).Mul(c.Multiplier.ToBigInt(), new(big.Int).SetUint64(uint64(weight.RefTime))))
+}
+
+func (c ConstantMultiplier) Polynomial() sc.Sequence[WeightToFeeCoefficient] {
+	return sc.Sequence[WeightToFeeCoefficient]{
+		{
+			CoeffInteger: c.Multiplier,
+			CoeffFrac:    Perquintill{},
+			Negative:     false,
+			Degree:       1,
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConstantMultiplier_WeightToFee(t *testing.T) {
+	target := NewConstantMultiplier(sc.NewU128(10))
+
+	result := target.WeightToFee(WeightFromParts(7, 3))
+
+	assert.Equal(t, sc.NewU128(70), result)
+}
+
+func Test_ConstantMultiplier_WeightToFee_Saturates(t *testing.T) {
+	target := NewConstantMultiplier(sc.MaxU128())
+
+	result := target.WeightToFee(WeightFromParts(2, 0))
+
+	assert.Equal(t, sc.MaxU128(), result)
+}
+
+func Test_ConstantMultiplier_Polynomial(t *testing.T) {
+	target := NewConstantMultiplier(sc.NewU128(10))
+	expect := sc.Sequence[WeightToFeeCoefficient]{
+		{CoeffInteger: sc.NewU128(10), Degree: 1},
+	}
+
+	assert.Equal(t, expect, target.Polynomial())
+	assert.Equal(t, target.WeightToFee(WeightFromParts(7, 0)), NewWeightToFeePolynomial(expect...).WeightToFee(WeightFromParts(7, 0)))
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type DepositBase struct {
+	sc.U128
+}
+
+func (db DepositBase) Docs() string {
+	return "The base amount of currency needed to reserve for creating a multisig execution or to store a dispatch call for later."
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type DepositFactor struct {
+	sc.U128
+}
+
+func (df DepositFactor) Docs() string {
+	return "The amount of currency needed per unit threshold when creating a multisig execution."
+}
@@ -31,6 +31,21 @@ func NewDispatchOutcome(value sc.Encodable) (DispatchOutcome, error) {
 	}
 }
 
+// NewDispatchOutcomeFromError converts the error, returned from dispatching a call, into a DispatchOutcome.
+// Errors, which are not of type DispatchError, are returned unchanged.
+func NewDispatchOutcomeFromError(err error) (DispatchOutcome, error) {
+	if err == nil {
+		return NewDispatchOutcome(nil)
+	}
+
+	dispatchErr, ok := err.(DispatchError)
+	if !ok {
+		return DispatchOutcome{}, err
+	}
+
+	return NewDispatchOutcome(dispatchErr)
+}
+
 func (o DispatchOutcome) Encode(buffer *bytes.Buffer) error {
 	value := o[0]
 
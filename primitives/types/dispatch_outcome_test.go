@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
@@ -16,6 +17,25 @@ func Test_DispatchOutcome_New_TypeError(t *testing.T) {
 	assert.Equal(t, DispatchOutcome{}, result)
 }
 
+func Test_DispatchOutcome_NewFromError(t *testing.T) {
+	result, err := NewDispatchOutcomeFromError(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, dispatchOutcome, result)
+
+	result, err = NewDispatchOutcomeFromError(NewDispatchErrorBadOrigin())
+	assert.NoError(t, err)
+	assert.Equal(t, dispatchOutcomeBadOriginErr, result)
+}
+
+func Test_DispatchOutcome_NewFromError_NotDispatchError(t *testing.T) {
+	expectedErr := errors.New("fatal")
+
+	result, err := NewDispatchOutcomeFromError(expectedErr)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, DispatchOutcome{}, result)
+}
+
 func Test_DispatchOutcome_Encode(t *testing.T) {
 	var testExamples = []struct {
 		label       string
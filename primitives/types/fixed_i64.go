@@ -0,0 +1,207 @@
+package types
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+var (
+	fixedI64Accuracy = big.NewInt(1_000_000_000)
+	minI64           = big.NewInt(math.MinInt64)
+	maxI64           = big.NewInt(math.MaxInt64)
+)
+
+// FixedI64 is a signed fixed point number with 9 decimals.
+// The represented value is `Inner / 10^9`.
+type FixedI64 struct {
+	Inner sc.I64
+}
+
+// FixedI64One returns the FixedI64 representation of 1.
+func FixedI64One() FixedI64 {
+	return FixedI64{Inner: sc.I64(fixedI64Accuracy.Int64())}
+}
+
+// FixedI64Zero returns the FixedI64 representation of 0.
+func FixedI64Zero() FixedI64 {
+	return FixedI64{Inner: 0}
+}
+
+// FixedI64FromInteger returns the FixedI64 representation of `n`.
+// Returns an error if `n` cannot be represented.
+func FixedI64FromInteger(n sc.I64) (FixedI64, error) {
+	inner, err := i64FromBig(new(big.Int).Mul(big.NewInt(int64(n)), fixedI64Accuracy))
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+// FixedI64SaturatingFromInteger returns the FixedI64 representation of `n`, saturating at the bounds.
+func FixedI64SaturatingFromInteger(n sc.I64) FixedI64 {
+	return FixedI64{Inner: saturatingI64FromBig(new(big.Int).Mul(big.NewInt(int64(n)), fixedI64Accuracy))}
+}
+
+// FixedI64FromRational returns the FixedI64 representation of `n / d`, rounded towards zero.
+// Returns an error if `d` is zero or the result cannot be represented.
+func FixedI64FromRational(n, d sc.I64) (FixedI64, error) {
+	if d == 0 {
+		return FixedI64{}, NewArithmeticErrorDivisionByZero()
+	}
+
+	inner, err := i64FromBig(mulDiv(big.NewInt(int64(n)), fixedI64Accuracy, big.NewInt(int64(d))))
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+// FixedI64SaturatingFromRational returns the FixedI64 representation of `n / d`, rounded towards zero
+// and saturating at the bounds. A zero `d` results in the bound with the sign of `n`.
+func FixedI64SaturatingFromRational(n, d sc.I64) FixedI64 {
+	if d == 0 {
+		if n < 0 {
+			return FixedI64{Inner: math.MinInt64}
+		}
+		return FixedI64{Inner: math.MaxInt64}
+	}
+	return FixedI64{Inner: saturatingI64FromBig(mulDiv(big.NewInt(int64(n)), fixedI64Accuracy, big.NewInt(int64(d))))}
+}
+
+func (f FixedI64) Encode(buffer *bytes.Buffer) error {
+	return f.Inner.Encode(buffer)
+}
+
+func DecodeFixedI64(buffer *bytes.Buffer) (FixedI64, error) {
+	inner, err := sc.DecodeI64(buffer)
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+func (f FixedI64) Bytes() []byte {
+	return sc.EncodedBytes(f)
+}
+
+func (f FixedI64) IsZero() bool {
+	return f.Inner == 0
+}
+
+func (f FixedI64) IsOne() bool {
+	return int64(f.Inner) == fixedI64Accuracy.Int64()
+}
+
+func (f FixedI64) IsNegative() bool {
+	return f.Inner < 0
+}
+
+func (f FixedI64) IsPositive() bool {
+	return f.Inner > 0
+}
+
+func (f FixedI64) CheckedAdd(other FixedI64) (FixedI64, error) {
+	inner, err := i64FromBig(new(big.Int).Add(f.big(), other.big()))
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+func (f FixedI64) CheckedSub(other FixedI64) (FixedI64, error) {
+	inner, err := i64FromBig(new(big.Int).Sub(f.big(), other.big()))
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+func (f FixedI64) CheckedMul(other FixedI64) (FixedI64, error) {
+	inner, err := i64FromBig(mulDiv(f.big(), other.big(), fixedI64Accuracy))
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+func (f FixedI64) CheckedDiv(other FixedI64) (FixedI64, error) {
+	if other.IsZero() {
+		return FixedI64{}, NewArithmeticErrorDivisionByZero()
+	}
+
+	inner, err := i64FromBig(mulDiv(f.big(), fixedI64Accuracy, other.big()))
+	if err != nil {
+		return FixedI64{}, err
+	}
+	return FixedI64{Inner: inner}, nil
+}
+
+func (f FixedI64) SaturatingAdd(other FixedI64) FixedI64 {
+	return FixedI64{Inner: saturatingI64FromBig(new(big.Int).Add(f.big(), other.big()))}
+}
+
+func (f FixedI64) SaturatingSub(other FixedI64) FixedI64 {
+	return FixedI64{Inner: saturatingI64FromBig(new(big.Int).Sub(f.big(), other.big()))}
+}
+
+func (f FixedI64) SaturatingMul(other FixedI64) FixedI64 {
+	return FixedI64{Inner: saturatingI64FromBig(mulDiv(f.big(), other.big(), fixedI64Accuracy))}
+}
+
+// SaturatingPow raises `f` to the power of `exp`, saturating at the bounds.
+func (f FixedI64) SaturatingPow(exp sc.U32) FixedI64 {
+	result := FixedI64One()
+	base := f
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result.SaturatingMul(base)
+		}
+		exp >>= 1
+		if exp > 0 {
+			base = base.SaturatingMul(base)
+		}
+	}
+
+	return result
+}
+
+// CheckedMulInt multiplies the integer `n` by `f` and returns the integer part of the result.
+func (f FixedI64) CheckedMulInt(n sc.I64) (sc.I64, error) {
+	return i64FromBig(mulDiv(big.NewInt(int64(n)), f.big(), fixedI64Accuracy))
+}
+
+// SaturatingMulInt multiplies the integer `n` by `f` and returns the integer part of the result,
+// saturating at the bounds.
+func (f FixedI64) SaturatingMulInt(n sc.I64) sc.I64 {
+	return saturatingI64FromBig(mulDiv(big.NewInt(int64(n)), f.big(), fixedI64Accuracy))
+}
+
+func (f FixedI64) big() *big.Int {
+	return big.NewInt(int64(f.Inner))
+}
+
+// i64FromBig converts `n` to I64. Returns an error if `n` is out of range.
+func i64FromBig(n *big.Int) (sc.I64, error) {
+	if n.Cmp(minI64) < 0 {
+		return 0, NewArithmeticErrorUnderflow()
+	}
+	if n.Cmp(maxI64) > 0 {
+		return 0, NewArithmeticErrorOverflow()
+	}
+	return sc.I64(n.Int64()), nil
+}
+
+// saturatingI64FromBig converts `n` to I64, saturating at the bounds.
+func saturatingI64FromBig(n *big.Int) sc.I64 {
+	if n.Cmp(minI64) < 0 {
+		return math.MinInt64
+	}
+	if n.Cmp(maxI64) > 0 {
+		return math.MaxInt64
+	}
+	return sc.I64(n.Int64())
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixedI64Half      = FixedI64{Inner: 500_000_000}
+	fixedI64Two       = FixedI64{Inner: 2_000_000_000}
+	fixedI64MinusOne  = FixedI64{Inner: -1_000_000_000}
+	fixedI64Max       = FixedI64{Inner: math.MaxInt64}
+	fixedI64Min       = FixedI64{Inner: math.MinInt64}
+	expectBytesI64One = []byte{0x0, 0xca, 0x9a, 0x3b, 0x0, 0x0, 0x0, 0x0}
+)
+
+func Test_FixedI64_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := FixedI64One().Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectBytesI64One, buffer.Bytes())
+}
+
+func Test_FixedI64_Bytes(t *testing.T) {
+	assert.Equal(t, expectBytesI64One, FixedI64One().Bytes())
+}
+
+func Test_DecodeFixedI64(t *testing.T) {
+	buffer := bytes.NewBuffer(expectBytesI64One)
+
+	result, err := DecodeFixedI64(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64One(), result)
+}
+
+func Test_FixedI64FromInteger(t *testing.T) {
+	result, err := FixedI64FromInteger(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, fixedI64MinusOne, result)
+
+	_, err = FixedI64FromInteger(math.MaxInt64)
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+
+	_, err = FixedI64FromInteger(math.MinInt64)
+	assert.Equal(t, NewArithmeticErrorUnderflow(), err)
+}
+
+func Test_FixedI64SaturatingFromInteger(t *testing.T) {
+	assert.Equal(t, fixedI64Max, FixedI64SaturatingFromInteger(math.MaxInt64))
+	assert.Equal(t, fixedI64Min, FixedI64SaturatingFromInteger(math.MinInt64))
+}
+
+func Test_FixedI64FromRational(t *testing.T) {
+	result, err := FixedI64FromRational(1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, fixedI64Half, result)
+
+	result, err = FixedI64FromRational(-1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64{Inner: -333_333_333}, result)
+
+	_, err = FixedI64FromRational(1, 0)
+	assert.Equal(t, NewArithmeticErrorDivisionByZero(), err)
+}
+
+func Test_FixedI64SaturatingFromRational(t *testing.T) {
+	assert.Equal(t, fixedI64Half, FixedI64SaturatingFromRational(1, 2))
+	assert.Equal(t, fixedI64Max, FixedI64SaturatingFromRational(1, 0))
+	assert.Equal(t, fixedI64Min, FixedI64SaturatingFromRational(-1, 0))
+	assert.Equal(t, fixedI64Min, FixedI64SaturatingFromRational(math.MinInt64, 1))
+}
+
+func Test_FixedI64_Sign(t *testing.T) {
+	assert.True(t, FixedI64Zero().IsZero())
+	assert.True(t, FixedI64One().IsOne())
+	assert.True(t, fixedI64MinusOne.IsNegative())
+	assert.False(t, fixedI64MinusOne.IsPositive())
+	assert.True(t, fixedI64Half.IsPositive())
+	assert.False(t, FixedI64Zero().IsPositive())
+}
+
+func Test_FixedI64_CheckedAdd(t *testing.T) {
+	result, err := FixedI64One().CheckedAdd(fixedI64MinusOne)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64Zero(), result)
+
+	_, err = fixedI64Max.CheckedAdd(FixedI64One())
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedI64_CheckedSub(t *testing.T) {
+	result, err := FixedI64Zero().CheckedSub(FixedI64One())
+	assert.NoError(t, err)
+	assert.Equal(t, fixedI64MinusOne, result)
+
+	_, err = fixedI64Min.CheckedSub(FixedI64One())
+	assert.Equal(t, NewArithmeticErrorUnderflow(), err)
+}
+
+func Test_FixedI64_CheckedMul(t *testing.T) {
+	result, err := fixedI64Two.CheckedMul(fixedI64MinusOne)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64{Inner: -2_000_000_000}, result)
+
+	_, err = fixedI64Max.CheckedMul(fixedI64Two)
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedI64_CheckedDiv(t *testing.T) {
+	result, err := FixedI64One().CheckedDiv(fixedI64Two)
+	assert.NoError(t, err)
+	assert.Equal(t, fixedI64Half, result)
+
+	_, err = FixedI64One().CheckedDiv(FixedI64Zero())
+	assert.Equal(t, NewArithmeticErrorDivisionByZero(), err)
+}
+
+func Test_FixedI64_SaturatingOps(t *testing.T) {
+	assert.Equal(t, fixedI64Max, fixedI64Max.SaturatingAdd(FixedI64One()))
+	assert.Equal(t, fixedI64Min, fixedI64Min.SaturatingSub(FixedI64One()))
+	assert.Equal(t, fixedI64Min, fixedI64Max.SaturatingMul(FixedI64{Inner: -2_000_000_000}))
+	assert.Equal(t, fixedI64MinusOne, FixedI64Zero().SaturatingSub(FixedI64One()))
+}
+
+func Test_FixedI64_SaturatingPow(t *testing.T) {
+	assert.Equal(t, FixedI64{Inner: 1_024_000_000_000}, fixedI64Two.SaturatingPow(10))
+	assert.Equal(t, fixedI64MinusOne, fixedI64MinusOne.SaturatingPow(3))
+	assert.Equal(t, fixedI64Max, fixedI64Two.SaturatingPow(64))
+}
+
+func Test_FixedI64_MulInt(t *testing.T) {
+	result, err := fixedI64Half.CheckedMulInt(-5)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.I64(-2), result)
+
+	_, err = fixedI64Two.CheckedMulInt(math.MaxInt64)
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+
+	assert.Equal(t, sc.I64(math.MinInt64), fixedI64Two.SaturatingMulInt(math.MinInt64))
+}
@@ -0,0 +1,235 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+var (
+	fixedU128Accuracy = big.NewInt(1_000_000_000_000_000_000)
+	maxU128           = sc.MaxU128().ToBigInt()
+)
+
+// FixedU128 is an unsigned fixed point number with 18 decimals.
+// The represented value is `Inner / 10^18`.
+type FixedU128 struct {
+	Inner sc.U128
+}
+
+// FixedU128One returns the FixedU128 representation of 1.
+func FixedU128One() FixedU128 {
+	return FixedU128{Inner: sc.NewU128(fixedU128Accuracy)}
+}
+
+// FixedU128Zero returns the FixedU128 representation of 0.
+func FixedU128Zero() FixedU128 {
+	return FixedU128{Inner: sc.NewU128(0)}
+}
+
+// FixedU128FromInteger returns the FixedU128 representation of `n`.
+// Returns an error if `n` cannot be represented.
+func FixedU128FromInteger(n sc.U128) (FixedU128, error) {
+	inner, err := u128FromBig(new(big.Int).Mul(n.ToBigInt(), fixedU128Accuracy))
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+// FixedU128SaturatingFromInteger returns the FixedU128 representation of `n`, saturating at the maximum.
+func FixedU128SaturatingFromInteger(n sc.U128) FixedU128 {
+	return FixedU128{Inner: saturatingU128FromBig(new(big.Int).Mul(n.ToBigInt(), fixedU128Accuracy))}
+}
+
+// FixedU128FromRational returns the FixedU128 representation of `n / d`, rounded down.
+// Returns an error if `d` is zero or the result cannot be represented.
+func FixedU128FromRational(n, d sc.U128) (FixedU128, error) {
+	denominator := d.ToBigInt()
+	if denominator.Sign() == 0 {
+		return FixedU128{}, NewArithmeticErrorDivisionByZero()
+	}
+
+	inner, err := u128FromBig(mulDiv(n.ToBigInt(), fixedU128Accuracy, denominator))
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+// FixedU128SaturatingFromRational returns the FixedU128 representation of `n / d`, rounded down
+// and saturating at the maximum. A zero `d` results in the maximum.
+func FixedU128SaturatingFromRational(n, d sc.U128) FixedU128 {
+	if d.ToBigInt().Sign() == 0 {
+		return FixedU128{Inner: sc.MaxU128()}
+	}
+	return FixedU128{Inner: saturatingU128FromBig(mulDiv(n.ToBigInt(), fixedU128Accuracy, d.ToBigInt()))}
+}
+
+func (f FixedU128) Encode(buffer *bytes.Buffer) error {
+	return f.Inner.Encode(buffer)
+}
+
+func DecodeFixedU128(buffer *bytes.Buffer) (FixedU128, error) {
+	inner, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+func (f FixedU128) Bytes() []byte {
+	return sc.EncodedBytes(f)
+}
+
+// Cmp compares `f` and `other` and returns -1, 0 or +1.
+func (f FixedU128) Cmp(other FixedU128) int {
+	return f.Inner.ToBigInt().Cmp(other.Inner.ToBigInt())
+}
+
+func (f FixedU128) IsZero() bool {
+	return f.Inner.ToBigInt().Sign() == 0
+}
+
+func (f FixedU128) IsOne() bool {
+	return f.Inner.ToBigInt().Cmp(fixedU128Accuracy) == 0
+}
+
+func (f FixedU128) CheckedAdd(other FixedU128) (FixedU128, error) {
+	inner, err := u128FromBig(new(big.Int).Add(f.Inner.ToBigInt(), other.Inner.ToBigInt()))
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+func (f FixedU128) CheckedSub(other FixedU128) (FixedU128, error) {
+	inner, err := u128FromBig(new(big.Int).Sub(f.Inner.ToBigInt(), other.Inner.ToBigInt()))
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+func (f FixedU128) CheckedMul(other FixedU128) (FixedU128, error) {
+	inner, err := u128FromBig(mulDiv(f.Inner.ToBigInt(), other.Inner.ToBigInt(), fixedU128Accuracy))
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+func (f FixedU128) CheckedDiv(other FixedU128) (FixedU128, error) {
+	if other.IsZero() {
+		return FixedU128{}, NewArithmeticErrorDivisionByZero()
+	}
+
+	inner, err := u128FromBig(mulDiv(f.Inner.ToBigInt(), fixedU128Accuracy, other.Inner.ToBigInt()))
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{Inner: inner}, nil
+}
+
+// SaturatingDiv divides `f` by `other`, saturating at the maximum. A zero `other` results in the maximum.
+func (f FixedU128) SaturatingDiv(other FixedU128) FixedU128 {
+	if other.IsZero() {
+		return FixedU128{Inner: sc.MaxU128()}
+	}
+	return FixedU128{Inner: saturatingU128FromBig(mulDiv(f.Inner.ToBigInt(), fixedU128Accuracy, other.Inner.ToBigInt()))}
+}
+
+func (f FixedU128) SaturatingAdd(other FixedU128) FixedU128 {
+	return FixedU128{Inner: saturatingU128FromBig(new(big.Int).Add(f.Inner.ToBigInt(), other.Inner.ToBigInt()))}
+}
+
+func (f FixedU128) SaturatingSub(other FixedU128) FixedU128 {
+	return FixedU128{Inner: saturatingU128FromBig(new(big.Int).Sub(f.Inner.ToBigInt(), other.Inner.ToBigInt()))}
+}
+
+func (f FixedU128) SaturatingMul(other FixedU128) FixedU128 {
+	return FixedU128{Inner: saturatingU128FromBig(mulDiv(f.Inner.ToBigInt(), other.Inner.ToBigInt(), fixedU128Accuracy))}
+}
+
+// CheckedPow raises `f` to the power of `exp`. Returns an error if the result cannot be represented.
+func (f FixedU128) CheckedPow(exp sc.U32) (FixedU128, error) {
+	result := FixedU128One()
+	base := f
+
+	for exp > 0 {
+		var err error
+		if exp&1 == 1 {
+			result, err = result.CheckedMul(base)
+			if err != nil {
+				return FixedU128{}, err
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			base, err = base.CheckedMul(base)
+			if err != nil {
+				return FixedU128{}, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// SaturatingPow raises `f` to the power of `exp`, saturating at the maximum.
+func (f FixedU128) SaturatingPow(exp sc.U32) FixedU128 {
+	result := FixedU128One()
+	base := f
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result.SaturatingMul(base)
+		}
+		exp >>= 1
+		if exp > 0 {
+			base = base.SaturatingMul(base)
+		}
+	}
+
+	return result
+}
+
+// CheckedMulInt multiplies the integer `n` by `f` and returns the integer part of the result.
+func (f FixedU128) CheckedMulInt(n sc.U128) (sc.U128, error) {
+	return u128FromBig(mulDiv(n.ToBigInt(), f.Inner.ToBigInt(), fixedU128Accuracy))
+}
+
+// SaturatingMulInt multiplies the integer `n` by `f` and returns the integer part of the result,
+// saturating at the maximum.
+func (f FixedU128) SaturatingMulInt(n sc.U128) sc.U128 {
+	return saturatingU128FromBig(mulDiv(n.ToBigInt(), f.Inner.ToBigInt(), fixedU128Accuracy))
+}
+
+// mulDiv returns `a * b / c`, rounded towards zero.
+func mulDiv(a, b, c *big.Int) *big.Int {
+	product := new(big.Int).Mul(a, b)
+	return product.Quo(product, c)
+}
+
+// u128FromBig converts `n` to U128. Returns an error if `n` is out of range.
+func u128FromBig(n *big.Int) (sc.U128, error) {
+	if n.Sign() < 0 {
+		return sc.U128{}, NewArithmeticErrorUnderflow()
+	}
+	if n.Cmp(maxU128) > 0 {
+		return sc.U128{}, NewArithmeticErrorOverflow()
+	}
+	return sc.NewU128(n), nil
+}
+
+// saturatingU128FromBig converts `n` to U128, saturating at the bounds.
+func saturatingU128FromBig(n *big.Int) sc.U128 {
+	if n.Sign() < 0 {
+		return sc.NewU128(0)
+	}
+	if n.Cmp(maxU128) > 0 {
+		return sc.MaxU128()
+	}
+	return sc.NewU128(n)
+}
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixedU128Half      = FixedU128{Inner: sc.NewU128(uint64(500_000_000_000_000_000))}
+	fixedU128Two       = FixedU128{Inner: sc.NewU128(uint64(2_000_000_000_000_000_000))}
+	fixedU128Max       = FixedU128{Inner: sc.MaxU128()}
+	expectBytesU128One = []byte{0x0, 0x0, 0x64, 0xa7, 0xb3, 0xb6, 0xe0, 0x0d, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}
+)
+
+func Test_FixedU128_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := FixedU128One().Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectBytesU128One, buffer.Bytes())
+}
+
+func Test_FixedU128_Bytes(t *testing.T) {
+	assert.Equal(t, expectBytesU128One, FixedU128One().Bytes())
+}
+
+func Test_DecodeFixedU128(t *testing.T) {
+	buffer := bytes.NewBuffer(expectBytesU128One)
+
+	result, err := DecodeFixedU128(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, FixedU128One(), result)
+}
+
+func Test_FixedU128FromInteger(t *testing.T) {
+	result, err := FixedU128FromInteger(sc.NewU128(2))
+
+	assert.NoError(t, err)
+	assert.Equal(t, fixedU128Two, result)
+}
+
+func Test_FixedU128FromInteger_Overflow(t *testing.T) {
+	_, err := FixedU128FromInteger(sc.MaxU128())
+
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedU128SaturatingFromInteger(t *testing.T) {
+	assert.Equal(t, fixedU128Max, FixedU128SaturatingFromInteger(sc.MaxU128()))
+}
+
+func Test_FixedU128FromRational(t *testing.T) {
+	result, err := FixedU128FromRational(sc.NewU128(1), sc.NewU128(2))
+
+	assert.NoError(t, err)
+	assert.Equal(t, fixedU128Half, result)
+}
+
+func Test_FixedU128FromRational_DivisionByZero(t *testing.T) {
+	_, err := FixedU128FromRational(sc.NewU128(1), sc.NewU128(0))
+
+	assert.Equal(t, NewArithmeticErrorDivisionByZero(), err)
+}
+
+func Test_FixedU128SaturatingFromRational(t *testing.T) {
+	assert.Equal(t, FixedU128{Inner: sc.NewU128(333_333_333_333_333_333)}, FixedU128SaturatingFromRational(sc.NewU128(1), sc.NewU128(3)))
+	assert.Equal(t, fixedU128Max, FixedU128SaturatingFromRational(sc.NewU128(1), sc.NewU128(0)))
+	assert.Equal(t, fixedU128Max, FixedU128SaturatingFromRational(sc.MaxU128(), sc.NewU128(1)))
+}
+
+func Test_FixedU128_Cmp(t *testing.T) {
+	assert.Equal(t, -1, fixedU128Half.Cmp(FixedU128One()))
+	assert.Equal(t, 0, FixedU128One().Cmp(FixedU128One()))
+	assert.Equal(t, 1, fixedU128Two.Cmp(FixedU128One()))
+}
+
+func Test_FixedU128_IsZero_IsOne(t *testing.T) {
+	assert.True(t, FixedU128Zero().IsZero())
+	assert.False(t, FixedU128One().IsZero())
+	assert.True(t, FixedU128One().IsOne())
+	assert.False(t, fixedU128Two.IsOne())
+}
+
+func Test_FixedU128_CheckedAdd(t *testing.T) {
+	result, err := FixedU128One().CheckedAdd(FixedU128One())
+	assert.NoError(t, err)
+	assert.Equal(t, fixedU128Two, result)
+
+	_, err = fixedU128Max.CheckedAdd(FixedU128One())
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedU128_CheckedSub(t *testing.T) {
+	result, err := fixedU128Two.CheckedSub(FixedU128One())
+	assert.NoError(t, err)
+	assert.Equal(t, FixedU128One(), result)
+
+	_, err = FixedU128Zero().CheckedSub(FixedU128One())
+	assert.Equal(t, NewArithmeticErrorUnderflow(), err)
+}
+
+func Test_FixedU128_CheckedMul(t *testing.T) {
+	result, err := fixedU128Two.CheckedMul(fixedU128Half)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedU128One(), result)
+
+	_, err = fixedU128Max.CheckedMul(fixedU128Two)
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedU128_CheckedDiv(t *testing.T) {
+	result, err := FixedU128One().CheckedDiv(fixedU128Two)
+	assert.NoError(t, err)
+	assert.Equal(t, fixedU128Half, result)
+
+	_, err = FixedU128One().CheckedDiv(FixedU128Zero())
+	assert.Equal(t, NewArithmeticErrorDivisionByZero(), err)
+}
+
+func Test_FixedU128_SaturatingDiv(t *testing.T) {
+	assert.Equal(t, fixedU128Half, FixedU128One().SaturatingDiv(fixedU128Two))
+	assert.Equal(t, fixedU128Max, fixedU128Max.SaturatingDiv(fixedU128Half))
+	assert.Equal(t, fixedU128Max, FixedU128One().SaturatingDiv(FixedU128Zero()))
+}
+
+func Test_FixedU128_SaturatingOps(t *testing.T) {
+	assert.Equal(t, fixedU128Max, fixedU128Max.SaturatingAdd(FixedU128One()))
+	assert.Equal(t, FixedU128Zero(), FixedU128One().SaturatingSub(fixedU128Two))
+	assert.Equal(t, fixedU128Max, fixedU128Max.SaturatingMul(fixedU128Two))
+	assert.Equal(t, fixedU128Half, FixedU128One().SaturatingMul(fixedU128Half))
+}
+
+func Test_FixedU128_CheckedPow(t *testing.T) {
+	expect, _ := FixedU128FromInteger(sc.NewU128(1024))
+
+	result, err := fixedU128Two.CheckedPow(10)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, result)
+
+	result, err = fixedU128Two.CheckedPow(0)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedU128One(), result)
+
+	_, err = fixedU128Two.CheckedPow(200)
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedU128_SaturatingPow(t *testing.T) {
+	expect, _ := FixedU128FromInteger(sc.NewU128(1024))
+
+	assert.Equal(t, expect, fixedU128Two.SaturatingPow(10))
+	assert.Equal(t, FixedU128One(), fixedU128Two.SaturatingPow(0))
+	assert.Equal(t, fixedU128Max, fixedU128Two.SaturatingPow(200))
+}
+
+func Test_FixedU128_CheckedMulInt(t *testing.T) {
+	result, err := fixedU128Half.CheckedMulInt(sc.NewU128(5))
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(2), result)
+
+	_, err = fixedU128Two.CheckedMulInt(sc.MaxU128())
+	assert.Equal(t, NewArithmeticErrorOverflow(), err)
+}
+
+func Test_FixedU128_SaturatingMulInt(t *testing.T) {
+	assert.Equal(t, sc.NewU128(2), fixedU128Half.SaturatingMulInt(sc.NewU128(5)))
+	assert.Equal(t, sc.MaxU128(), fixedU128Two.SaturatingMulInt(sc.MaxU128()))
+}
+
+func Test_FixedU128_SaturatingMulInt_Large(t *testing.T) {
+	n := sc.NewU128(new(big.Int).Lsh(big.NewInt(1), 100))
+
+	assert.Equal(t, n, FixedU128One().SaturatingMulInt(n))
+}
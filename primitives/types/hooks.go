@@ -6,6 +6,14 @@ type OnRuntimeUpgrade interface {
 	OnRuntimeUpgrade() Weight
 }
 
+// UpgradeCheck is implemented by modules and migrations, which verify the state before and after
+// OnRuntimeUpgrade when a runtime upgrade is dry-run with try-runtime. The state returned by
+// PreUpgrade is passed to PostUpgrade.
+type UpgradeCheck interface {
+	PreUpgrade() (sc.Sequence[sc.U8], error)
+	PostUpgrade(state sc.Sequence[sc.U8]) error
+}
+
 type OnInitialize interface {
 	OnInitialize(n sc.U64) (Weight, error)
 }
@@ -17,3 +25,17 @@ type DispatchModule interface {
 	OnIdle(n sc.U64, remainingWeight Weight) Weight
 	OffchainWorker(n sc.U64)
 }
+
+// MultiBlockMigrator is implemented by modules, which execute migrations over multiple blocks.
+// While migrations are ongoing, only mandatory extrinsics are applied.
+type MultiBlockMigrator interface {
+	Ongoing() (bool, error)
+}
+
+// StorageVersioned is implemented by modules, which track the version of their storage layout.
+// The in-code storage version is stored at genesis and bumped by the storage migrations of the module.
+type StorageVersioned interface {
+	InCodeStorageVersion() sc.U16
+	OnChainStorageVersion() (sc.U16, error)
+	PutInCodeStorageVersion()
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type IndexDeposit struct {
+	sc.U128
+}
+
+func (id IndexDeposit) Docs() string {
+	return "The deposit needed for reserving an index."
+}
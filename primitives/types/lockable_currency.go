@@ -0,0 +1,15 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// LockableCurrency provides an abstraction over freezing parts of accounts balances.
+type LockableCurrency interface {
+	// SetLock creates a new lock with `id` on `who` or replaces an existing one.
+	// If `amount` is zero, the lock is removed.
+	SetLock(id [8]byte, who AccountId, amount sc.U128, reasons Reasons) error
+	// ExtendLock makes an existing lock with `id` on `who` at least as strict as `amount` and `reasons`.
+	// Creates a new lock if one with `id` does not exist.
+	ExtendLock(id [8]byte, who AccountId, amount sc.U128, reasons Reasons) error
+	// RemoveLock removes the lock with `id` from `who`.
+	RemoveLock(id [8]byte, who AccountId) error
+}
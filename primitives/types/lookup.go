@@ -1,5 +1,21 @@
 package types
 
+// StaticLookup converts a MultiAddress into the AccountId it refers to.
+type StaticLookup interface {
+	Lookup(a MultiAddress) (AccountId, error)
+}
+
+// IdentityLookup is a StaticLookup, which resolves only AccountId addresses.
+type IdentityLookup struct{}
+
+func NewIdentityLookup() IdentityLookup {
+	return IdentityLookup{}
+}
+
+func (l IdentityLookup) Lookup(a MultiAddress) (AccountId, error) {
+	return Lookup(a)
+}
+
 func Lookup(a MultiAddress) (AccountId, error) {
 	if !a.IsAccountId() {
 		return AccountId{}, NewTransactionValidityError(NewUnknownTransactionCannotLookup())
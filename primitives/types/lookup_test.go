@@ -33,3 +33,15 @@ func Test_Lookup_MultiAddress_NotValid(t *testing.T) {
 	assert.Equal(t, expectedTransactionCannotLookupErr, err)
 	assert.Equal(t, AccountId{}, result)
 }
+
+func Test_IdentityLookup_Lookup(t *testing.T) {
+	target := NewIdentityLookup()
+
+	result, err := target.Lookup(multiAddressId)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedAccountId, result)
+
+	result, err = target.Lookup(multiAddressIndex)
+	assert.Equal(t, expectedTransactionCannotLookupErr, err)
+	assert.Equal(t, AccountId{}, result)
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxHolds struct {
+	sc.U32
+}
+
+func (mh MaxHolds) Docs() string {
+	return "The maximum number of holds that can exist on an account at any time."
+}
@@ -7,5 +7,5 @@ type MaxLocks struct {
 }
 
 func (ml MaxLocks) Docs() string {
-	return "The maximum number of locks that should exist on an account.  Not strictly enforced, but used for weight estimation."
+	return "The maximum number of locks that can exist on an account."
 }
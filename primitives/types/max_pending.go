@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxPending struct {
+	sc.U32
+}
+
+func (mp MaxPending) Docs() string {
+	return "The maximum amount of time-delayed announcements that are allowed to be pending."
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxProxies struct {
+	sc.U32
+}
+
+func (mp MaxProxies) Docs() string {
+	return "The maximum amount of proxies allowed for a single account."
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxScheduledPerBlock struct {
+	sc.U32
+}
+
+func (mspb MaxScheduledPerBlock) Docs() string {
+	return "The maximum number of scheduled calls in the queue for a single block."
+}
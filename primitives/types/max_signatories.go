@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxSignatories struct {
+	sc.U32
+}
+
+func (ms MaxSignatories) Docs() string {
+	return "The maximum amount of signatories allowed in the multisig."
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxVestingSchedules struct {
+	sc.U32
+}
+
+func (mvs MaxVestingSchedules) Docs() string {
+	return "The maximum number of vesting schedules of a single account."
+}
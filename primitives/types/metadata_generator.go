@@ -11,7 +11,7 @@ import (
 )
 
 const (
-	lastAvailableIndex = 136 // the last enum id from constants/metadata.go
+	lastAvailableIndex = 206 // the last enum id from constants/metadata.go
 )
 
 const (
@@ -69,6 +69,17 @@ func BuildMetadataTypesIdsMap() map[string]int {
 		"CodeUpgradeAuthorization":   metadata.TypesCodeUpgradeAuthorization,
 		"RuntimeVersion":             metadata.TypesRuntimeVersion,
 		"Weight":                     metadata.TypesWeight,
+		"RuntimeCall":                metadata.RuntimeCall,
+		"AccountId":                  metadata.TypesAddress32,
+		"SequenceAccountId":          metadata.TypesSequenceAddress32,
+		"Timepoint":                  metadata.TypesMultisigTimepoint,
+		"ProxyType":                  metadata.TypesProxyType,
+		"VestingInfo":                metadata.TypesVestingInfo,
+		"TaskName":                   metadata.TypesFixedSequence32U8,
+		"Period":                     metadata.TypesTupleU64U32,
+		"Option<Period>":             metadata.TypesOptionTupleU64U32,
+		"SchedulerMaximumWeight":     metadata.TypesWeight,
+		"SessionKeys":                metadata.TypesSessionKeys,
 	}
 }
 
@@ -101,6 +112,12 @@ func (g *MetadataTypeGenerator) AppendMetadataTypes(types sc.Sequence[MetadataTy
 
 // BuildMetadataTypeRecursively Builds the metadata type (recursively) if it does not exist
 func (g *MetadataTypeGenerator) BuildMetadataTypeRecursively(v reflect.Value, path *sc.Sequence[sc.Str], def *MetadataTypeDefinition, params *sc.Sequence[MetadataTypeParameter]) int {
+	// Bounded collections are checked before the type name, since in TinyGo it does not include the type arguments
+	if v.CanInterface() {
+		if collection, ok := v.Interface().(boundedCollection); ok {
+			return g.constructBoundedCollectionType(collection)
+		}
+	}
 	valueType := v.Type()
 	typeName := valueType.Name()
 	typeId, ok := g.GetId(typeName)
@@ -352,6 +369,59 @@ func (g *MetadataTypeGenerator) constructOptionType(v reflect.Value) int {
 	return typeId
 }
 
+func (g *MetadataTypeGenerator) constructBoundedCollectionType(collection boundedCollection) int {
+	collectionName := collection.metadataName()
+	typeId, ok := g.GetId(collectionName)
+	if ok {
+		return typeId
+	}
+	innerId := collection.buildInnerMetadataType(g)
+	typeId = g.assignNewMetadataId(collectionName)
+	metadataTypeDef := NewMetadataTypeDefinitionComposite(
+		sc.Sequence[MetadataTypeDefinitionField]{
+			NewMetadataTypeDefinitionField(innerId),
+		})
+
+	newMetadataType := NewMetadataTypeWithPath(typeId, collectionName, collection.metadataPath(), metadataTypeDef)
+	g.metadataTypes = append(g.metadataTypes, newMetadataType)
+	return typeId
+}
+
+// buildBTreeMapType builds the metadata type of a map, described as a sequence of key-value tuples.
+func (g *MetadataTypeGenerator) buildBTreeMapType(keyTypeName, valueTypeName string, keyId, valueId int) int {
+	mapName := "BTreeMap<" + keyTypeName + "," + valueTypeName + ">"
+	mapId, ok := g.GetId(mapName)
+	if ok {
+		return mapId
+	}
+
+	tupleName := "(" + keyTypeName + "," + valueTypeName + ")"
+	tupleId, ok := g.GetId(tupleName)
+	if !ok {
+		tupleId = g.assignNewMetadataId(tupleName)
+		g.metadataTypes = append(g.metadataTypes, generateCompositeType(tupleId, tupleName, sc.Sequence[sc.Compact]{sc.ToCompact(keyId), sc.ToCompact(valueId)}))
+	}
+
+	sequenceName := "Sequence" + tupleName
+	sequenceId, ok := g.GetId(sequenceName)
+	if !ok {
+		sequenceId = g.assignNewMetadataId(sequenceName)
+		g.metadataTypes = append(g.metadataTypes, NewMetadataType(sequenceId, sequenceName, NewMetadataTypeDefinitionSequence(sc.ToCompact(tupleId))))
+	}
+
+	mapId = g.assignNewMetadataId(mapName)
+	metadataTypeDef := NewMetadataTypeDefinitionComposite(
+		sc.Sequence[MetadataTypeDefinitionField]{
+			NewMetadataTypeDefinitionField(sequenceId),
+		})
+	metadataTypeParams := sc.Sequence[MetadataTypeParameter]{
+		NewMetadataTypeParameter(keyId, "K"),
+		NewMetadataTypeParameter(valueId, "V"),
+	}
+	g.metadataTypes = append(g.metadataTypes, NewMetadataTypeWithParams(mapId, mapName, sc.Sequence[sc.Str]{"BTreeMap"}, metadataTypeDef, metadataTypeParams))
+	return mapId
+}
+
 func (g *MetadataTypeGenerator) isCompactVariation(v reflect.Value) (int, bool) {
 	field := v.FieldByName("Number")
 	if field.IsValid() {
@@ -446,16 +516,20 @@ func constructTypeDocs(typeName string) string {
 	return strings.Replace(metadataDocs, goscalePathTrim, "", 1)
 }
 
-// constructFunctionName constructs the formal name of a function call for the module metadata type given its struct name as an input (e.g. callTransferAll -> transfer_all)
+// constructFunctionName constructs the formal name of a function call for the module metadata type given its struct name as an input (e.g. callTransferAll -> transfer_all, callAsMultiThreshold1 -> as_multi_threshold_1)
 func constructFunctionName(input string) string {
 	input, _ = strings.CutPrefix(input, "call")
 	var result strings.Builder
 
+	previous := rune(0)
 	for i, char := range input {
-		if i > 0 && 'A' <= char && char <= 'Z' {
+		isUpper := 'A' <= char && char <= 'Z'
+		isDigitAfterLetter := '0' <= char && char <= '9' && !('0' <= previous && previous <= '9')
+		if i > 0 && (isUpper || isDigitAfterLetter) {
 			result.WriteRune('_')
 		}
 		result.WriteRune(char)
+		previous = char
 	}
 
 	return strings.ToLower(result.String())
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MinVestedTransfer struct {
+	sc.U128
+}
+
+func (mvt MinVestedTransfer) Docs() string {
+	return "The minimum amount transferred to call `vested_transfer`."
+}
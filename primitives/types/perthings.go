@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 )
@@ -42,3 +43,245 @@ func (p Perbill) Mul(v sc.Encodable) (sc.Encodable, error) {
 		return nil, errors.New("unsupported type")
 	}
 }
+
+// ErrPerThingOutOfRange is returned when decoding a per-thing, whose parts exceed its accuracy.
+var ErrPerThingOutOfRange = errors.New("per-thing parts exceed accuracy")
+
+const (
+	permillAccuracy     = 1_000_000
+	percentAccuracy     = 100
+	perquintillAccuracy = 1_000_000_000_000_000_000
+)
+
+// Permill is a fixed point representation of a number in the range [0, 1], in parts per million.
+type Permill struct {
+	Parts sc.U32
+}
+
+// PermillFromPercent returns the Permill representation of `percent`, saturating at 100.
+func PermillFromPercent(percent sc.U8) Permill {
+	return Permill{Parts: sc.U32(perThingFromPercent(percent, permillAccuracy))}
+}
+
+// PermillFromRational returns the Permill representation of `p / q`, rounded down.
+// Saturates at one if `p` is greater than or equal to `q`.
+func PermillFromRational(p, q sc.U128) Permill {
+	return Permill{Parts: sc.U32(perThingFromRational(p, q, permillAccuracy))}
+}
+
+func (p Permill) Encode(buffer *bytes.Buffer) error {
+	return p.Parts.Encode(buffer)
+}
+
+func DecodePermill(buffer *bytes.Buffer) (Permill, error) {
+	parts, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Permill{}, err
+	}
+	if parts > permillAccuracy {
+		return Permill{}, ErrPerThingOutOfRange
+	}
+	return Permill{Parts: parts}, nil
+}
+
+func (p Permill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Permill) SaturatingAdd(other Permill) Permill {
+	return Permill{Parts: sc.U32(perThingSaturatingAdd(sc.U64(p.Parts), sc.U64(other.Parts), permillAccuracy))}
+}
+
+func (p Permill) SaturatingSub(other Permill) Permill {
+	return Permill{Parts: sc.U32(sc.SaturatingSubU64(sc.U64(p.Parts), sc.U64(other.Parts)))}
+}
+
+func (p Permill) SaturatingMul(other Permill) Permill {
+	return Permill{Parts: sc.U32(perThingMul(sc.U64(p.Parts), sc.U64(other.Parts), permillAccuracy))}
+}
+
+func (p Permill) SaturatingPow(exp sc.U32) Permill {
+	return Permill{Parts: sc.U32(perThingPow(sc.U64(p.Parts), exp, permillAccuracy))}
+}
+
+// MulFloor returns `p * n`, rounded down.
+func (p Permill) MulFloor(n sc.U128) sc.U128 {
+	return perThingMulFloor(sc.U64(p.Parts), n, permillAccuracy)
+}
+
+// MulCeil returns `p * n`, rounded up.
+func (p Permill) MulCeil(n sc.U128) sc.U128 {
+	return perThingMulCeil(sc.U64(p.Parts), n, permillAccuracy)
+}
+
+// Percent is a fixed point representation of a number in the range [0, 1], in parts per hundred.
+type Percent struct {
+	Parts sc.U8
+}
+
+// PercentFromRational returns the Percent representation of `p / q`, rounded down.
+// Saturates at one if `p` is greater than or equal to `q`.
+func PercentFromRational(p, q sc.U128) Percent {
+	return Percent{Parts: sc.U8(perThingFromRational(p, q, percentAccuracy))}
+}
+
+func (p Percent) Encode(buffer *bytes.Buffer) error {
+	return p.Parts.Encode(buffer)
+}
+
+func DecodePercent(buffer *bytes.Buffer) (Percent, error) {
+	parts, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Percent{}, err
+	}
+	if parts > percentAccuracy {
+		return Percent{}, ErrPerThingOutOfRange
+	}
+	return Percent{Parts: parts}, nil
+}
+
+func (p Percent) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Percent) SaturatingAdd(other Percent) Percent {
+	return Percent{Parts: sc.U8(perThingSaturatingAdd(sc.U64(p.Parts), sc.U64(other.Parts), percentAccuracy))}
+}
+
+func (p Percent) SaturatingSub(other Percent) Percent {
+	return Percent{Parts: sc.U8(sc.SaturatingSubU64(sc.U64(p.Parts), sc.U64(other.Parts)))}
+}
+
+func (p Percent) SaturatingMul(other Percent) Percent {
+	return Percent{Parts: sc.U8(perThingMul(sc.U64(p.Parts), sc.U64(other.Parts), percentAccuracy))}
+}
+
+func (p Percent) SaturatingPow(exp sc.U32) Percent {
+	return Percent{Parts: sc.U8(perThingPow(sc.U64(p.Parts), exp, percentAccuracy))}
+}
+
+// MulFloor returns `p * n`, rounded down.
+func (p Percent) MulFloor(n sc.U128) sc.U128 {
+	return perThingMulFloor(sc.U64(p.Parts), n, percentAccuracy)
+}
+
+// MulCeil returns `p * n`, rounded up.
+func (p Percent) MulCeil(n sc.U128) sc.U128 {
+	return perThingMulCeil(sc.U64(p.Parts), n, percentAccuracy)
+}
+
+// Perquintill is a fixed point representation of a number in the range [0, 1], in parts per 10^18.
+type Perquintill struct {
+	Parts sc.U64
+}
+
+// PerquintillFromPercent returns the Perquintill representation of `percent`, saturating at 100.
+func PerquintillFromPercent(percent sc.U8) Perquintill {
+	return Perquintill{Parts: perThingFromPercent(percent, perquintillAccuracy)}
+}
+
+// PerquintillFromRational returns the Perquintill representation of `p / q`, rounded down.
+// Saturates at one if `p` is greater than or equal to `q`.
+func PerquintillFromRational(p, q sc.U128) Perquintill {
+	return Perquintill{Parts: perThingFromRational(p, q, perquintillAccuracy)}
+}
+
+func (p Perquintill) Encode(buffer *bytes.Buffer) error {
+	return p.Parts.Encode(buffer)
+}
+
+func DecodePerquintill(buffer *bytes.Buffer) (Perquintill, error) {
+	parts, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Perquintill{}, err
+	}
+	if parts > perquintillAccuracy {
+		return Perquintill{}, ErrPerThingOutOfRange
+	}
+	return Perquintill{Parts: parts}, nil
+}
+
+func (p Perquintill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Perquintill) SaturatingAdd(other Perquintill) Perquintill {
+	return Perquintill{Parts: perThingSaturatingAdd(p.Parts, other.Parts, perquintillAccuracy)}
+}
+
+func (p Perquintill) SaturatingSub(other Perquintill) Perquintill {
+	return Perquintill{Parts: sc.SaturatingSubU64(p.Parts, other.Parts)}
+}
+
+func (p Perquintill) SaturatingMul(other Perquintill) Perquintill {
+	return Perquintill{Parts: perThingMul(p.Parts, other.Parts, perquintillAccuracy)}
+}
+
+func (p Perquintill) SaturatingPow(exp sc.U32) Perquintill {
+	return Perquintill{Parts: perThingPow(p.Parts, exp, perquintillAccuracy)}
+}
+
+// MulFloor returns `p * n`, rounded down.
+func (p Perquintill) MulFloor(n sc.U128) sc.U128 {
+	return perThingMulFloor(p.Parts, n, perquintillAccuracy)
+}
+
+// MulCeil returns `p * n`, rounded up.
+func (p Perquintill) MulCeil(n sc.U128) sc.U128 {
+	return perThingMulCeil(p.Parts, n, perquintillAccuracy)
+}
+
+func perThingFromPercent(percent sc.U8, accuracy sc.U64) sc.U64 {
+	return sc.U64(sc.Min64(sc.U64(percent), percentAccuracy)) * (accuracy / percentAccuracy)
+}
+
+func perThingFromRational(p, q sc.U128, accuracy sc.U64) sc.U64 {
+	numerator, denominator := p.ToBigInt(), q.ToBigInt()
+	if denominator.Sign() == 0 || numerator.Cmp(denominator) >= 0 {
+		return accuracy
+	}
+
+	return sc.U64(mulDiv(numerator, new(big.Int).SetUint64(uint64(accuracy)), denominator).Uint64())
+}
+
+func perThingSaturatingAdd(a, b, accuracy sc.U64) sc.U64 {
+	return sc.Min64(sc.SaturatingAddU64(a, b), accuracy)
+}
+
+func perThingMul(a, b, accuracy sc.U64) sc.U64 {
+	acc := new(big.Int).SetUint64(uint64(accuracy))
+	return sc.U64(mulDiv(new(big.Int).SetUint64(uint64(a)), new(big.Int).SetUint64(uint64(b)), acc).Uint64())
+}
+
+// perThingPow raises `parts` to the power of `exp` by square-and-multiply, so that it
+// takes O(log exp) multiplications.
+func perThingPow(parts sc.U64, exp sc.U32, accuracy sc.U64) sc.U64 {
+	result := accuracy
+	base := parts
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = perThingMul(result, base, accuracy)
+		}
+		exp >>= 1
+		if exp > 0 {
+			base = perThingMul(base, base, accuracy)
+		}
+	}
+
+	return result
+}
+
+func perThingMulFloor(parts sc.U64, n sc.U128, accuracy sc.U64) sc.U128 {
+	return sc.NewU128(mulDiv(n.ToBigInt(), new(big.Int).SetUint64(uint64(parts)), new(big.Int).SetUint64(uint64(accuracy))))
+}
+
+func perThingMulCeil(parts sc.U64, n sc.U128, accuracy sc.U64) sc.U128 {
+	acc := new(big.Int).SetUint64(uint64(accuracy))
+	product := new(big.Int).Mul(n.ToBigInt(), new(big.Int).SetUint64(uint64(parts)))
+	quotient, remainder := new(big.Int).QuoRem(product, acc, new(big.Int))
+	if remainder.Sign() != 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	return sc.NewU128(quotient)
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PermillFromPercent(t *testing.T) {
+	assert.Equal(t, Permill{Parts: 250_000}, PermillFromPercent(25))
+	assert.Equal(t, Permill{Parts: 1_000_000}, PermillFromPercent(150))
+}
+
+func Test_PermillFromRational(t *testing.T) {
+	assert.Equal(t, Permill{Parts: 333_333}, PermillFromRational(sc.NewU128(1), sc.NewU128(3)))
+	assert.Equal(t, Permill{Parts: 1_000_000}, PermillFromRational(sc.NewU128(4), sc.NewU128(3)))
+	assert.Equal(t, Permill{Parts: 1_000_000}, PermillFromRational(sc.NewU128(1), sc.NewU128(0)))
+}
+
+func Test_Permill_Encode_Decode(t *testing.T) {
+	permill := PermillFromPercent(50)
+	buffer := &bytes.Buffer{}
+
+	err := permill.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(500_000).Bytes(), buffer.Bytes())
+	assert.Equal(t, buffer.Bytes(), permill.Bytes())
+
+	result, err := DecodePermill(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, permill, result)
+}
+
+func Test_DecodePermill_OutOfRange(t *testing.T) {
+	result, err := DecodePermill(bytes.NewBuffer(sc.U32(1_000_001).Bytes()))
+
+	assert.Equal(t, ErrPerThingOutOfRange, err)
+	assert.Equal(t, Permill{}, result)
+}
+
+func Test_Permill_SaturatingOps(t *testing.T) {
+	half := PermillFromPercent(50)
+	quarter := PermillFromPercent(25)
+
+	assert.Equal(t, PermillFromPercent(100), half.SaturatingAdd(PermillFromPercent(75)))
+	assert.Equal(t, quarter, half.SaturatingSub(quarter))
+	assert.Equal(t, Permill{}, quarter.SaturatingSub(half))
+	assert.Equal(t, quarter, half.SaturatingMul(half))
+	assert.Equal(t, Permill{Parts: 125_000}, half.SaturatingPow(3))
+	assert.Equal(t, PermillFromPercent(100), half.SaturatingPow(0))
+}
+
+func Test_Permill_SaturatingPow_LargeExponent(t *testing.T) {
+	assert.Equal(t, PermillFromPercent(100), PermillFromPercent(100).SaturatingPow(math.MaxUint32))
+	assert.Equal(t, Permill{}, Permill{Parts: 999_999}.SaturatingPow(math.MaxUint32))
+	assert.Equal(t, Permill{Parts: 999_000}, Permill{Parts: 999_999}.SaturatingPow(1_000))
+}
+
+func Test_Permill_MulFloor_MulCeil(t *testing.T) {
+	p := PermillFromRational(sc.NewU128(1), sc.NewU128(3))
+
+	assert.Equal(t, sc.NewU128(333), p.MulFloor(sc.NewU128(1000)))
+	assert.Equal(t, sc.NewU128(334), p.MulCeil(sc.NewU128(1000)))
+	assert.Equal(t, sc.NewU128(500), PermillFromPercent(50).MulCeil(sc.NewU128(1000)))
+}
+
+func Test_PercentFromRational(t *testing.T) {
+	assert.Equal(t, Percent{Parts: 33}, PercentFromRational(sc.NewU128(1), sc.NewU128(3)))
+	assert.Equal(t, Percent{Parts: 100}, PercentFromRational(sc.NewU128(3), sc.NewU128(3)))
+}
+
+func Test_Percent_Encode_Decode(t *testing.T) {
+	percent := Percent{Parts: 42}
+
+	assert.Equal(t, []byte{42}, percent.Bytes())
+
+	result, err := DecodePercent(bytes.NewBuffer([]byte{42}))
+	assert.NoError(t, err)
+	assert.Equal(t, percent, result)
+
+	result, err = DecodePercent(bytes.NewBuffer([]byte{100}))
+	assert.NoError(t, err)
+	assert.Equal(t, Percent{Parts: 100}, result)
+}
+
+func Test_DecodePercent_OutOfRange(t *testing.T) {
+	result, err := DecodePercent(bytes.NewBuffer([]byte{101}))
+
+	assert.Equal(t, ErrPerThingOutOfRange, err)
+	assert.Equal(t, Percent{}, result)
+}
+
+func Test_Percent_SaturatingOps(t *testing.T) {
+	assert.Equal(t, Percent{Parts: 100}, Percent{Parts: 60}.SaturatingAdd(Percent{Parts: 60}))
+	assert.Equal(t, Percent{Parts: 0}, Percent{Parts: 10}.SaturatingSub(Percent{Parts: 60}))
+	assert.Equal(t, Percent{Parts: 25}, Percent{Parts: 50}.SaturatingMul(Percent{Parts: 50}))
+	assert.Equal(t, Percent{Parts: 12}, Percent{Parts: 50}.SaturatingPow(3))
+	assert.Equal(t, sc.NewU128(3), Percent{Parts: 30}.MulFloor(sc.NewU128(11)))
+	assert.Equal(t, sc.NewU128(4), Percent{Parts: 30}.MulCeil(sc.NewU128(11)))
+}
+
+func Test_PerquintillFromPercent(t *testing.T) {
+	assert.Equal(t, Perquintill{Parts: 250_000_000_000_000_000}, PerquintillFromPercent(25))
+}
+
+func Test_PerquintillFromRational(t *testing.T) {
+	assert.Equal(t, Perquintill{Parts: 333_333_333_333_333_333}, PerquintillFromRational(sc.NewU128(1), sc.NewU128(3)))
+	assert.Equal(t, Perquintill{Parts: perquintillAccuracy}, PerquintillFromRational(sc.MaxU128(), sc.NewU128(1)))
+}
+
+func Test_Perquintill_Encode_Decode(t *testing.T) {
+	perquintill := PerquintillFromPercent(25)
+
+	assert.Equal(t, sc.U64(250_000_000_000_000_000).Bytes(), perquintill.Bytes())
+
+	result, err := DecodePerquintill(bytes.NewBuffer(perquintill.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, perquintill, result)
+}
+
+func Test_DecodePerquintill_OutOfRange(t *testing.T) {
+	result, err := DecodePerquintill(bytes.NewBuffer(sc.U64(perquintillAccuracy + 1).Bytes()))
+
+	assert.Equal(t, ErrPerThingOutOfRange, err)
+	assert.Equal(t, Perquintill{}, result)
+}
+
+func Test_Perquintill_SaturatingOps(t *testing.T) {
+	half := PerquintillFromPercent(50)
+
+	assert.Equal(t, PerquintillFromPercent(100), half.SaturatingAdd(PerquintillFromPercent(60)))
+	assert.Equal(t, Perquintill{}, half.SaturatingSub(PerquintillFromPercent(60)))
+	assert.Equal(t, PerquintillFromPercent(25), half.SaturatingMul(half))
+	assert.Equal(t, Perquintill{Parts: 125_000_000_000_000_000}, half.SaturatingPow(3))
+	assert.Equal(t, PerquintillFromPercent(100), PerquintillFromPercent(100).SaturatingPow(math.MaxUint32))
+	assert.Equal(t, Perquintill{}, half.SaturatingPow(math.MaxUint32))
+}
+
+func Test_Perquintill_MulFloor_MulCeil(t *testing.T) {
+	p := PerquintillFromPercent(25)
+
+	assert.Equal(t, sc.NewU128(250), p.MulFloor(sc.NewU128(1001)))
+	assert.Equal(t, sc.NewU128(251), p.MulCeil(sc.NewU128(1001)))
+}
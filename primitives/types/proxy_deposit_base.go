@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type ProxyDepositBase struct {
+	sc.U128
+}
+
+func (pdb ProxyDepositBase) Docs() string {
+	return "The base amount of currency needed to reserve for creating a proxy."
+}
@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type ProxyDepositFactor struct {
+	sc.U128
+}
+
+func (pdf ProxyDepositFactor) Docs() string {
+	return "The amount of currency needed per proxy added."
+}
@@ -0,0 +1,43 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// ReservableCurrency provides an abstraction over reserving parts of accounts balances.
+type ReservableCurrency interface {
+	// CanReserve returns whether `who` can reserve `value` from their free balance.
+	CanReserve(who AccountId, value sc.U128) (bool, error)
+	// ReservedBalance returns the amount of balance of `who` which is reserved.
+	ReservedBalance(who AccountId) (Balance, error)
+	// Reserve moves `value` from the free balance of `who` to their reserved balance.
+	// Returns an error if the free balance is too low or restricted by locks.
+	Reserve(who AccountId, value sc.U128) error
+	// Unreserve moves up to `value` from the reserved balance of `who` to their free balance.
+	// Returns the amount which could not be unreserved.
+	Unreserve(who AccountId, value sc.U128) (Balance, error)
+	// SlashReserved deducts up to `value` from the reserved balance of `who`, reducing the total issuance.
+	// Returns the amount which could not be slashed.
+	SlashReserved(who AccountId, value sc.U128) (Balance, error)
+	// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to the balance of `beneficiary`.
+	// `status` is the balance status (free or reserved) in which the funds end up in `beneficiary`.
+	// Returns the amount which could not be moved.
+	RepatriateReserved(slashed AccountId, beneficiary AccountId, value sc.U128, status sc.U8) (Balance, error)
+}
+
+// NamedReservableCurrency provides an abstraction over reserving parts of accounts balances under an identifier.
+type NamedReservableCurrency interface {
+	ReservableCurrency
+	// ReservedBalanceNamed returns the amount of balance of `who` which is reserved under `id`.
+	ReservedBalanceNamed(id [8]byte, who AccountId) (Balance, error)
+	// ReserveNamed moves `value` from the free balance of `who` to their reserved balance under `id`.
+	ReserveNamed(id [8]byte, who AccountId, value sc.U128) error
+	// UnreserveNamed moves up to `value` reserved under `id` back to the free balance of `who`.
+	// Returns the amount which could not be unreserved.
+	UnreserveNamed(id [8]byte, who AccountId, value sc.U128) (Balance, error)
+	// SlashReservedNamed deducts up to `value` reserved under `id` from `who`, reducing the total issuance.
+	// Returns the amount which could not be slashed.
+	SlashReservedNamed(id [8]byte, who AccountId, value sc.U128) (Balance, error)
+	// RepatriateReservedNamed moves up to `value` reserved under `id` from `slashed` to `beneficiary`.
+	// If `status` is reserved, the funds are reserved under the same `id` in `beneficiary`.
+	// Returns the amount which could not be moved.
+	RepatriateReservedNamed(id [8]byte, slashed AccountId, beneficiary AccountId, value sc.U128, status sc.U8) (Balance, error)
+}
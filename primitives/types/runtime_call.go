@@ -0,0 +1,11 @@
+package types
+
+// RuntimeCall wraps a call, which is passed as an argument to another call.
+// It is encoded as the wrapped call and is represented as the `RuntimeCall` type in the metadata.
+type RuntimeCall struct {
+	Call
+}
+
+func NewRuntimeCall(call Call) RuntimeCall {
+	return RuntimeCall{call}
+}
@@ -0,0 +1,9 @@
+package types
+
+type SchedulerMaximumWeight struct {
+	Weight
+}
+
+func (smw SchedulerMaximumWeight) Docs() string {
+	return "The maximum weight that may be scheduled per block for any dispatchables."
+}
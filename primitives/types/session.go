@@ -1,7 +1,33 @@
 package types
 
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
 // Session provides the key type and id of a module, which has a session.
 type Session interface {
 	KeyType() PublicKeyType
 	KeyTypeId() [4]byte
 }
+
+// SessionHandler is a Session, which is notified by the session module when the validator set changes.
+type SessionHandler interface {
+	Session
+	// OnGenesisSession is called with the validators of the genesis session and their keys.
+	OnGenesisSession(validators sc.Sequence[SessionValidator]) error
+	// OnNewSession is called when a new session starts. `changed` is true if the validators or
+	// their keys have changed since the last session.
+	OnNewSession(changed bool, validators sc.Sequence[SessionValidator], queuedValidators sc.Sequence[SessionValidator]) error
+}
+
+// SessionValidator is a validator, together with its session key of the key type of a SessionHandler.
+type SessionValidator struct {
+	AccountId AccountId
+	Key       sc.Sequence[sc.U8]
+}
+
+// DisabledValidators provides whether a validator of the current session is disabled and should not author blocks.
+type DisabledValidators interface {
+	// IsDisabled returns whether the validator at `index` in the current validator set is disabled.
+	IsDisabled(index sc.U32) (bool, error)
+}
@@ -8,5 +8,7 @@ type StoredMap interface {
 	EventDepositor
 	Get(key AccountId) (AccountInfo, error)
 	CanDecProviders(who AccountId) (bool, error)
+	IncConsumers(who AccountId) error
+	DecConsumers(who AccountId)
 	TryMutateExists(who AccountId, f func(who *AccountData) (sc.Encodable, error)) (sc.Encodable, error)
 }
@@ -11,4 +11,7 @@ type UncheckedExtrinsic interface {
 
 	IsSigned() bool
 	Check() (CheckedExtrinsic, error)
+	// UncheckedIntoChecked converts into a checked extrinsic without verifying the signature.
+	// Used only to dry-run blocks with try-runtime.
+	UncheckedIntoChecked() (CheckedExtrinsic, error)
 }
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// WeakBoundedVec is a sequence, which should contain at most `S.Bound()` items.
+// Unlike BoundedVec, decoding accepts longer sequences, so that lowering the bound does not make stored values undecodable.
+// Adding items is bounded as usual.
+type WeakBoundedVec[T sc.Encodable, S Bound] struct {
+	items sc.Sequence[T]
+}
+
+// NewWeakBoundedVec returns a WeakBoundedVec of items. Returns ErrBoundExceeded if there are more items than the bound.
+func NewWeakBoundedVec[T sc.Encodable, S Bound](items sc.Sequence[T]) (WeakBoundedVec[T, S], error) {
+	if sc.U32(len(items)) > boundOf[S]() {
+		return WeakBoundedVec[T, S]{}, ErrBoundExceeded
+	}
+	return WeakBoundedVec[T, S]{items: items}, nil
+}
+
+// NewWeakBoundedVecForce returns a WeakBoundedVec of items, even if there are more items than the bound.
+func NewWeakBoundedVecForce[T sc.Encodable, S Bound](items sc.Sequence[T]) WeakBoundedVec[T, S] {
+	return WeakBoundedVec[T, S]{items: items}
+}
+
+func (wbv WeakBoundedVec[T, S]) Encode(buffer *bytes.Buffer) error {
+	return wbv.items.Encode(buffer)
+}
+
+func DecodeWeakBoundedVecWith[T sc.Encodable, S Bound](buffer *bytes.Buffer, decodeFunc func(buffer *bytes.Buffer) (T, error)) (WeakBoundedVec[T, S], error) {
+	items, err := sc.DecodeSequenceWith(buffer, decodeFunc)
+	if err != nil {
+		return WeakBoundedVec[T, S]{}, err
+	}
+	return WeakBoundedVec[T, S]{items: items}, nil
+}
+
+func (wbv WeakBoundedVec[T, S]) Bytes() []byte {
+	return sc.EncodedBytes(wbv)
+}
+
+// Items returns the items of the sequence. Appending to the result does not affect the WeakBoundedVec.
+func (wbv WeakBoundedVec[T, S]) Items() sc.Sequence[T] {
+	return wbv.items[:len(wbv.items):len(wbv.items)]
+}
+
+func (wbv WeakBoundedVec[T, S]) Len() int {
+	return len(wbv.items)
+}
+
+func (wbv WeakBoundedVec[T, S]) Bound() sc.U32 {
+	return boundOf[S]()
+}
+
+func (wbv WeakBoundedVec[T, S]) IsFull() bool {
+	return sc.U32(len(wbv.items)) >= wbv.Bound()
+}
+
+// TryPush appends item to the end of the sequence. Returns ErrBoundExceeded if the sequence is full.
+func (wbv *WeakBoundedVec[T, S]) TryPush(item T) error {
+	if wbv.IsFull() {
+		return ErrBoundExceeded
+	}
+	wbv.items = append(wbv.items, item)
+	return nil
+}
+
+// TryInsert inserts item at index, shifting all items after it. Returns ErrBoundExceeded if the sequence is full.
+// Panics if index is greater than the length of the sequence.
+func (wbv *WeakBoundedVec[T, S]) TryInsert(index int, item T) error {
+	if wbv.IsFull() {
+		return ErrBoundExceeded
+	}
+	wbv.items = insertAt(wbv.items, index, item)
+	return nil
+}
+
+// Remove removes and returns the item at index, shifting all items after it.
+// Panics if index is out of bounds.
+func (wbv *WeakBoundedVec[T, S]) Remove(index int) T {
+	item := wbv.items[index]
+	wbv.items = append(wbv.items[:index:index], wbv.items[index+1:]...)
+	return item
+}
+
+func (wbv WeakBoundedVec[T, S]) metadataName() string {
+	return "WeakBoundedVec<" + reflect.TypeOf(*new(T)).Name() + ">"
+}
+
+func (wbv WeakBoundedVec[T, S]) metadataPath() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"bounded_collections", "weak_bounded_vec", "WeakBoundedVec"}
+}
+
+func (wbv WeakBoundedVec[T, S]) buildInnerMetadataType(g *MetadataTypeGenerator) int {
+	return g.BuildMetadataTypeRecursively(reflect.ValueOf(sc.Sequence[T]{}), nil, nil, nil)
+}
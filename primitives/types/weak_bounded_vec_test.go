@@ -0,0 +1,83 @@
+package types
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	weakBoundedOversizedItems      = sc.Sequence[sc.U8]{1, 2, 3, 4}
+	weakBoundedOversizedItemsBytes = []byte{16, 1, 2, 3, 4}
+)
+
+func Test_NewWeakBoundedVec_BoundExceeded(t *testing.T) {
+	_, err := NewWeakBoundedVec[sc.U8, testBoundThree](weakBoundedOversizedItems)
+
+	assert.Equal(t, ErrBoundExceeded, err)
+}
+
+func Test_NewWeakBoundedVecForce(t *testing.T) {
+	target := NewWeakBoundedVecForce[sc.U8, testBoundThree](weakBoundedOversizedItems)
+
+	assert.Equal(t, weakBoundedOversizedItems, target.Items())
+	assert.Equal(t, weakBoundedOversizedItemsBytes, target.Bytes())
+	assert.True(t, target.IsFull())
+}
+
+func Test_DecodeWeakBoundedVecWith_AcceptsOversized(t *testing.T) {
+	buffer := bytes.NewBuffer(weakBoundedOversizedItemsBytes)
+
+	result, err := DecodeWeakBoundedVecWith[sc.U8, testBoundThree](buffer, sc.DecodeU8)
+
+	assert.NoError(t, err)
+	assert.Equal(t, weakBoundedOversizedItems, result.Items())
+	assert.Equal(t, 4, result.Len())
+}
+
+func Test_WeakBoundedVec_TryPush(t *testing.T) {
+	target, err := NewWeakBoundedVec[sc.U8, testBoundThree](sc.Sequence[sc.U8]{1, 2})
+	assert.NoError(t, err)
+
+	assert.NoError(t, target.TryPush(3))
+	assert.Equal(t, ErrBoundExceeded, target.TryPush(4))
+	assert.Equal(t, boundedItems, target.Items())
+}
+
+func Test_WeakBoundedVec_TryInsert(t *testing.T) {
+	target := NewWeakBoundedVecForce[sc.U8, testBoundThree](sc.Sequence[sc.U8]{2, 3})
+
+	assert.NoError(t, target.TryInsert(0, 1))
+	assert.Equal(t, boundedItems, target.Items())
+	assert.Equal(t, ErrBoundExceeded, target.TryInsert(0, 0))
+}
+
+func Test_WeakBoundedVec_Remove(t *testing.T) {
+	target := NewWeakBoundedVecForce[sc.U8, testBoundThree](weakBoundedOversizedItems)
+
+	assert.Equal(t, sc.U8(4), target.Remove(3))
+	assert.Equal(t, boundedItems, target.Items())
+}
+
+func Test_WeakBoundedVec_Metadata(t *testing.T) {
+	target := NewMetadataTypeGenerator()
+	expectedId := target.GetLastAvailableIndex() + 1
+	expectedType := NewMetadataTypeWithPath(
+		expectedId,
+		"WeakBoundedVec<U8>",
+		sc.Sequence[sc.Str]{"bounded_collections", "weak_bounded_vec", "WeakBoundedVec"},
+		NewMetadataTypeDefinitionComposite(
+			sc.Sequence[MetadataTypeDefinitionField]{
+				NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
+			}),
+	)
+
+	result := target.BuildMetadataTypeRecursively(reflect.ValueOf(WeakBoundedVec[sc.U8, testBoundThree]{}), nil, nil, nil)
+
+	assert.Equal(t, expectedId, result)
+	assert.Equal(t, sc.Sequence[MetadataType]{expectedType}, target.GetMetadataTypes())
+}
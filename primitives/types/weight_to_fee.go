@@ -1,5 +1,9 @@
 package types
 
+import sc "github.com/LimeChain/goscale"
+
 type WeightToFee interface {
 	WeightToFee(weight Weight) Balance
+	// Polynomial returns the coefficients of the polynomial which describes the conversion.
+	Polynomial() sc.Sequence[WeightToFeeCoefficient]
 }
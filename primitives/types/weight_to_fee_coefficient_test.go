@@ -0,0 +1,47 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	weightToFeeCoefficient = WeightToFeeCoefficient{
+		CoeffInteger: sc.NewU128(5),
+		CoeffFrac:    Perquintill{Parts: 1},
+		Negative:     true,
+		Degree:       2,
+	}
+
+	expectBytesWeightToFeeCoefficient = []byte{
+		0x5, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x1,
+		0x2,
+	}
+)
+
+func Test_WeightToFeeCoefficient_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := weightToFeeCoefficient.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectBytesWeightToFeeCoefficient, buffer.Bytes())
+}
+
+func Test_WeightToFeeCoefficient_Bytes(t *testing.T) {
+	assert.Equal(t, expectBytesWeightToFeeCoefficient, weightToFeeCoefficient.Bytes())
+}
+
+func Test_DecodeWeightToFeeCoefficient(t *testing.T) {
+	buffer := bytes.NewBuffer(expectBytesWeightToFeeCoefficient)
+
+	result, err := DecodeWeightToFeeCoefficient(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, weightToFeeCoefficient, result)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// WeightToFeePolynomial implements WeightToFee by evaluating a polynomial of the ref time
+// of the weight. Every step of the evaluation saturates, so the fee is always within [0, MaxU128].
+type WeightToFeePolynomial struct {
+	Coefficients sc.Sequence[WeightToFeeCoefficient]
+}
+
+func NewWeightToFeePolynomial(coefficients ...WeightToFeeCoefficient) WeightToFeePolynomial {
+	return WeightToFeePolynomial{
+		Coefficients: coefficients,
+	}
+}
+
+func (p WeightToFeePolynomial) WeightToFee(weight Weight) Balance {
+	refTime := new(big.Int).SetUint64(uint64(weight.RefTime))
+	acc := big.NewInt(0)
+
+	for _, c := range p.Coefficients {
+		w := saturatingU128FromBig(new(big.Int).Exp(refTime, big.NewInt(int64(c.Degree)), nil))
+
+		frac := c.CoeffFrac.MulFloor(w).ToBigInt()
+		integer := saturatingU128FromBig(new(big.Int).Mul(c.CoeffInteger.ToBigInt(), w.ToBigInt())).ToBigInt()
+
+		if c.Negative {
+			acc = saturatingU128FromBig(acc.Sub(acc, frac)).ToBigInt()
+			acc = saturatingU128FromBig(acc.Sub(acc, integer)).ToBigInt()
+		} else {
+			acc = saturatingU128FromBig(acc.Add(acc, frac)).ToBigInt()
+			acc = saturatingU128FromBig(acc.Add(acc, integer)).ToBigInt()
+		}
+	}
+
+	return saturatingU128FromBig(acc)
+}
+
+func (p WeightToFeePolynomial) Polynomial() sc.Sequence[WeightToFeeCoefficient] {
+	return p.Coefficients
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// 2w^3 + 0.5w^2 - 1w + 7
+	weightToFeePolynomial = NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(2), Degree: 3},
+		WeightToFeeCoefficient{CoeffFrac: PerquintillFromPercent(50), Degree: 2},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(1), Negative: true, Degree: 1},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(7), Degree: 0},
+	)
+)
+
+func Test_WeightToFeePolynomial_WeightToFee(t *testing.T) {
+	result := weightToFeePolynomial.WeightToFee(WeightFromParts(10, 100))
+
+	// 2000 + 50 - 10 + 7
+	assert.Equal(t, sc.NewU128(2047), result)
+}
+
+func Test_WeightToFeePolynomial_WeightToFee_Zero(t *testing.T) {
+	result := weightToFeePolynomial.WeightToFee(WeightZero())
+
+	assert.Equal(t, sc.NewU128(7), result)
+}
+
+func Test_WeightToFeePolynomial_WeightToFee_SaturatesAtZero(t *testing.T) {
+	target := NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(1), Negative: true, Degree: 1},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(3), Degree: 0},
+	)
+
+	result := target.WeightToFee(WeightFromParts(5, 0))
+
+	assert.Equal(t, sc.NewU128(3), result)
+}
+
+func Test_WeightToFeePolynomial_WeightToFee_SaturatesAtMax(t *testing.T) {
+	target := NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(1), Degree: 3},
+	)
+
+	result := target.WeightToFee(WeightFromParts(sc.U64(1<<63), 0))
+
+	assert.Equal(t, sc.MaxU128(), result)
+}
+
+func Test_WeightToFeePolynomial_Polynomial(t *testing.T) {
+	assert.Equal(t, weightToFeePolynomial.Coefficients, weightToFeePolynomial.Polynomial())
+}
@@ -44,7 +44,7 @@ func Test_Balances_TransferAll_Success_AllowDeath(t *testing.T) {
 	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
 	assert.True(t, ok)
 
-	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+	keyStorageAccountAlice, _ := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
 
 	// Sign the transaction using Alice's default account
 	err = ext.Sign(signature.TestKeyringPairAlice, o)
@@ -94,24 +94,8 @@ func Test_Balances_TransferAll_Success_AllowDeath(t *testing.T) {
 
 	assert.Equal(t, expectedBobAccountInfo, bobAccountInfo)
 
-	expectedAliceAccountInfo := gossamertypes.AccountInfo{
-		Nonce:       1,
-		Consumers:   0,
-		Producers:   1,
-		Sufficients: 0,
-		Data: gossamertypes.AccountData{
-			Free:       scale.MustNewUint128(big.NewInt(0)),
-			Reserved:   scale.MustNewUint128(big.NewInt(0)),
-			MiscFrozen: scale.MustNewUint128(big.NewInt(0)),
-			FreeFrozen: scale.MustNewUint128(big.NewInt(0)),
-		},
-	}
-
-	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
-	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
-	assert.NoError(t, err)
-
-	assert.Equal(t, expectedAliceAccountInfo, aliceAccountInfo)
+	// Alice's last provider is removed, which reaps the account.
+	assert.Empty(t, (*storage).Get(keyStorageAccountAlice))
 }
 
 func Test_Balances_TransferAll_Success_KeepAlive(t *testing.T) {
@@ -18,7 +18,7 @@ func Test_BlockBuilder_Inherent_Extrinsics(t *testing.T) {
 	err := idata.SetInherent(gossamertypes.Timstap0, uint64(time))
 	assert.NoError(t, err)
 
-	decoder := types.NewRuntimeDecoder(modules, newSignedExtra(), log.NewLogger())
+	decoder := types.NewRuntimeDecoder(modules, newSignedExtra(), lookup, log.NewLogger())
 
 	rt, _ := newTestRuntime(t)
 	metadata := runtimeMetadata(t, rt)
@@ -46,3 +46,18 @@ func Test_Grandpa_Authorities(t *testing.T) {
 
 	assert.Equal(t, storageAuthorityList.AuthorityList.Bytes(), result)
 }
+
+func Test_Grandpa_CurrentSetId(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	setId := sc.U64(3)
+
+	keyGrandpaHash, _ := common.Twox128Hash([]byte("Grandpa"))
+	keyCurrentSetIdHash, _ := common.Twox128Hash([]byte("CurrentSetId"))
+	err := (*storage).Put(append(keyGrandpaHash, keyCurrentSetIdHash...), setId.Bytes())
+	assert.NoError(t, err)
+
+	result, err := rt.Exec("GrandpaApi_current_set_id", []byte{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, setId.Bytes(), result)
+}
@@ -4,6 +4,8 @@ Targets WebAssembly MVP
 package main
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/api/account_nonce"
 	apiAura "github.com/LimeChain/gosemble/api/aura"
@@ -25,12 +27,22 @@ import (
 	"github.com/LimeChain/gosemble/frame/balances"
 	"github.com/LimeChain/gosemble/frame/executive"
 	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/indices"
+	mbm "github.com/LimeChain/gosemble/frame/multi_block_migrations"
+	"github.com/LimeChain/gosemble/frame/multisig"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/proxy"
+	"github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/sudo"
 	"github.com/LimeChain/gosemble/frame/system"
 	sysExtensions "github.com/LimeChain/gosemble/frame/system/extensions"
 	tm "github.com/LimeChain/gosemble/frame/testable"
 	"github.com/LimeChain/gosemble/frame/timestamp"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	txExtensions "github.com/LimeChain/gosemble/frame/transaction_payment/extensions"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/frame/vesting"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -43,24 +55,120 @@ const (
 const (
 	BalancesMaxLocks    = 50
 	BalancesMaxReserves = 50
+	BalancesMaxHolds    = 50
+	BalancesMaxFreezes  = 50
 )
 
 const (
 	TimestampMinimumPeriod = 1 * 1_000 // 1 second
 )
 
+const (
+	// UtilityBatchedCallsLimit is the limit on the number of batched calls,
+	// half of the maximum possible allocation divided by the aligned call size.
+	UtilityBatchedCallsLimit = 10_922
+)
+
+const (
+	// MultisigMaxSignatories is the maximum number of signatories of a multisig operation.
+	MultisigMaxSignatories = 100
+)
+
+const (
+	// ProxyMaxProxies is the maximum number of proxies of a single account.
+	ProxyMaxProxies = 32
+	// ProxyMaxPending is the maximum number of pending announcements of a single proxy.
+	ProxyMaxPending = 32
+)
+
+const (
+	// VestingMaxVestingSchedules is the maximum number of vesting schedules of a single account.
+	VestingMaxVestingSchedules = 28
+)
+
+const (
+	// SchedulerMaxScheduledPerBlock is the maximum number of tasks in the agenda of a single block.
+	SchedulerMaxScheduledPerBlock = 50
+)
+
+const (
+	// SessionPeriod is the number of blocks in a session, an hour with 2 second blocks.
+	SessionPeriod = 1_800
+	// SessionOffset is the block number of the first session change.
+	SessionOffset = 0
+)
+
 var (
 	BalancesExistentialDeposit = sc.NewU128(1 * constants.Dollar)
 )
 
+var (
+	// MultisigDepositBase is the deposit for storing a multisig operation,
+	// which consists of one item of 88 bytes.
+	MultisigDepositBase = sc.NewU128(15*constants.Cents + 88*6*constants.Cents)
+	// MultisigDepositFactor is the additional deposit per unit of threshold, for the 32 bytes of an approving account.
+	MultisigDepositFactor = sc.NewU128(32 * 6 * constants.Cents)
+)
+
+var (
+	// ProxyDepositBase is the deposit for storing the proxies of an account, which consists of one item of 8 bytes.
+	ProxyDepositBase = sc.NewU128(15*constants.Cents + 8*6*constants.Cents)
+	// ProxyDepositFactor is the additional deposit per proxy, for the 33 bytes of a proxy definition.
+	ProxyDepositFactor = sc.NewU128(33 * 6 * constants.Cents)
+	// ProxyAnnouncementDepositBase is the deposit for storing the announcements of a proxy,
+	// which consists of one item of 8 bytes.
+	ProxyAnnouncementDepositBase = sc.NewU128(15*constants.Cents + 8*6*constants.Cents)
+	// ProxyAnnouncementDepositFactor is the additional deposit per announcement, for the 68 bytes of an announcement.
+	ProxyAnnouncementDepositFactor = sc.NewU128(68 * 6 * constants.Cents)
+)
+
+var (
+	// VestingMinVestedTransfer is the minimum amount, which can be transferred with a vesting schedule.
+	VestingMinVestedTransfer = sc.NewU128(1 * constants.Dollar)
+)
+
+var (
+	// IndicesDeposit is the deposit, reserved for claiming an account index.
+	IndicesDeposit = sc.NewU128(1 * constants.Dollar)
+)
+
+var (
+	// SchedulerMaximumWeightRatio is the portion of the maximum block weight, which can be used by scheduled tasks.
+	SchedulerMaximumWeightRatio = primitives.Perbill{Percentage: 80}
+)
+
+var (
+	// PreimageBaseDeposit is the deposit for storing the request status of a preimage,
+	// which consists of two items of 64 bytes.
+	PreimageBaseDeposit = sc.NewU128(2*15*constants.Cents + 64*6*constants.Cents)
+	// PreimageByteDeposit is the additional deposit per byte of a preimage.
+	PreimageByteDeposit = sc.NewU128(6 * constants.Cents)
+)
+
 var (
 	DbWeight = constants.RocksDbWeight
 )
 
+var (
+	// TransactionByteFee is the fee charged per byte of an extrinsic.
+	TransactionByteFee = sc.NewU128(1)
+)
+
 var (
 	OperationalFeeMultiplier                        = sc.U8(5)
 	WeightToFee              primitives.WeightToFee = primitives.IdentityFee{}
-	LengthToFee              primitives.WeightToFee = primitives.IdentityFee{}
+	LengthToFee              primitives.WeightToFee = primitives.NewConstantMultiplier(TransactionByteFee)
+)
+
+var (
+	// TransactionPaymentTargetBlockFullness is the portion of the normal block weight, above which fees rise.
+	TransactionPaymentTargetBlockFullness = primitives.PerquintillFromPercent(25)
+	// TransactionPaymentAdjustmentVariable is the rate of fee adjustment.
+	TransactionPaymentAdjustmentVariable = primitives.FixedU128SaturatingFromRational(sc.NewU128(3), sc.NewU128(100_000))
+	// TransactionPaymentMinimumMultiplier is the lowest fee multiplier.
+	TransactionPaymentMinimumMultiplier = primitives.FixedU128SaturatingFromRational(sc.NewU128(1), sc.NewU128(1_000_000_000))
+	// TransactionPaymentMaximumMultiplier is the highest fee multiplier.
+	TransactionPaymentMaximumMultiplier = primitives.FixedU128{Inner: sc.MaxU128()}
 )
 
 const (
@@ -70,6 +178,16 @@ const (
 	GrandpaIndex
 	BalancesIndex
 	TxPaymentsIndex
+	SudoIndex
+	UtilityIndex
+	MultiBlockMigrationsIndex
+	MultisigIndex
+	ProxyIndex
+	VestingIndex
+	IndicesIndex
+	SchedulerIndex
+	PreimageIndex
+	SessionIndex
 	TestableIndex = 255
 )
 
@@ -92,10 +210,13 @@ var (
 var (
 	logger      = log.NewLogger()
 	mdGenerator = primitives.NewMetadataTypeGenerator()
+	// lookup resolves the addresses of the extrinsic signers and in call arguments,
+	// including the account indices, assigned in the Indices module.
+	lookup = indices.NewStaticLookup()
 	// Modules contains all the modules used by the runtime.
 	modules = initializeModules()
 	extra   = newSignedExtra()
-	decoder = types.NewRuntimeDecoder(modules, extra, logger)
+	decoder = types.NewRuntimeDecoder(modules, extra, lookup, logger)
 )
 
 func initializeBlockDefaults() (primitives.BlockWeights, primitives.BlockLength) {
@@ -129,6 +250,8 @@ func initializeModules() []primitives.Module {
 			AuraMaxAuthorities,
 			false,
 			systemModule.StorageDigest,
+			systemModule.DepositLog,
+			sessionDisabledValidators{},
 		),
 		mdGenerator,
 	)
@@ -139,19 +262,170 @@ func initializeModules() []primitives.Module {
 		mdGenerator,
 	)
 
-	grandpaModule := grandpa.New(GrandpaIndex, logger, mdGenerator)
+	grandpaModule := grandpa.New(
+		GrandpaIndex,
+		grandpa.NewConfig(DbWeight, systemModule, systemModule.StorageBlockNumber, systemModule.DepositLog, sessionCurrentIndex),
+		logger,
+		mdGenerator,
+	)
 
 	balancesModule := balances.New(
 		BalancesIndex,
-		balances.NewConfig(DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesExistentialDeposit, systemModule),
+		balances.NewConfig(DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesMaxHolds, BalancesMaxFreezes, BalancesExistentialDeposit, systemModule, lookup),
 		logger,
 		mdGenerator,
 	)
 
 	tpmModule := transaction_payment.New(
 		TxPaymentsIndex,
-		transaction_payment.NewConfig(OperationalFeeMultiplier, WeightToFee, LengthToFee, blockWeights),
+		transaction_payment.NewConfig(
+			OperationalFeeMultiplier,
+			WeightToFee,
+			LengthToFee,
+			blockWeights,
+			transaction_payment.NewTargetedFeeAdjustment(
+				TransactionPaymentTargetBlockFullness,
+				TransactionPaymentAdjustmentVariable,
+				TransactionPaymentMinimumMultiplier,
+				TransactionPaymentMaximumMultiplier,
+				blockWeights,
+				systemModule.StorageBlockWeight,
+			),
+		),
+		mdGenerator,
+	)
+
+	sudoModule := sudo.New(
+		SudoIndex,
+		sudo.NewConfig(DbWeight, systemModule, lookup),
+		mdGenerator,
+		logger,
+	)
+
+	utilityModule := utility.New(
+		UtilityIndex,
+		utility.NewConfig(DbWeight, systemModule, UtilityBatchedCallsLimit),
+		mdGenerator,
+		logger,
+	)
+
+	multiBlockMigrationsModule := mbm.New(
+		MultiBlockMigrationsIndex,
+		mbm.NewConfig(DbWeight, systemModule, blockWeights, systemModule.StorageBlockNumber, steppedMigrations()),
+		mdGenerator,
+		logger,
+	)
+
+	multisigModule := multisig.New(
+		MultisigIndex,
+		multisig.NewConfig(
+			DbWeight,
+			systemModule,
+			balancesModule,
+			MultisigDepositBase,
+			MultisigDepositFactor,
+			MultisigMaxSignatories,
+			systemModule.StorageBlockNumber,
+			systemModule.StorageExtrinsicIndex,
+			lookup,
+		),
 		mdGenerator,
+		logger,
+	)
+
+	proxyModule := proxy.New(
+		ProxyIndex,
+		proxy.NewConfig(
+			DbWeight,
+			systemModule,
+			balancesModule,
+			proxyInstanceFilter(),
+			ProxyDepositBase,
+			ProxyDepositFactor,
+			ProxyMaxProxies,
+			ProxyMaxPending,
+			ProxyAnnouncementDepositBase,
+			ProxyAnnouncementDepositFactor,
+			systemModule.StorageBlockNumber,
+			systemModule.StorageExtrinsicIndex,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	vestingModule := vesting.New(
+		VestingIndex,
+		vesting.NewConfig(
+			DbWeight,
+			systemModule,
+			balancesModule,
+			balancesModule,
+			VestingMinVestedTransfer,
+			VestingMaxVestingSchedules,
+			systemModule.StorageBlockNumber,
+			lookup,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	indicesModule := indices.New(
+		IndicesIndex,
+		indices.NewConfig(
+			DbWeight,
+			systemModule,
+			balancesModule,
+			IndicesDeposit,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	preimageModule := preimage.New(
+		PreimageIndex,
+		preimage.NewConfig(
+			DbWeight,
+			systemModule,
+			balancesModule,
+			PreimageBaseDeposit,
+			PreimageByteDeposit,
+			runtimeCallDecoder{},
+		),
+		mdGenerator,
+		logger,
+	)
+
+	schedulerMaximumWeight, err := SchedulerMaximumWeightRatio.Mul(blockWeights.MaxBlock)
+	if err != nil {
+		logger.Critical(err.Error())
+	}
+
+	schedulerModule := scheduler.New(
+		SchedulerIndex,
+		scheduler.NewConfig(
+			DbWeight,
+			systemModule,
+			schedulerMaximumWeight.(primitives.Weight),
+			SchedulerMaxScheduledPerBlock,
+			preimageModule,
+			systemModule.StorageBlockNumber,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	sessionModule := session.New(
+		SessionIndex,
+		session.NewConfig(
+			DbWeight,
+			systemModule,
+			[]primitives.SessionHandler{auraModule, grandpaModule},
+			session.DefaultSessionManager{},
+			SessionPeriod,
+			SessionOffset,
+		),
+		mdGenerator,
+		logger,
 	)
 
 	testableModule := tm.New(TestableIndex, mdGenerator)
@@ -163,10 +437,42 @@ func initializeModules() []primitives.Module {
 		grandpaModule,
 		balancesModule,
 		tpmModule,
+		sudoModule,
+		utilityModule,
+		multiBlockMigrationsModule,
+		multisigModule,
+		proxyModule,
+		vestingModule,
+		indicesModule,
+		schedulerModule,
+		preimageModule,
+		sessionModule,
 		testableModule,
 	}
 }
 
+// runtimeCallDecoder decodes the calls, referenced by a preimage or migrated by the Scheduler module, with the runtime decoder,
+// which is only available after the modules are initialized.
+type runtimeCallDecoder struct{}
+
+func (runtimeCallDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return decoder.DecodeCall(buffer)
+}
+
+// sessionDisabledValidators provides the disabled validators of the Session module to the Aura module,
+// which is initialized before it, since it is one of the session handlers.
+type sessionDisabledValidators struct{}
+
+func (sessionDisabledValidators) IsDisabled(index sc.U32) (bool, error) {
+	return primitives.MustGetModule(SessionIndex, modules).(session.Module).IsDisabled(index)
+}
+
+// sessionCurrentIndex provides the current session index of the Session module to the Grandpa module,
+// which is initialized before it, since it is one of the session handlers.
+func sessionCurrentIndex() (sc.U32, error) {
+	return primitives.MustGetModule(SessionIndex, modules).(session.Module).CurrentIndex()
+}
+
 func newSignedExtra() primitives.SignedExtra {
 	systemModule := primitives.MustGetModule(SystemIndex, modules).(system.Module)
 	balancesModule := primitives.MustGetModule(BalancesIndex, modules).(balances.Module)
@@ -186,17 +492,53 @@ func newSignedExtra() primitives.SignedExtra {
 	return primitives.NewSignedExtra(extras, mdGenerator)
 }
 
+// migrations returns the storage migrations of the runtime, which are executed on runtime upgrade in the given order,
+// before the OnRuntimeUpgrade hooks of the modules. Storage migrations should be wrapped in support.VersionedMigration.
+func migrations() primitives.OnRuntimeUpgrade {
+	preimageModule := primitives.MustGetModule(PreimageIndex, modules).(preimage.Module)
+
+	return hooks.NewOnRuntimeUpgrades(
+		scheduler.NewMigrationToV1(preimageModule, runtimeCallDecoder{}, DbWeight, logger),
+	)
+}
+
+// steppedMigrations returns the migrations of the runtime, which are executed over multiple blocks after a runtime upgrade,
+// in the given order. Only mandatory extrinsics are applied until all of them are complete.
+func steppedMigrations() []mbm.SteppedMigration {
+	return []mbm.SteppedMigration{}
+}
+
+// proxyInstanceFilter returns the filter of the calls, which can be dispatched by proxies of each type.
+// NonTransfer proxies cannot dispatch any balances calls, vested transfers or index transfers. The runtime does not
+// include governance modules yet, so Governance proxies can only dispatch batches.
+func proxyInstanceFilter() proxy.InstanceFilter {
+	return proxy.NewInstanceFilter(
+		sc.Sequence[proxy.CallGroup]{
+			{ModuleIndex: BalancesIndex},
+			// vested_transfer
+			{ModuleIndex: VestingIndex, FunctionIndices: sc.Sequence[sc.U8]{2}},
+			// transfer
+			{ModuleIndex: IndicesIndex, FunctionIndices: sc.Sequence[sc.U8]{1}},
+		},
+		sc.Sequence[proxy.CallGroup]{
+			{ModuleIndex: UtilityIndex},
+		},
+	)
+}
+
 func runtimeApi() types.RuntimeApi {
 	runtimeExtrinsic := extrinsic.New(modules, extra, mdGenerator, logger)
 	systemModule := primitives.MustGetModule(SystemIndex, modules).(system.Module)
 	auraModule := primitives.MustGetModule(AuraIndex, modules).(aura.Module)
 	grandpaModule := primitives.MustGetModule(GrandpaIndex, modules).(grandpa.Module)
 	txPaymentsModule := primitives.MustGetModule(TxPaymentsIndex, modules).(transaction_payment.Module)
+	multiBlockMigrationsModule := primitives.MustGetModule(MultiBlockMigrationsIndex, modules).(mbm.Module)
 
 	executiveModule := executive.New(
 		systemModule,
 		runtimeExtrinsic,
-		hooks.DefaultOnRuntimeUpgrade{},
+		migrations(),
+		multiBlockMigrationsModule,
 		logger,
 	)
 
@@ -249,6 +591,7 @@ func runtimeApi() types.RuntimeApi {
 		offchainWorkerApi,
 		genesisBuilderApi,
 	}
+	apis = append(apis, tryRuntimeApis(executiveModule)...)
 
 	runtimeApi := types.NewRuntimeApi(apis, logger)
 
@@ -330,6 +673,13 @@ func AuraApiAuthorities(_, _ int32) int64 {
 		Authorities()
 }
 
+//go:export GrandpaApi_current_set_id
+func GrandpaApiCurrentSetId(_, _ int32) int64 {
+	return runtimeApi().
+		Module(apiGrandpa.ApiModuleName).(apiGrandpa.Module).
+		CurrentSetId()
+}
+
 //go:export AccountNonceApi_account_nonce
 func AccountNonceApiAccountNonce(dataPtr int32, dataLen int32) int64 {
 	return runtimeApi().
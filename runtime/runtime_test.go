@@ -15,8 +15,10 @@ import (
 	"github.com/ChainSafe/gossamer/pkg/trie"
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/balances"
+	"github.com/LimeChain/gosemble/frame/sudo"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
+	"github.com/LimeChain/gosemble/frame/utility"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
@@ -59,6 +61,8 @@ var (
 	keyTotalIssuanceHash, _      = common.Twox128Hash([]byte("TotalIssuance"))
 	keyTransactionPaymentHash, _ = common.Twox128Hash([]byte("TransactionPayment"))
 	keyNextFeeMultiplierHash, _  = common.Twox128Hash([]byte("NextFeeMultiplier"))
+	keySudoHash, _               = common.Twox128Hash([]byte("Sudo"))
+	keyKeyHash, _                = common.Twox128Hash([]byte("Key"))
 )
 
 var (
@@ -208,6 +212,26 @@ func assertEmittedTransactionPaymentEvent(t assert.TestingT, event sc.U8, buffer
 	assert.True(t, emitted)
 }
 
+func assertEmittedSudoEvent(t assert.TestingT, event sc.U8, buffer *bytes.Buffer) {
+	var emitted bool
+	eventRecord, err := types.DecodeEventRecord(SudoIndex, sudo.DecodeEvent, buffer)
+	assert.NoError(t, err)
+	if eventRecord.Event.VaryingData[1] == event {
+		emitted = true
+	}
+	assert.True(t, emitted)
+}
+
+func assertEmittedUtilityEvent(t assert.TestingT, event sc.U8, buffer *bytes.Buffer) {
+	var emitted bool
+	eventRecord, err := types.DecodeEventRecord(UtilityIndex, utility.DecodeEvent, buffer)
+	assert.NoError(t, err)
+	if eventRecord.Event.VaryingData[1] == event {
+		emitted = true
+	}
+	assert.True(t, emitted)
+}
+
 func assertStorageDigestItem(t *testing.T, storage *runtime.Storage, digestItem sc.U8) {
 	buffer := bytes.NewBuffer((*storage).Get(append(keySystemHash, keyDigestHash...)))
 	decodeDigest, err := types.DecodeDigest(buffer)
@@ -246,6 +270,11 @@ func setStorageAccountInfo(t *testing.T, storage *runtime.Storage, account []byt
 	return keyStorageAccount, accountInfo
 }
 
+func setStorageSudoKey(t *testing.T, storage *runtime.Storage, account []byte) {
+	err := (*storage).Put(append(keySudoHash, keyKeyHash...), account)
+	assert.NoError(t, err)
+}
+
 func getQueryInfo(t *testing.T, runtime *wazero_runtime.Instance, extrinsic []byte) primitives.RuntimeDispatchInfo {
 	buffer := &bytes.Buffer{}
 
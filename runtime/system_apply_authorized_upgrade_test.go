@@ -24,7 +24,12 @@ func Test_ApplyAuthorizedUpgrade_DispatchOutcome(t *testing.T) {
 
 	initializeBlock(t, rt, parentHash, stateRoot, extrinsicsRoot, blockNumber)
 
-	call, err := ctypes.NewCall(metadata, "System.authorize_upgrade", codeHash)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.authorize_upgrade", codeHash)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -21,7 +21,12 @@ func Test_AuthorizeUpgrade_DispatchOutcome(t *testing.T) {
 
 	initializeBlock(t, rt, parentHash, stateRoot, extrinsicsRoot, blockNumber)
 
-	call, err := ctypes.NewCall(metadata, "System.authorize_upgrade", codeHash)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.authorize_upgrade", codeHash)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -60,7 +65,7 @@ func Test_AuthorizeUpgrade_DispatchOutcome(t *testing.T) {
 
 	decodedCount, err := sc.DecodeCompact[sc.U32](buffer)
 	assert.NoError(t, err)
-	assert.Equal(t, sc.U32(3), decodedCount.Number)
+	assert.Equal(t, sc.U32(4), decodedCount.Number)
 
 	assertEmittedSystemEvent(t, system.EventUpgradeAuthorized, buffer)
 
@@ -22,7 +22,12 @@ func Test_KillPrefix_DispatchOutcome(t *testing.T) {
 	prefix := []byte("test")
 	limit := uint32(2)
 
-	call, err := ctypes.NewCall(metadata, "System.kill_prefix", prefix, limit)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.kill_prefix", prefix, limit)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -24,7 +24,12 @@ func Test_KillStorage_DispatchOutcome(t *testing.T) {
 		[]byte("testkey2"),
 	}
 
-	call, err := ctypes.NewCall(metadata, "System.kill_storage", keys)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.kill_storage", keys)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -9,6 +9,7 @@ import (
 	"github.com/ChainSafe/gossamer/pkg/scale"
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/sudo"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	"github.com/LimeChain/gosemble/primitives/types"
@@ -31,7 +32,12 @@ func Test_SetCode_Success(t *testing.T) {
 
 	initializeBlock(t, rt, parentHash, stateRoot, extrinsicsRoot, blockNumber)
 
-	call, err := ctypes.NewCall(metadata, "System.set_code", codeSpecVersion101)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.set_code", codeSpecVersion101)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -66,16 +72,19 @@ func Test_SetCode_Success(t *testing.T) {
 	// Events are emitted
 	buffer := &bytes.Buffer{}
 
-	assertStorageSystemEventCount(t, storage, uint32(3))
+	assertStorageSystemEventCount(t, storage, uint32(4))
 
 	buffer.Write((*storage).Get(append(keySystemHash, keyEventsHash...)))
 	decodedCount, err := sc.DecodeCompact[sc.U32](buffer)
 	assert.NoError(t, err)
-	assert.Equal(t, uint32(decodedCount.Number.(sc.U32)), uint32(3))
+	assert.Equal(t, uint32(decodedCount.Number.(sc.U32)), uint32(4))
 
 	// Event system code updated
 	assertEmittedSystemEvent(t, system.EventCodeUpdated, buffer)
 
+	// Event sudo sudid
+	assertEmittedSudoEvent(t, sudo.EventSudid, buffer)
+
 	// Event txpayment transaction fee paid
 	assertEmittedTransactionPaymentEvent(t, transaction_payment.EventTransactionFeePaid, buffer)
 
@@ -148,7 +157,12 @@ func Test_Block_Execution_After_Code_Upgrade(t *testing.T) {
 
 	assert.Equal(t, applyExtrinsicResultOutcome.Bytes(), applyResult)
 
-	call, err := ctypes.NewCall(metadata, "System.set_code_without_checks", codeSpecVersion101)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.set_code_without_checks", codeSpecVersion101)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/sudo"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	"github.com/LimeChain/gosemble/primitives/types"
@@ -23,7 +24,12 @@ func Test_SetCodeWithoutChecks_DispatchOutcome(t *testing.T) {
 
 	initializeBlock(t, rt, parentHash, stateRoot, extrinsicsRoot, blockNumber)
 
-	call, err := ctypes.NewCall(metadata, "System.set_code_without_checks", codeSpecVersion101)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.set_code_without_checks", codeSpecVersion101)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -58,18 +64,21 @@ func Test_SetCodeWithoutChecks_DispatchOutcome(t *testing.T) {
 	// Events are emitted
 	buffer := &bytes.Buffer{}
 
-	assertStorageSystemEventCount(t, storage, uint32(3))
+	assertStorageSystemEventCount(t, storage, uint32(4))
 
 	buffer.Reset()
 	buffer.Write((*storage).Get(append(keySystemHash, keyEventsHash...)))
 
 	decodedCount, err := sc.DecodeCompact[sc.U32](buffer)
 	assert.NoError(t, err)
-	assert.Equal(t, uint32(decodedCount.Number.(sc.U32)), uint32(3))
+	assert.Equal(t, uint32(decodedCount.Number.(sc.U32)), uint32(4))
 
 	// Event system code updated
 	assertEmittedSystemEvent(t, system.EventCodeUpdated, buffer)
 
+	// Event sudo sudid
+	assertEmittedSudoEvent(t, sudo.EventSudid, buffer)
+
 	// Event txpayment transaction fee paid
 	assertEmittedTransactionPaymentEvent(t, transaction_payment.EventTransactionFeePaid, buffer)
 
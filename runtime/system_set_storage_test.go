@@ -34,7 +34,12 @@ func Test_SetStorage_DispatchOutcome(t *testing.T) {
 		},
 	}
 
-	call, err := ctypes.NewCall(metadata, "System.set_storage", items)
+	setStorageSudoKey(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	systemCall, err := ctypes.NewCall(metadata, "System.set_storage", items)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Sudo.sudo", systemCall)
 	assert.NoError(t, err)
 
 	extrinsic := ctypes.NewExtrinsic(call)
@@ -0,0 +1,30 @@
+//go:build tryruntime
+
+package main
+
+import (
+	tryruntime "github.com/LimeChain/gosemble/api/try_runtime"
+	"github.com/LimeChain/gosemble/frame/executive"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// tryRuntimeApis returns the TryRuntime API, which is exported only in runtimes built with the `tryruntime` build tag.
+func tryRuntimeApis(executiveModule executive.Module) []primitives.ApiModule {
+	return []primitives.ApiModule{
+		tryruntime.New(executiveModule, blockWeights, decoder, logger),
+	}
+}
+
+//go:export TryRuntime_on_runtime_upgrade
+func TryRuntimeOnRuntimeUpgrade(dataPtr int32, dataLen int32) int64 {
+	return runtimeApi().
+		Module(tryruntime.ApiModuleName).(tryruntime.Module).
+		OnRuntimeUpgrade(dataPtr, dataLen)
+}
+
+//go:export TryRuntime_execute_block
+func TryRuntimeExecuteBlock(dataPtr int32, dataLen int32) int64 {
+	return runtimeApi().
+		Module(tryruntime.ApiModuleName).(tryruntime.Module).
+		ExecuteBlock(dataPtr, dataLen)
+}
@@ -0,0 +1,12 @@
+//go:build !tryruntime
+
+package main
+
+import (
+	"github.com/LimeChain/gosemble/frame/executive"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func tryRuntimeApis(_ executive.Module) []primitives.ApiModule {
+	return nil
+}
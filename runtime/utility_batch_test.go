@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/balances"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/transaction_payment"
+	"github.com/LimeChain/gosemble/frame/utility"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Utility_Batch_DispatchOutcome(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	// Set account info
+	balance, e := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, e)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	initializeBlock(t, rt, parentHash, stateRoot, extrinsicsRoot, blockNumber)
+
+	firstRemark, err := ctypes.NewCall(metadata, "System.remark_with_event", sc.BytesToFixedSequenceU8([]byte("gm")).Bytes())
+	assert.NoError(t, err)
+	secondRemark, err := ctypes.NewCall(metadata, "System.remark_with_event", sc.BytesToFixedSequenceU8([]byte("wagmi")).Bytes())
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Utility.batch", []ctypes.Call{firstRemark, secondRemark})
+	assert.NoError(t, err)
+
+	extrinsic := ctypes.NewExtrinsic(call)
+
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	err = extrinsic.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = extrinsic.Encode(*encoder)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+
+	// Events are emitted
+	buffer := &bytes.Buffer{}
+
+	buffer.Write((*storage).Get(append(keySystemHash, keyEventCountHash...)))
+	storageEventCount, err := sc.DecodeU32(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(8), storageEventCount)
+
+	buffer.Reset()
+	buffer.Write((*storage).Get(append(keySystemHash, keyEventsHash...)))
+
+	decodedCount, err := sc.DecodeCompact[sc.U32](buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, decodedCount.Number, storageEventCount)
+
+	assertEmittedBalancesEvent(t, balances.EventWithdraw, buffer)
+	assertEmittedSystemEvent(t, system.EventRemarked, buffer)
+	assertEmittedUtilityEvent(t, utility.EventItemCompleted, buffer)
+	assertEmittedSystemEvent(t, system.EventRemarked, buffer)
+	assertEmittedUtilityEvent(t, utility.EventItemCompleted, buffer)
+	assertEmittedUtilityEvent(t, utility.EventBatchCompleted, buffer)
+	assertEmittedTransactionPaymentEvent(t, transaction_payment.EventTransactionFeePaid, buffer)
+	assertEmittedSystemEvent(t, system.EventExtrinsicSuccess, buffer)
+
+	assert.Equal(t, applyExtrinsicResultOutcome.Bytes(), res)
+}
+
+func Test_Utility_Batch_Unsigned_DispatchOutcome(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	remark, err := ctypes.NewCall(metadata, "System.remark", []byte{})
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Utility.batch", []ctypes.Call{remark})
+	assert.NoError(t, err)
+
+	extrinsic := ctypes.NewExtrinsic(call)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = extrinsic.Encode(*encoder)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, applyExtrinsicResultBadOriginErr.Bytes(), res)
+}
@@ -0,0 +1,41 @@
+//go:build nonwasmenv
+
+package utils
+
+import "github.com/LimeChain/gosemble/env/emulator"
+
+type WasmMemoryTranslator interface {
+	Int64ToOffsetAndSize(offsetAndSize int64) (offset int32, size int32)
+	Offset32(data []byte) int32
+	BytesToOffsetAndSize(data []byte) int64
+	GetWasmMemorySlice(offset int32, size int32) []byte
+}
+
+// memoryTranslator exchanges data with the host emulator, which registers every
+// region under a synthetic offset instead of its native pointer.
+type memoryTranslator struct{}
+
+func NewMemoryTranslator() WasmMemoryTranslator {
+	return &memoryTranslator{}
+}
+
+func (m memoryTranslator) Int64ToOffsetAndSize(offsetAndSize int64) (offset int32, size int32) {
+	return int32(offsetAndSize), int32(offsetAndSize >> 32)
+}
+
+func (m memoryTranslator) Offset32(data []byte) int32 {
+	return emulator.Current().Memory.Store(data)
+}
+
+func (m memoryTranslator) BytesToOffsetAndSize(data []byte) int64 {
+	offset := emulator.Current().Memory.Store(data)
+	return offsetAndSizeToInt64(offset, int32(len(data)))
+}
+
+func (m memoryTranslator) GetWasmMemorySlice(offset int32, size int32) []byte {
+	return emulator.Current().Memory.Load(offset, size)
+}
+
+func offsetAndSizeToInt64(offset int32, size int32) int64 {
+	return int64(offset) | (int64(size) << 32)
+}